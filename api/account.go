@@ -7,13 +7,31 @@ import (
 
 	db "github.com/mahanth/simplebank/db/sqlc"
 	"github.com/mahanth/simplebank/token"
+	"github.com/mahanth/simplebank/util"
 
 	"github.com/gin-gonic/gin"
 )
 
 type CreateAccountRequest struct {
 	Owner    string `json:"owner" binding:"required"`
-	Currency string `json:"currency" binding:"required,currency`
+	Currency string `json:"currency" binding:"required,currency"`
+	// Type is checking or savings, checking if empty
+	Type string `json:"type" binding:"omitempty,oneof=checking savings"`
+}
+
+// AccountResponse is an account with its balances also given in major units of its currency.
+type AccountResponse struct {
+	db.Account
+	BalanceMoney          util.Money `json:"balance_money"`
+	AvailableBalanceMoney util.Money `json:"available_balance_money"`
+}
+
+func newAccountResponse(account db.Account) AccountResponse {
+	return AccountResponse{
+		Account:               account,
+		BalanceMoney:          util.Money{Amount: account.Balance, Currency: account.Currency},
+		AvailableBalanceMoney: util.Money{Amount: account.AvailableBalance, Currency: account.Currency},
+	}
 }
 
 type GetAccountRequest struct {
@@ -34,6 +52,14 @@ type DeleteAccountRequest struct {
 	ID int64 `uri:"id" binding:"required,min=1"`
 }
 
+type UpdateOverdraftLimitURI struct {
+	ID int64 `uri:"id" binding:"required,min=1"`
+}
+
+type UpdateOverdraftLimitRequest struct {
+	OverdraftLimit int64 `json:"overdraft_limit" binding:"min=0"`
+}
+
 func (server *Server) createAccount(ctx *gin.Context) {
 	var req CreateAccountRequest
 	if err := ctx.ShouldBindJSON(&req); err != nil {
@@ -47,15 +73,19 @@ func (server *Server) createAccount(ctx *gin.Context) {
 		Owner:    authPayload.Username,
 		Balance:  0, // Initial balance is set to 0
 		Currency: req.Currency,
+		Type:     req.Type,
+	}
+	if arg.Type == "" {
+		arg.Type = db.AccountTypeChecking
 	}
 
-	account, err := server.store.CreateAccount(ctx, arg)
+	account, err := server.store.CreateAccount(auditContext(ctx, authPayload.Username, authPayload.Role), arg)
 	if err != nil {
 		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
 		return
 	}
 
-	ctx.JSON(http.StatusOK, account)
+	ctx.JSON(http.StatusOK, newAccountResponse(account))
 }
 
 func (server *Server) getAccount(ctx *gin.Context) {
@@ -74,14 +104,11 @@ func (server *Server) getAccount(ctx *gin.Context) {
 		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
 		return
 	}
-	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
-	if account.Owner != authPayload.Username {
-		err := errors.New("account doesn't belong to the authenticated user")
-		ctx.JSON(http.StatusUnauthorized, errorResponse(err))
+	if _, authorized := server.authorizeAccount(ctx, account, db.HolderPermissionView); !authorized {
 		return
 	}
 
-	ctx.JSON(http.StatusOK, account)
+	ctx.JSON(http.StatusOK, newAccountResponse(account))
 }
 
 func (server *Server) listAccounts(ctx *gin.Context) {
@@ -103,7 +130,12 @@ func (server *Server) listAccounts(ctx *gin.Context) {
 		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
 		return
 	}
-	ctx.JSON(http.StatusOK, accounts)
+
+	rsp := make([]AccountResponse, len(accounts))
+	for i, account := range accounts {
+		rsp[i] = newAccountResponse(account)
+	}
+	ctx.JSON(http.StatusOK, rsp)
 }
 
 func (server *Server) addAccountBalance(ctx *gin.Context) {
@@ -113,15 +145,61 @@ func (server *Server) addAccountBalance(ctx *gin.Context) {
 		return
 	}
 
-	arg := db.AddAccountBalanceParams{
-		ID:     req.ID,
-		Amount: req.Amount,
+	arg := db.AdjustBalanceTxParams{
+		AccountID: req.ID,
+		Amount:    req.Amount,
+		Type:      db.EntryTypeDeposit,
+	}
+
+	result, err := server.store.AdjustBalanceTx(ctx, arg)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			ctx.JSON(http.StatusNotFound, errorResponse(err))
+			return
+		}
+		if errors.Is(err, db.ErrAccountNotActive) {
+			ctx.JSON(http.StatusUnprocessableEntity, errorResponse(err))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+	ctx.JSON(http.StatusOK, newAccountResponse(result.Account))
+}
+
+// updateOverdraftLimit lets a banker set how far below zero an account's balance may go.
+func (server *Server) updateOverdraftLimit(ctx *gin.Context) {
+	var uri UpdateOverdraftLimitURI
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	var req UpdateOverdraftLimitRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	if authPayload.Role != util.BankerRole {
+		err := errors.New("only bankers can change the overdraft limit")
+		ctx.JSON(http.StatusForbidden, errorResponse(err))
+		return
 	}
 
-	account, err := server.store.AddAccountBalance(ctx, arg)
+	account, err := server.store.UpdateAccountOverdraftLimit(auditContext(ctx, authPayload.Username, authPayload.Role), db.UpdateAccountOverdraftLimitParams{
+		ID:             uri.ID,
+		OverdraftLimit: req.OverdraftLimit,
+	})
 	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			ctx.JSON(http.StatusNotFound, errorResponse(err))
+			return
+		}
 		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
 		return
 	}
-	ctx.JSON(http.StatusOK, account)
+
+	ctx.JSON(http.StatusOK, newAccountResponse(account))
 }
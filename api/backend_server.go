@@ -35,6 +35,8 @@ func NewServer(config util.Config, store db.Store) (*Server, error) {
 
 	if v, ok := binding.Validator.Engine().(*validator.Validate); ok {
 		v.RegisterValidation("currency", validCurrency)
+		v.RegisterValidation("memo", validMemo)
+		v.RegisterValidation("reference", validReference)
 	}
 
 	server.setUpRouter()
@@ -62,8 +64,12 @@ func (server *Server) setUpRouter() {
 	authRoutes.POST("/accounts", server.createAccount)
 	authRoutes.GET("/accounts/:id", server.getAccount)
 	authRoutes.GET("/accounts", server.listAccounts)
+	authRoutes.GET("/accounts/:id/transfers", server.listTransfers)
+	authRoutes.GET("/accounts/:id/entries", server.listEntries)
+	authRoutes.PATCH("/accounts/:id/overdraft_limit", server.updateOverdraftLimit)
 	authRoutes.PUT("/addbalance", server.addAccountBalance)
 	authRoutes.POST("/transfers", server.createTransfer)
+	authRoutes.GET("/transfers/search", server.searchTransfers)
 
 	server.router = router
 
@@ -0,0 +1,191 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgx/v5/pgtype"
+	db "github.com/mahanth/simplebank/db/sqlc"
+	"github.com/mahanth/simplebank/token"
+	"github.com/mahanth/simplebank/util"
+)
+
+// ListHistoryRequest holds the paging and filter query parameters shared by the transfer and entry
+// history of an account, which are listed newest first.
+type ListHistoryRequest struct {
+	PageSize int32 `form:"page_size" binding:"required,min=5,max=20"`
+	// PageToken is the next_page_token of the previous page, empty for the first page
+	PageToken string     `form:"page_token"`
+	StartTime *time.Time `form:"start_time" time_format:"2006-01-02T15:04:05Z07:00"`
+	EndTime   *time.Time `form:"end_time" time_format:"2006-01-02T15:04:05Z07:00"`
+	MinAmount *int64     `form:"min_amount" binding:"omitempty,min=0"`
+	MaxAmount *int64     `form:"max_amount" binding:"omitempty,min=0"`
+	Direction string     `form:"direction" binding:"omitempty,oneof=in out"`
+	// CounterpartyAccountID is the account on the other side of the transfer
+	CounterpartyAccountID *int64 `form:"counterparty_account_id" binding:"omitempty,min=1"`
+}
+
+// historyParams binds the account and the history query of the request, responding with an error and
+// returning false if they are invalid or the account is not the authenticated user's.
+// ListEntriesParams has the same fields as the returned ListTransfersParams, so it can be converted to it.
+func (server *Server) historyParams(ctx *gin.Context) (db.ListTransfersParams, bool) {
+	var uri GetAccountRequest
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return db.ListTransfersParams{}, false
+	}
+
+	var req ListHistoryRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return db.ListTransfersParams{}, false
+	}
+	if req.StartTime != nil && req.EndTime != nil && !req.EndTime.After(*req.StartTime) {
+		ctx.JSON(http.StatusBadRequest, errorResponse(errors.New("end_time must be after start_time")))
+		return db.ListTransfersParams{}, false
+	}
+	if req.MinAmount != nil && req.MaxAmount != nil && *req.MaxAmount < *req.MinAmount {
+		ctx.JSON(http.StatusBadRequest, errorResponse(errors.New("max_amount must not be less than min_amount")))
+		return db.ListTransfersParams{}, false
+	}
+
+	arg := db.ListTransfersParams{
+		AccountID: uri.ID,
+		Direction: pgtype.Text{String: req.Direction, Valid: req.Direction != ""},
+		// one row more than the page tells whether there is a next page
+		PageSize: req.PageSize + 1,
+	}
+	if req.PageToken != "" {
+		lastID, err := util.DecodePageToken(req.PageToken)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, errorResponse(err))
+			return db.ListTransfersParams{}, false
+		}
+		arg.BeforeID = pgtype.Int8{Int64: lastID, Valid: true}
+	}
+	if req.StartTime != nil {
+		arg.StartTime = pgtype.Timestamptz{Time: *req.StartTime, Valid: true}
+	}
+	if req.EndTime != nil {
+		arg.EndTime = pgtype.Timestamptz{Time: *req.EndTime, Valid: true}
+	}
+	if req.MinAmount != nil {
+		arg.MinAmount = pgtype.Int8{Int64: *req.MinAmount, Valid: true}
+	}
+	if req.MaxAmount != nil {
+		arg.MaxAmount = pgtype.Int8{Int64: *req.MaxAmount, Valid: true}
+	}
+	if req.CounterpartyAccountID != nil {
+		arg.CounterpartyAccountID = pgtype.Int8{Int64: *req.CounterpartyAccountID, Valid: true}
+	}
+
+	account, found := server.loadAccount(ctx, uri.ID)
+	if !found {
+		return db.ListTransfersParams{}, false
+	}
+	if _, authorized := server.authorizeAccount(ctx, account, db.HolderPermissionView); !authorized {
+		return db.ListTransfersParams{}, false
+	}
+	return arg, true
+}
+
+type ListTransfersResponse struct {
+	Transfers []db.Transfer `json:"transfers"`
+	// NextPageToken is empty on the last page
+	NextPageToken string `json:"next_page_token"`
+}
+
+func (server *Server) listTransfers(ctx *gin.Context) {
+	arg, ok := server.historyParams(ctx)
+	if !ok {
+		return
+	}
+
+	transfers, err := server.store.ListTransfers(ctx, arg)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	var rsp ListTransfersResponse
+	rsp.Transfers, rsp.NextPageToken = util.NextPage(transfers, arg.PageSize-1, func(transfer db.Transfer) int64 {
+		return transfer.ID
+	})
+	ctx.JSON(http.StatusOK, rsp)
+}
+
+type ListEntriesResponse struct {
+	Entries []db.Entry `json:"entries"`
+	// NextPageToken is empty on the last page
+	NextPageToken string `json:"next_page_token"`
+}
+
+func (server *Server) listEntries(ctx *gin.Context) {
+	arg, ok := server.historyParams(ctx)
+	if !ok {
+		return
+	}
+
+	entries, err := server.store.ListEntries(ctx, db.ListEntriesParams(arg))
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	var rsp ListEntriesResponse
+	rsp.Entries, rsp.NextPageToken = util.NextPage(entries, arg.PageSize-1, func(entry db.Entry) int64 {
+		return entry.ID
+	})
+	ctx.JSON(http.StatusOK, rsp)
+}
+
+// SearchTransfersRequest holds the query parameters of a search over the authenticated user's transfers.
+type SearchTransfersRequest struct {
+	// Query is matched against the memo and reference of the transfers, in web search syntax
+	Query    string `form:"q" binding:"required,max=100"`
+	PageSize int32  `form:"page_size" binding:"required,min=5,max=20"`
+	// PageToken is the next_page_token of the previous page, empty for the first page
+	PageToken             string `form:"page_token"`
+	CounterpartyAccountID *int64 `form:"counterparty_account_id" binding:"omitempty,min=1"`
+}
+
+func (server *Server) searchTransfers(ctx *gin.Context) {
+	var req SearchTransfersRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	arg := db.SearchTransfersParams{
+		Owner: authPayload.Username,
+		Query: req.Query,
+		// one row more than the page tells whether there is a next page
+		PageSize: req.PageSize + 1,
+	}
+	if req.PageToken != "" {
+		lastID, err := util.DecodePageToken(req.PageToken)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, errorResponse(err))
+			return
+		}
+		arg.BeforeID = pgtype.Int8{Int64: lastID, Valid: true}
+	}
+	if req.CounterpartyAccountID != nil {
+		arg.CounterpartyAccountID = pgtype.Int8{Int64: *req.CounterpartyAccountID, Valid: true}
+	}
+
+	transfers, err := server.store.SearchTransfers(ctx, arg)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	var rsp ListTransfersResponse
+	rsp.Transfers, rsp.NextPageToken = util.NextPage(transfers, req.PageSize, func(transfer db.Transfer) int64 {
+		return transfer.ID
+	})
+	ctx.JSON(http.StatusOK, rsp)
+}
@@ -1,12 +1,14 @@
 package api
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
 	"strings"
 
 	"github.com/gin-gonic/gin"
+	db "github.com/mahanth/simplebank/db/sqlc"
 	"github.com/mahanth/simplebank/token"
 )
 
@@ -54,3 +56,14 @@ func authMiddleware(tokenMaker token.Maker) gin.HandlerFunc {
 
 	}
 }
+
+// auditContext returns the request context carrying who makes the request and from where, for
+// the store to record in the audit log.
+func auditContext(ctx *gin.Context, username string, role string) context.Context {
+	return db.WithAuditActor(ctx, db.AuditActor{
+		Username:  username,
+		Role:      role,
+		ClientIP:  ctx.ClientIP(),
+		UserAgent: ctx.Request.UserAgent(),
+	})
+}
@@ -5,17 +5,37 @@ import (
 	"fmt"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	db "github.com/mahanth/simplebank/db/sqlc"
 	"github.com/mahanth/simplebank/token"
+	"github.com/mahanth/simplebank/util"
 )
 
+const (
+	idempotencyKeyHeader    = "Idempotency-Key"
+	maxIdempotencyKeyLength = 255
+)
+
+// TransferMoneyRequest gives the amount either in minor units with its currency, or as Money,
+// e.g. {"amount":"12.50","currency":"USD"}, in major units.
 type TransferMoneyRequest struct {
-	FromAccountId int64  `json:"from_account_id" binding:"required,min=1"`
-	ToAccountId   int64  `json:"to_account_id" binding:"required,min=1"`
-	Amount        int64  `json:"amount" binding:"required,gt=0"`
-	Currency      string `json:"currency" binding:"required,currency"`
+	FromAccountId int64       `json:"from_account_id" binding:"required,min=1"`
+	ToAccountId   int64       `json:"to_account_id" binding:"required,min=1,nefield=FromAccountId"`
+	Amount        int64       `json:"amount" binding:"required_without=Money,omitempty,gt=0"`
+	Currency      string      `json:"currency" binding:"required_without=Money,omitempty,currency"`
+	Money         *util.Money `json:"money" binding:"required_without=Amount"`
+	Memo          string      `json:"memo" binding:"memo"`
+	Reference     string      `json:"reference" binding:"reference"`
+}
+
+// TransferMoneyResponse is the result of a transfer with the amounts sent and received also given
+// in major units of the accounts' currencies.
+type TransferMoneyResponse struct {
+	db.TransferTxResult
+	Sent     util.Money `json:"sent"`
+	Received util.Money `json:"received"`
 }
 
 func (server *Server) createTransfer(ctx *gin.Context) {
@@ -24,37 +44,133 @@ func (server *Server) createTransfer(ctx *gin.Context) {
 		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 		return
 	}
+	if req.Money != nil {
+		if req.Amount != 0 || req.Currency != "" {
+			err := errors.New("amount and currency must not be given with money")
+			ctx.JSON(http.StatusBadRequest, errorResponse(err))
+			return
+		}
+		if req.Money.Amount <= 0 || !util.IsSupportedCurrency(req.Money.Currency) {
+			err := fmt.Errorf("money must be a positive amount of a supported currency, got %s", req.Money)
+			ctx.JSON(http.StatusBadRequest, errorResponse(err))
+			return
+		}
+		req.Amount, req.Currency = req.Money.Amount, req.Money.Currency
+	}
+
+	idempotencyKey := ctx.GetHeader(idempotencyKeyHeader)
+	if len(idempotencyKey) > maxIdempotencyKeyLength {
+		err := fmt.Errorf("%s header must not be longer than %d characters", idempotencyKeyHeader, maxIdempotencyKeyLength)
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
 
 	fromAccount, validFromAccount := server.validAccount(ctx, req.FromAccountId, req.Currency)
-	_, validToAccount := server.validAccount(ctx, req.FromAccountId, req.Currency)
+	if !validFromAccount {
+		return
+	}
 
-	if !validFromAccount || !validToAccount {
+	// the amount is in the sender's currency and converted if the receiver holds another one
+	toAccount, foundToAccount := server.loadAccount(ctx, req.ToAccountId)
+	if !foundToAccount {
 		return
 	}
 
 	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
 
-	if authPayload.Username != fromAccount.Owner {
-		err := errors.New("from account does not belong to the authenticated user")
-		ctx.JSON(http.StatusUnauthorized, errorResponse(err))
+	// the store holds the transfer to the limit set for a holder of the account
+	if _, authorized := server.authorizeAccount(ctx, fromAccount, db.HolderPermissionTransfer); !authorized {
+		return
+	}
+
+	if !activeAccount(ctx, fromAccount) || !activeAccount(ctx, toAccount) {
 		return
 	}
 	arg := db.TransferTxParams{
-		FromAccountID: req.FromAccountId,
-		ToAccountID:   req.ToAccountId,
-		Amount:        req.Amount,
+		FromAccountID:  req.FromAccountId,
+		ToAccountID:    req.ToAccountId,
+		Amount:         req.Amount,
+		Memo:           req.Memo,
+		Reference:      req.Reference,
+		Username:       authPayload.Username,
+		IdempotencyKey: idempotencyKey,
+		Role:           authPayload.Role,
+	}
+
+	transferTx := server.store.TransferTx
+	if toAccount.Currency != fromAccount.Currency {
+		transferTx = server.store.CrossCurrencyTransferTx
 	}
 
-	result, err := server.store.TransferTx(ctx, arg)
+	result, err := transferTx(auditContext(ctx, authPayload.Username, authPayload.Role), arg)
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		// the store refuses amounts above the approval threshold, which are held for a banker instead
+		if errors.Is(err, db.ErrApprovalRequired) {
+			server.requestTransferApproval(ctx, authPayload, arg)
+			return
+		}
+		transferErrorResponse(ctx, err)
 		return
 	}
 
-	ctx.JSON(http.StatusOK, result)
+	ctx.JSON(http.StatusOK, TransferMoneyResponse{
+		TransferTxResult: result,
+		Sent:             util.Money{Amount: result.Transfer.Amount, Currency: fromAccount.Currency},
+		Received:         util.Money{Amount: result.Transfer.ToAmount, Currency: toAccount.Currency},
+	})
+}
+
+// requestTransferApproval holds the funds of a transfer that needs approval and responds with
+// 202 Accepted and the pending approval instead of a transfer.
+func (server *Server) requestTransferApproval(ctx *gin.Context, authPayload *token.Payload, arg db.TransferTxParams) {
+	result, err := server.store.RequestTransferApprovalTx(auditContext(ctx, authPayload.Username, authPayload.Role), db.RequestTransferApprovalTxParams{
+		TransferTxParams: arg,
+		ExpiresAt:        time.Now().Add(server.config.TransferApprovalTTL),
+	})
+	if err != nil {
+		transferErrorResponse(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusAccepted, result)
+}
+
+// transferErrorResponse responds with the error a money-moving store transaction failed with.
+func transferErrorResponse(ctx *gin.Context, err error) {
+	var limitErr *db.TransferLimitError
+	if errors.As(err, &limitErr) {
+		ctx.JSON(http.StatusUnprocessableEntity, gin.H{"error": err.Error(), "transfer_limit": limitErr})
+		return
+	}
+	if errors.Is(err, db.ErrInsufficientFunds) || errors.Is(err, db.ErrExchangeRateNotFound) ||
+		errors.Is(err, db.ErrAccountNotActive) || errors.Is(err, db.ErrCurrencyMismatch) ||
+		errors.Is(err, db.ErrApprovalRequired) {
+		ctx.JSON(http.StatusUnprocessableEntity, errorResponse(err))
+		return
+	}
+	if errors.Is(err, db.ErrIdempotencyKeyReused) {
+		ctx.JSON(http.StatusConflict, errorResponse(err))
+		return
+	}
+	ctx.JSON(http.StatusInternalServerError, errorResponse(err))
 }
 
 func (server *Server) validAccount(ctx *gin.Context, accountID int64, currency string) (db.Account, bool) {
+	account, found := server.loadAccount(ctx, accountID)
+	if !found {
+		return account, false
+	}
+
+	if account.Currency != currency {
+		err := fmt.Errorf("account %d currency mismatch: expected %s, got %s", accountID, currency, account.Currency)
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return account, false
+	}
+
+	return account, true
+}
+
+func (server *Server) loadAccount(ctx *gin.Context, accountID int64) (db.Account, bool) {
 	account, err := server.store.GetAccount(ctx, accountID)
 	if err != nil {
 		if strings.Contains(err.Error(), "account not found") {
@@ -64,12 +180,32 @@ func (server *Server) validAccount(ctx *gin.Context, accountID int64, currency s
 		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
 		return account, false
 	}
+	return account, true
+}
 
-	if account.Currency != currency {
-		err := fmt.Errorf("account %d currency mismatch: expected %s, got %s", accountID, currency, account.Currency)
-		ctx.JSON(http.StatusBadRequest, errorResponse(err))
-		return account, false
+// authorizeAccount responds with an error and returns false unless the authenticated user owns
+// the account or is an active holder of it with at least permission.
+func (server *Server) authorizeAccount(ctx *gin.Context, account db.Account, permission string) (db.AccountAccess, bool) {
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	access, err := db.GetAccountAccess(ctx, server.store, account, authPayload.Username)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return access, false
+	}
+	if !access.Allows(permission) {
+		err := fmt.Errorf("authenticated user is not allowed to %s account %d", permission, account.ID)
+		ctx.JSON(http.StatusUnauthorized, errorResponse(err))
+		return access, false
 	}
+	return access, true
+}
 
-	return account, true
+// activeAccount responds with an error and returns false if money cannot move in or out of the account.
+func activeAccount(ctx *gin.Context, account db.Account) bool {
+	if account.Status != db.AccountStatusActive {
+		err := fmt.Errorf("account %d is %s", account.ID, account.Status)
+		ctx.JSON(http.StatusUnprocessableEntity, errorResponse(err))
+		return false
+	}
+	return true
 }
@@ -55,7 +55,8 @@ func (server *Server) createUser(ctx *gin.Context) {
 		Email:          req.Email,
 	}
 
-	user, err := server.store.CreateUser(ctx, arg)
+	// the new user is the actor, as signing up needs no login
+	user, err := server.store.CreateUser(auditContext(ctx, req.UserName, util.DepositorRole), arg)
 	if err != nil {
 		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
 		return
@@ -116,7 +117,7 @@ func (server *Server) loginUser(ctx *gin.Context) {
 		return
 	}
 
-	session, err := server.store.CreateSession(ctx, db.CreateSessionParams{
+	session, err := server.store.CreateSession(auditContext(ctx, user.Username, user.Role), db.CreateSessionParams{
 		ID:           pgtype.UUID{Bytes: refreshTokenPayload.ID, Valid: true},
 		Username:     refreshTokenPayload.Username,
 		RefreshToken: refreshToken,
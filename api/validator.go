@@ -3,9 +3,10 @@ package api
 import (
 	"github.com/go-playground/validator/v10"
 	"github.com/mahanth/simplebank/util"
+	"github.com/mahanth/simplebank/val"
 )
 
-// Custom validation function to check if the currency is supported
+// Custom validation function to check if the currency is enabled in the currency registry
 var validCurrency validator.Func = func(fl validator.FieldLevel) bool {
 	currency, ok := fl.Field().Interface().(string)
 	if !ok {
@@ -13,3 +14,15 @@ var validCurrency validator.Func = func(fl validator.FieldLevel) bool {
 	}
 	return util.IsSupportedCurrency(currency)
 }
+
+// validMemo checks the memo of a transfer with the same rules as the gRPC API
+var validMemo validator.Func = func(fl validator.FieldLevel) bool {
+	memo, ok := fl.Field().Interface().(string)
+	return ok && val.ValidateMemo(memo) == nil
+}
+
+// validReference checks the external reference of a transfer with the same rules as the gRPC API
+var validReference validator.Func = func(fl validator.FieldLevel) bool {
+	reference, ok := fl.Field().Interface().(string)
+	return ok && val.ValidateReference(reference) == nil
+}
@@ -0,0 +1,5 @@
+ALTER TABLE "entries" DROP COLUMN IF EXISTS "transfer_id";
+
+ALTER TABLE "entries" DROP CONSTRAINT IF EXISTS "entries_type_check";
+
+ALTER TABLE "entries" DROP COLUMN IF EXISTS "type";
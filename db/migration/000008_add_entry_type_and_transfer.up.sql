@@ -0,0 +1,33 @@
+ALTER TABLE "entries" ADD COLUMN "type" varchar NOT NULL DEFAULT 'adjustment';
+
+ALTER TABLE "entries" ALTER COLUMN "type" DROP DEFAULT;
+
+ALTER TABLE "entries" ADD CONSTRAINT "entries_type_check"
+  CHECK ("type" IN ('transfer', 'deposit', 'withdrawal', 'fee', 'adjustment'));
+
+ALTER TABLE "entries" ADD COLUMN "transfer_id" bigint;
+
+ALTER TABLE "entries" ADD FOREIGN KEY ("transfer_id") REFERENCES "transfers" ("id");
+
+CREATE INDEX ON "entries" ("transfer_id");
+
+-- the entries of a transfer were booked in its transaction, so they were created at the same time
+UPDATE "entries"
+SET "type" = 'transfer', "transfer_id" = "transfers"."id"
+FROM "transfers"
+WHERE "entries"."created_at" = "transfers"."created_at"
+  AND (("entries"."account_id" = "transfers"."from_account_id" AND "entries"."amount" = -"transfers"."amount")
+    OR ("entries"."account_id" = "transfers"."to_account_id" AND "entries"."amount" = "transfers"."amount"));
+
+-- balances the entries do not account for, such as opening balances, are booked as adjustments
+-- dated when the account was opened, so that the entries of every account add up to its balance
+INSERT INTO "entries" ("account_id", "amount", "created_at", "type")
+SELECT "accounts"."id", "accounts"."balance" - COALESCE(SUM("entries"."amount"), 0), "accounts"."created_at", 'adjustment'
+FROM "accounts"
+LEFT JOIN "entries" ON "entries"."account_id" = "accounts"."id"
+GROUP BY "accounts"."id"
+HAVING "accounts"."balance" <> COALESCE(SUM("entries"."amount"), 0);
+
+COMMENT ON COLUMN "entries"."type" IS 'transfer, deposit, withdrawal, fee or adjustment';
+
+COMMENT ON COLUMN "entries"."transfer_id" IS 'transfer that produced the entry, if any';
@@ -0,0 +1,13 @@
+CREATE TABLE "reconciliation_reports" (
+  "id" bigserial PRIMARY KEY,
+  "triggered_by" varchar NOT NULL,
+  "discrepancy_count" integer NOT NULL,
+  "discrepancies" jsonb NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE INDEX ON "reconciliation_reports" ("created_at");
+
+COMMENT ON COLUMN "reconciliation_reports"."triggered_by" IS 'username of the banker who ran it, or scheduler';
+
+COMMENT ON COLUMN "reconciliation_reports"."discrepancies" IS 'list of ledger invariants that did not hold';
@@ -0,0 +1,26 @@
+CREATE TABLE "exchange_rates" (
+  "id" bigserial PRIMARY KEY,
+  "base_currency" varchar NOT NULL,
+  "quote_currency" varchar NOT NULL,
+  "rate" numeric NOT NULL,
+  "effective_at" timestamptz NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE UNIQUE INDEX ON "exchange_rates" ("base_currency", "quote_currency", "effective_at");
+
+ALTER TABLE "exchange_rates" ADD CONSTRAINT "exchange_rates_rate_check" CHECK ("rate" > 0);
+
+COMMENT ON COLUMN "exchange_rates"."rate" IS 'units of quote currency per unit of base currency';
+
+ALTER TABLE "transfers" ADD COLUMN "to_amount" bigint;
+
+UPDATE "transfers" SET "to_amount" = "amount";
+
+ALTER TABLE "transfers" ALTER COLUMN "to_amount" SET NOT NULL;
+
+ALTER TABLE "transfers" ADD COLUMN "exchange_rate" numeric;
+
+COMMENT ON COLUMN "transfers"."to_amount" IS 'amount credited, in the currency of the receiving account';
+
+COMMENT ON COLUMN "transfers"."exchange_rate" IS 'rate applied when the accounts differ in currency';
@@ -0,0 +1,56 @@
+CREATE TABLE "scheduled_transfers" (
+  "id" bigserial PRIMARY KEY,
+  "owner" varchar NOT NULL,
+  "from_account_id" bigint NOT NULL,
+  "to_account_id" bigint NOT NULL,
+  "amount" bigint NOT NULL,
+  "schedule" varchar NOT NULL,
+  "start_at" timestamptz NOT NULL,
+  "end_at" timestamptz,
+  "next_run_at" timestamptz,
+  "status" varchar NOT NULL DEFAULT 'active',
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  "updated_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "scheduled_transfer_executions" (
+  "id" bigserial PRIMARY KEY,
+  "scheduled_transfer_id" bigint NOT NULL,
+  "scheduled_for" timestamptz NOT NULL,
+  "status" varchar NOT NULL,
+  "transfer_id" bigint,
+  "failure_reason" varchar,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE INDEX ON "scheduled_transfers" ("owner");
+
+CREATE INDEX ON "scheduled_transfers" ("status", "next_run_at");
+
+CREATE UNIQUE INDEX ON "scheduled_transfer_executions" ("scheduled_transfer_id", "scheduled_for");
+
+ALTER TABLE "scheduled_transfers" ADD CONSTRAINT "scheduled_transfers_amount_check" CHECK ("amount" > 0);
+
+ALTER TABLE "scheduled_transfers" ADD CONSTRAINT "scheduled_transfers_status_check"
+  CHECK ("status" IN ('active', 'paused', 'cancelled', 'completed'));
+
+ALTER TABLE "scheduled_transfer_executions" ADD CONSTRAINT "scheduled_transfer_executions_status_check"
+  CHECK ("status" IN ('succeeded', 'failed'));
+
+COMMENT ON COLUMN "scheduled_transfers"."schedule" IS 'cron expression or @every interval, evaluated in UTC';
+
+COMMENT ON COLUMN "scheduled_transfers"."next_run_at" IS 'null once the schedule is cancelled or completed';
+
+COMMENT ON COLUMN "scheduled_transfers"."status" IS 'active, paused, cancelled or completed';
+
+COMMENT ON COLUMN "scheduled_transfer_executions"."failure_reason" IS 'why the transfer was not made, e.g. insufficient funds';
+
+ALTER TABLE "scheduled_transfers" ADD FOREIGN KEY ("owner") REFERENCES "users" ("username");
+
+ALTER TABLE "scheduled_transfers" ADD FOREIGN KEY ("from_account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "scheduled_transfers" ADD FOREIGN KEY ("to_account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "scheduled_transfer_executions" ADD FOREIGN KEY ("scheduled_transfer_id") REFERENCES "scheduled_transfers" ("id");
+
+ALTER TABLE "scheduled_transfer_executions" ADD FOREIGN KEY ("transfer_id") REFERENCES "transfers" ("id");
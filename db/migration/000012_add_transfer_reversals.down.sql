@@ -0,0 +1,12 @@
+UPDATE "entries" SET "type" = 'adjustment' WHERE "type" = 'reversal';
+
+ALTER TABLE "entries" DROP CONSTRAINT IF EXISTS "entries_type_check";
+
+ALTER TABLE "entries" ADD CONSTRAINT "entries_type_check"
+  CHECK ("type" IN ('transfer', 'deposit', 'withdrawal', 'fee', 'adjustment'));
+
+DROP TABLE IF EXISTS "transfer_reversals";
+
+ALTER TABLE "transfers" DROP COLUMN IF EXISTS "status";
+
+ALTER TABLE "transfers" DROP COLUMN IF EXISTS "reversed_amount";
@@ -0,0 +1,42 @@
+ALTER TABLE "transfers" ADD COLUMN "reversed_amount" bigint NOT NULL DEFAULT 0;
+
+ALTER TABLE "transfers" ADD COLUMN "status" varchar NOT NULL DEFAULT 'completed';
+
+ALTER TABLE "transfers" ADD CONSTRAINT "transfers_reversed_amount_check"
+  CHECK ("reversed_amount" >= 0 AND "reversed_amount" <= "amount");
+
+ALTER TABLE "transfers" ADD CONSTRAINT "transfers_status_check"
+  CHECK ("status" IN ('completed', 'partially_reversed', 'reversed'));
+
+COMMENT ON COLUMN "transfers"."reversed_amount" IS 'part of amount refunded to the sender so far';
+
+COMMENT ON COLUMN "transfers"."status" IS 'completed, partially_reversed or reversed';
+
+CREATE TABLE "transfer_reversals" (
+  "id" bigserial PRIMARY KEY,
+  "transfer_id" bigint NOT NULL,
+  "amount" bigint NOT NULL,
+  "to_amount" bigint NOT NULL,
+  "reason" varchar NOT NULL,
+  "initiated_by" varchar NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE INDEX ON "transfer_reversals" ("transfer_id");
+
+ALTER TABLE "transfer_reversals" ADD CONSTRAINT "transfer_reversals_amount_check" CHECK ("amount" > 0);
+
+COMMENT ON COLUMN "transfer_reversals"."amount" IS 'credited back to the sender, in the currency of the transfer amount';
+
+COMMENT ON COLUMN "transfer_reversals"."to_amount" IS 'debited from the receiver, in the currency of the receiving account';
+
+ALTER TABLE "transfer_reversals" ADD FOREIGN KEY ("transfer_id") REFERENCES "transfers" ("id");
+
+ALTER TABLE "transfer_reversals" ADD FOREIGN KEY ("initiated_by") REFERENCES "users" ("username");
+
+ALTER TABLE "entries" DROP CONSTRAINT "entries_type_check";
+
+ALTER TABLE "entries" ADD CONSTRAINT "entries_type_check"
+  CHECK ("type" IN ('transfer', 'deposit', 'withdrawal', 'fee', 'adjustment', 'reversal'));
+
+COMMENT ON COLUMN "entries"."type" IS 'transfer, deposit, withdrawal, fee, adjustment or reversal';
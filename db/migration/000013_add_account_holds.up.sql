@@ -0,0 +1,44 @@
+ALTER TABLE "accounts" ADD COLUMN "held_amount" bigint NOT NULL DEFAULT 0;
+
+ALTER TABLE "accounts" ADD CONSTRAINT "accounts_held_amount_check" CHECK ("held_amount" >= 0);
+
+ALTER TABLE "accounts" ADD COLUMN "available_balance" bigint GENERATED ALWAYS AS ("balance" - "held_amount") STORED;
+
+COMMENT ON COLUMN "accounts"."held_amount" IS 'sum of the active holds on the account';
+
+COMMENT ON COLUMN "accounts"."available_balance" IS 'balance minus active holds';
+
+CREATE TABLE "account_holds" (
+  "id" bigserial PRIMARY KEY,
+  "account_id" bigint NOT NULL,
+  "to_account_id" bigint NOT NULL,
+  "amount" bigint NOT NULL,
+  "description" varchar NOT NULL,
+  "status" varchar NOT NULL DEFAULT 'active',
+  "expires_at" timestamptz NOT NULL,
+  "captured_amount" bigint NOT NULL DEFAULT 0,
+  "transfer_id" bigint,
+  "resolved_at" timestamptz,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE INDEX ON "account_holds" ("account_id");
+
+CREATE INDEX ON "account_holds" ("status", "expires_at");
+
+ALTER TABLE "account_holds" ADD CONSTRAINT "account_holds_amount_check" CHECK ("amount" > 0);
+
+ALTER TABLE "account_holds" ADD CONSTRAINT "account_holds_status_check"
+  CHECK ("status" IN ('active', 'captured', 'released', 'expired'));
+
+COMMENT ON COLUMN "account_holds"."to_account_id" IS 'account the funds go to when the hold is captured';
+
+COMMENT ON COLUMN "account_holds"."status" IS 'active, captured, released or expired';
+
+COMMENT ON COLUMN "account_holds"."transfer_id" IS 'transfer that captured the hold';
+
+ALTER TABLE "account_holds" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "account_holds" ADD FOREIGN KEY ("to_account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "account_holds" ADD FOREIGN KEY ("transfer_id") REFERENCES "transfers" ("id");
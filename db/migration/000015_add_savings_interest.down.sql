@@ -0,0 +1,16 @@
+DROP TABLE IF EXISTS "interest_accruals";
+
+DROP TABLE IF EXISTS "interest_rates";
+
+UPDATE "entries" SET "type" = 'adjustment' WHERE "type" = 'interest';
+
+ALTER TABLE "entries" DROP CONSTRAINT IF EXISTS "entries_type_check";
+
+ALTER TABLE "entries" ADD CONSTRAINT "entries_type_check"
+  CHECK ("type" IN ('transfer', 'deposit', 'withdrawal', 'fee', 'adjustment', 'reversal'));
+
+ALTER TABLE "accounts" DROP CONSTRAINT IF EXISTS "owners_currency_type_key";
+
+ALTER TABLE "accounts" ADD CONSTRAINT "owners_currency_key" UNIQUE ("owner", "currency");
+
+ALTER TABLE "accounts" DROP COLUMN IF EXISTS "type";
@@ -0,0 +1,62 @@
+ALTER TABLE "accounts" ADD COLUMN "type" varchar NOT NULL DEFAULT 'checking';
+
+ALTER TABLE "accounts" ADD CONSTRAINT "accounts_type_check" CHECK ("type" IN ('checking', 'savings'));
+
+COMMENT ON COLUMN "accounts"."type" IS 'checking or savings';
+
+-- an owner may hold a checking and a savings account in the same currency
+ALTER TABLE "accounts" DROP CONSTRAINT IF EXISTS "owners_currency_key";
+
+ALTER TABLE "accounts" ADD CONSTRAINT "owners_currency_type_key" UNIQUE ("owner", "currency", "type");
+
+ALTER TABLE "entries" DROP CONSTRAINT IF EXISTS "entries_type_check";
+
+ALTER TABLE "entries" ADD CONSTRAINT "entries_type_check"
+  CHECK ("type" IN ('transfer', 'deposit', 'withdrawal', 'fee', 'adjustment', 'reversal', 'interest'));
+
+COMMENT ON COLUMN "entries"."type" IS 'transfer, deposit, withdrawal, fee, adjustment, reversal or interest';
+
+-- the bank's own accounts, such as the one interest is paid from, belong to this user;
+-- the name cannot be registered through the API and the empty password never matches
+INSERT INTO "users" ("username", "hashed_password", "full_name", "email", "is_email_verified")
+VALUES ('bank-system', '', 'Bank System', 'system@bank.invalid', true)
+ON CONFLICT DO NOTHING;
+
+CREATE TABLE "interest_rates" (
+  "id" bigserial PRIMARY KEY,
+  "account_type" varchar NOT NULL,
+  "currency" varchar NOT NULL,
+  "annual_rate" numeric NOT NULL,
+  "effective_at" timestamptz NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE UNIQUE INDEX ON "interest_rates" ("account_type", "currency", "effective_at");
+
+ALTER TABLE "interest_rates" ADD CONSTRAINT "interest_rates_annual_rate_check" CHECK ("annual_rate" >= 0);
+
+COMMENT ON COLUMN "interest_rates"."annual_rate" IS 'yearly rate as a fraction, e.g. 0.025 for 2.5%';
+
+CREATE TABLE "interest_accruals" (
+  "id" bigserial PRIMARY KEY,
+  "account_id" bigint NOT NULL,
+  "accrual_date" date NOT NULL,
+  "balance" bigint NOT NULL,
+  "annual_rate" numeric NOT NULL,
+  "amount" numeric NOT NULL,
+  "entry_id" bigint,
+  "credited_at" timestamptz,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE UNIQUE INDEX ON "interest_accruals" ("account_id", "accrual_date");
+
+COMMENT ON COLUMN "interest_accruals"."balance" IS 'balance the interest was accrued on';
+
+COMMENT ON COLUMN "interest_accruals"."amount" IS 'interest for the day in minor units, not rounded';
+
+COMMENT ON COLUMN "interest_accruals"."entry_id" IS 'interest entry that paid the accrual, NULL if the month rounded to zero';
+
+ALTER TABLE "interest_accruals" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "interest_accruals" ADD FOREIGN KEY ("entry_id") REFERENCES "entries" ("id");
@@ -0,0 +1,5 @@
+ALTER TABLE "transfers" DROP COLUMN IF EXISTS "fee_rule_id";
+
+ALTER TABLE "transfers" DROP COLUMN IF EXISTS "fee_amount";
+
+DROP TABLE IF EXISTS "fee_rules";
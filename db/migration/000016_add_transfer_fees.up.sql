@@ -0,0 +1,44 @@
+CREATE TABLE "fee_rules" (
+  "id" bigserial PRIMARY KEY,
+  "currency" varchar,
+  "role" varchar,
+  "same_owner" boolean,
+  "flat_fee" bigint NOT NULL DEFAULT 0,
+  "percentage" numeric NOT NULL DEFAULT 0,
+  "min_fee" bigint NOT NULL DEFAULT 0,
+  "max_fee" bigint,
+  "created_by" varchar NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+ALTER TABLE "fee_rules" ADD CONSTRAINT "fee_rules_amounts_check"
+  CHECK ("flat_fee" >= 0 AND "min_fee" >= 0 AND ("max_fee" IS NULL OR "max_fee" >= "min_fee"));
+
+ALTER TABLE "fee_rules" ADD CONSTRAINT "fee_rules_percentage_check" CHECK ("percentage" >= 0 AND "percentage" < 1);
+
+COMMENT ON COLUMN "fee_rules"."currency" IS 'currency of the sending account, NULL for any';
+
+COMMENT ON COLUMN "fee_rules"."role" IS 'role of the user making the transfer, NULL for any';
+
+COMMENT ON COLUMN "fee_rules"."same_owner" IS 'whether both accounts belong to the same owner, NULL for either';
+
+COMMENT ON COLUMN "fee_rules"."percentage" IS 'share of the amount charged on top of the flat fee, e.g. 0.005 for 0.5%';
+
+COMMENT ON COLUMN "fee_rules"."max_fee" IS 'NULL for no maximum';
+
+ALTER TABLE "fee_rules" ADD FOREIGN KEY ("created_by") REFERENCES "users" ("username");
+
+ALTER TABLE "transfers" ADD COLUMN "fee_amount" bigint NOT NULL DEFAULT 0;
+
+ALTER TABLE "transfers" ADD COLUMN "fee_rule_id" bigint;
+
+ALTER TABLE "transfers" ADD CONSTRAINT "transfers_fee_amount_check" CHECK ("fee_amount" >= 0);
+
+COMMENT ON COLUMN "transfers"."fee_amount" IS 'fee charged to the sender on top of amount, in its currency';
+
+ALTER TABLE "transfers" ADD FOREIGN KEY ("fee_rule_id") REFERENCES "fee_rules" ("id");
+
+-- transfer fees are paid into this user's accounts
+INSERT INTO "users" ("username", "hashed_password", "full_name", "email", "is_email_verified")
+VALUES ('bank-revenue', '', 'Bank Revenue', 'revenue@bank.invalid', true)
+ON CONFLICT DO NOTHING;
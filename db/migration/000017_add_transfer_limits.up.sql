@@ -0,0 +1,38 @@
+CREATE TABLE "transfer_limits" (
+  "id" bigserial PRIMARY KEY,
+  "username" varchar,
+  "account_id" bigint,
+  "max_single" bigint,
+  "max_daily_total" bigint,
+  "max_daily_count" int,
+  "updated_by" varchar NOT NULL,
+  "updated_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE UNIQUE INDEX ON "transfer_limits" ("username");
+
+CREATE UNIQUE INDEX ON "transfer_limits" ("account_id");
+
+ALTER TABLE "transfer_limits" ADD CONSTRAINT "transfer_limits_scope_check"
+  CHECK (("username" IS NULL) <> ("account_id" IS NULL));
+
+ALTER TABLE "transfer_limits" ADD CONSTRAINT "transfer_limits_values_check"
+  CHECK ("max_single" >= 0 AND "max_daily_total" >= 0 AND "max_daily_count" >= 0);
+
+COMMENT ON COLUMN "transfer_limits"."username" IS 'set for an override of all the user''s accounts';
+
+COMMENT ON COLUMN "transfer_limits"."account_id" IS 'set for an override of one account';
+
+COMMENT ON COLUMN "transfer_limits"."max_single" IS 'NULL to keep the default, 0 for no limit';
+
+COMMENT ON COLUMN "transfer_limits"."max_daily_total" IS 'outgoing total per UTC day, NULL to keep the default, 0 for no limit';
+
+COMMENT ON COLUMN "transfer_limits"."max_daily_count" IS 'outgoing transfers per UTC day, NULL to keep the default, 0 for no limit';
+
+ALTER TABLE "transfer_limits" ADD FOREIGN KEY ("username") REFERENCES "users" ("username");
+
+ALTER TABLE "transfer_limits" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "transfer_limits" ADD FOREIGN KEY ("updated_by") REFERENCES "users" ("username");
+
+CREATE INDEX ON "transfers" ("from_account_id", "created_at");
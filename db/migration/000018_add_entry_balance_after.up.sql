@@ -0,0 +1,22 @@
+ALTER TABLE "entries" ADD COLUMN "balance_after" bigint;
+
+-- existing entries are backfilled from the current balances, walking back through the later entries
+WITH "later" AS (
+  SELECT
+    "id",
+    COALESCE(SUM("amount") OVER (
+      PARTITION BY "account_id" ORDER BY "id" DESC
+      ROWS BETWEEN UNBOUNDED PRECEDING AND 1 PRECEDING
+    ), 0) AS "amount"
+  FROM "entries"
+)
+UPDATE "entries"
+SET "balance_after" = "accounts"."balance" - "later"."amount"
+FROM "later", "accounts"
+WHERE "later"."id" = "entries"."id" AND "accounts"."id" = "entries"."account_id";
+
+ALTER TABLE "entries" ALTER COLUMN "balance_after" SET NOT NULL;
+
+COMMENT ON COLUMN "entries"."balance_after" IS 'balance of the account once the entry was booked';
+
+CREATE INDEX ON "entries" ("account_id", "created_at");
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS "transfers_search_idx";
+
+ALTER TABLE "transfers" DROP COLUMN IF EXISTS "reference";
+
+ALTER TABLE "transfers" DROP COLUMN IF EXISTS "memo";
@@ -0,0 +1,11 @@
+ALTER TABLE "transfers" ADD COLUMN "memo" varchar NOT NULL DEFAULT '';
+
+ALTER TABLE "transfers" ADD COLUMN "reference" varchar NOT NULL DEFAULT '';
+
+COMMENT ON COLUMN "transfers"."memo" IS 'description of the payment given by the sender';
+
+COMMENT ON COLUMN "transfers"."reference" IS 'identifier of the payment in an external system, such as an invoice number';
+
+-- SearchTransfers matches against this expression, so that it can use the index
+CREATE INDEX "transfers_search_idx" ON "transfers"
+  USING GIN (to_tsvector('simple', "memo" || ' ' || "reference"));
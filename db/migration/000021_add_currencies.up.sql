@@ -0,0 +1,29 @@
+CREATE TABLE "currencies" (
+  "code" varchar PRIMARY KEY,
+  "exponent" int NOT NULL,
+  "name" varchar NOT NULL,
+  "enabled" boolean NOT NULL DEFAULT false,
+  "updated_by" varchar,
+  "updated_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+ALTER TABLE "currencies" ADD CONSTRAINT "currencies_exponent_check" CHECK ("exponent" BETWEEN 0 AND 4);
+
+COMMENT ON COLUMN "currencies"."code" IS 'ISO 4217 code';
+
+COMMENT ON COLUMN "currencies"."exponent" IS 'digits of the minor unit amounts are stored in, e.g. 2 for cents';
+
+COMMENT ON COLUMN "currencies"."enabled" IS 'only enabled currencies can be used for new accounts and transfers';
+
+ALTER TABLE "currencies" ADD FOREIGN KEY ("updated_by") REFERENCES "users" ("username");
+
+INSERT INTO "currencies" ("code", "exponent", "name", "enabled") VALUES
+  ('AUD', 2, 'Australian Dollar', false),
+  ('CAD', 2, 'Canadian Dollar', true),
+  ('CHF', 2, 'Swiss Franc', false),
+  ('EUR', 2, 'Euro', true),
+  ('GBP', 2, 'Pound Sterling', false),
+  ('INR', 2, 'Indian Rupee', false),
+  ('JPY', 0, 'Yen', false),
+  ('KWD', 3, 'Kuwaiti Dinar', false),
+  ('USD', 2, 'US Dollar', true);
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS "accounts_bank_owner_currency_type_key";
+
+ALTER TABLE "accounts" ADD CONSTRAINT "owners_currency_type_key" UNIQUE ("owner", "currency", "type");
+
+DROP TABLE IF EXISTS "account_holders";
@@ -0,0 +1,43 @@
+CREATE TABLE "account_holders" (
+  "id" bigserial PRIMARY KEY,
+  "account_id" bigint NOT NULL,
+  "username" varchar NOT NULL,
+  "permission" varchar NOT NULL,
+  "transfer_limit" bigint,
+  "status" varchar NOT NULL DEFAULT 'invited',
+  "invited_by" varchar NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  "accepted_at" timestamptz
+);
+
+CREATE UNIQUE INDEX ON "account_holders" ("account_id", "username");
+
+CREATE INDEX ON "account_holders" ("username");
+
+ALTER TABLE "account_holders" ADD CONSTRAINT "account_holders_permission_check"
+  CHECK ("permission" IN ('view', 'transfer', 'manage'));
+
+ALTER TABLE "account_holders" ADD CONSTRAINT "account_holders_status_check"
+  CHECK ("status" IN ('invited', 'active'));
+
+ALTER TABLE "account_holders" ADD CONSTRAINT "account_holders_transfer_limit_check"
+  CHECK ("transfer_limit" > 0);
+
+COMMENT ON COLUMN "account_holders"."permission" IS 'view, transfer or manage, each including the ones before it';
+
+COMMENT ON COLUMN "account_holders"."transfer_limit" IS 'largest single transfer the holder may make, NULL for no limit';
+
+COMMENT ON COLUMN "account_holders"."status" IS 'invited until the user accepts, then active';
+
+ALTER TABLE "account_holders" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "account_holders" ADD FOREIGN KEY ("username") REFERENCES "users" ("username");
+
+ALTER TABLE "account_holders" ADD FOREIGN KEY ("invited_by") REFERENCES "users" ("username");
+
+-- users may open several accounts of a kind, e.g. a personal one and one shared with their partner;
+-- the bank's own accounts stay unique as they are opened on first use
+ALTER TABLE "accounts" DROP CONSTRAINT IF EXISTS "owners_currency_type_key";
+
+CREATE UNIQUE INDEX "accounts_bank_owner_currency_type_key" ON "accounts" ("owner", "currency", "type")
+  WHERE "owner" IN ('bank-system', 'bank-revenue');
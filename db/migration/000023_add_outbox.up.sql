@@ -0,0 +1,17 @@
+CREATE TABLE "outbox" (
+  "id" bigserial PRIMARY KEY,
+  "event_type" varchar NOT NULL,
+  "payload" jsonb NOT NULL,
+  "attempts" int NOT NULL DEFAULT 0,
+  "last_error" varchar,
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  "sent_at" timestamptz
+);
+
+CREATE INDEX "outbox_unsent_idx" ON "outbox" ("id") WHERE "sent_at" IS NULL;
+
+COMMENT ON COLUMN "outbox"."event_type" IS 'user.created, transfer.completed or account.status_changed';
+
+COMMENT ON COLUMN "outbox"."attempts" IS 'failed attempts to publish the event';
+
+COMMENT ON COLUMN "outbox"."sent_at" IS 'when the event was published, NULL until then';
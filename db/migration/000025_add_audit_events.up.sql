@@ -0,0 +1,40 @@
+CREATE TABLE "audit_events" (
+  "id" bigserial PRIMARY KEY,
+  "actor" varchar NOT NULL,
+  "role" varchar NOT NULL,
+  "client_ip" varchar NOT NULL,
+  "user_agent" varchar NOT NULL,
+  "action" varchar NOT NULL,
+  "target_type" varchar NOT NULL,
+  "target_id" varchar NOT NULL,
+  "before" json,
+  "after" json,
+  "created_at" timestamptz NOT NULL,
+  "prev_hash" varchar NOT NULL,
+  "hash" varchar UNIQUE NOT NULL
+);
+
+CREATE INDEX ON "audit_events" ("target_type", "target_id");
+
+COMMENT ON COLUMN "audit_events"."actor" IS 'username the change was made by, or system';
+
+COMMENT ON COLUMN "audit_events"."action" IS 'what was done, e.g. transfer.create';
+
+COMMENT ON COLUMN "audit_events"."before" IS 'json rather than jsonb so that the text the hash was computed over is kept as is';
+
+COMMENT ON COLUMN "audit_events"."prev_hash" IS 'hash of the previous event, 64 zeros for the first';
+
+COMMENT ON COLUMN "audit_events"."hash" IS 'hex SHA-256 of prev_hash and the other columns but id';
+
+-- the log is append-only; the hash chain exposes changes made with the triggers disabled
+CREATE FUNCTION "audit_events_append_only"() RETURNS trigger AS $$
+BEGIN
+  RAISE EXCEPTION 'audit_events is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER "audit_events_no_update_or_delete" BEFORE UPDATE OR DELETE ON "audit_events"
+  FOR EACH ROW EXECUTE FUNCTION "audit_events_append_only"();
+
+CREATE TRIGGER "audit_events_no_truncate" BEFORE TRUNCATE ON "audit_events"
+  FOR EACH STATEMENT EXECUTE FUNCTION "audit_events_append_only"();
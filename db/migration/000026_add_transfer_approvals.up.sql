@@ -0,0 +1,50 @@
+CREATE TABLE "transfer_approvals" (
+  "id" bigserial PRIMARY KEY,
+  "from_account_id" bigint NOT NULL,
+  "to_account_id" bigint NOT NULL,
+  "amount" bigint NOT NULL,
+  "memo" varchar NOT NULL DEFAULT '',
+  "reference" varchar NOT NULL DEFAULT '',
+  "requested_by" varchar NOT NULL,
+  "requester_role" varchar NOT NULL,
+  "hold_id" bigint NOT NULL,
+  "status" varchar NOT NULL DEFAULT 'pending',
+  "decided_by" varchar,
+  "reason" varchar NOT NULL DEFAULT '',
+  "transfer_id" bigint,
+  "expires_at" timestamptz NOT NULL,
+  "decided_at" timestamptz,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE INDEX ON "transfer_approvals" ("status", "expires_at");
+
+CREATE UNIQUE INDEX ON "transfer_approvals" ("hold_id");
+
+ALTER TABLE "transfer_approvals" ADD CONSTRAINT "transfer_approvals_amount_check" CHECK ("amount" > 0);
+
+ALTER TABLE "transfer_approvals" ADD CONSTRAINT "transfer_approvals_status_check"
+  CHECK ("status" IN ('pending', 'approved', 'rejected', 'expired'));
+
+ALTER TABLE "transfer_approvals" ADD CONSTRAINT "transfer_approvals_decided_by_check"
+  CHECK ("decided_by" <> "requested_by");
+
+COMMENT ON COLUMN "transfer_approvals"."requester_role" IS 'role of the requester, which picks the fee rule of the transfer';
+
+COMMENT ON COLUMN "transfer_approvals"."hold_id" IS 'hold keeping the amount and fee available until the transfer is decided';
+
+COMMENT ON COLUMN "transfer_approvals"."status" IS 'pending until a banker other than the requester approves or rejects it, or it expires';
+
+COMMENT ON COLUMN "transfer_approvals"."transfer_id" IS 'transfer made once approved';
+
+ALTER TABLE "transfer_approvals" ADD FOREIGN KEY ("from_account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "transfer_approvals" ADD FOREIGN KEY ("to_account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "transfer_approvals" ADD FOREIGN KEY ("requested_by") REFERENCES "users" ("username");
+
+ALTER TABLE "transfer_approvals" ADD FOREIGN KEY ("hold_id") REFERENCES "account_holds" ("id");
+
+ALTER TABLE "transfer_approvals" ADD FOREIGN KEY ("decided_by") REFERENCES "users" ("username");
+
+ALTER TABLE "transfer_approvals" ADD FOREIGN KEY ("transfer_id") REFERENCES "transfers" ("id");
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS "transfers_initiated_by_created_at_idx";
+
+ALTER TABLE "account_holds" DROP COLUMN IF EXISTS "placed_by";
+
+ALTER TABLE "transfers" DROP COLUMN IF EXISTS "initiated_by";
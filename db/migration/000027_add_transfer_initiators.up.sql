@@ -0,0 +1,28 @@
+ALTER TABLE "transfers" ADD COLUMN "initiated_by" varchar;
+
+ALTER TABLE "account_holds" ADD COLUMN "placed_by" varchar;
+
+-- earlier transfers and holds are counted against the owner of the account they were made from
+UPDATE "transfers"
+SET "initiated_by" = "accounts"."owner"
+FROM "accounts"
+WHERE "accounts"."id" = "transfers"."from_account_id";
+
+UPDATE "account_holds"
+SET "placed_by" = "accounts"."owner"
+FROM "accounts"
+WHERE "accounts"."id" = "account_holds"."account_id";
+
+ALTER TABLE "transfers" ALTER COLUMN "initiated_by" SET NOT NULL;
+
+ALTER TABLE "account_holds" ALTER COLUMN "placed_by" SET NOT NULL;
+
+COMMENT ON COLUMN "transfers"."initiated_by" IS 'user whose transfer limits the transfer counts against';
+
+COMMENT ON COLUMN "account_holds"."placed_by" IS 'user whose transfer limits the capture counts against';
+
+ALTER TABLE "transfers" ADD FOREIGN KEY ("initiated_by") REFERENCES "users" ("username");
+
+ALTER TABLE "account_holds" ADD FOREIGN KEY ("placed_by") REFERENCES "users" ("username");
+
+CREATE INDEX ON "transfers" ("initiated_by", "created_at");
@@ -0,0 +1,3 @@
+ALTER TABLE "account_holds" DROP COLUMN IF EXISTS "placer_role";
+
+ALTER TABLE "scheduled_transfers" DROP COLUMN IF EXISTS "owner_role";
@@ -0,0 +1,22 @@
+ALTER TABLE "scheduled_transfers" ADD COLUMN "owner_role" varchar;
+
+ALTER TABLE "account_holds" ADD COLUMN "placer_role" varchar;
+
+-- earlier schedules and holds take the current role of the user who made them
+UPDATE "scheduled_transfers"
+SET "owner_role" = "users"."role"
+FROM "users"
+WHERE "users"."username" = "scheduled_transfers"."owner";
+
+UPDATE "account_holds"
+SET "placer_role" = "users"."role"
+FROM "users"
+WHERE "users"."username" = "account_holds"."placed_by";
+
+ALTER TABLE "scheduled_transfers" ALTER COLUMN "owner_role" SET NOT NULL;
+
+ALTER TABLE "account_holds" ALTER COLUMN "placer_role" SET NOT NULL;
+
+COMMENT ON COLUMN "scheduled_transfers"."owner_role" IS 'role of the owner, which picks the fee rule of the transfers';
+
+COMMENT ON COLUMN "account_holds"."placer_role" IS 'role of the user who placed the hold, which picks the fee rule of the capture';
@@ -0,0 +1,21 @@
+ALTER TABLE "currencies" ADD COLUMN "approval_threshold" bigint;
+
+ALTER TABLE "currencies" ADD CONSTRAINT "currencies_approval_threshold_check" CHECK ("approval_threshold" > 0);
+
+COMMENT ON COLUMN "currencies"."approval_threshold" IS 'largest amount in minor units a transfer may move without a banker''s approval, NULL for no approval';
+
+-- about 5000 US dollars in each currency
+UPDATE "currencies"
+SET "approval_threshold" = "thresholds"."approval_threshold"
+FROM (VALUES
+  ('AUD', 750000),
+  ('CAD', 650000),
+  ('CHF', 450000),
+  ('EUR', 450000),
+  ('GBP', 400000),
+  ('INR', 40000000),
+  ('JPY', 750000),
+  ('KWD', 1500000),
+  ('USD', 500000)
+) AS "thresholds" ("code", "approval_threshold")
+WHERE "currencies"."code" = "thresholds"."code";
@@ -0,0 +1,8 @@
+ALTER TABLE "idempotency_keys" ADD COLUMN "operation" varchar NOT NULL DEFAULT 'transfer';
+
+COMMENT ON COLUMN "idempotency_keys"."operation" IS 'store transaction the key was claimed by, e.g. transfer or transfer_approval';
+
+-- keys claimed by a transfer approval request stored the pending approval as their response
+UPDATE "idempotency_keys"
+SET "operation" = 'transfer_approval'
+WHERE "response" ? 'approval';
@@ -0,0 +1 @@
+ALTER TABLE "account_holds" DROP COLUMN IF EXISTS "held_fee";
@@ -0,0 +1,5 @@
+ALTER TABLE "account_holds" ADD COLUMN "held_fee" bigint NOT NULL DEFAULT 0;
+
+ALTER TABLE "account_holds" ADD CONSTRAINT "account_holds_held_fee_check" CHECK ("held_fee" >= 0);
+
+COMMENT ON COLUMN "account_holds"."held_fee" IS 'fee held on top of the amount for the capture';
@@ -2,9 +2,10 @@
 INSERT INTO accounts (
     owner,
     balance,
-    currency
+    currency,
+    type
 ) VALUES (
-    $1, $2, $3
+    $1, $2, $3, $4
 ) RETURNING *;
 
 -- name: GetAccount :one
@@ -14,8 +15,10 @@ SELECT * FROM accounts WHERE id = $1 LIMIT 1;
 SELECT * FROM accounts WHERE id = $1 LIMIT 1 FOR NO KEY UPDATE;
 
 -- name: ListAccounts :many
+-- Accounts the user owns or is an active holder of.
 SELECT * FROM accounts
-WHERE owner=$1
+WHERE owner = $1
+  OR id IN (SELECT account_id FROM account_holders WHERE username = $1 AND status = 'active')
 ORDER BY id
 LIMIT $2
 OFFSET $3;
@@ -34,4 +37,43 @@ RETURNING *;
 
 -- name: DeleteAccount :exec
 DELETE FROM accounts
-WHERE id = $1;
\ No newline at end of file
+WHERE id = $1;
+
+-- name: UpdateAccountOverdraftLimit :one
+UPDATE accounts
+SET overdraft_limit = $2
+WHERE id = $1
+RETURNING *;
+
+-- name: AddAccountHeldAmount :one
+UPDATE accounts
+SET held_amount = held_amount + sqlc.arg(amount)
+WHERE id = sqlc.arg(id)
+RETURNING *;
+
+-- name: UpdateAccountStatus :one
+UPDATE accounts
+SET status = $2
+WHERE id = $1
+RETURNING *;
+
+-- name: GetOwnerAccount :one
+SELECT * FROM accounts
+WHERE owner = $1 AND currency = $2 AND type = $3
+LIMIT 1;
+
+-- name: EnsureAccount :exec
+INSERT INTO accounts (
+    owner,
+    balance,
+    currency,
+    type
+) VALUES (
+    $1, 0, $2, $3
+) ON CONFLICT (owner, currency, type) WHERE owner IN ('bank-system', 'bank-revenue') DO NOTHING;
+
+-- name: ListInterestBearingAccounts :many
+SELECT * FROM accounts
+WHERE type = 'savings' AND status = 'active' AND id > $1
+ORDER BY id
+LIMIT $2;
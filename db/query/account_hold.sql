@@ -0,0 +1,45 @@
+-- name: CreateAccountHold :one
+INSERT INTO account_holds (
+  account_id,
+  to_account_id,
+  amount,
+  description,
+  expires_at,
+  placed_by,
+  placer_role,
+  held_fee
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7, $8
+) RETURNING *;
+
+-- name: GetAccountHold :one
+SELECT * FROM account_holds
+WHERE id = $1 LIMIT 1;
+
+-- name: GetAccountHoldForUpdate :one
+SELECT * FROM account_holds
+WHERE id = $1 LIMIT 1
+FOR NO KEY UPDATE;
+
+-- name: ListAccountHolds :many
+SELECT * FROM account_holds
+WHERE account_id = $1
+ORDER BY id
+LIMIT $2
+OFFSET $3;
+
+-- name: ListExpiredAccountHolds :many
+SELECT * FROM account_holds
+WHERE status = 'active' AND expires_at <= now()
+ORDER BY expires_at
+LIMIT $1;
+
+-- name: UpdateAccountHoldStatus :one
+UPDATE account_holds
+SET
+  status = $2,
+  captured_amount = $3,
+  transfer_id = $4,
+  resolved_at = now()
+WHERE id = $1
+RETURNING *;
@@ -0,0 +1,33 @@
+-- name: CreateAccountHolder :one
+INSERT INTO account_holders (
+  account_id,
+  username,
+  permission,
+  transfer_limit,
+  invited_by
+) VALUES (
+  $1, $2, $3, $4, $5
+) RETURNING *;
+
+-- name: GetAccountHolder :one
+SELECT * FROM account_holders
+WHERE account_id = $1 AND username = $2
+LIMIT 1;
+
+-- name: ListAccountHolders :many
+SELECT * FROM account_holders
+WHERE account_id = $1
+ORDER BY id;
+
+-- name: AcceptAccountHolder :one
+UPDATE account_holders
+SET
+  status = 'active',
+  accepted_at = now()
+WHERE account_id = $1 AND username = $2 AND status = 'invited'
+RETURNING *;
+
+-- name: DeleteAccountHolder :one
+DELETE FROM account_holders
+WHERE account_id = $1 AND username = $2
+RETURNING *;
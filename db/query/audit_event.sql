@@ -0,0 +1,34 @@
+-- name: LockAuditChain :exec
+-- Serializes appends to the audit log until the transaction ends, so that each event
+-- chains to the one committed before it.
+SELECT pg_advisory_xact_lock(hashtext('audit_events'));
+
+-- name: GetLastAuditEvent :one
+SELECT * FROM audit_events
+ORDER BY id DESC
+LIMIT 1;
+
+-- name: CreateAuditEvent :one
+INSERT INTO audit_events (
+  actor,
+  role,
+  client_ip,
+  user_agent,
+  action,
+  target_type,
+  target_id,
+  before,
+  after,
+  created_at,
+  prev_hash,
+  hash
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12
+) RETURNING *;
+
+-- name: ListAuditEvents :many
+-- Events after the given id in the order they were chained.
+SELECT * FROM audit_events
+WHERE id > sqlc.arg(after_id)
+ORDER BY id
+LIMIT sqlc.arg(page_size);
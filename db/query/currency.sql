@@ -0,0 +1,30 @@
+-- name: GetCurrency :one
+SELECT * FROM currencies
+WHERE code = $1 LIMIT 1;
+
+-- name: GetCurrencyForUpdate :one
+SELECT * FROM currencies
+WHERE code = $1 LIMIT 1
+FOR NO KEY UPDATE;
+
+-- name: ListCurrencies :many
+SELECT * FROM currencies
+ORDER BY code;
+
+-- name: UpdateCurrencyApprovalThreshold :one
+UPDATE currencies
+SET
+  approval_threshold = $2,
+  updated_by = $3,
+  updated_at = now()
+WHERE code = $1
+RETURNING *;
+
+-- name: UpdateCurrencyEnabled :one
+UPDATE currencies
+SET
+  enabled = $2,
+  updated_by = $3,
+  updated_at = now()
+WHERE code = $1
+RETURNING *;
@@ -1,18 +1,71 @@
 -- name: CreateEntry :one
+-- The account is locked before the entry is numbered and timestamped, so that the entries of an
+-- account are numbered and timestamped in the order they are booked, rather than stamped with the
+-- start of a transaction that may have waited for the lock. The caller applies the amount to the
+-- balance afterwards.
+WITH account AS (
+    SELECT balance FROM accounts
+    WHERE id = sqlc.arg(account_id)
+    FOR NO KEY UPDATE
+)
 INSERT INTO entries (
     account_id,
-    amount
-) VALUES (
-    $1, $2
-) RETURNING *;
+    amount,
+    type,
+    transfer_id,
+    balance_after,
+    created_at
+)
+SELECT
+    sqlc.arg(account_id)::bigint,
+    sqlc.arg(amount)::bigint,
+    sqlc.arg(type)::varchar,
+    sqlc.narg(transfer_id)::bigint,
+    account.balance + sqlc.arg(amount)::bigint,
+    clock_timestamp()
+FROM account
+RETURNING *;
 
 -- name: GetEntry :one
 SELECT * FROM entries WHERE id = $1 LIMIT 1;
 
 -- name: ListEntries :many
+-- Entries of an account, newest first. Direction is in for credits and out for debits, amounts are
+-- filtered by their absolute value, and the counterparty is the other account of the entry's transfer.
+SELECT e.* FROM entries e
+LEFT JOIN transfers t ON t.id = e.transfer_id
+WHERE e.account_id = sqlc.arg(account_id)
+  AND (sqlc.narg(before_id)::bigint IS NULL OR e.id < sqlc.narg(before_id))
+  AND (sqlc.narg(start_time)::timestamptz IS NULL OR e.created_at >= sqlc.narg(start_time))
+  AND (sqlc.narg(end_time)::timestamptz IS NULL OR e.created_at < sqlc.narg(end_time))
+  AND (sqlc.narg(direction)::varchar IS NULL
+    OR (sqlc.narg(direction) = 'out' AND e.amount < 0)
+    OR (sqlc.narg(direction) = 'in' AND e.amount > 0))
+  AND (sqlc.narg(counterparty_account_id)::bigint IS NULL
+    OR (t.from_account_id = e.account_id AND t.to_account_id = sqlc.narg(counterparty_account_id))
+    OR (t.to_account_id = e.account_id AND t.from_account_id = sqlc.narg(counterparty_account_id)))
+  AND (sqlc.narg(min_amount)::bigint IS NULL OR abs(e.amount) >= sqlc.narg(min_amount))
+  AND (sqlc.narg(max_amount)::bigint IS NULL OR abs(e.amount) <= sqlc.narg(max_amount))
+ORDER BY e.id DESC
+LIMIT sqlc.arg(page_size);
+
+-- name: ListEntriesByTransfer :many
 SELECT * FROM entries
-WHERE account_id = $1
-ORDER BY id
-LIMIT $2
-OFFSET $3;
- 
\ No newline at end of file
+WHERE transfer_id = $1
+ORDER BY id;
+
+-- name: GetBalanceAt :one
+-- The balance after the last entry booked by the time, or before the first entry if none was.
+-- The entries of an account are booked in the order of both their id and created_at.
+SELECT COALESCE(
+    (SELECT e.balance_after FROM entries e
+     WHERE e.account_id = sqlc.arg(account_id) AND e.created_at <= sqlc.arg(at)
+     ORDER BY e.id DESC
+     LIMIT 1),
+    (SELECT e.balance_after - e.amount FROM entries e
+     WHERE e.account_id = sqlc.arg(account_id)
+     ORDER BY e.id
+     LIMIT 1),
+    (SELECT a.balance FROM accounts a
+     WHERE a.id = sqlc.arg(account_id))
+)::bigint AS balance;
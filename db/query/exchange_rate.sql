@@ -0,0 +1,25 @@
+-- name: UpsertExchangeRate :one
+INSERT INTO exchange_rates (
+  base_currency,
+  quote_currency,
+  rate,
+  effective_at
+) VALUES (
+  $1, $2, $3, $4
+)
+ON CONFLICT (base_currency, quote_currency, effective_at) DO UPDATE
+SET rate = EXCLUDED.rate
+RETURNING *;
+
+-- name: GetExchangeRate :one
+SELECT * FROM exchange_rates
+WHERE base_currency = $1 AND quote_currency = $2 AND effective_at <= now()
+ORDER BY effective_at DESC
+LIMIT 1;
+
+-- name: ListExchangeRates :many
+SELECT * FROM exchange_rates
+WHERE base_currency = $1
+ORDER BY effective_at DESC, quote_currency
+LIMIT $2
+OFFSET $3;
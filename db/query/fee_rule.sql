@@ -0,0 +1,28 @@
+-- name: CreateFeeRule :one
+INSERT INTO fee_rules (
+  currency,
+  role,
+  same_owner,
+  flat_fee,
+  percentage,
+  min_fee,
+  max_fee,
+  created_by
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7, $8
+) RETURNING *;
+
+-- name: GetFeeRule :one
+-- the most specific rule matching the transfer applies; of equally specific rules, the newest
+SELECT * FROM fee_rules
+WHERE (currency IS NULL OR currency = sqlc.arg(currency)::varchar)
+  AND (role IS NULL OR role = sqlc.arg(role)::varchar)
+  AND (same_owner IS NULL OR same_owner = sqlc.arg(same_owner)::boolean)
+ORDER BY (currency IS NOT NULL)::int + (role IS NOT NULL)::int + (same_owner IS NOT NULL)::int DESC, id DESC
+LIMIT 1;
+
+-- name: ListFeeRules :many
+SELECT * FROM fee_rules
+ORDER BY id
+LIMIT $1
+OFFSET $2;
@@ -0,0 +1,64 @@
+-- name: UpsertInterestRate :one
+INSERT INTO interest_rates (
+  account_type,
+  currency,
+  annual_rate,
+  effective_at
+) VALUES (
+  $1, $2, $3, $4
+)
+ON CONFLICT (account_type, currency, effective_at) DO UPDATE
+SET annual_rate = EXCLUDED.annual_rate
+RETURNING *;
+
+-- name: GetInterestRate :one
+SELECT * FROM interest_rates
+WHERE account_type = $1 AND currency = $2 AND effective_at <= sqlc.arg(at)
+ORDER BY effective_at DESC
+LIMIT 1;
+
+-- name: CreateInterestAccrual :one
+INSERT INTO interest_accruals (
+  account_id,
+  accrual_date,
+  balance,
+  annual_rate,
+  amount
+) VALUES (
+  $1, $2, $3, $4, $5
+)
+ON CONFLICT (account_id, accrual_date) DO NOTHING
+RETURNING *;
+
+-- name: ListUncreditedInterestAccruals :many
+SELECT * FROM interest_accruals
+WHERE account_id = $1 AND credited_at IS NULL AND accrual_date <= sqlc.arg(through)
+ORDER BY accrual_date
+FOR UPDATE;
+
+-- name: GetCreditedInterest :one
+-- The interest accrued on the account that was credited, unrounded, and what its entries paid.
+SELECT
+  COALESCE(SUM(amount), 0)::numeric AS accrued,
+  COALESCE((
+    SELECT SUM(e.amount) FROM entries e
+    WHERE e.id IN (SELECT entry_id FROM interest_accruals WHERE account_id = $1)
+  ), 0)::bigint AS paid
+FROM interest_accruals
+WHERE account_id = $1 AND credited_at IS NOT NULL;
+
+-- name: MarkInterestAccrualsCredited :exec
+UPDATE interest_accruals
+SET entry_id = $2, credited_at = now()
+WHERE account_id = $1 AND credited_at IS NULL AND accrual_date <= sqlc.arg(through);
+
+-- name: ListInterestAccruals :many
+SELECT * FROM interest_accruals
+WHERE account_id = $1
+ORDER BY accrual_date DESC
+LIMIT $2
+OFFSET $3;
+
+-- name: GetLastInterestAccrualDate :one
+-- The latest day accrued on any account, NULL if none was.
+SELECT MAX(accrual_date)::date AS last_accrual_date FROM interest_accruals;
@@ -0,0 +1,26 @@
+-- name: CreateOutboxEvent :one
+INSERT INTO outbox (
+  event_type,
+  payload
+) VALUES (
+  $1, $2
+) RETURNING *;
+
+-- name: ListUnsentOutboxEvents :many
+-- Events waiting to be published, oldest first.
+SELECT * FROM outbox
+WHERE sent_at IS NULL
+ORDER BY id
+LIMIT $1;
+
+-- name: MarkOutboxEventSent :exec
+UPDATE outbox
+SET sent_at = now()
+WHERE id = $1;
+
+-- name: RecordOutboxEventFailure :exec
+UPDATE outbox
+SET
+  attempts = attempts + 1,
+  last_error = $2
+WHERE id = $1;
@@ -0,0 +1,67 @@
+-- name: ListAccountBalanceMismatches :many
+SELECT
+  a.id,
+  a.currency,
+  a.balance,
+  COALESCE(SUM(e.amount), 0)::bigint AS entries_total
+FROM accounts a
+LEFT JOIN entries e ON e.account_id = a.id
+GROUP BY a.id
+HAVING a.balance <> COALESCE(SUM(e.amount), 0)
+ORDER BY a.id;
+
+-- name: ListUnmatchedTransfers :many
+SELECT
+  t.id,
+  t.from_account_id,
+  t.to_account_id,
+  t.amount,
+  t.reversed_amount,
+  t.fee_amount,
+  COUNT(e.id) FILTER (WHERE e.type = 'transfer' AND e.account_id = t.from_account_id AND e.amount = -t.amount)::int AS debit_count,
+  COUNT(e.id) FILTER (WHERE e.type = 'transfer' AND e.account_id = t.to_account_id AND e.amount = t.to_amount)::int AS credit_count,
+  COUNT(e.id) FILTER (WHERE e.type = 'transfer')::int AS entry_count,
+  COALESCE(SUM(e.amount) FILTER (WHERE e.type = 'reversal' AND e.account_id = t.from_account_id), 0)::bigint AS refunded_total,
+  COALESCE(-SUM(e.amount) FILTER (WHERE e.type = 'fee' AND e.account_id = t.from_account_id), 0)::bigint AS fee_total
+FROM transfers t
+LEFT JOIN entries e ON e.transfer_id = t.id
+GROUP BY t.id
+HAVING
+  COUNT(e.id) FILTER (WHERE e.type = 'transfer' AND e.account_id = t.from_account_id AND e.amount = -t.amount) <> 1
+  OR COUNT(e.id) FILTER (WHERE e.type = 'transfer' AND e.account_id = t.to_account_id AND e.amount = t.to_amount) <> 1
+  OR COUNT(e.id) FILTER (WHERE e.type = 'transfer') <> 2
+  OR COALESCE(SUM(e.amount) FILTER (WHERE e.type = 'reversal' AND e.account_id = t.from_account_id), 0) <> t.reversed_amount
+  OR COALESCE(-SUM(e.amount) FILTER (WHERE e.type = 'fee' AND e.account_id = t.from_account_id), 0) <> t.fee_amount
+ORDER BY t.id;
+
+-- name: ListCurrencyImbalances :many
+SELECT
+  a.currency,
+  COALESCE(SUM(-e.amount) FILTER (WHERE e.amount < 0), 0)::bigint AS debits,
+  COALESCE(SUM(e.amount) FILTER (WHERE e.amount > 0), 0)::bigint AS credits
+FROM entries e
+JOIN accounts a ON a.id = e.account_id
+JOIN transfers t ON t.id = e.transfer_id
+WHERE t.exchange_rate IS NULL
+GROUP BY a.currency
+HAVING SUM(e.amount) <> 0
+ORDER BY a.currency;
+
+-- name: CreateReconciliationReport :one
+INSERT INTO reconciliation_reports (
+  triggered_by,
+  discrepancy_count,
+  discrepancies
+) VALUES (
+  $1, $2, $3
+) RETURNING *;
+
+-- name: GetReconciliationReport :one
+SELECT * FROM reconciliation_reports
+WHERE id = $1 LIMIT 1;
+
+-- name: ListReconciliationReports :many
+SELECT * FROM reconciliation_reports
+ORDER BY id DESC
+LIMIT $1
+OFFSET $2;
@@ -0,0 +1,65 @@
+-- name: CreateScheduledTransfer :one
+INSERT INTO scheduled_transfers (
+  owner,
+  from_account_id,
+  to_account_id,
+  amount,
+  schedule,
+  start_at,
+  end_at,
+  next_run_at,
+  owner_role
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7, $8, $9
+) RETURNING *;
+
+-- name: GetScheduledTransfer :one
+SELECT * FROM scheduled_transfers
+WHERE id = $1 LIMIT 1;
+
+-- name: GetScheduledTransferForUpdate :one
+SELECT * FROM scheduled_transfers
+WHERE id = $1 LIMIT 1
+FOR NO KEY UPDATE;
+
+-- name: ListScheduledTransfers :many
+SELECT * FROM scheduled_transfers
+WHERE owner = $1
+ORDER BY id
+LIMIT $2
+OFFSET $3;
+
+-- name: ListDueScheduledTransfers :many
+SELECT * FROM scheduled_transfers
+WHERE status = 'active' AND next_run_at <= now()
+ORDER BY next_run_at
+LIMIT $1;
+
+-- name: UpdateScheduledTransferStatus :one
+UPDATE scheduled_transfers
+SET
+  status = $2,
+  next_run_at = $3,
+  updated_at = now()
+WHERE id = $1
+RETURNING *;
+
+-- name: CreateScheduledTransferExecution :one
+INSERT INTO scheduled_transfer_executions (
+  scheduled_transfer_id,
+  scheduled_for,
+  status,
+  transfer_id,
+  failure_reason
+) VALUES (
+  $1, $2, $3, $4, $5
+)
+ON CONFLICT (scheduled_transfer_id, scheduled_for) DO NOTHING
+RETURNING *;
+
+-- name: ListScheduledTransferExecutions :many
+SELECT * FROM scheduled_transfer_executions
+WHERE scheduled_transfer_id = $1
+ORDER BY scheduled_for DESC
+LIMIT $2
+OFFSET $3;
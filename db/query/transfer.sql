@@ -2,17 +2,67 @@
 INSERT INTO transfers (
     from_account_id,
     to_account_id,
-    amount
+    amount,
+    to_amount,
+    exchange_rate,
+    fee_amount,
+    fee_rule_id,
+    memo,
+    reference,
+    initiated_by
 ) VALUES (
-    $1, $2, $3
-) RETURNING *; 
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10
+) RETURNING *;
 
 -- name: GetTransfer :one
 SELECT * FROM transfers WHERE id = $1 LIMIT 1;
 
 -- name: ListTransfers :many
+-- Transfers in or out of an account, newest first. Amounts are filtered as the account sees them:
+-- the amount sent for outgoing transfers and the amount received for incoming ones.
 SELECT * FROM transfers
-WHERE from_account_id = $1 OR to_account_id = $2
-ORDER BY id
-LIMIT $3
-OFFSET $4;
\ No newline at end of file
+WHERE (from_account_id = sqlc.arg(account_id) OR to_account_id = sqlc.arg(account_id))
+  AND (sqlc.narg(before_id)::bigint IS NULL OR id < sqlc.narg(before_id))
+  AND (sqlc.narg(start_time)::timestamptz IS NULL OR created_at >= sqlc.narg(start_time))
+  AND (sqlc.narg(end_time)::timestamptz IS NULL OR created_at < sqlc.narg(end_time))
+  AND (sqlc.narg(direction)::varchar IS NULL
+    OR (sqlc.narg(direction) = 'out' AND from_account_id = sqlc.arg(account_id))
+    OR (sqlc.narg(direction) = 'in' AND to_account_id = sqlc.arg(account_id)))
+  AND (sqlc.narg(counterparty_account_id)::bigint IS NULL
+    OR (from_account_id = sqlc.arg(account_id) AND to_account_id = sqlc.narg(counterparty_account_id))
+    OR (to_account_id = sqlc.arg(account_id) AND from_account_id = sqlc.narg(counterparty_account_id)))
+  AND (sqlc.narg(min_amount)::bigint IS NULL
+    OR CASE WHEN from_account_id = sqlc.arg(account_id) THEN amount ELSE to_amount END >= sqlc.narg(min_amount))
+  AND (sqlc.narg(max_amount)::bigint IS NULL
+    OR CASE WHEN from_account_id = sqlc.arg(account_id) THEN amount ELSE to_amount END <= sqlc.narg(max_amount))
+ORDER BY id DESC
+LIMIT sqlc.arg(page_size);
+
+-- name: GetTransferForUpdate :one
+SELECT * FROM transfers
+WHERE id = $1 LIMIT 1
+FOR NO KEY UPDATE;
+
+-- name: UpdateTransferReversal :one
+UPDATE transfers
+SET
+  reversed_amount = $2,
+  status = $3
+WHERE id = $1
+RETURNING *;
+
+-- name: SearchTransfers :many
+-- Transfers of the accounts the owner holds whose memo or reference match a web search style query,
+-- newest first, optionally only those with the counterparty account on either side.
+SELECT * FROM transfers
+WHERE (from_account_id IN (SELECT id FROM accounts WHERE owner = sqlc.arg(owner)
+      UNION SELECT account_id FROM account_holders WHERE username = sqlc.arg(owner) AND status = 'active')
+    OR to_account_id IN (SELECT id FROM accounts WHERE owner = sqlc.arg(owner)
+      UNION SELECT account_id FROM account_holders WHERE username = sqlc.arg(owner) AND status = 'active'))
+  AND to_tsvector('simple', memo || ' ' || reference) @@ websearch_to_tsquery('simple', sqlc.arg(query))
+  AND (sqlc.narg(counterparty_account_id)::bigint IS NULL
+    OR from_account_id = sqlc.narg(counterparty_account_id)
+    OR to_account_id = sqlc.narg(counterparty_account_id))
+  AND (sqlc.narg(before_id)::bigint IS NULL OR id < sqlc.narg(before_id))
+ORDER BY id DESC
+LIMIT sqlc.arg(page_size);
@@ -0,0 +1,47 @@
+-- name: CreateTransferApproval :one
+INSERT INTO transfer_approvals (
+  from_account_id,
+  to_account_id,
+  amount,
+  memo,
+  reference,
+  requested_by,
+  requester_role,
+  hold_id,
+  expires_at
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7, $8, $9
+) RETURNING *;
+
+-- name: GetTransferApproval :one
+SELECT * FROM transfer_approvals
+WHERE id = $1 LIMIT 1;
+
+-- name: GetTransferApprovalForUpdate :one
+SELECT * FROM transfer_approvals
+WHERE id = $1 LIMIT 1
+FOR NO KEY UPDATE;
+
+-- name: GetTransferApprovalByHoldForUpdate :one
+SELECT * FROM transfer_approvals
+WHERE hold_id = $1 LIMIT 1
+FOR NO KEY UPDATE;
+
+-- name: ListPendingTransferApprovals :many
+-- Pending approvals that have not expired, oldest first.
+SELECT * FROM transfer_approvals
+WHERE status = 'pending' AND expires_at > now()
+ORDER BY id
+LIMIT $1
+OFFSET $2;
+
+-- name: UpdateTransferApprovalStatus :one
+UPDATE transfer_approvals
+SET
+  status = $2,
+  decided_by = $3,
+  reason = $4,
+  transfer_id = $5,
+  decided_at = now()
+WHERE id = $1
+RETURNING *;
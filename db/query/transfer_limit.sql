@@ -0,0 +1,65 @@
+-- name: UpsertUserTransferLimit :one
+INSERT INTO transfer_limits (
+  username,
+  max_single,
+  max_daily_total,
+  max_daily_count,
+  updated_by
+) VALUES (
+  $1, $2, $3, $4, $5
+)
+ON CONFLICT (username) DO UPDATE
+SET
+  max_single = EXCLUDED.max_single,
+  max_daily_total = EXCLUDED.max_daily_total,
+  max_daily_count = EXCLUDED.max_daily_count,
+  updated_by = EXCLUDED.updated_by,
+  updated_at = now()
+RETURNING *;
+
+-- name: UpsertAccountTransferLimit :one
+INSERT INTO transfer_limits (
+  account_id,
+  max_single,
+  max_daily_total,
+  max_daily_count,
+  updated_by
+) VALUES (
+  $1, $2, $3, $4, $5
+)
+ON CONFLICT (account_id) DO UPDATE
+SET
+  max_single = EXCLUDED.max_single,
+  max_daily_total = EXCLUDED.max_daily_total,
+  max_daily_count = EXCLUDED.max_daily_count,
+  updated_by = EXCLUDED.updated_by,
+  updated_at = now()
+RETURNING *;
+
+-- name: ListTransferLimitOverrides :many
+-- the user's override first, so that the account's override is applied over it
+SELECT * FROM transfer_limits
+WHERE username = sqlc.arg(username)::varchar OR account_id = sqlc.arg(account_id)::bigint
+ORDER BY account_id NULLS FIRST;
+
+-- name: LockUserTransferLimits :exec
+-- Serializes the limit checks of a user's transfers until the transaction ends, so that
+-- transfers they make from different accounts at the same time are counted one after another.
+SELECT pg_advisory_xact_lock(hashtext('transfer_limits:' || sqlc.arg(username)::varchar));
+
+-- name: GetAccountOutgoingTransferTotals :one
+-- What was sent since the time from the account, whoever made the transfers.
+SELECT
+  COALESCE(SUM(amount), 0)::bigint AS total,
+  COUNT(*)::int AS count
+FROM transfers
+WHERE from_account_id = $1 AND created_at >= sqlc.arg(since);
+
+-- name: GetOutgoingTransferTotals :one
+-- What a user sent since the time from accounts in the currency, whoever owns them.
+SELECT
+  COALESCE(SUM(t.amount), 0)::bigint AS total,
+  COUNT(*)::int AS count
+FROM transfers t
+JOIN accounts a ON a.id = t.from_account_id
+WHERE t.initiated_by = $1 AND a.currency = $2 AND t.created_at >= sqlc.arg(since);
@@ -24,3 +24,8 @@ WHERE
 RETURNING *;
 
 
+
+-- name: ListUsersByRole :many
+SELECT * FROM users
+WHERE role = $1
+ORDER BY username;
@@ -0,0 +1,67 @@
+-- name: CreateWebhookSubscription :one
+INSERT INTO webhook_subscriptions (
+  owner,
+  url,
+  event_types,
+  secret
+) VALUES (
+  $1, $2, $3, $4
+) RETURNING *;
+
+-- name: GetWebhookSubscription :one
+SELECT * FROM webhook_subscriptions
+WHERE id = $1 LIMIT 1;
+
+-- name: ListWebhookSubscriptions :many
+SELECT * FROM webhook_subscriptions
+WHERE owner = $1
+ORDER BY id
+LIMIT $2
+OFFSET $3;
+
+-- name: ListWebhookSubscriptionsForEvent :many
+-- Active subscriptions of the user that include the event type.
+SELECT * FROM webhook_subscriptions
+WHERE owner = sqlc.arg(owner) AND active AND sqlc.arg(event_type)::varchar = ANY(event_types)
+ORDER BY id;
+
+-- name: DeactivateWebhookSubscription :one
+UPDATE webhook_subscriptions
+SET active = false
+WHERE id = $1 AND active
+RETURNING *;
+
+-- name: CreateWebhookDelivery :one
+-- Returns the existing delivery when the event was already fanned out to the subscription.
+INSERT INTO webhook_deliveries (
+  subscription_id,
+  event_id,
+  event_type,
+  payload
+) VALUES (
+  $1, $2, $3, $4
+)
+ON CONFLICT (subscription_id, event_id, event_type) DO UPDATE SET event_type = EXCLUDED.event_type
+RETURNING *;
+
+-- name: GetWebhookDelivery :one
+SELECT * FROM webhook_deliveries
+WHERE id = $1 LIMIT 1;
+
+-- name: ListWebhookDeliveries :many
+SELECT * FROM webhook_deliveries
+WHERE subscription_id = $1
+ORDER BY id DESC
+LIMIT $2
+OFFSET $3;
+
+-- name: RecordWebhookDeliveryAttempt :one
+UPDATE webhook_deliveries
+SET
+  status = sqlc.arg(status),
+  attempts = attempts + 1,
+  response_status = sqlc.arg(response_status),
+  last_error = sqlc.arg(last_error),
+  delivered_at = CASE WHEN sqlc.arg(status) = 'succeeded' THEN now() ELSE delivered_at END
+WHERE id = sqlc.arg(id) AND status = 'pending'
+RETURNING *;
@@ -0,0 +1,84 @@
+package db
+
+import (
+	"context"
+	"errors"
+)
+
+// Permissions an account holder can be granted, each including the ones before it.
+const (
+	// HolderPermissionView lets the holder read the account and its history.
+	HolderPermissionView = "view"
+	// HolderPermissionTransfer also lets the holder send money, up to their transfer limit if set.
+	HolderPermissionTransfer = "transfer"
+	// HolderPermissionManage also lets the holder invite and remove other holders.
+	HolderPermissionManage = "manage"
+)
+
+// Statuses recorded in account_holders.status.
+const (
+	HolderStatusInvited = "invited"
+	HolderStatusActive  = "active"
+)
+
+// holderPermissionRanks orders the permissions so that each includes the lower ranked ones.
+var holderPermissionRanks = map[string]int{
+	HolderPermissionView:     1,
+	HolderPermissionTransfer: 2,
+	HolderPermissionManage:   3,
+}
+
+// IsSupportedHolderPermission reports whether permission is one a holder can be granted.
+func IsSupportedHolderPermission(permission string) bool {
+	_, ok := holderPermissionRanks[permission]
+	return ok
+}
+
+// AccountAccess is what a user may do with an account. The zero value allows nothing.
+type AccountAccess struct {
+	Permission string
+	// TransferLimit is the largest single transfer the user may make, 0 for no limit.
+	TransferLimit int64
+}
+
+// Allows reports whether the access includes permission.
+func (access AccountAccess) Allows(permission string) bool {
+	rank, ok := holderPermissionRanks[permission]
+	return ok && holderPermissionRanks[access.Permission] >= rank
+}
+
+// CanTransfer reports whether the user may send amount out of the account.
+func (access AccountAccess) CanTransfer(amount int64) bool {
+	if !access.Allows(HolderPermissionTransfer) {
+		return false
+	}
+	return access.TransferLimit == 0 || amount <= access.TransferLimit
+}
+
+// GetAccountAccess returns what username may do with the account: everything if they own it,
+// what they were granted if they are an active holder, and nothing otherwise. Owners are
+// resolved without a query.
+func GetAccountAccess(ctx context.Context, q Querier, account Account, username string) (AccountAccess, error) {
+	if account.Owner == username {
+		return AccountAccess{Permission: HolderPermissionManage}, nil
+	}
+
+	holder, err := q.GetAccountHolder(ctx, GetAccountHolderParams{
+		AccountID: account.ID,
+		Username:  username,
+	})
+	if err != nil {
+		if errors.Is(err, ErrRecordNotFound) {
+			return AccountAccess{}, nil
+		}
+		return AccountAccess{}, err
+	}
+	if holder.Status != HolderStatusActive {
+		return AccountAccess{}, nil
+	}
+
+	return AccountAccess{
+		Permission:    holder.Permission,
+		TransferLimit: holder.TransferLimit.Int64,
+	}, nil
+}
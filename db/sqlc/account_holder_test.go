@@ -0,0 +1,115 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccountHolderLifecycle(t *testing.T) {
+	account := createRandomAccount(t)
+	partner := createRandomUser(t)
+
+	holder, err := testQueries.CreateAccountHolder(context.Background(), CreateAccountHolderParams{
+		AccountID:     account.ID,
+		Username:      partner.Username,
+		Permission:    HolderPermissionTransfer,
+		TransferLimit: pgtype.Int8{Int64: 500, Valid: true},
+		InvitedBy:     account.Owner,
+	})
+	require.NoError(t, err)
+	require.Equal(t, HolderStatusInvited, holder.Status)
+	require.False(t, holder.AcceptedAt.Valid)
+
+	// the account is shared only once the invitation is accepted
+	access, err := GetAccountAccess(context.Background(), testQueries, account, partner.Username)
+	require.NoError(t, err)
+	require.False(t, access.Allows(HolderPermissionView))
+
+	accounts, err := testQueries.ListAccounts(context.Background(), ListAccountsParams{Owner: partner.Username, Limit: 5})
+	require.NoError(t, err)
+	require.Empty(t, accounts)
+
+	accepted, err := testQueries.AcceptAccountHolder(context.Background(), AcceptAccountHolderParams{
+		AccountID: account.ID,
+		Username:  partner.Username,
+	})
+	require.NoError(t, err)
+	require.Equal(t, HolderStatusActive, accepted.Status)
+	require.True(t, accepted.AcceptedAt.Valid)
+
+	_, err = testQueries.AcceptAccountHolder(context.Background(), AcceptAccountHolderParams{
+		AccountID: account.ID,
+		Username:  partner.Username,
+	})
+	require.ErrorIs(t, err, ErrRecordNotFound)
+
+	access, err = GetAccountAccess(context.Background(), testQueries, account, partner.Username)
+	require.NoError(t, err)
+	require.True(t, access.CanTransfer(500))
+	require.False(t, access.CanTransfer(501))
+	require.False(t, access.Allows(HolderPermissionManage))
+
+	accounts, err = testQueries.ListAccounts(context.Background(), ListAccountsParams{Owner: partner.Username, Limit: 5})
+	require.NoError(t, err)
+	require.Len(t, accounts, 1)
+	require.Equal(t, account.ID, accounts[0].ID)
+
+	holders, err := testQueries.ListAccountHolders(context.Background(), account.ID)
+	require.NoError(t, err)
+	require.Len(t, holders, 1)
+
+	_, err = testQueries.CreateAccountHolder(context.Background(), CreateAccountHolderParams{
+		AccountID:  account.ID,
+		Username:   partner.Username,
+		Permission: HolderPermissionView,
+		InvitedBy:  account.Owner,
+	})
+	require.Equal(t, UniqueViolation, ErrorCode(err))
+
+	removed, err := testQueries.DeleteAccountHolder(context.Background(), DeleteAccountHolderParams{
+		AccountID: account.ID,
+		Username:  partner.Username,
+	})
+	require.NoError(t, err)
+	require.Equal(t, holder.ID, removed.ID)
+
+	access, err = GetAccountAccess(context.Background(), testQueries, account, partner.Username)
+	require.NoError(t, err)
+	require.Zero(t, access)
+}
+
+func TestOpenSecondAccountOfSameKind(t *testing.T) {
+	account := createRandomAccount(t)
+
+	// a personal and a joint account may share an owner, currency and type
+	second, err := testQueries.CreateAccount(context.Background(), CreateAccountParams{
+		Owner:    account.Owner,
+		Balance:  0,
+		Currency: account.Currency,
+		Type:     account.Type,
+	})
+	require.NoError(t, err)
+	require.NotEqual(t, account.ID, second.ID)
+}
+
+func TestAccountAccess(t *testing.T) {
+	owner := AccountAccess{Permission: HolderPermissionManage}
+	require.True(t, owner.Allows(HolderPermissionView))
+	require.True(t, owner.Allows(HolderPermissionManage))
+	require.True(t, owner.CanTransfer(1_000_000))
+
+	viewer := AccountAccess{Permission: HolderPermissionView}
+	require.True(t, viewer.Allows(HolderPermissionView))
+	require.False(t, viewer.Allows(HolderPermissionTransfer))
+	require.False(t, viewer.CanTransfer(1))
+
+	limited := AccountAccess{Permission: HolderPermissionTransfer, TransferLimit: 100}
+	require.True(t, limited.CanTransfer(100))
+	require.False(t, limited.CanTransfer(101))
+
+	require.False(t, AccountAccess{}.Allows(HolderPermissionView))
+	require.False(t, owner.Allows("owner"))
+}
@@ -89,12 +89,48 @@ func TestListAccounts(t *testing.T) {
 	}
 }
 
+func TestUpdateAccountOverdraftLimit(t *testing.T) {
+	account1 := createRandomAccount(t)
+	require.Zero(t, account1.OverdraftLimit)
+
+	arg := UpdateAccountOverdraftLimitParams{
+		ID:             account1.ID,
+		OverdraftLimit: util.RandomInt(1, 1000),
+	}
+
+	account2, err := testQueries.UpdateAccountOverdraftLimit(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, account1.ID, account2.ID)
+	require.Equal(t, account1.Balance, account2.Balance)
+	require.Equal(t, arg.OverdraftLimit, account2.OverdraftLimit)
+
+	// a negative limit is rejected by the check constraint
+	_, err = testQueries.UpdateAccountOverdraftLimit(context.Background(), UpdateAccountOverdraftLimitParams{
+		ID:             account1.ID,
+		OverdraftLimit: -1,
+	})
+	require.Error(t, err)
+}
+
 func createRandomAccount(t *testing.T) Account {
+	return createRandomAccountWithBalance(t, util.RandomBalance())
+}
+
+func createRandomAccountWithBalance(t *testing.T, balance int64) Account {
+	return createRandomAccountWithCurrency(t, util.RandomCurrency(), balance)
+}
+
+func createRandomAccountWithCurrency(t *testing.T, currency string, balance int64) Account {
+	return createRandomAccountWithType(t, AccountTypeChecking, currency, balance)
+}
+
+func createRandomAccountWithType(t *testing.T, accountType, currency string, balance int64) Account {
 	user := createRandomUser(t)
 	arg := CreateAccountParams{
 		Owner:    user.Username,
-		Balance:  util.RandomBalance(),
-		Currency: util.RandomCurrency(),
+		Balance:  balance,
+		Currency: currency,
+		Type:     accountType,
 	}
 	account, err := testQueries.CreateAccount(context.Background(), arg)
 	require.NoError(t, err)
@@ -102,6 +138,7 @@ func createRandomAccount(t *testing.T) Account {
 	require.Equal(t, arg.Owner, account.Owner)
 	require.Equal(t, arg.Balance, account.Balance)
 	require.Equal(t, arg.Currency, account.Currency)
+	require.Equal(t, arg.Type, account.Type)
 	require.NotZero(t, account.ID)
 	require.NotZero(t, account.CreatedAt)
 	return account
@@ -0,0 +1,319 @@
+package db
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// Actions recorded in audit_events.action.
+const (
+	AuditUserCreate     = "user.create"
+	AuditUserUpdate     = "user.update"
+	AuditAccountCreate  = "account.create"
+	AuditAccountUpdate  = "account.update"
+	AuditAccountDelete  = "account.delete"
+	AuditTransferCreate = "transfer.create"
+	AuditTransferUpdate = "transfer.update"
+	AuditSessionCreate  = "session.create"
+	AuditFeeRuleCreate  = "fee_rule.create"
+	AuditCurrencyUpdate = "currency.update"
+
+	AuditTransferApprovalCreate = "transfer_approval.create"
+	AuditTransferApprovalUpdate = "transfer_approval.update"
+	AuditTransferReversalCreate = "transfer_reversal.create"
+	AuditAccountHoldCreate      = "account_hold.create"
+	AuditAccountHoldUpdate      = "account_hold.update"
+	AuditTransferLimitCreate    = "transfer_limit.create"
+	AuditTransferLimitUpdate    = "transfer_limit.update"
+
+	AuditAccountHolderCreate       = "account_holder.create"
+	AuditAccountHolderUpdate       = "account_holder.update"
+	AuditAccountHolderDelete       = "account_holder.delete"
+	AuditScheduledTransferCreate   = "scheduled_transfer.create"
+	AuditScheduledTransferUpdate   = "scheduled_transfer.update"
+	AuditWebhookSubscriptionCreate = "webhook_subscription.create"
+	AuditWebhookSubscriptionUpdate = "webhook_subscription.update"
+)
+
+// AuditSystemActor is recorded for changes made without an actor on the context, such as
+// scheduled transfers run by the worker.
+const AuditSystemActor = "system"
+
+// auditGenesisHash is the prev_hash of the first event in the chain.
+var auditGenesisHash = strings.Repeat("0", sha256.Size*2)
+
+// auditVerifyBatchSize is how many events VerifyAuditLog reads at a time.
+const auditVerifyBatchSize = 1000
+
+// AuditActor is who makes a change and from where.
+type AuditActor struct {
+	Username  string
+	Role      string
+	ClientIP  string
+	UserAgent string
+}
+
+type auditActorKey struct{}
+
+// WithAuditActor returns a context under which the store records changes as made by actor.
+func WithAuditActor(ctx context.Context, actor AuditActor) context.Context {
+	return context.WithValue(ctx, auditActorKey{}, actor)
+}
+
+// AuditActorFrom returns the actor set on ctx by WithAuditActor, or the system actor.
+func AuditActorFrom(ctx context.Context) AuditActor {
+	actor, ok := ctx.Value(auditActorKey{}).(AuditActor)
+	if !ok {
+		return AuditActor{Username: AuditSystemActor, Role: AuditSystemActor}
+	}
+	return actor
+}
+
+// auditedUser is what the audit log keeps of a user; the password hash is left out.
+type auditedUser struct {
+	Username              string    `json:"username"`
+	Role                  string    `json:"role"`
+	FullName              string    `json:"full_name"`
+	Email                 string    `json:"email"`
+	IsEmailVerified       bool      `json:"is_email_verified"`
+	LastPasswordChangedAt time.Time `json:"last_password_changed_at"`
+}
+
+func auditUser(user User) auditedUser {
+	return auditedUser{
+		Username:              user.Username,
+		Role:                  user.Role,
+		FullName:              user.FullName,
+		Email:                 user.Email,
+		IsEmailVerified:       user.IsEmailVerified,
+		LastPasswordChangedAt: user.LastPasswordChangedAt.Time,
+	}
+}
+
+// auditedSession is what the audit log keeps of a session; the refresh token is left out.
+type auditedSession struct {
+	ID        string    `json:"id"`
+	Username  string    `json:"username"`
+	UserAgent string    `json:"user_agent"`
+	ClientIP  string    `json:"client_ip"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+func auditSession(session Session) auditedSession {
+	return auditedSession{
+		ID:        session.ID.String(),
+		Username:  session.Username,
+		UserAgent: session.UserAgent,
+		ClientIP:  session.ClientIp,
+		ExpiresAt: session.ExpiresAt.Time,
+	}
+}
+
+// auditedWebhookSubscription is what the audit log keeps of a webhook subscription; the signing
+// secret is left out.
+type auditedWebhookSubscription struct {
+	ID         int64     `json:"id"`
+	Owner      string    `json:"owner"`
+	Url        string    `json:"url"`
+	EventTypes []string  `json:"event_types"`
+	Active     bool      `json:"active"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+func auditWebhookSubscription(subscription WebhookSubscription) auditedWebhookSubscription {
+	return auditedWebhookSubscription{
+		ID:         subscription.ID,
+		Owner:      subscription.Owner,
+		Url:        subscription.Url,
+		EventTypes: subscription.EventTypes,
+		Active:     subscription.Active,
+		CreatedAt:  subscription.CreatedAt.Time,
+	}
+}
+
+// recordAudit appends an event to the audit log, chained to the last one. It must run in the
+// transaction making the change, as late as possible since it holds the chain until commit.
+func (q txQueries) recordAudit(ctx context.Context, action string, targetType string, targetID any, before any, after any) error {
+	beforeJSON, err := marshalAuditState(before)
+	if err != nil {
+		return err
+	}
+	afterJSON, err := marshalAuditState(after)
+	if err != nil {
+		return err
+	}
+
+	err = q.LockAuditChain(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to lock audit chain: %w", err)
+	}
+
+	prevHash := auditGenesisHash
+	last, err := q.GetLastAuditEvent(ctx)
+	if err != nil && !errors.Is(err, ErrRecordNotFound) {
+		return fmt.Errorf("failed to get last audit event: %w", err)
+	}
+	if err == nil {
+		prevHash = last.Hash
+	}
+
+	actor := AuditActorFrom(ctx)
+	arg := CreateAuditEventParams{
+		Actor:      actor.Username,
+		Role:       actor.Role,
+		ClientIp:   actor.ClientIP,
+		UserAgent:  actor.UserAgent,
+		Action:     action,
+		TargetType: targetType,
+		TargetID:   fmt.Sprint(targetID),
+		Before:     beforeJSON,
+		After:      afterJSON,
+		// postgres keeps microseconds, so the hash is computed over what it will store
+		CreatedAt: pgtype.Timestamptz{Time: time.Now().UTC().Truncate(time.Microsecond), Valid: true},
+		PrevHash:  prevHash,
+	}
+	arg.Hash = hashAuditEvent(arg)
+
+	_, err = q.CreateAuditEvent(ctx, arg)
+	if err != nil {
+		return fmt.Errorf("failed to record audit event: %w", err)
+	}
+	return nil
+}
+
+func marshalAuditState(state any) ([]byte, error) {
+	if state == nil {
+		return nil, nil
+	}
+	data, err := json.Marshal(state)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal audit state: %w", err)
+	}
+	return data, nil
+}
+
+// hashAuditEvent returns the hex SHA-256 of every column of the event but its id and hash.
+func hashAuditEvent(arg CreateAuditEventParams) string {
+	// field order is fixed by the struct, and before/after keep their stored text
+	content, _ := json.Marshal(struct {
+		PrevHash   string          `json:"prev_hash"`
+		Actor      string          `json:"actor"`
+		Role       string          `json:"role"`
+		ClientIP   string          `json:"client_ip"`
+		UserAgent  string          `json:"user_agent"`
+		Action     string          `json:"action"`
+		TargetType string          `json:"target_type"`
+		TargetID   string          `json:"target_id"`
+		Before     json.RawMessage `json:"before"`
+		After      json.RawMessage `json:"after"`
+		CreatedAt  string          `json:"created_at"`
+	}{
+		PrevHash:   arg.PrevHash,
+		Actor:      arg.Actor,
+		Role:       arg.Role,
+		ClientIP:   arg.ClientIp,
+		UserAgent:  arg.UserAgent,
+		Action:     arg.Action,
+		TargetType: arg.TargetType,
+		TargetID:   arg.TargetID,
+		Before:     arg.Before,
+		After:      arg.After,
+		CreatedAt:  arg.CreatedAt.Time.UTC().Format(time.RFC3339Nano),
+	})
+
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
+
+// AuditTamperError reports the first event of the audit log that does not match its hash or
+// does not chain to the event before it.
+type AuditTamperError struct {
+	EventID int64
+	Reason  string
+}
+
+func (e *AuditTamperError) Error() string {
+	return fmt.Sprintf("audit event %d was tampered with: %s", e.EventID, e.Reason)
+}
+
+// VerifyAuditChain checks that events, in id order, follow prevHash and each other. It returns
+// the hash of the last event that passed, to continue the check from, and how many passed.
+// It fails with an *AuditTamperError.
+func VerifyAuditChain(prevHash string, events []AuditEvent) (string, int, error) {
+	for i, event := range events {
+		if event.PrevHash != prevHash {
+			return prevHash, i, &AuditTamperError{EventID: event.ID, Reason: "does not chain to the previous event"}
+		}
+
+		hash := hashAuditEvent(CreateAuditEventParams{
+			Actor:      event.Actor,
+			Role:       event.Role,
+			ClientIp:   event.ClientIp,
+			UserAgent:  event.UserAgent,
+			Action:     event.Action,
+			TargetType: event.TargetType,
+			TargetID:   event.TargetID,
+			Before:     event.Before,
+			After:      event.After,
+			CreatedAt:  event.CreatedAt,
+			PrevHash:   event.PrevHash,
+		})
+		if hash != event.Hash {
+			return prevHash, i, &AuditTamperError{EventID: event.ID, Reason: "content does not match its hash"}
+		}
+		prevHash = event.Hash
+	}
+	return prevHash, len(events), nil
+}
+
+// AuditVerification is the outcome of VerifyAuditLog.
+type AuditVerification struct {
+	// EventsVerified is how many events passed the check, from the start of the log.
+	EventsVerified int64
+	// HeadHash is the hash of the last event that passed. Keeping it outside the database
+	// lets a later check tell that no events were removed from the end.
+	HeadHash string
+	// Tampered is set to the first event that failed the check.
+	Tampered *AuditTamperError
+}
+
+// VerifyAuditLog walks the whole audit log and checks its hash chain.
+func (store *SQLStore) VerifyAuditLog(ctx context.Context) (AuditVerification, error) {
+	result := AuditVerification{HeadHash: auditGenesisHash}
+	var afterID int64
+
+	for {
+		events, err := store.q.ListAuditEvents(ctx, ListAuditEventsParams{
+			AfterID:  afterID,
+			PageSize: auditVerifyBatchSize,
+		})
+		if err != nil {
+			return AuditVerification{}, err
+		}
+
+		var verified int
+		result.HeadHash, verified, err = VerifyAuditChain(result.HeadHash, events)
+		result.EventsVerified += int64(verified)
+		if err != nil {
+			var tampered *AuditTamperError
+			if !errors.As(err, &tampered) {
+				return AuditVerification{}, err
+			}
+			result.Tampered = tampered
+			return result, nil
+		}
+
+		if len(events) < auditVerifyBatchSize {
+			return result, nil
+		}
+		afterID = events[len(events)-1].ID
+	}
+}
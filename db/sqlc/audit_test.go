@@ -0,0 +1,210 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/mahanth/simplebank/util"
+	"github.com/stretchr/testify/require"
+)
+
+// chainAuditEvents builds n events chained to each other from the genesis hash.
+func chainAuditEvents(n int) []AuditEvent {
+	events := make([]AuditEvent, n)
+	prevHash := auditGenesisHash
+	for i := range events {
+		arg := CreateAuditEventParams{
+			Actor:      util.RandomOwner(),
+			Role:       util.DepositorRole,
+			ClientIp:   "10.0.0.1:5000",
+			UserAgent:  "test",
+			Action:     AuditTransferCreate,
+			TargetType: "transfer",
+			TargetID:   util.RandomString(4),
+			After:      []byte(`{"amount": 10, "memo": "rent"}`),
+			CreatedAt:  pgtype.Timestamptz{Time: time.Now().UTC().Truncate(time.Microsecond), Valid: true},
+			PrevHash:   prevHash,
+		}
+		arg.Hash = hashAuditEvent(arg)
+		prevHash = arg.Hash
+
+		events[i] = AuditEvent{
+			ID:         int64(i + 1),
+			Actor:      arg.Actor,
+			Role:       arg.Role,
+			ClientIp:   arg.ClientIp,
+			UserAgent:  arg.UserAgent,
+			Action:     arg.Action,
+			TargetType: arg.TargetType,
+			TargetID:   arg.TargetID,
+			After:      arg.After,
+			CreatedAt:  arg.CreatedAt,
+			PrevHash:   arg.PrevHash,
+			Hash:       arg.Hash,
+		}
+	}
+	return events
+}
+
+func TestVerifyAuditChain(t *testing.T) {
+	testCases := []struct {
+		name       string
+		tamper     func(events []AuditEvent) []AuditEvent
+		tamperedID int64
+		verified   int
+	}{
+		{
+			name:     "Intact",
+			tamper:   func(events []AuditEvent) []AuditEvent { return events },
+			verified: 5,
+		},
+		{
+			name: "AlteredContent",
+			tamper: func(events []AuditEvent) []AuditEvent {
+				events[2].After = []byte(`{"amount": 1000, "memo": "rent"}`)
+				return events
+			},
+			tamperedID: 3,
+			verified:   2,
+		},
+		{
+			name: "AlteredActor",
+			tamper: func(events []AuditEvent) []AuditEvent {
+				events[0].Actor = "someone-else"
+				return events
+			},
+			tamperedID: 1,
+			verified:   0,
+		},
+		{
+			name: "RemovedEvent",
+			tamper: func(events []AuditEvent) []AuditEvent {
+				return append(events[:1], events[2:]...)
+			},
+			tamperedID: 3,
+			verified:   1,
+		},
+		{
+			name: "RehashedEvent",
+			tamper: func(events []AuditEvent) []AuditEvent {
+				// recomputing the altered event's own hash still breaks the link to the next one
+				events[1].TargetID = "forged"
+				events[1].Hash = hashAuditEvent(CreateAuditEventParams{
+					Actor:      events[1].Actor,
+					Role:       events[1].Role,
+					ClientIp:   events[1].ClientIp,
+					UserAgent:  events[1].UserAgent,
+					Action:     events[1].Action,
+					TargetType: events[1].TargetType,
+					TargetID:   events[1].TargetID,
+					After:      events[1].After,
+					CreatedAt:  events[1].CreatedAt,
+					PrevHash:   events[1].PrevHash,
+				})
+				return events
+			},
+			tamperedID: 3,
+			verified:   2,
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			events := chainAuditEvents(5)
+			head := events[len(events)-1].Hash
+
+			hash, verified, err := VerifyAuditChain(auditGenesisHash, tc.tamper(events))
+			require.Equal(t, tc.verified, verified)
+
+			if tc.tamperedID == 0 {
+				require.NoError(t, err)
+				require.Equal(t, head, hash)
+				return
+			}
+
+			var tampered *AuditTamperError
+			require.True(t, errors.As(err, &tampered))
+			require.Equal(t, tc.tamperedID, tampered.EventID)
+		})
+	}
+}
+
+func TestAuditActorFrom(t *testing.T) {
+	actor := AuditActorFrom(context.Background())
+	require.Equal(t, AuditSystemActor, actor.Username)
+
+	banker := AuditActor{Username: util.RandomOwner(), Role: util.BankerRole, ClientIP: "10.0.0.1"}
+	require.Equal(t, banker, AuditActorFrom(WithAuditActor(context.Background(), banker)))
+}
+
+func TestAuditLog(t *testing.T) {
+	store := NewStore(testDB)
+	actor := AuditActor{
+		Username:  util.RandomOwner(),
+		Role:      util.DepositorRole,
+		ClientIP:  "10.0.0.1:5000",
+		UserAgent: "audit-test",
+	}
+	ctx := WithAuditActor(context.Background(), actor)
+
+	hashedPassword, err := util.HashPassword(util.RandomString(6))
+	require.NoError(t, err)
+
+	user, err := store.CreateUser(ctx, CreateUserParams{
+		Username:       actor.Username,
+		HashedPassword: hashedPassword,
+		FullName:       util.RandomOwner(),
+		Email:          util.RandomEmail(),
+	})
+	require.NoError(t, err)
+
+	event, err := testQueries.GetLastAuditEvent(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, AuditUserCreate, event.Action)
+	require.Equal(t, user.Username, event.TargetID)
+	require.Equal(t, actor.Username, event.Actor)
+	require.Equal(t, actor.ClientIP, event.ClientIp)
+	require.Equal(t, actor.UserAgent, event.UserAgent)
+	require.Nil(t, event.Before)
+	require.NotContains(t, string(event.After), hashedPassword)
+
+	account, err := store.CreateAccount(ctx, CreateAccountParams{
+		Owner:    user.Username,
+		Balance:  0,
+		Currency: util.USD,
+		Type:     AccountTypeChecking,
+	})
+	require.NoError(t, err)
+
+	_, err = store.UpdateAccount(ctx, UpdateAccountParams{ID: account.ID, Balance: 100})
+	require.NoError(t, err)
+
+	update, err := testQueries.GetLastAuditEvent(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, AuditAccountUpdate, update.Action)
+	require.NotNil(t, update.Before)
+	events, err := testQueries.ListAuditEvents(context.Background(), ListAuditEventsParams{
+		AfterID:  event.ID - 1,
+		PageSize: 3,
+	})
+	require.NoError(t, err)
+	require.Len(t, events, 3)
+	for i := 1; i < len(events); i++ {
+		require.Equal(t, events[i-1].Hash, events[i].PrevHash)
+	}
+
+	// history cannot be rewritten in place
+	_, err = testDB.Exec(context.Background(), "UPDATE audit_events SET actor = 'someone-else' WHERE id = $1", event.ID)
+	require.Error(t, err)
+	_, err = testDB.Exec(context.Background(), "DELETE FROM audit_events WHERE id = $1", event.ID)
+	require.Error(t, err)
+
+	result, err := store.VerifyAuditLog(context.Background())
+	require.NoError(t, err)
+	require.Nil(t, result.Tampered)
+	require.Positive(t, result.EventsVerified)
+}
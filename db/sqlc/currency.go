@@ -0,0 +1,13 @@
+package db
+
+import "github.com/mahanth/simplebank/util"
+
+// CurrencyFromDB converts a stored currency into the entry util's currency registry keeps.
+func CurrencyFromDB(currency Currency) util.Currency {
+	return util.Currency{
+		Code:     currency.Code,
+		Exponent: currency.Exponent,
+		Name:     currency.Name,
+		Enabled:  currency.Enabled,
+	}
+}
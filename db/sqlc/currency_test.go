@@ -0,0 +1,52 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/mahanth/simplebank/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListCurrencies(t *testing.T) {
+	currencies, err := testQueries.ListCurrencies(context.Background())
+	require.NoError(t, err)
+
+	// the seeded currencies match the registry's defaults
+	byCode := make(map[string]util.Currency)
+	for _, currency := range currencies {
+		byCode[currency.Code] = CurrencyFromDB(currency)
+	}
+	for _, currency := range util.DefaultCurrencies {
+		stored, ok := byCode[currency.Code]
+		require.True(t, ok, currency.Code)
+		require.Equal(t, currency.Exponent, stored.Exponent)
+	}
+}
+
+func TestUpdateCurrencyEnabled(t *testing.T) {
+	banker := createRandomUser(t)
+
+	currency, err := testQueries.GetCurrency(context.Background(), util.CHF)
+	require.NoError(t, err)
+
+	updated, err := testQueries.UpdateCurrencyEnabled(context.Background(), UpdateCurrencyEnabledParams{
+		Code:      util.CHF,
+		Enabled:   !currency.Enabled,
+		UpdatedBy: pgtype.Text{String: banker.Username, Valid: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, !currency.Enabled, updated.Enabled)
+	require.Equal(t, banker.Username, updated.UpdatedBy.String)
+	require.True(t, updated.UpdatedAt.Time.After(currency.UpdatedAt.Time))
+
+	_, err = testQueries.UpdateCurrencyEnabled(context.Background(), UpdateCurrencyEnabledParams{
+		Code:    util.CHF,
+		Enabled: currency.Enabled,
+	})
+	require.NoError(t, err)
+
+	_, err = testQueries.UpdateCurrencyEnabled(context.Background(), UpdateCurrencyEnabledParams{Code: "XYZ"})
+	require.ErrorIs(t, err, ErrRecordNotFound)
+}
@@ -0,0 +1,16 @@
+package db
+
+// Directions that ListTransfers and ListEntries can filter by, as seen from the listed account.
+const (
+	DirectionIn  = "in"
+	DirectionOut = "out"
+)
+
+// IsSupportedDirection reports whether direction is one the history queries can filter by.
+func IsSupportedDirection(direction string) bool {
+	switch direction {
+	case DirectionIn, DirectionOut:
+		return true
+	}
+	return false
+}
@@ -7,36 +7,97 @@ package db
 
 import (
 	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 const createEntry = `-- name: CreateEntry :one
+WITH account AS (
+    SELECT balance FROM accounts
+    WHERE id = $1
+    FOR NO KEY UPDATE
+)
 INSERT INTO entries (
     account_id,
-    amount
-) VALUES (
-    $1, $2
-) RETURNING id, account_id, amount, created_at
+    amount,
+    type,
+    transfer_id,
+    balance_after,
+    created_at
+)
+SELECT
+    $1::bigint,
+    $2::bigint,
+    $3::varchar,
+    $4::bigint,
+    account.balance + $2::bigint,
+    clock_timestamp()
+FROM account
+RETURNING id, account_id, amount, created_at, type, transfer_id, balance_after
 `
 
 type CreateEntryParams struct {
-	AccountID int64 `json:"account_id"`
-	Amount    int64 `json:"amount"`
+	AccountID  int64       `json:"account_id"`
+	Amount     int64       `json:"amount"`
+	Type       string      `json:"type"`
+	TransferID pgtype.Int8 `json:"transfer_id"`
 }
 
+// The account is locked before the entry is numbered and timestamped, so that the entries of an
+// account are numbered and timestamped in the order they are booked, rather than stamped with the
+// start of a transaction that may have waited for the lock. The caller applies the amount to the
+// balance afterwards.
 func (q *Queries) CreateEntry(ctx context.Context, arg CreateEntryParams) (Entry, error) {
-	row := q.db.QueryRow(ctx, createEntry, arg.AccountID, arg.Amount)
+	row := q.db.QueryRow(ctx, createEntry,
+		arg.AccountID,
+		arg.Amount,
+		arg.Type,
+		arg.TransferID,
+	)
 	var i Entry
 	err := row.Scan(
 		&i.ID,
 		&i.AccountID,
 		&i.Amount,
 		&i.CreatedAt,
+		&i.Type,
+		&i.TransferID,
+		&i.BalanceAfter,
 	)
 	return i, err
 }
 
+const getBalanceAt = `-- name: GetBalanceAt :one
+SELECT COALESCE(
+    (SELECT e.balance_after FROM entries e
+     WHERE e.account_id = $1 AND e.created_at <= $2
+     ORDER BY e.id DESC
+     LIMIT 1),
+    (SELECT e.balance_after - e.amount FROM entries e
+     WHERE e.account_id = $1
+     ORDER BY e.id
+     LIMIT 1),
+    (SELECT a.balance FROM accounts a
+     WHERE a.id = $1)
+)::bigint AS balance
+`
+
+type GetBalanceAtParams struct {
+	AccountID int64              `json:"account_id"`
+	At        pgtype.Timestamptz `json:"at"`
+}
+
+// The balance after the last entry booked by the time, or before the first entry if none was.
+// The entries of an account are booked in the order of both their id and created_at.
+func (q *Queries) GetBalanceAt(ctx context.Context, arg GetBalanceAtParams) (int64, error) {
+	row := q.db.QueryRow(ctx, getBalanceAt, arg.AccountID, arg.At)
+	var balance int64
+	err := row.Scan(&balance)
+	return balance, err
+}
+
 const getEntry = `-- name: GetEntry :one
-SELECT id, account_id, amount, created_at FROM entries WHERE id = $1 LIMIT 1
+SELECT id, account_id, amount, created_at, type, transfer_id, balance_after FROM entries WHERE id = $1 LIMIT 1
 `
 
 func (q *Queries) GetEntry(ctx context.Context, id int64) (Entry, error) {
@@ -47,26 +108,92 @@ func (q *Queries) GetEntry(ctx context.Context, id int64) (Entry, error) {
 		&i.AccountID,
 		&i.Amount,
 		&i.CreatedAt,
+		&i.Type,
+		&i.TransferID,
+		&i.BalanceAfter,
 	)
 	return i, err
 }
 
 const listEntries = `-- name: ListEntries :many
-SELECT id, account_id, amount, created_at FROM entries
-WHERE account_id = $1
-ORDER BY id
-LIMIT $2
-OFFSET $3
+SELECT e.id, e.account_id, e.amount, e.created_at, e.type, e.transfer_id, e.balance_after FROM entries e
+LEFT JOIN transfers t ON t.id = e.transfer_id
+WHERE e.account_id = $1
+  AND ($2::bigint IS NULL OR e.id < $2)
+  AND ($3::timestamptz IS NULL OR e.created_at >= $3)
+  AND ($4::timestamptz IS NULL OR e.created_at < $4)
+  AND ($5::varchar IS NULL
+    OR ($5 = 'out' AND e.amount < 0)
+    OR ($5 = 'in' AND e.amount > 0))
+  AND ($6::bigint IS NULL
+    OR (t.from_account_id = e.account_id AND t.to_account_id = $6)
+    OR (t.to_account_id = e.account_id AND t.from_account_id = $6))
+  AND ($7::bigint IS NULL OR abs(e.amount) >= $7)
+  AND ($8::bigint IS NULL OR abs(e.amount) <= $8)
+ORDER BY e.id DESC
+LIMIT $9
 `
 
 type ListEntriesParams struct {
-	AccountID int64 `json:"account_id"`
-	Limit     int32 `json:"limit"`
-	Offset    int32 `json:"offset"`
+	AccountID             int64              `json:"account_id"`
+	BeforeID              pgtype.Int8        `json:"before_id"`
+	StartTime             pgtype.Timestamptz `json:"start_time"`
+	EndTime               pgtype.Timestamptz `json:"end_time"`
+	Direction             pgtype.Text        `json:"direction"`
+	CounterpartyAccountID pgtype.Int8        `json:"counterparty_account_id"`
+	MinAmount             pgtype.Int8        `json:"min_amount"`
+	MaxAmount             pgtype.Int8        `json:"max_amount"`
+	PageSize              int32              `json:"page_size"`
 }
 
+// Entries of an account, newest first. Direction is in for credits and out for debits, amounts are
+// filtered by their absolute value, and the counterparty is the other account of the entry's transfer.
 func (q *Queries) ListEntries(ctx context.Context, arg ListEntriesParams) ([]Entry, error) {
-	rows, err := q.db.Query(ctx, listEntries, arg.AccountID, arg.Limit, arg.Offset)
+	rows, err := q.db.Query(ctx, listEntries,
+		arg.AccountID,
+		arg.BeforeID,
+		arg.StartTime,
+		arg.EndTime,
+		arg.Direction,
+		arg.CounterpartyAccountID,
+		arg.MinAmount,
+		arg.MaxAmount,
+		arg.PageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Entry{}
+	for rows.Next() {
+		var i Entry
+		if err := rows.Scan(
+			&i.ID,
+			&i.AccountID,
+			&i.Amount,
+			&i.CreatedAt,
+			&i.Type,
+			&i.TransferID,
+			&i.BalanceAfter,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listEntriesByTransfer = `-- name: ListEntriesByTransfer :many
+SELECT id, account_id, amount, created_at, type, transfer_id, balance_after FROM entries
+WHERE transfer_id = $1
+ORDER BY id
+`
+
+func (q *Queries) ListEntriesByTransfer(ctx context.Context, transferID pgtype.Int8) ([]Entry, error) {
+	rows, err := q.db.Query(ctx, listEntriesByTransfer, transferID)
 	if err != nil {
 		return nil, err
 	}
@@ -79,6 +206,9 @@ func (q *Queries) ListEntries(ctx context.Context, arg ListEntriesParams) ([]Ent
 			&i.AccountID,
 			&i.Amount,
 			&i.CreatedAt,
+			&i.Type,
+			&i.TransferID,
+			&i.BalanceAfter,
 		); err != nil {
 			return nil, err
 		}
@@ -3,9 +3,12 @@ package db
 import (
 	"context"
 	"testing"
+	"time"
 
-	"github.com/mahanth/simplebank/util"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
 
+	"github.com/mahanth/simplebank/util"
 	"github.com/stretchr/testify/require"
 )
 
@@ -19,6 +22,7 @@ func TestCreateEntry(t *testing.T) {
 	arg := CreateEntryParams{
 		AccountID: account.ID,
 		Amount:    -util.RandomBalance(),
+		Type:      EntryTypeAdjustment,
 	}
 
 	entry, err := testQueries.CreateEntry(context.Background(), arg)
@@ -27,6 +31,7 @@ func TestCreateEntry(t *testing.T) {
 	require.NotEmpty(t, entry)
 	require.Equal(t, arg.AccountID, entry.AccountID)
 	require.Equal(t, arg.Amount, entry.Amount)
+	require.Equal(t, account.Balance+arg.Amount, entry.BalanceAfter)
 	require.NotZero(t, entry.ID)
 	require.NotZero(t, entry.CreatedAt)
 }
@@ -40,6 +45,7 @@ func TestGetEntry(t *testing.T) {
 	arg := CreateEntryParams{
 		AccountID: account.ID,
 		Amount:    util.RandomBalance(),
+		Type:      EntryTypeAdjustment,
 	}
 
 	entry1, err := testQueries.CreateEntry(context.Background(), arg)
@@ -62,28 +68,216 @@ func TestListEntries(t *testing.T) {
 	require.NotEmpty(t, account)
 	require.NotZero(t, account.ID)
 
+	var created []Entry
 	for i := 0; i < 10; i++ {
 		arg := CreateEntryParams{
 			AccountID: account.ID,
-			Amount:    util.RandomBalance(),
+			Amount:    int64(i + 1),
+			Type:      EntryTypeAdjustment,
+		}
+		if i%2 == 1 {
+			arg.Amount = -arg.Amount
 		}
 
 		entry, err := testQueries.CreateEntry(context.Background(), arg)
 		require.NoError(t, err)
 		require.NotEmpty(t, entry)
+		created = append(created, entry)
 	}
 
 	arg := ListEntriesParams{
 		AccountID: account.ID,
-		Limit:     5,
-		Offset:    2,
+		PageSize:  5,
 	}
 
 	entries, err := testQueries.ListEntries(context.Background(), arg)
 	require.NoError(t, err)
 	require.Len(t, entries, 5)
 
-	for _, entry := range entries {
+	// newest first
+	for i, entry := range entries {
 		require.Equal(t, account.ID, entry.AccountID)
+		require.Equal(t, created[9-i].ID, entry.ID)
+	}
+
+	// the next page starts after the last entry of this one
+	arg.BeforeID = pgtype.Int8{Int64: entries[4].ID, Valid: true}
+	entries, err = testQueries.ListEntries(context.Background(), arg)
+	require.NoError(t, err)
+	require.Len(t, entries, 5)
+	require.Equal(t, created[4].ID, entries[0].ID)
+	require.Equal(t, created[0].ID, entries[4].ID)
+
+	entries, err = testQueries.ListEntries(context.Background(), ListEntriesParams{
+		AccountID: account.ID,
+		Direction: pgtype.Text{String: DirectionOut, Valid: true},
+		MinAmount: pgtype.Int8{Int64: 4, Valid: true},
+		MaxAmount: pgtype.Int8{Int64: 8, Valid: true},
+		PageSize:  10,
+	})
+	require.NoError(t, err)
+	require.Len(t, entries, 3)
+	for _, entry := range entries {
+		require.Negative(t, entry.Amount)
+	}
+}
+
+func TestEntryRunningBalance(t *testing.T) {
+	store := NewStore(testDB)
+
+	account1 := createRandomAccountWithCurrency(t, util.USD, 1000)
+	account2 := createRandomAccountWithCurrency(t, util.USD, 1000)
+
+	n := 10
+	errs := make(chan error)
+	for i := 0; i < n; i++ {
+		fromAccountID, toAccountID := account1.ID, account2.ID
+		if i%2 == 1 {
+			fromAccountID, toAccountID = toAccountID, fromAccountID
+		}
+		go func() {
+			_, err := store.TransferTx(context.Background(), TransferTxParams{
+				FromAccountID: fromAccountID,
+				ToAccountID:   toAccountID,
+				Amount:        int64(10 + i),
+			})
+			errs <- err
+		}()
+	}
+	for i := 0; i < n; i++ {
+		require.NoError(t, <-errs)
+	}
+
+	for _, account := range []Account{account1, account2} {
+		entries, err := store.ListEntries(context.Background(), ListEntriesParams{
+			AccountID: account.ID,
+			PageSize:  int32(n),
+		})
+		require.NoError(t, err)
+		require.Len(t, entries, n)
+
+		// however the transfers interleaved, each entry continues from the one before it
+		balance := account.Balance
+		for i := len(entries) - 1; i >= 0; i-- {
+			balance += entries[i].Amount
+			require.Equal(t, balance, entries[i].BalanceAfter)
+		}
+
+		updated, err := store.GetAccount(context.Background(), account.ID)
+		require.NoError(t, err)
+		require.Equal(t, updated.Balance, balance)
+	}
+}
+
+func TestGetBalanceAt(t *testing.T) {
+	store := NewStore(testDB)
+
+	account, err := store.CreateAccount(context.Background(), CreateAccountParams{
+		Owner:    createRandomUser(t).Username,
+		Balance:  500,
+		Currency: util.USD,
+		Type:     AccountTypeChecking,
+	})
+	require.NoError(t, err)
+	opened := time.Now()
+
+	balanceAt := func(at time.Time) int64 {
+		balance, err := store.GetBalanceAt(context.Background(), GetBalanceAtParams{
+			AccountID: account.ID,
+			At:        pgtype.Timestamptz{Time: at, Valid: true},
+		})
+		require.NoError(t, err)
+		return balance
+	}
+
+	// before the opening deposit the account was empty
+	require.Zero(t, balanceAt(account.CreatedAt.Time.Add(-time.Second)))
+	require.Equal(t, int64(500), balanceAt(opened))
+
+	time.Sleep(10 * time.Millisecond)
+	_, err = store.AdjustBalanceTx(context.Background(), AdjustBalanceTxParams{
+		AccountID: account.ID,
+		Amount:    -200,
+		Type:      EntryTypeWithdrawal,
+	})
+	require.NoError(t, err)
+
+	require.Equal(t, int64(500), balanceAt(opened))
+	require.Equal(t, int64(300), balanceAt(time.Now()))
+}
+
+func TestGetBalanceAtConcurrentEntries(t *testing.T) {
+	ctx := context.Background()
+	store := NewStore(testDB)
+
+	account, err := store.CreateAccount(ctx, CreateAccountParams{
+		Owner:    createRandomUser(t).Username,
+		Balance:  500,
+		Currency: util.USD,
+		Type:     AccountTypeChecking,
+	})
+	require.NoError(t, err)
+
+	book := func(tx pgx.Tx, amount int64) (Entry, error) {
+		q := New(tx)
+		entry, err := q.CreateEntry(ctx, CreateEntryParams{
+			AccountID: account.ID,
+			Amount:    amount,
+			Type:      EntryTypeAdjustment,
+		})
+		if err != nil {
+			return Entry{}, err
+		}
+		_, err = q.AddAccountBalance(ctx, AddAccountBalanceParams{ID: account.ID, Amount: amount})
+		if err != nil {
+			return Entry{}, err
+		}
+		return entry, tx.Commit(ctx)
+	}
+
+	// the first transaction starts before the window but waits for the second to book its entry
+	tx1, err := testDB.Begin(ctx)
+	require.NoError(t, err)
+	defer tx1.Rollback(ctx)
+	time.Sleep(20 * time.Millisecond)
+	windowStart := time.Now()
+
+	tx2, err := testDB.Begin(ctx)
+	require.NoError(t, err)
+	defer tx2.Rollback(ctx)
+	_, err = New(tx2).GetAccountForUpdate(ctx, account.ID)
+	require.NoError(t, err)
+
+	type booked struct {
+		entry Entry
+		err   error
+	}
+	first := make(chan booked, 1)
+	go func() {
+		entry, err := book(tx1, 100)
+		first <- booked{entry, err}
+	}()
+	time.Sleep(20 * time.Millisecond)
+
+	entry2, err := book(tx2, 10)
+	require.NoError(t, err)
+	result := <-first
+	require.NoError(t, result.err)
+	entry1 := result.entry
+
+	// the entry booked last is numbered and timestamped last
+	require.Greater(t, entry1.ID, entry2.ID)
+	require.True(t, entry1.CreatedAt.Time.After(entry2.CreatedAt.Time))
+
+	balanceAt := func(at time.Time) int64 {
+		balance, err := store.GetBalanceAt(ctx, GetBalanceAtParams{
+			AccountID: account.ID,
+			At:        pgtype.Timestamptz{Time: at, Valid: true},
+		})
+		require.NoError(t, err)
+		return balance
 	}
+	require.Equal(t, int64(500), balanceAt(windowStart))
+	require.Equal(t, int64(510), balanceAt(entry2.CreatedAt.Time))
+	require.Equal(t, int64(610), balanceAt(entry1.CreatedAt.Time))
 }
@@ -0,0 +1,13 @@
+package db
+
+// Entry types recorded in entries.type. Every change to an account balance is
+// booked as an entry of one of these types.
+const (
+	EntryTypeTransfer   = "transfer"
+	EntryTypeDeposit    = "deposit"
+	EntryTypeWithdrawal = "withdrawal"
+	EntryTypeFee        = "fee"
+	EntryTypeAdjustment = "adjustment"
+	EntryTypeReversal   = "reversal"
+	EntryTypeInterest   = "interest"
+)
@@ -0,0 +1,44 @@
+package db
+
+import (
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+const (
+	ForeignKeyViolation  = "23503"
+	UniqueViolation      = "23505"
+	CheckViolation       = "23514"
+	SerializationFailure = "40001"
+	DeadlockDetected     = "40P01"
+)
+
+var (
+	ErrRecordNotFound          = pgx.ErrNoRows
+	ErrInsufficientFunds       = errors.New("insufficient funds")
+	ErrIdempotencyKeyReused    = errors.New("idempotency key was already used with a different request")
+	ErrCurrencyMismatch        = errors.New("accounts have different currencies")
+	ErrExchangeRateNotFound    = errors.New("no exchange rate in effect")
+	ErrReversalExceedsTransfer = errors.New("reversal exceeds the amount of the transfer left to reverse")
+	ErrHoldNotActive           = errors.New("hold is no longer active")
+	ErrCaptureExceedsHold      = errors.New("capture exceeds the amount of the hold")
+	ErrAccountNotActive        = errors.New("account is not active")
+	ErrInvalidStatusChange     = errors.New("account status cannot change that way")
+	ErrAccountNotEmpty         = errors.New("account still holds funds")
+	ErrTransferLimitExceeded   = errors.New("transfer limit exceeded")
+	ErrApprovalNotPending      = errors.New("transfer approval is no longer pending")
+	ErrSelfApproval            = errors.New("transfer approval must be decided by someone other than its requester")
+	ErrHoldAwaitingApproval    = errors.New("hold secures a transfer awaiting approval")
+	ErrApprovalRequired        = errors.New("transfer must be approved by a banker")
+)
+
+// ErrorCode returns the postgres error code of err, or an empty string if err is not a postgres error.
+func ErrorCode(err error) string {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		return pgErr.Code
+	}
+	return ""
+}
@@ -0,0 +1,87 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/mahanth/simplebank/fx"
+)
+
+// LoadExchangeRatesTx stores a batch of published rates, replacing any rate already stored
+// for the same currencies and effective time. It returns the number of rates stored.
+func (store *SQLStore) LoadExchangeRatesTx(ctx context.Context, rates []fx.Rate) (int, error) {
+	err := store.execTx(ctx, func(q txQueries) error {
+		for _, rate := range rates {
+			value, err := numericFromString(rate.Rate)
+			if err != nil {
+				return err
+			}
+
+			_, err = q.UpsertExchangeRate(ctx, UpsertExchangeRateParams{
+				BaseCurrency:  rate.Base,
+				QuoteCurrency: rate.Quote,
+				Rate:          value,
+				EffectiveAt:   pgtype.Timestamptz{Time: rate.EffectiveAt, Valid: true},
+			})
+			if err != nil {
+				return fmt.Errorf("failed to store %s/%s rate: %w", rate.Base, rate.Quote, err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return len(rates), nil
+}
+
+// exchangeRate returns the rate in effect for converting from into to, rounded to fx.RateScale.
+// A rate stored for the pair is used directly; otherwise the rate is crossed through the ECB base currency.
+func (q txQueries) exchangeRate(ctx context.Context, from, to string) (*big.Rat, error) {
+	direct, err := q.GetExchangeRate(ctx, GetExchangeRateParams{
+		BaseCurrency:  from,
+		QuoteCurrency: to,
+	})
+	if err == nil {
+		rate, err := ratFromNumeric(direct.Rate)
+		if err != nil {
+			return nil, err
+		}
+		return fx.RoundRate(rate), nil
+	}
+	if !errors.Is(err, ErrRecordNotFound) {
+		return nil, err
+	}
+
+	fromRate, err := q.baseRate(ctx, from)
+	if err != nil {
+		return nil, err
+	}
+	toRate, err := q.baseRate(ctx, to)
+	if err != nil {
+		return nil, err
+	}
+	return fx.CrossRate(fromRate, toRate)
+}
+
+// baseRate returns how many units of currency one unit of the ECB base currency buys.
+func (q txQueries) baseRate(ctx context.Context, currency string) (*big.Rat, error) {
+	if currency == fx.ECBBaseCurrency {
+		return big.NewRat(1, 1), nil
+	}
+
+	rate, err := q.GetExchangeRate(ctx, GetExchangeRateParams{
+		BaseCurrency:  fx.ECBBaseCurrency,
+		QuoteCurrency: currency,
+	})
+	if err != nil {
+		if errors.Is(err, ErrRecordNotFound) {
+			return nil, fmt.Errorf("%w: %s/%s", ErrExchangeRateNotFound, fx.ECBBaseCurrency, currency)
+		}
+		return nil, err
+	}
+	return ratFromNumeric(rate.Rate)
+}
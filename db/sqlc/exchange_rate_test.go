@@ -0,0 +1,117 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/mahanth/simplebank/fx"
+	"github.com/mahanth/simplebank/util"
+	"github.com/stretchr/testify/require"
+)
+
+func loadTestExchangeRates(t *testing.T, store Store) {
+	effectiveAt := time.Now().Add(-time.Second).UTC()
+	rates := []fx.Rate{
+		{Base: fx.ECBBaseCurrency, Quote: util.USD, Rate: "1.0956", EffectiveAt: effectiveAt},
+		{Base: fx.ECBBaseCurrency, Quote: util.CAD, Rate: "1.4565", EffectiveAt: effectiveAt},
+	}
+
+	n, err := store.LoadExchangeRatesTx(context.Background(), rates)
+	require.NoError(t, err)
+	require.Equal(t, len(rates), n)
+
+	// loading the same rates again replaces them
+	n, err = store.LoadExchangeRatesTx(context.Background(), rates)
+	require.NoError(t, err)
+	require.Equal(t, len(rates), n)
+}
+
+func TestLoadExchangeRatesTx(t *testing.T) {
+	store := NewStore(testDB)
+	loadTestExchangeRates(t, store)
+
+	rate, err := store.GetExchangeRate(context.Background(), GetExchangeRateParams{
+		BaseCurrency:  fx.ECBBaseCurrency,
+		QuoteCurrency: util.USD,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "1.0956", NumericString(rate.Rate))
+
+	_, err = store.LoadExchangeRatesTx(context.Background(), []fx.Rate{
+		{Base: fx.ECBBaseCurrency, Quote: util.USD, Rate: "-1", EffectiveAt: time.Now()},
+	})
+	require.Error(t, err)
+}
+
+func TestCrossCurrencyTransferTx(t *testing.T) {
+	store := NewStore(testDB)
+	loadTestExchangeRates(t, store)
+
+	testCases := []struct {
+		name         string
+		fromCurrency string
+		toCurrency   string
+		rate         string
+	}{
+		{name: "FromBase", fromCurrency: util.EUR, toCurrency: util.USD, rate: "1.0956"},
+		{name: "ToBase", fromCurrency: util.USD, toCurrency: util.EUR, rate: "0.9127418766"},
+		{name: "Crossed", fromCurrency: util.USD, toCurrency: util.CAD, rate: "1.3294085433"},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			account1 := createRandomAccountWithCurrency(t, tc.fromCurrency, 10000)
+			account2 := createRandomAccountWithCurrency(t, tc.toCurrency, 0)
+			amount := util.RandomInt(1, 10000)
+
+			result, err := store.CrossCurrencyTransferTx(context.Background(), TransferTxParams{
+				FromAccountID: account1.ID,
+				ToAccountID:   account2.ID,
+				Amount:        amount,
+			})
+			require.NoError(t, err)
+
+			rate, ok := new(big.Rat).SetString(tc.rate)
+			require.True(t, ok)
+			toAmount, err := fx.Convert(amount, tc.fromCurrency, tc.toCurrency, rate)
+			require.NoError(t, err)
+
+			require.Equal(t, amount, result.Transfer.Amount)
+			require.Equal(t, toAmount, result.Transfer.ToAmount)
+			require.Equal(t, rate.FloatString(fx.RateScale), NumericString(result.Transfer.ExchangeRate))
+
+			require.Equal(t, -amount, result.FromEntry.Amount)
+			require.Equal(t, toAmount, result.ToEntry.Amount)
+			require.Equal(t, account1.Balance-amount, result.FromAccount.Balance)
+			require.Equal(t, account2.Balance+toAmount, result.ToAccount.Balance)
+		})
+	}
+}
+
+func TestTransferTxCurrencyMismatch(t *testing.T) {
+	store := NewStore(testDB)
+	account1 := createRandomAccountWithCurrency(t, util.USD, 100)
+	account2 := createRandomAccountWithCurrency(t, util.EUR, 100)
+
+	_, err := store.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        10,
+	})
+	require.Error(t, err)
+	require.True(t, errors.Is(err, ErrCurrencyMismatch))
+
+	// no rate is ever published for this currency
+	account3 := createRandomAccountWithCurrency(t, "XTS", 0)
+	_, err = store.CrossCurrencyTransferTx(context.Background(), TransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account3.ID,
+		Amount:        10,
+	})
+	require.Error(t, err)
+	require.True(t, errors.Is(err, ErrExchangeRateNotFound))
+}
@@ -0,0 +1,99 @@
+package db
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// Operations recorded in idempotency_keys.operation, naming the store transaction that claimed a key.
+const (
+	idempotencyOperationTransfer         = "transfer"
+	idempotencyOperationTransferApproval = "transfer_approval"
+)
+
+// idempotencyOperationError is returned by claimIdempotencyKey for a key that another operation
+// claimed with the same request. It wraps ErrIdempotencyKeyReused.
+type idempotencyOperationError struct {
+	operation string
+}
+
+func (e *idempotencyOperationError) Error() string {
+	return fmt.Sprintf("%s: it was used by %s", ErrIdempotencyKeyReused, e.operation)
+}
+
+func (e *idempotencyOperationError) Unwrap() error {
+	return ErrIdempotencyKeyReused
+}
+
+// claimIdempotencyKey records the key for the user and operation inside the running transaction.
+// If the key was used before by the same operation with the same request, the stored response is
+// decoded into response and replayed is true. A key reused with a different request fails with
+// ErrIdempotencyKeyReused, and one reused by another operation with an *idempotencyOperationError.
+func (q txQueries) claimIdempotencyKey(ctx context.Context,
+	operation, username, key string, request any, response any) (replayed bool, err error) {
+	requestHash, err := hashRequest(request)
+	if err != nil {
+		return false, err
+	}
+
+	// a concurrent transaction holding the same key makes this insert wait until it finishes
+	_, err = q.CreateIdempotencyKey(ctx, CreateIdempotencyKeyParams{
+		Username:    username,
+		Key:         key,
+		RequestHash: requestHash,
+		Operation:   operation,
+	})
+	if err == nil {
+		return false, nil
+	}
+	if !errors.Is(err, ErrRecordNotFound) {
+		return false, err
+	}
+
+	existing, err := q.GetIdempotencyKey(ctx, GetIdempotencyKeyParams{
+		Username: username,
+		Key:      key,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	if existing.RequestHash != requestHash {
+		return false, ErrIdempotencyKeyReused
+	}
+	if existing.Operation != operation {
+		return false, &idempotencyOperationError{operation: existing.Operation}
+	}
+
+	if err = json.Unmarshal(existing.Response, response); err != nil {
+		return false, fmt.Errorf("failed to decode stored response for idempotency key %s: %w", key, err)
+	}
+	return true, nil
+}
+
+// saveIdempotentResponse stores the response returned for a claimed key so that replays can return it.
+func (q txQueries) saveIdempotentResponse(ctx context.Context, username, key string, response any) error {
+	data, err := json.Marshal(response)
+	if err != nil {
+		return fmt.Errorf("failed to encode response for idempotency key %s: %w", key, err)
+	}
+
+	return q.UpdateIdempotencyKeyResponse(ctx, UpdateIdempotencyKeyResponseParams{
+		Username: username,
+		Key:      key,
+		Response: data,
+	})
+}
+
+func hashRequest(request any) (string, error) {
+	data, err := json.Marshal(request)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode request: %w", err)
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
@@ -0,0 +1,48 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/mahanth/simplebank/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateIdempotencyKey(t *testing.T) {
+	user := createRandomUser(t)
+
+	arg := CreateIdempotencyKeyParams{
+		Username:    user.Username,
+		Key:         util.RandomString(16),
+		RequestHash: util.RandomString(64),
+		Operation:   idempotencyOperationTransfer,
+	}
+
+	idempotencyKey, err := testQueries.CreateIdempotencyKey(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, arg.Username, idempotencyKey.Username)
+	require.Equal(t, arg.Key, idempotencyKey.Key)
+	require.Equal(t, arg.RequestHash, idempotencyKey.RequestHash)
+	require.Equal(t, arg.Operation, idempotencyKey.Operation)
+	require.Nil(t, idempotencyKey.Response)
+	require.NotZero(t, idempotencyKey.CreatedAt)
+
+	// a second insert with the same key does nothing
+	_, err = testQueries.CreateIdempotencyKey(context.Background(), arg)
+	require.ErrorIs(t, err, ErrRecordNotFound)
+
+	err = testQueries.UpdateIdempotencyKeyResponse(context.Background(), UpdateIdempotencyKeyResponseParams{
+		Username: arg.Username,
+		Key:      arg.Key,
+		Response: []byte(`{"ok":true}`),
+	})
+	require.NoError(t, err)
+
+	stored, err := testQueries.GetIdempotencyKey(context.Background(), GetIdempotencyKeyParams{
+		Username: arg.Username,
+		Key:      arg.Key,
+	})
+	require.NoError(t, err)
+	require.Equal(t, arg.RequestHash, stored.RequestHash)
+	require.JSONEq(t, `{"ok":true}`, string(stored.Response))
+}
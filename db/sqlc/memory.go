@@ -0,0 +1,393 @@
+package db
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/mahanth/simplebank/util"
+)
+
+// Postgres error codes reported by the memory store besides the ones callers check for.
+const (
+	notNullViolation          = "23502"
+	invalidTextRepresentation = "22P02"
+	invalidRowCountInLimit    = "2201W"
+	invalidRowCountInOffset   = "2201X"
+)
+
+// NewMemoryStore returns a Store that keeps its data in memory, for development and for tests that
+// should not need Postgres. It runs the same transactions as the store of NewStore and fails the same
+// way: ErrRecordNotFound for missing rows and a *pgconn.PgError with the Postgres code for a violated
+// unique, foreign key or check constraint. Transactions run one at a time and are rolled back in full
+// when they fail. The data starts out as the migrations leave it and lives as long as the store.
+func NewMemoryStore(opts ...StoreOption) Store {
+	return newStore(newMemoryDB(), opts...)
+}
+
+// memoryDB is a database in memory.
+type memoryDB struct {
+	// mu serializes the queries run outside transactions and the transactions.
+	mu sync.Mutex
+	*memQueries
+}
+
+func newMemoryDB() *memoryDB {
+	db := &memoryDB{}
+	db.memQueries = &memQueries{lock: &db.mu, tables: newMemTables(time.Now()), now: time.Now}
+	return db
+}
+
+// runTx runs fn on the tables, undoing its changes unless it succeeds. As in Postgres, now() is
+// the time the transaction started for all of its queries.
+func (db *memoryDB) runTx(ctx context.Context, opts pgx.TxOptions, fn func(Querier) error) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	start := time.Now()
+	tx := &memQueries{
+		lock:   noLock{},
+		tables: db.tables,
+		now:    func() time.Time { return start },
+	}
+
+	committed := false
+	db.tables.undo = []func(){}
+	defer func() {
+		// a panic in fn rolls back too
+		if !committed {
+			db.tables.rollback()
+		}
+		db.tables.undo = nil
+	}()
+
+	if err := fn(tx); err != nil {
+		return err
+	}
+	committed = true
+	return nil
+}
+
+// memQueries runs queries against tables, each holding lock while it runs.
+type memQueries struct {
+	lock   sync.Locker
+	tables *memTables
+	now    func() time.Time
+}
+
+var _ Querier = (*memQueries)(nil)
+
+// noLock is the lock of a transaction's queries, which run while the transaction holds the database's.
+type noLock struct{}
+
+func (noLock) Lock()   {}
+func (noLock) Unlock() {}
+
+// sessionID is the primary key of sessions.
+type sessionID [16]byte
+
+// idempotencyKeyID is the primary key of idempotency_keys.
+type idempotencyKeyID struct {
+	username string
+	key      string
+}
+
+// memTables holds a row per primary key for each table, and the last id of each bigserial column.
+type memTables struct {
+	accountHolders              map[int64]AccountHolder
+	accountHolds                map[int64]AccountHold
+	accountStatusChanges        map[int64]AccountStatusChange
+	accounts                    map[int64]Account
+	auditEvents                 map[int64]AuditEvent
+	currencies                  map[string]Currency
+	entries                     map[int64]Entry
+	exchangeRates               map[int64]ExchangeRate
+	feeRules                    map[int64]FeeRule
+	idempotencyKeys             map[idempotencyKeyID]IdempotencyKey
+	interestAccruals            map[int64]InterestAccrual
+	interestRates               map[int64]InterestRate
+	outbox                      map[int64]Outbox
+	reconciliationReports       map[int64]ReconciliationReport
+	scheduledTransferExecutions map[int64]ScheduledTransferExecution
+	scheduledTransfers          map[int64]ScheduledTransfer
+	sessions                    map[sessionID]Session
+	transferApprovals           map[int64]TransferApproval
+	transferLimits              map[int64]TransferLimit
+	transferReversals           map[int64]TransferReversal
+	transfers                   map[int64]Transfer
+	users                       map[string]User
+	verifyEmails                map[int64]VerifyEmail
+	webhookDeliveries           map[int64]WebhookDelivery
+	webhookSubscriptions        map[int64]WebhookSubscription
+	lastIDs                     map[string]int64
+	// undo reverses the changes of the running transaction, in the order they were made. It is nil
+	// outside transactions.
+	undo []func()
+}
+
+// newMemTables returns the tables with the rows the migrations insert.
+func newMemTables(now time.Time) *memTables {
+	t := &memTables{
+		accountHolders:              map[int64]AccountHolder{},
+		accountHolds:                map[int64]AccountHold{},
+		accountStatusChanges:        map[int64]AccountStatusChange{},
+		accounts:                    map[int64]Account{},
+		auditEvents:                 map[int64]AuditEvent{},
+		currencies:                  map[string]Currency{},
+		entries:                     map[int64]Entry{},
+		exchangeRates:               map[int64]ExchangeRate{},
+		feeRules:                    map[int64]FeeRule{},
+		idempotencyKeys:             map[idempotencyKeyID]IdempotencyKey{},
+		interestAccruals:            map[int64]InterestAccrual{},
+		interestRates:               map[int64]InterestRate{},
+		outbox:                      map[int64]Outbox{},
+		reconciliationReports:       map[int64]ReconciliationReport{},
+		scheduledTransferExecutions: map[int64]ScheduledTransferExecution{},
+		scheduledTransfers:          map[int64]ScheduledTransfer{},
+		sessions:                    map[sessionID]Session{},
+		transferApprovals:           map[int64]TransferApproval{},
+		transferLimits:              map[int64]TransferLimit{},
+		transferReversals:           map[int64]TransferReversal{},
+		transfers:                   map[int64]Transfer{},
+		users:                       map[string]User{},
+		verifyEmails:                map[int64]VerifyEmail{},
+		webhookDeliveries:           map[int64]WebhookDelivery{},
+		webhookSubscriptions:        map[int64]WebhookSubscription{},
+		lastIDs:                     map[string]int64{},
+	}
+
+	for _, bankUser := range []struct{ username, fullName, email string }{
+		{SystemUsername, "Bank System", "system@bank.invalid"},
+		{RevenueUsername, "Bank Revenue", "revenue@bank.invalid"},
+	} {
+		t.users[bankUser.username] = User{
+			Username:              bankUser.username,
+			FullName:              bankUser.fullName,
+			Email:                 bankUser.email,
+			LastPasswordChangedAt: timestamptz(time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC)),
+			CreatedAt:             timestamptz(now),
+			IsEmailVerified:       true,
+			Role:                  util.DepositorRole,
+		}
+	}
+
+	for _, currency := range []Currency{
+		{Code: "AUD", Exponent: 2, Name: "Australian Dollar", ApprovalThreshold: pgtype.Int8{Int64: 750000, Valid: true}},
+		{Code: "CAD", Exponent: 2, Name: "Canadian Dollar", Enabled: true, ApprovalThreshold: pgtype.Int8{Int64: 650000, Valid: true}},
+		{Code: "CHF", Exponent: 2, Name: "Swiss Franc", ApprovalThreshold: pgtype.Int8{Int64: 450000, Valid: true}},
+		{Code: "EUR", Exponent: 2, Name: "Euro", Enabled: true, ApprovalThreshold: pgtype.Int8{Int64: 450000, Valid: true}},
+		{Code: "GBP", Exponent: 2, Name: "Pound Sterling", ApprovalThreshold: pgtype.Int8{Int64: 400000, Valid: true}},
+		{Code: "INR", Exponent: 2, Name: "Indian Rupee", ApprovalThreshold: pgtype.Int8{Int64: 40000000, Valid: true}},
+		{Code: "JPY", Exponent: 0, Name: "Yen", ApprovalThreshold: pgtype.Int8{Int64: 750000, Valid: true}},
+		{Code: "KWD", Exponent: 3, Name: "Kuwaiti Dinar", ApprovalThreshold: pgtype.Int8{Int64: 1500000, Valid: true}},
+		{Code: "USD", Exponent: 2, Name: "US Dollar", Enabled: true, ApprovalThreshold: pgtype.Int8{Int64: 500000, Valid: true}},
+	} {
+		currency.UpdatedAt = timestamptz(now)
+		t.currencies[currency.Code] = currency
+	}
+	return t
+}
+
+// putRow stores row under key in table, a table of t.
+func putRow[K comparable, V any](t *memTables, table map[K]V, key K, row V) {
+	logUndo(t, table, key)
+	table[key] = row
+}
+
+// deleteRow deletes the row with the given key from table, a table of t.
+func deleteRow[K comparable, V any](t *memTables, table map[K]V, key K) {
+	logUndo(t, table, key)
+	delete(table, key)
+}
+
+// logUndo records, while a transaction runs, how to restore the row of table with the given key
+// as it is now. Rows are restored by value; the byte and string slices in them are never changed
+// in place.
+func logUndo[K comparable, V any](t *memTables, table map[K]V, key K) {
+	if t.undo == nil {
+		return
+	}
+	row, existed := table[key]
+	if existed {
+		t.undo = append(t.undo, func() { table[key] = row })
+	} else {
+		t.undo = append(t.undo, func() { delete(table, key) })
+	}
+}
+
+// rollback reverses the changes of the running transaction, latest first.
+func (t *memTables) rollback() {
+	for i := len(t.undo) - 1; i >= 0; i-- {
+		t.undo[i]()
+	}
+}
+
+// nextID returns the next value of the bigserial id of table. As with a Postgres sequence, the
+// value is not given back when the transaction rolls back.
+func (t *memTables) nextID(table string) int64 {
+	t.lastIDs[table]++
+	return t.lastIDs[table]
+}
+
+// selectRows returns the rows of table that match keep, in primary key order.
+func selectRows[K cmp.Ordered, V any](table map[K]V, keep func(V) bool) []V {
+	items := []V{}
+	for _, key := range slices.Sorted(maps.Keys(table)) {
+		if keep(table[key]) {
+			items = append(items, table[key])
+		}
+	}
+	return items
+}
+
+// firstRow returns the first of items, or ErrRecordNotFound if there is none.
+func firstRow[V any](items []V) (V, error) {
+	if len(items) == 0 {
+		var zero V
+		return zero, ErrRecordNotFound
+	}
+	return items[0], nil
+}
+
+// limitRows applies LIMIT limit OFFSET offset to items.
+func limitRows[V any](items []V, limit, offset int32) ([]V, error) {
+	if limit < 0 {
+		return nil, pgError(invalidRowCountInLimit, "", "LIMIT must not be negative")
+	}
+	if offset < 0 {
+		return nil, pgError(invalidRowCountInOffset, "", "OFFSET must not be negative")
+	}
+	start := min(int(offset), len(items))
+	end := min(start+int(limit), len(items))
+	return items[start:end], nil
+}
+
+// getRow returns the row of table with the given key, or ErrRecordNotFound.
+func getRow[K comparable, V any](table map[K]V, key K) (V, error) {
+	row, ok := table[key]
+	if !ok {
+		return row, ErrRecordNotFound
+	}
+	return row, nil
+}
+
+// references returns a foreign key violation of constraint if table has no row with the given key.
+func references[K comparable, V any](referenced map[K]V, key K, table, constraint string) error {
+	if _, ok := referenced[key]; !ok {
+		return pgError(ForeignKeyViolation, constraint, fmt.Sprintf(
+			"insert or update on table %q violates foreign key constraint %q", table, constraint))
+	}
+	return nil
+}
+
+// referencedBy returns a foreign key violation of constraint if any row of table matches ref, to
+// refuse deleting the row it refers to.
+func referencedBy[K comparable, V any](rows map[K]V, ref func(V) bool, referenced, table, constraint string) error {
+	for _, row := range rows {
+		if ref(row) {
+			return pgError(ForeignKeyViolation, constraint, fmt.Sprintf(
+				"update or delete on table %q violates foreign key constraint %q on table %q", referenced, constraint, table))
+		}
+	}
+	return nil
+}
+
+func pgError(code, constraint, message string) error {
+	return &pgconn.PgError{
+		Severity:       "ERROR",
+		Code:           code,
+		Message:        message,
+		ConstraintName: constraint,
+	}
+}
+
+func uniqueViolation(constraint string) error {
+	return pgError(UniqueViolation, constraint, fmt.Sprintf("duplicate key value violates unique constraint %q", constraint))
+}
+
+func checkViolation(table, constraint string) error {
+	return pgError(CheckViolation, constraint, fmt.Sprintf("new row for relation %q violates check constraint %q", table, constraint))
+}
+
+func notNull(table, column string) error {
+	return pgError(notNullViolation, "", fmt.Sprintf("null value in column %q of relation %q violates not-null constraint", column, table))
+}
+
+// checkIn reports a violation of constraint unless value is one of allowed.
+func checkIn(table, constraint, value string, allowed ...string) error {
+	if !slices.Contains(allowed, value) {
+		return checkViolation(table, constraint)
+	}
+	return nil
+}
+
+// timestamptz returns t as a timestamptz column reads back: to the microsecond, in local time.
+func timestamptz(t time.Time) pgtype.Timestamptz {
+	return pgtype.Timestamptz{Time: time.Unix(t.Unix(), int64(t.Nanosecond()/1000*1000)), Valid: true}
+}
+
+// storedTimestamptz returns ts as it reads back once stored.
+func storedTimestamptz(ts pgtype.Timestamptz) pgtype.Timestamptz {
+	if !ts.Valid {
+		return pgtype.Timestamptz{}
+	}
+	return timestamptz(ts.Time)
+}
+
+// storedDate returns d as it reads back once stored: midnight UTC of its day.
+func storedDate(d pgtype.Date) pgtype.Date {
+	if !d.Valid {
+		return pgtype.Date{}
+	}
+	year, month, day := d.Time.Date()
+	return pgtype.Date{Time: time.Date(year, month, day, 0, 0, 0, 0, time.UTC), Valid: true}
+}
+
+// storedJSON returns a copy of the value of a json or jsonb column, failing like Postgres if it is
+// not valid JSON or is NULL for a column that is not nullable.
+func storedJSON(table, column string, value []byte, nullable bool) ([]byte, error) {
+	if value == nil {
+		if !nullable {
+			return nil, notNull(table, column)
+		}
+		return nil, nil
+	}
+	if !json.Valid(value) {
+		return nil, pgError(invalidTextRepresentation, "", "invalid input syntax for type json")
+	}
+	return slices.Clone(value), nil
+}
+
+// numericSign returns the sign of n, or 0 if it is not a finite number.
+func numericSign(n pgtype.Numeric) int {
+	r, err := ratFromNumeric(n)
+	if err != nil {
+		return 0
+	}
+	return r.Sign()
+}
+
+// compareTimestamptz orders timestamps, with NULL after all others as in an ascending ORDER BY.
+func compareTimestamptz(a, b pgtype.Timestamptz) int {
+	switch {
+	case !a.Valid && !b.Valid:
+		return 0
+	case !a.Valid:
+		return 1
+	case !b.Valid:
+		return -1
+	}
+	return a.Time.Compare(b.Time)
+}
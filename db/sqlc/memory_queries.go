@@ -0,0 +1,2172 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"slices"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/mahanth/simplebank/util"
+)
+
+// The queries of db/query run against the memory tables, in the order of their files.
+
+// locked takes the lock and returns the function that releases it.
+func (q *memQueries) locked() func() {
+	q.lock.Lock()
+	return q.lock.Unlock
+}
+
+// account.sql
+
+// storedAccount returns the account with its generated columns computed.
+func storedAccount(account Account) Account {
+	account.AvailableBalance = account.Balance - account.HeldAmount
+	return account
+}
+
+// isBankOwner reports whether owner is one of the users the accounts_bank_owner_currency_type_key
+// index keeps to one account per currency and type.
+func isBankOwner(owner string) bool {
+	return owner == SystemUsername || owner == RevenueUsername
+}
+
+// bankAccountExists reports whether the bank owner already has an account of the currency and type.
+func (t *memTables) bankAccountExists(owner, currency, accountType string) bool {
+	for _, account := range t.accounts {
+		if account.Owner == owner && account.Currency == currency && account.Type == accountType {
+			return true
+		}
+	}
+	return false
+}
+
+func (t *memTables) checkAccount(account Account) error {
+	if err := checkIn("accounts", "accounts_type_check", account.Type, AccountTypeChecking, AccountTypeSavings); err != nil {
+		return err
+	}
+	if err := checkIn("accounts", "accounts_status_check", account.Status,
+		AccountStatusActive, AccountStatusFrozen, AccountStatusDormant, AccountStatusClosed); err != nil {
+		return err
+	}
+	if account.OverdraftLimit < 0 {
+		return checkViolation("accounts", "accounts_overdraft_limit_check")
+	}
+	if account.HeldAmount < 0 {
+		return checkViolation("accounts", "accounts_held_amount_check")
+	}
+	return nil
+}
+
+func (t *memTables) insertAccount(arg CreateAccountParams, now time.Time) (Account, error) {
+	account := Account{
+		Owner:     arg.Owner,
+		Balance:   arg.Balance,
+		Currency:  arg.Currency,
+		CreatedAt: timestamptz(now),
+		Status:    AccountStatusActive,
+		Type:      arg.Type,
+	}
+	if err := t.checkAccount(account); err != nil {
+		return Account{}, err
+	}
+	if isBankOwner(arg.Owner) && t.bankAccountExists(arg.Owner, arg.Currency, arg.Type) {
+		return Account{}, uniqueViolation("accounts_bank_owner_currency_type_key")
+	}
+	if err := references(t.users, arg.Owner, "accounts", "accounts_owner_fkey"); err != nil {
+		return Account{}, err
+	}
+
+	account.ID = t.nextID("accounts")
+	account = storedAccount(account)
+	putRow(t, t.accounts, account.ID, account)
+	return account, nil
+}
+
+// updateAccount applies change to the account and stores it if it still passes the checks.
+func (t *memTables) updateAccount(id int64, change func(*Account)) (Account, error) {
+	account, err := getRow(t.accounts, id)
+	if err != nil {
+		return Account{}, err
+	}
+	change(&account)
+	if err := t.checkAccount(account); err != nil {
+		return Account{}, err
+	}
+	account = storedAccount(account)
+	putRow(t, t.accounts, id, account)
+	return account, nil
+}
+
+func (q *memQueries) CreateAccount(ctx context.Context, arg CreateAccountParams) (Account, error) {
+	defer q.locked()()
+	return q.tables.insertAccount(arg, q.now())
+}
+
+func (q *memQueries) GetAccount(ctx context.Context, id int64) (Account, error) {
+	defer q.locked()()
+	return getRow(q.tables.accounts, id)
+}
+
+func (q *memQueries) GetAccountForUpdate(ctx context.Context, id int64) (Account, error) {
+	defer q.locked()()
+	return getRow(q.tables.accounts, id)
+}
+
+func (q *memQueries) ListAccounts(ctx context.Context, arg ListAccountsParams) ([]Account, error) {
+	defer q.locked()()
+	accounts := selectRows(q.tables.accounts, func(account Account) bool {
+		return account.Owner == arg.Owner || q.tables.isActiveHolder(account.ID, arg.Owner)
+	})
+	return limitRows(accounts, arg.Limit, arg.Offset)
+}
+
+func (q *memQueries) UpdateAccount(ctx context.Context, arg UpdateAccountParams) (Account, error) {
+	defer q.locked()()
+	return q.tables.updateAccount(arg.ID, func(account *Account) {
+		account.Balance = arg.Balance
+	})
+}
+
+func (q *memQueries) AddAccountBalance(ctx context.Context, arg AddAccountBalanceParams) (Account, error) {
+	defer q.locked()()
+	return q.tables.updateAccount(arg.ID, func(account *Account) {
+		account.Balance += arg.Amount
+	})
+}
+
+func (q *memQueries) DeleteAccount(ctx context.Context, id int64) error {
+	defer q.locked()()
+	t := q.tables
+	if _, ok := t.accounts[id]; !ok {
+		return nil
+	}
+
+	for _, err := range []error{
+		referencedBy(t.entries, func(entry Entry) bool { return entry.AccountID == id },
+			"accounts", "entries", "entries_account_id_fkey"),
+		referencedBy(t.transfers, func(transfer Transfer) bool { return transfer.FromAccountID == id },
+			"accounts", "transfers", "transfers_from_account_id_fkey"),
+		referencedBy(t.transfers, func(transfer Transfer) bool { return transfer.ToAccountID == id },
+			"accounts", "transfers", "transfers_to_account_id_fkey"),
+		referencedBy(t.scheduledTransfers, func(scheduled ScheduledTransfer) bool { return scheduled.FromAccountID == id },
+			"accounts", "scheduled_transfers", "scheduled_transfers_from_account_id_fkey"),
+		referencedBy(t.scheduledTransfers, func(scheduled ScheduledTransfer) bool { return scheduled.ToAccountID == id },
+			"accounts", "scheduled_transfers", "scheduled_transfers_to_account_id_fkey"),
+		referencedBy(t.accountHolds, func(hold AccountHold) bool { return hold.AccountID == id },
+			"accounts", "account_holds", "account_holds_account_id_fkey"),
+		referencedBy(t.accountHolds, func(hold AccountHold) bool { return hold.ToAccountID == id },
+			"accounts", "account_holds", "account_holds_to_account_id_fkey"),
+		referencedBy(t.accountStatusChanges, func(change AccountStatusChange) bool { return change.AccountID == id },
+			"accounts", "account_status_changes", "account_status_changes_account_id_fkey"),
+		referencedBy(t.interestAccruals, func(accrual InterestAccrual) bool { return accrual.AccountID == id },
+			"accounts", "interest_accruals", "interest_accruals_account_id_fkey"),
+		referencedBy(t.transferLimits, func(limit TransferLimit) bool { return limit.AccountID.Valid && limit.AccountID.Int64 == id },
+			"accounts", "transfer_limits", "transfer_limits_account_id_fkey"),
+		referencedBy(t.accountHolders, func(holder AccountHolder) bool { return holder.AccountID == id },
+			"accounts", "account_holders", "account_holders_account_id_fkey"),
+		referencedBy(t.transferApprovals, func(approval TransferApproval) bool { return approval.FromAccountID == id },
+			"accounts", "transfer_approvals", "transfer_approvals_from_account_id_fkey"),
+		referencedBy(t.transferApprovals, func(approval TransferApproval) bool { return approval.ToAccountID == id },
+			"accounts", "transfer_approvals", "transfer_approvals_to_account_id_fkey"),
+	} {
+		if err != nil {
+			return err
+		}
+	}
+
+	deleteRow(t, t.accounts, id)
+	return nil
+}
+
+func (q *memQueries) UpdateAccountOverdraftLimit(ctx context.Context, arg UpdateAccountOverdraftLimitParams) (Account, error) {
+	defer q.locked()()
+	return q.tables.updateAccount(arg.ID, func(account *Account) {
+		account.OverdraftLimit = arg.OverdraftLimit
+	})
+}
+
+func (q *memQueries) AddAccountHeldAmount(ctx context.Context, arg AddAccountHeldAmountParams) (Account, error) {
+	defer q.locked()()
+	return q.tables.updateAccount(arg.ID, func(account *Account) {
+		account.HeldAmount += arg.Amount
+	})
+}
+
+func (q *memQueries) UpdateAccountStatus(ctx context.Context, arg UpdateAccountStatusParams) (Account, error) {
+	defer q.locked()()
+	return q.tables.updateAccount(arg.ID, func(account *Account) {
+		account.Status = arg.Status
+	})
+}
+
+func (q *memQueries) GetOwnerAccount(ctx context.Context, arg GetOwnerAccountParams) (Account, error) {
+	defer q.locked()()
+	return firstRow(selectRows(q.tables.accounts, func(account Account) bool {
+		return account.Owner == arg.Owner && account.Currency == arg.Currency && account.Type == arg.Type
+	}))
+}
+
+func (q *memQueries) EnsureAccount(ctx context.Context, arg EnsureAccountParams) error {
+	defer q.locked()()
+	if isBankOwner(arg.Owner) && q.tables.bankAccountExists(arg.Owner, arg.Currency, arg.Type) {
+		return nil
+	}
+	_, err := q.tables.insertAccount(CreateAccountParams{
+		Owner:    arg.Owner,
+		Currency: arg.Currency,
+		Type:     arg.Type,
+	}, q.now())
+	return err
+}
+
+func (q *memQueries) ListInterestBearingAccounts(ctx context.Context, arg ListInterestBearingAccountsParams) ([]Account, error) {
+	defer q.locked()()
+	accounts := selectRows(q.tables.accounts, func(account Account) bool {
+		return account.Type == AccountTypeSavings && account.Status == AccountStatusActive && account.ID > arg.ID
+	})
+	return limitRows(accounts, arg.Limit, 0)
+}
+
+// account_hold.sql
+
+func checkAccountHold(hold AccountHold) error {
+	if hold.Amount <= 0 {
+		return checkViolation("account_holds", "account_holds_amount_check")
+	}
+	if hold.HeldFee < 0 {
+		return checkViolation("account_holds", "account_holds_held_fee_check")
+	}
+	return checkIn("account_holds", "account_holds_status_check", hold.Status,
+		HoldStatusActive, HoldStatusCaptured, HoldStatusReleased, HoldStatusExpired)
+}
+
+func (q *memQueries) CreateAccountHold(ctx context.Context, arg CreateAccountHoldParams) (AccountHold, error) {
+	defer q.locked()()
+	t := q.tables
+	if !arg.ExpiresAt.Valid {
+		return AccountHold{}, notNull("account_holds", "expires_at")
+	}
+	hold := AccountHold{
+		AccountID:   arg.AccountID,
+		ToAccountID: arg.ToAccountID,
+		Amount:      arg.Amount,
+		Description: arg.Description,
+		Status:      HoldStatusActive,
+		ExpiresAt:   storedTimestamptz(arg.ExpiresAt),
+		CreatedAt:   timestamptz(q.now()),
+		PlacedBy:    arg.PlacedBy,
+		PlacerRole:  arg.PlacerRole,
+		HeldFee:     arg.HeldFee,
+	}
+	if err := checkAccountHold(hold); err != nil {
+		return AccountHold{}, err
+	}
+	if err := references(t.accounts, hold.AccountID, "account_holds", "account_holds_account_id_fkey"); err != nil {
+		return AccountHold{}, err
+	}
+	if err := references(t.accounts, hold.ToAccountID, "account_holds", "account_holds_to_account_id_fkey"); err != nil {
+		return AccountHold{}, err
+	}
+	if err := references(t.users, hold.PlacedBy, "account_holds", "account_holds_placed_by_fkey"); err != nil {
+		return AccountHold{}, err
+	}
+
+	hold.ID = t.nextID("account_holds")
+	putRow(t, t.accountHolds, hold.ID, hold)
+	return hold, nil
+}
+
+func (q *memQueries) GetAccountHold(ctx context.Context, id int64) (AccountHold, error) {
+	defer q.locked()()
+	return getRow(q.tables.accountHolds, id)
+}
+
+func (q *memQueries) GetAccountHoldForUpdate(ctx context.Context, id int64) (AccountHold, error) {
+	defer q.locked()()
+	return getRow(q.tables.accountHolds, id)
+}
+
+func (q *memQueries) ListAccountHolds(ctx context.Context, arg ListAccountHoldsParams) ([]AccountHold, error) {
+	defer q.locked()()
+	holds := selectRows(q.tables.accountHolds, func(hold AccountHold) bool {
+		return hold.AccountID == arg.AccountID
+	})
+	return limitRows(holds, arg.Limit, arg.Offset)
+}
+
+func (q *memQueries) ListExpiredAccountHolds(ctx context.Context, limit int32) ([]AccountHold, error) {
+	defer q.locked()()
+	now := q.now()
+	holds := selectRows(q.tables.accountHolds, func(hold AccountHold) bool {
+		return hold.Status == HoldStatusActive && !hold.ExpiresAt.Time.After(now)
+	})
+	slices.SortStableFunc(holds, func(a, b AccountHold) int {
+		return compareTimestamptz(a.ExpiresAt, b.ExpiresAt)
+	})
+	return limitRows(holds, limit, 0)
+}
+
+func (q *memQueries) UpdateAccountHoldStatus(ctx context.Context, arg UpdateAccountHoldStatusParams) (AccountHold, error) {
+	defer q.locked()()
+	t := q.tables
+	hold, err := getRow(t.accountHolds, arg.ID)
+	if err != nil {
+		return AccountHold{}, err
+	}
+	hold.Status = arg.Status
+	hold.CapturedAmount = arg.CapturedAmount
+	hold.TransferID = arg.TransferID
+	hold.ResolvedAt = timestamptz(q.now())
+	if err := checkAccountHold(hold); err != nil {
+		return AccountHold{}, err
+	}
+	if hold.TransferID.Valid {
+		if err := references(t.transfers, hold.TransferID.Int64, "account_holds", "account_holds_transfer_id_fkey"); err != nil {
+			return AccountHold{}, err
+		}
+	}
+
+	putRow(t, t.accountHolds, hold.ID, hold)
+	return hold, nil
+}
+
+// account_holder.sql
+
+// isActiveHolder reports whether username is an active holder of the account.
+func (t *memTables) isActiveHolder(accountID int64, username string) bool {
+	for _, holder := range t.accountHolders {
+		if holder.AccountID == accountID && holder.Username == username && holder.Status == HolderStatusActive {
+			return true
+		}
+	}
+	return false
+}
+
+// findAccountHolder returns the id of the holder of the account with the username, or 0.
+func (t *memTables) findAccountHolder(accountID int64, username string) int64 {
+	for id, holder := range t.accountHolders {
+		if holder.AccountID == accountID && holder.Username == username {
+			return id
+		}
+	}
+	return 0
+}
+
+func (q *memQueries) CreateAccountHolder(ctx context.Context, arg CreateAccountHolderParams) (AccountHolder, error) {
+	defer q.locked()()
+	t := q.tables
+	if err := checkIn("account_holders", "account_holders_permission_check", arg.Permission,
+		HolderPermissionView, HolderPermissionTransfer, HolderPermissionManage); err != nil {
+		return AccountHolder{}, err
+	}
+	if arg.TransferLimit.Valid && arg.TransferLimit.Int64 <= 0 {
+		return AccountHolder{}, checkViolation("account_holders", "account_holders_transfer_limit_check")
+	}
+	if t.findAccountHolder(arg.AccountID, arg.Username) != 0 {
+		return AccountHolder{}, uniqueViolation("account_holders_account_id_username_idx")
+	}
+	if err := references(t.accounts, arg.AccountID, "account_holders", "account_holders_account_id_fkey"); err != nil {
+		return AccountHolder{}, err
+	}
+	if err := references(t.users, arg.Username, "account_holders", "account_holders_username_fkey"); err != nil {
+		return AccountHolder{}, err
+	}
+	if err := references(t.users, arg.InvitedBy, "account_holders", "account_holders_invited_by_fkey"); err != nil {
+		return AccountHolder{}, err
+	}
+
+	holder := AccountHolder{
+		ID:            t.nextID("account_holders"),
+		AccountID:     arg.AccountID,
+		Username:      arg.Username,
+		Permission:    arg.Permission,
+		TransferLimit: arg.TransferLimit,
+		Status:        HolderStatusInvited,
+		InvitedBy:     arg.InvitedBy,
+		CreatedAt:     timestamptz(q.now()),
+	}
+	putRow(t, t.accountHolders, holder.ID, holder)
+	return holder, nil
+}
+
+func (q *memQueries) GetAccountHolder(ctx context.Context, arg GetAccountHolderParams) (AccountHolder, error) {
+	defer q.locked()()
+	return getRow(q.tables.accountHolders, q.tables.findAccountHolder(arg.AccountID, arg.Username))
+}
+
+func (q *memQueries) ListAccountHolders(ctx context.Context, accountID int64) ([]AccountHolder, error) {
+	defer q.locked()()
+	return selectRows(q.tables.accountHolders, func(holder AccountHolder) bool {
+		return holder.AccountID == accountID
+	}), nil
+}
+
+func (q *memQueries) AcceptAccountHolder(ctx context.Context, arg AcceptAccountHolderParams) (AccountHolder, error) {
+	defer q.locked()()
+	t := q.tables
+	holder, err := getRow(t.accountHolders, t.findAccountHolder(arg.AccountID, arg.Username))
+	if err != nil || holder.Status != HolderStatusInvited {
+		return AccountHolder{}, ErrRecordNotFound
+	}
+	holder.Status = HolderStatusActive
+	holder.AcceptedAt = timestamptz(q.now())
+	putRow(t, t.accountHolders, holder.ID, holder)
+	return holder, nil
+}
+
+func (q *memQueries) DeleteAccountHolder(ctx context.Context, arg DeleteAccountHolderParams) (AccountHolder, error) {
+	defer q.locked()()
+	t := q.tables
+	holder, err := getRow(t.accountHolders, t.findAccountHolder(arg.AccountID, arg.Username))
+	if err != nil {
+		return AccountHolder{}, err
+	}
+	deleteRow(t, t.accountHolders, holder.ID)
+	return holder, nil
+}
+
+// account_status_change.sql
+
+func (q *memQueries) CreateAccountStatusChange(ctx context.Context, arg CreateAccountStatusChangeParams) (AccountStatusChange, error) {
+	defer q.locked()()
+	t := q.tables
+	if err := references(t.accounts, arg.AccountID, "account_status_changes", "account_status_changes_account_id_fkey"); err != nil {
+		return AccountStatusChange{}, err
+	}
+	if err := references(t.users, arg.ChangedBy, "account_status_changes", "account_status_changes_changed_by_fkey"); err != nil {
+		return AccountStatusChange{}, err
+	}
+
+	change := AccountStatusChange{
+		ID:         t.nextID("account_status_changes"),
+		AccountID:  arg.AccountID,
+		FromStatus: arg.FromStatus,
+		ToStatus:   arg.ToStatus,
+		ChangedBy:  arg.ChangedBy,
+		Reason:     arg.Reason,
+		CreatedAt:  timestamptz(q.now()),
+	}
+	putRow(t, t.accountStatusChanges, change.ID, change)
+	return change, nil
+}
+
+func (q *memQueries) ListAccountStatusChanges(ctx context.Context, arg ListAccountStatusChangesParams) ([]AccountStatusChange, error) {
+	defer q.locked()()
+	changes := selectRows(q.tables.accountStatusChanges, func(change AccountStatusChange) bool {
+		return change.AccountID == arg.AccountID
+	})
+	return limitRows(changes, arg.Limit, arg.Offset)
+}
+
+// audit_event.sql
+
+// LockAuditChain has nothing to do, as the transaction already runs alone.
+func (q *memQueries) LockAuditChain(ctx context.Context) error {
+	return nil
+}
+
+func (q *memQueries) GetLastAuditEvent(ctx context.Context) (AuditEvent, error) {
+	defer q.locked()()
+	events := selectRows(q.tables.auditEvents, func(AuditEvent) bool { return true })
+	slices.Reverse(events)
+	return firstRow(events)
+}
+
+func (q *memQueries) CreateAuditEvent(ctx context.Context, arg CreateAuditEventParams) (AuditEvent, error) {
+	defer q.locked()()
+	t := q.tables
+	before, err := storedJSON("audit_events", "before", arg.Before, true)
+	if err != nil {
+		return AuditEvent{}, err
+	}
+	after, err := storedJSON("audit_events", "after", arg.After, true)
+	if err != nil {
+		return AuditEvent{}, err
+	}
+	if !arg.CreatedAt.Valid {
+		return AuditEvent{}, notNull("audit_events", "created_at")
+	}
+	for _, event := range t.auditEvents {
+		if event.Hash == arg.Hash {
+			return AuditEvent{}, uniqueViolation("audit_events_hash_key")
+		}
+	}
+
+	event := AuditEvent{
+		ID:         t.nextID("audit_events"),
+		Actor:      arg.Actor,
+		Role:       arg.Role,
+		ClientIp:   arg.ClientIp,
+		UserAgent:  arg.UserAgent,
+		Action:     arg.Action,
+		TargetType: arg.TargetType,
+		TargetID:   arg.TargetID,
+		Before:     before,
+		After:      after,
+		CreatedAt:  storedTimestamptz(arg.CreatedAt),
+		PrevHash:   arg.PrevHash,
+		Hash:       arg.Hash,
+	}
+	putRow(t, t.auditEvents, event.ID, event)
+	return event, nil
+}
+
+func (q *memQueries) ListAuditEvents(ctx context.Context, arg ListAuditEventsParams) ([]AuditEvent, error) {
+	defer q.locked()()
+	events := selectRows(q.tables.auditEvents, func(event AuditEvent) bool {
+		return event.ID > arg.AfterID
+	})
+	return limitRows(events, arg.PageSize, 0)
+}
+
+// currency.sql
+
+func (q *memQueries) GetCurrency(ctx context.Context, code string) (Currency, error) {
+	defer q.locked()()
+	return getRow(q.tables.currencies, code)
+}
+
+func (q *memQueries) GetCurrencyForUpdate(ctx context.Context, code string) (Currency, error) {
+	defer q.locked()()
+	return getRow(q.tables.currencies, code)
+}
+
+func (q *memQueries) ListCurrencies(ctx context.Context) ([]Currency, error) {
+	defer q.locked()()
+	return selectRows(q.tables.currencies, func(Currency) bool { return true }), nil
+}
+
+func (q *memQueries) UpdateCurrencyApprovalThreshold(ctx context.Context, arg UpdateCurrencyApprovalThresholdParams) (Currency, error) {
+	defer q.locked()()
+	t := q.tables
+	currency, err := getRow(t.currencies, arg.Code)
+	if err != nil {
+		return Currency{}, err
+	}
+	if arg.ApprovalThreshold.Valid && arg.ApprovalThreshold.Int64 <= 0 {
+		return Currency{}, checkViolation("currencies", "currencies_approval_threshold_check")
+	}
+	if arg.UpdatedBy.Valid {
+		if err := references(t.users, arg.UpdatedBy.String, "currencies", "currencies_updated_by_fkey"); err != nil {
+			return Currency{}, err
+		}
+	}
+
+	currency.ApprovalThreshold = arg.ApprovalThreshold
+	currency.UpdatedBy = arg.UpdatedBy
+	currency.UpdatedAt = timestamptz(q.now())
+	putRow(t, t.currencies, currency.Code, currency)
+	return currency, nil
+}
+
+func (q *memQueries) UpdateCurrencyEnabled(ctx context.Context, arg UpdateCurrencyEnabledParams) (Currency, error) {
+	defer q.locked()()
+	t := q.tables
+	currency, err := getRow(t.currencies, arg.Code)
+	if err != nil {
+		return Currency{}, err
+	}
+	if arg.UpdatedBy.Valid {
+		if err := references(t.users, arg.UpdatedBy.String, "currencies", "currencies_updated_by_fkey"); err != nil {
+			return Currency{}, err
+		}
+	}
+
+	currency.Enabled = arg.Enabled
+	currency.UpdatedBy = arg.UpdatedBy
+	currency.UpdatedAt = timestamptz(q.now())
+	putRow(t, t.currencies, currency.Code, currency)
+	return currency, nil
+}
+
+// entry.sql
+
+func (q *memQueries) CreateEntry(ctx context.Context, arg CreateEntryParams) (Entry, error) {
+	defer q.locked()()
+	t := q.tables
+	// the entry is selected from the account, so there is none without it
+	account, err := getRow(t.accounts, arg.AccountID)
+	if err != nil {
+		return Entry{}, err
+	}
+	if err := checkIn("entries", "entries_type_check", arg.Type, EntryTypeTransfer, EntryTypeDeposit,
+		EntryTypeWithdrawal, EntryTypeFee, EntryTypeAdjustment, EntryTypeReversal, EntryTypeInterest); err != nil {
+		return Entry{}, err
+	}
+	if arg.TransferID.Valid {
+		if err := references(t.transfers, arg.TransferID.Int64, "entries", "entries_transfer_id_fkey"); err != nil {
+			return Entry{}, err
+		}
+	}
+
+	// stamped with clock_timestamp() rather than the start of the transaction
+	entry := Entry{
+		ID:           t.nextID("entries"),
+		AccountID:    arg.AccountID,
+		Amount:       arg.Amount,
+		CreatedAt:    timestamptz(time.Now()),
+		Type:         arg.Type,
+		TransferID:   arg.TransferID,
+		BalanceAfter: account.Balance + arg.Amount,
+	}
+	putRow(t, t.entries, entry.ID, entry)
+	return entry, nil
+}
+
+func (q *memQueries) GetEntry(ctx context.Context, id int64) (Entry, error) {
+	defer q.locked()()
+	return getRow(q.tables.entries, id)
+}
+
+func (q *memQueries) ListEntries(ctx context.Context, arg ListEntriesParams) ([]Entry, error) {
+	defer q.locked()()
+	t := q.tables
+	entries := selectRows(t.entries, func(entry Entry) bool {
+		if entry.AccountID != arg.AccountID ||
+			!inHistoryPage(entry.ID, entry.CreatedAt, arg.BeforeID, arg.StartTime, arg.EndTime) {
+			return false
+		}
+		if arg.Direction.Valid &&
+			!(arg.Direction.String == DirectionOut && entry.Amount < 0) &&
+			!(arg.Direction.String == DirectionIn && entry.Amount > 0) {
+			return false
+		}
+		if arg.CounterpartyAccountID.Valid {
+			transfer, ok := t.transfers[entry.TransferID.Int64]
+			if !entry.TransferID.Valid || !ok ||
+				!(transfer.FromAccountID == entry.AccountID && transfer.ToAccountID == arg.CounterpartyAccountID.Int64) &&
+					!(transfer.ToAccountID == entry.AccountID && transfer.FromAccountID == arg.CounterpartyAccountID.Int64) {
+				return false
+			}
+		}
+		return inAmountRange(abs64(entry.Amount), arg.MinAmount, arg.MaxAmount)
+	})
+	slices.Reverse(entries)
+	return limitRows(entries, arg.PageSize, 0)
+}
+
+// inHistoryPage reports whether a row of an account's history passes the before_id, start_time and
+// end_time filters.
+func inHistoryPage(id int64, createdAt pgtype.Timestamptz, beforeID pgtype.Int8, startTime, endTime pgtype.Timestamptz) bool {
+	return (!beforeID.Valid || id < beforeID.Int64) &&
+		(!startTime.Valid || !createdAt.Time.Before(startTime.Time)) &&
+		(!endTime.Valid || createdAt.Time.Before(endTime.Time))
+}
+
+// inAmountRange reports whether amount passes the min_amount and max_amount filters.
+func inAmountRange(amount int64, minAmount, maxAmount pgtype.Int8) bool {
+	return (!minAmount.Valid || amount >= minAmount.Int64) && (!maxAmount.Valid || amount <= maxAmount.Int64)
+}
+
+func abs64(x int64) int64 {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
+
+func (q *memQueries) ListEntriesByTransfer(ctx context.Context, transferID pgtype.Int8) ([]Entry, error) {
+	defer q.locked()()
+	return selectRows(q.tables.entries, func(entry Entry) bool {
+		return transferID.Valid && entry.TransferID.Valid && entry.TransferID.Int64 == transferID.Int64
+	}), nil
+}
+
+func (q *memQueries) GetBalanceAt(ctx context.Context, arg GetBalanceAtParams) (int64, error) {
+	defer q.locked()()
+	t := q.tables
+	entries := selectRows(t.entries, func(entry Entry) bool {
+		return entry.AccountID == arg.AccountID
+	})
+	if len(entries) == 0 {
+		account, ok := t.accounts[arg.AccountID]
+		if !ok {
+			return 0, fmt.Errorf("can't scan into dest[0]: cannot scan NULL into *int64")
+		}
+		return account.Balance, nil
+	}
+
+	for i := len(entries) - 1; i >= 0; i-- {
+		if arg.At.Valid && !entries[i].CreatedAt.Time.After(arg.At.Time) {
+			return entries[i].BalanceAfter, nil
+		}
+	}
+	return entries[0].BalanceAfter - entries[0].Amount, nil
+}
+
+// exchange_rate.sql
+
+func (q *memQueries) UpsertExchangeRate(ctx context.Context, arg UpsertExchangeRateParams) (ExchangeRate, error) {
+	defer q.locked()()
+	t := q.tables
+	if !arg.Rate.Valid {
+		return ExchangeRate{}, notNull("exchange_rates", "rate")
+	}
+	if !arg.EffectiveAt.Valid {
+		return ExchangeRate{}, notNull("exchange_rates", "effective_at")
+	}
+	if numericSign(arg.Rate) <= 0 {
+		return ExchangeRate{}, checkViolation("exchange_rates", "exchange_rates_rate_check")
+	}
+
+	effectiveAt := storedTimestamptz(arg.EffectiveAt)
+	for id, rate := range t.exchangeRates {
+		if rate.BaseCurrency == arg.BaseCurrency && rate.QuoteCurrency == arg.QuoteCurrency &&
+			rate.EffectiveAt.Time.Equal(effectiveAt.Time) {
+			rate.Rate = arg.Rate
+			putRow(t, t.exchangeRates, id, rate)
+			return rate, nil
+		}
+	}
+
+	rate := ExchangeRate{
+		ID:            t.nextID("exchange_rates"),
+		BaseCurrency:  arg.BaseCurrency,
+		QuoteCurrency: arg.QuoteCurrency,
+		Rate:          arg.Rate,
+		EffectiveAt:   effectiveAt,
+		CreatedAt:     timestamptz(q.now()),
+	}
+	putRow(t, t.exchangeRates, rate.ID, rate)
+	return rate, nil
+}
+
+func (q *memQueries) GetExchangeRate(ctx context.Context, arg GetExchangeRateParams) (ExchangeRate, error) {
+	defer q.locked()()
+	now := q.now()
+	rates := selectRows(q.tables.exchangeRates, func(rate ExchangeRate) bool {
+		return rate.BaseCurrency == arg.BaseCurrency && rate.QuoteCurrency == arg.QuoteCurrency &&
+			!rate.EffectiveAt.Time.After(now)
+	})
+	slices.SortStableFunc(rates, func(a, b ExchangeRate) int {
+		return b.EffectiveAt.Time.Compare(a.EffectiveAt.Time)
+	})
+	return firstRow(rates)
+}
+
+func (q *memQueries) ListExchangeRates(ctx context.Context, arg ListExchangeRatesParams) ([]ExchangeRate, error) {
+	defer q.locked()()
+	rates := selectRows(q.tables.exchangeRates, func(rate ExchangeRate) bool {
+		return rate.BaseCurrency == arg.BaseCurrency
+	})
+	slices.SortStableFunc(rates, func(a, b ExchangeRate) int {
+		if c := b.EffectiveAt.Time.Compare(a.EffectiveAt.Time); c != 0 {
+			return c
+		}
+		return strings.Compare(a.QuoteCurrency, b.QuoteCurrency)
+	})
+	return limitRows(rates, arg.Limit, arg.Offset)
+}
+
+// fee_rule.sql
+
+func (q *memQueries) CreateFeeRule(ctx context.Context, arg CreateFeeRuleParams) (FeeRule, error) {
+	defer q.locked()()
+	t := q.tables
+	if !arg.Percentage.Valid {
+		return FeeRule{}, notNull("fee_rules", "percentage")
+	}
+	if arg.FlatFee < 0 || arg.MinFee < 0 || arg.MaxFee.Valid && arg.MaxFee.Int64 < arg.MinFee {
+		return FeeRule{}, checkViolation("fee_rules", "fee_rules_amounts_check")
+	}
+	if percentage, err := ratFromNumeric(arg.Percentage); err != nil || percentage.Sign() < 0 || percentage.Cmp(big.NewRat(1, 1)) >= 0 {
+		return FeeRule{}, checkViolation("fee_rules", "fee_rules_percentage_check")
+	}
+	if err := references(t.users, arg.CreatedBy, "fee_rules", "fee_rules_created_by_fkey"); err != nil {
+		return FeeRule{}, err
+	}
+
+	rule := FeeRule{
+		ID:         t.nextID("fee_rules"),
+		Currency:   arg.Currency,
+		Role:       arg.Role,
+		SameOwner:  arg.SameOwner,
+		FlatFee:    arg.FlatFee,
+		Percentage: arg.Percentage,
+		MinFee:     arg.MinFee,
+		MaxFee:     arg.MaxFee,
+		CreatedBy:  arg.CreatedBy,
+		CreatedAt:  timestamptz(q.now()),
+	}
+	putRow(t, t.feeRules, rule.ID, rule)
+	return rule, nil
+}
+
+func (q *memQueries) GetFeeRule(ctx context.Context, arg GetFeeRuleParams) (FeeRule, error) {
+	defer q.locked()()
+	rules := selectRows(q.tables.feeRules, func(rule FeeRule) bool {
+		return (!rule.Currency.Valid || rule.Currency.String == arg.Currency) &&
+			(!rule.Role.Valid || rule.Role.String == arg.Role) &&
+			(!rule.SameOwner.Valid || rule.SameOwner.Bool == arg.SameOwner)
+	})
+	specificity := func(rule FeeRule) int {
+		n := 0
+		for _, set := range []bool{rule.Currency.Valid, rule.Role.Valid, rule.SameOwner.Valid} {
+			if set {
+				n++
+			}
+		}
+		return n
+	}
+	slices.SortStableFunc(rules, func(a, b FeeRule) int {
+		if c := specificity(b) - specificity(a); c != 0 {
+			return c
+		}
+		return int(b.ID - a.ID)
+	})
+	return firstRow(rules)
+}
+
+func (q *memQueries) ListFeeRules(ctx context.Context, arg ListFeeRulesParams) ([]FeeRule, error) {
+	defer q.locked()()
+	rules := selectRows(q.tables.feeRules, func(FeeRule) bool { return true })
+	return limitRows(rules, arg.Limit, arg.Offset)
+}
+
+// idempotency_key.sql
+
+func (q *memQueries) CreateIdempotencyKey(ctx context.Context, arg CreateIdempotencyKeyParams) (IdempotencyKey, error) {
+	defer q.locked()()
+	t := q.tables
+	id := idempotencyKeyID{username: arg.Username, key: arg.Key}
+	if _, ok := t.idempotencyKeys[id]; ok {
+		// ON CONFLICT DO NOTHING returns no row
+		return IdempotencyKey{}, ErrRecordNotFound
+	}
+	if err := references(t.users, arg.Username, "idempotency_keys", "idempotency_keys_username_fkey"); err != nil {
+		return IdempotencyKey{}, err
+	}
+
+	key := IdempotencyKey{
+		Username:    arg.Username,
+		Key:         arg.Key,
+		RequestHash: arg.RequestHash,
+		CreatedAt:   timestamptz(q.now()),
+		Operation:   arg.Operation,
+	}
+	putRow(t, t.idempotencyKeys, id, key)
+	return key, nil
+}
+
+func (q *memQueries) GetIdempotencyKey(ctx context.Context, arg GetIdempotencyKeyParams) (IdempotencyKey, error) {
+	defer q.locked()()
+	return getRow(q.tables.idempotencyKeys, idempotencyKeyID{username: arg.Username, key: arg.Key})
+}
+
+func (q *memQueries) UpdateIdempotencyKeyResponse(ctx context.Context, arg UpdateIdempotencyKeyResponseParams) error {
+	defer q.locked()()
+	t := q.tables
+	id := idempotencyKeyID{username: arg.Username, key: arg.Key}
+	key, ok := t.idempotencyKeys[id]
+	if !ok {
+		return nil
+	}
+	response, err := storedJSON("idempotency_keys", "response", arg.Response, true)
+	if err != nil {
+		return err
+	}
+	key.Response = response
+	putRow(t, t.idempotencyKeys, id, key)
+	return nil
+}
+
+// interest.sql
+
+func (q *memQueries) UpsertInterestRate(ctx context.Context, arg UpsertInterestRateParams) (InterestRate, error) {
+	defer q.locked()()
+	t := q.tables
+	if !arg.AnnualRate.Valid {
+		return InterestRate{}, notNull("interest_rates", "annual_rate")
+	}
+	if !arg.EffectiveAt.Valid {
+		return InterestRate{}, notNull("interest_rates", "effective_at")
+	}
+	if numericSign(arg.AnnualRate) < 0 {
+		return InterestRate{}, checkViolation("interest_rates", "interest_rates_annual_rate_check")
+	}
+
+	effectiveAt := storedTimestamptz(arg.EffectiveAt)
+	for id, rate := range t.interestRates {
+		if rate.AccountType == arg.AccountType && rate.Currency == arg.Currency &&
+			rate.EffectiveAt.Time.Equal(effectiveAt.Time) {
+			rate.AnnualRate = arg.AnnualRate
+			putRow(t, t.interestRates, id, rate)
+			return rate, nil
+		}
+	}
+
+	rate := InterestRate{
+		ID:          t.nextID("interest_rates"),
+		AccountType: arg.AccountType,
+		Currency:    arg.Currency,
+		AnnualRate:  arg.AnnualRate,
+		EffectiveAt: effectiveAt,
+		CreatedAt:   timestamptz(q.now()),
+	}
+	putRow(t, t.interestRates, rate.ID, rate)
+	return rate, nil
+}
+
+func (q *memQueries) GetInterestRate(ctx context.Context, arg GetInterestRateParams) (InterestRate, error) {
+	defer q.locked()()
+	rates := selectRows(q.tables.interestRates, func(rate InterestRate) bool {
+		return rate.AccountType == arg.AccountType && rate.Currency == arg.Currency &&
+			arg.At.Valid && !rate.EffectiveAt.Time.After(arg.At.Time)
+	})
+	slices.SortStableFunc(rates, func(a, b InterestRate) int {
+		return b.EffectiveAt.Time.Compare(a.EffectiveAt.Time)
+	})
+	return firstRow(rates)
+}
+
+func (q *memQueries) CreateInterestAccrual(ctx context.Context, arg CreateInterestAccrualParams) (InterestAccrual, error) {
+	defer q.locked()()
+	t := q.tables
+	if !arg.AccrualDate.Valid {
+		return InterestAccrual{}, notNull("interest_accruals", "accrual_date")
+	}
+	if !arg.AnnualRate.Valid {
+		return InterestAccrual{}, notNull("interest_accruals", "annual_rate")
+	}
+	if !arg.Amount.Valid {
+		return InterestAccrual{}, notNull("interest_accruals", "amount")
+	}
+
+	accrualDate := storedDate(arg.AccrualDate)
+	for _, accrual := range t.interestAccruals {
+		if accrual.AccountID == arg.AccountID && accrual.AccrualDate.Time.Equal(accrualDate.Time) {
+			// ON CONFLICT DO NOTHING returns no row
+			return InterestAccrual{}, ErrRecordNotFound
+		}
+	}
+	if err := references(t.accounts, arg.AccountID, "interest_accruals", "interest_accruals_account_id_fkey"); err != nil {
+		return InterestAccrual{}, err
+	}
+
+	accrual := InterestAccrual{
+		ID:          t.nextID("interest_accruals"),
+		AccountID:   arg.AccountID,
+		AccrualDate: accrualDate,
+		Balance:     arg.Balance,
+		AnnualRate:  arg.AnnualRate,
+		Amount:      arg.Amount,
+		CreatedAt:   timestamptz(q.now()),
+	}
+	putRow(t, t.interestAccruals, accrual.ID, accrual)
+	return accrual, nil
+}
+
+// isUncreditedThrough reports whether the accrual of the account is not credited yet and dates from
+// through or before.
+func isUncreditedThrough(accrual InterestAccrual, accountID int64, through pgtype.Date) bool {
+	return accrual.AccountID == accountID && !accrual.CreditedAt.Valid &&
+		through.Valid && !accrual.AccrualDate.Time.After(storedDate(through).Time)
+}
+
+func (q *memQueries) ListUncreditedInterestAccruals(ctx context.Context, arg ListUncreditedInterestAccrualsParams) ([]InterestAccrual, error) {
+	defer q.locked()()
+	accruals := selectRows(q.tables.interestAccruals, func(accrual InterestAccrual) bool {
+		return isUncreditedThrough(accrual, arg.AccountID, arg.Through)
+	})
+	slices.SortStableFunc(accruals, func(a, b InterestAccrual) int {
+		return a.AccrualDate.Time.Compare(b.AccrualDate.Time)
+	})
+	return accruals, nil
+}
+
+func (q *memQueries) GetCreditedInterest(ctx context.Context, accountID int64) (GetCreditedInterestRow, error) {
+	defer q.locked()()
+	var credited GetCreditedInterestRow
+	accrued := new(big.Rat)
+	paidEntries := map[int64]bool{}
+	for _, accrual := range q.tables.interestAccruals {
+		if accrual.AccountID != accountID {
+			continue
+		}
+		if accrual.EntryID.Valid && !paidEntries[accrual.EntryID.Int64] {
+			paidEntries[accrual.EntryID.Int64] = true
+			credited.Paid += q.tables.entries[accrual.EntryID.Int64].Amount
+		}
+		if !accrual.CreditedAt.Valid {
+			continue
+		}
+		amount, err := ratFromNumeric(accrual.Amount)
+		if err != nil {
+			return GetCreditedInterestRow{}, err
+		}
+		accrued.Add(accrued, amount)
+	}
+
+	var err error
+	credited.Accrued, err = numericFromRat(accrued, accrualScale)
+	if err != nil {
+		return GetCreditedInterestRow{}, err
+	}
+	return credited, nil
+}
+
+func (q *memQueries) MarkInterestAccrualsCredited(ctx context.Context, arg MarkInterestAccrualsCreditedParams) error {
+	defer q.locked()()
+	t := q.tables
+	accruals := selectRows(t.interestAccruals, func(accrual InterestAccrual) bool {
+		return isUncreditedThrough(accrual, arg.AccountID, arg.Through)
+	})
+	if len(accruals) > 0 && arg.EntryID.Valid {
+		if err := references(t.entries, arg.EntryID.Int64, "interest_accruals", "interest_accruals_entry_id_fkey"); err != nil {
+			return err
+		}
+	}
+
+	now := timestamptz(q.now())
+	for _, accrual := range accruals {
+		accrual.EntryID = arg.EntryID
+		accrual.CreditedAt = now
+		putRow(t, t.interestAccruals, accrual.ID, accrual)
+	}
+	return nil
+}
+
+func (q *memQueries) ListInterestAccruals(ctx context.Context, arg ListInterestAccrualsParams) ([]InterestAccrual, error) {
+	defer q.locked()()
+	accruals := selectRows(q.tables.interestAccruals, func(accrual InterestAccrual) bool {
+		return accrual.AccountID == arg.AccountID
+	})
+	slices.SortStableFunc(accruals, func(a, b InterestAccrual) int {
+		return b.AccrualDate.Time.Compare(a.AccrualDate.Time)
+	})
+	return limitRows(accruals, arg.Limit, arg.Offset)
+}
+
+func (q *memQueries) GetLastInterestAccrualDate(ctx context.Context) (pgtype.Date, error) {
+	defer q.locked()()
+	var last pgtype.Date
+	for _, accrual := range q.tables.interestAccruals {
+		if !last.Valid || accrual.AccrualDate.Time.After(last.Time) {
+			last = accrual.AccrualDate
+		}
+	}
+	return last, nil
+}
+
+// outbox.sql
+
+func (q *memQueries) CreateOutboxEvent(ctx context.Context, arg CreateOutboxEventParams) (Outbox, error) {
+	defer q.locked()()
+	t := q.tables
+	payload, err := storedJSON("outbox", "payload", arg.Payload, false)
+	if err != nil {
+		return Outbox{}, err
+	}
+
+	event := Outbox{
+		ID:        t.nextID("outbox"),
+		EventType: arg.EventType,
+		Payload:   payload,
+		CreatedAt: timestamptz(q.now()),
+	}
+	putRow(t, t.outbox, event.ID, event)
+	return event, nil
+}
+
+func (q *memQueries) ListUnsentOutboxEvents(ctx context.Context, limit int32) ([]Outbox, error) {
+	defer q.locked()()
+	events := selectRows(q.tables.outbox, func(event Outbox) bool {
+		return !event.SentAt.Valid
+	})
+	return limitRows(events, limit, 0)
+}
+
+func (q *memQueries) MarkOutboxEventSent(ctx context.Context, id int64) error {
+	defer q.locked()()
+	event, ok := q.tables.outbox[id]
+	if !ok {
+		return nil
+	}
+	event.SentAt = timestamptz(q.now())
+	putRow(q.tables, q.tables.outbox, id, event)
+	return nil
+}
+
+func (q *memQueries) RecordOutboxEventFailure(ctx context.Context, arg RecordOutboxEventFailureParams) error {
+	defer q.locked()()
+	event, ok := q.tables.outbox[arg.ID]
+	if !ok {
+		return nil
+	}
+	event.Attempts++
+	event.LastError = arg.LastError
+	putRow(q.tables, q.tables.outbox, arg.ID, event)
+	return nil
+}
+
+// reconciliation.sql
+
+func (q *memQueries) ListAccountBalanceMismatches(ctx context.Context) ([]ListAccountBalanceMismatchesRow, error) {
+	defer q.locked()()
+	t := q.tables
+	totals := map[int64]int64{}
+	for _, entry := range t.entries {
+		totals[entry.AccountID] += entry.Amount
+	}
+
+	items := []ListAccountBalanceMismatchesRow{}
+	for _, account := range selectRows(t.accounts, func(Account) bool { return true }) {
+		if account.Balance != totals[account.ID] {
+			items = append(items, ListAccountBalanceMismatchesRow{
+				ID:           account.ID,
+				Currency:     account.Currency,
+				Balance:      account.Balance,
+				EntriesTotal: totals[account.ID],
+			})
+		}
+	}
+	return items, nil
+}
+
+func (q *memQueries) ListUnmatchedTransfers(ctx context.Context) ([]ListUnmatchedTransfersRow, error) {
+	defer q.locked()()
+	t := q.tables
+	rows := map[int64]*ListUnmatchedTransfersRow{}
+	for _, transfer := range t.transfers {
+		rows[transfer.ID] = &ListUnmatchedTransfersRow{
+			ID:             transfer.ID,
+			FromAccountID:  transfer.FromAccountID,
+			ToAccountID:    transfer.ToAccountID,
+			Amount:         transfer.Amount,
+			ReversedAmount: transfer.ReversedAmount,
+			FeeAmount:      transfer.FeeAmount,
+		}
+	}
+	for _, entry := range t.entries {
+		if !entry.TransferID.Valid {
+			continue
+		}
+		row, ok := rows[entry.TransferID.Int64]
+		if !ok {
+			continue
+		}
+		transfer := t.transfers[row.ID]
+		switch entry.Type {
+		case EntryTypeTransfer:
+			row.EntryCount++
+			if entry.AccountID == transfer.FromAccountID && entry.Amount == -transfer.Amount {
+				row.DebitCount++
+			}
+			if entry.AccountID == transfer.ToAccountID && entry.Amount == transfer.ToAmount {
+				row.CreditCount++
+			}
+		case EntryTypeReversal:
+			if entry.AccountID == transfer.FromAccountID {
+				row.RefundedTotal += entry.Amount
+			}
+		case EntryTypeFee:
+			if entry.AccountID == transfer.FromAccountID {
+				row.FeeTotal -= entry.Amount
+			}
+		}
+	}
+
+	items := []ListUnmatchedTransfersRow{}
+	for _, row := range selectRows(rows, func(*ListUnmatchedTransfersRow) bool { return true }) {
+		if row.DebitCount != 1 || row.CreditCount != 1 || row.EntryCount != 2 ||
+			row.RefundedTotal != row.ReversedAmount || row.FeeTotal != row.FeeAmount {
+			items = append(items, *row)
+		}
+	}
+	return items, nil
+}
+
+func (q *memQueries) ListCurrencyImbalances(ctx context.Context) ([]ListCurrencyImbalancesRow, error) {
+	defer q.locked()()
+	t := q.tables
+	rows := map[string]*ListCurrencyImbalancesRow{}
+	for _, entry := range t.entries {
+		transfer, ok := t.transfers[entry.TransferID.Int64]
+		if !entry.TransferID.Valid || !ok || transfer.ExchangeRate.Valid {
+			continue
+		}
+		currency := t.accounts[entry.AccountID].Currency
+		row, ok := rows[currency]
+		if !ok {
+			row = &ListCurrencyImbalancesRow{Currency: currency}
+			rows[currency] = row
+		}
+		if entry.Amount < 0 {
+			row.Debits -= entry.Amount
+		} else {
+			row.Credits += entry.Amount
+		}
+	}
+
+	items := []ListCurrencyImbalancesRow{}
+	for _, row := range selectRows(rows, func(*ListCurrencyImbalancesRow) bool { return true }) {
+		if row.Credits != row.Debits {
+			items = append(items, *row)
+		}
+	}
+	return items, nil
+}
+
+func (q *memQueries) CreateReconciliationReport(ctx context.Context, arg CreateReconciliationReportParams) (ReconciliationReport, error) {
+	defer q.locked()()
+	t := q.tables
+	discrepancies, err := storedJSON("reconciliation_reports", "discrepancies", arg.Discrepancies, false)
+	if err != nil {
+		return ReconciliationReport{}, err
+	}
+
+	report := ReconciliationReport{
+		ID:               t.nextID("reconciliation_reports"),
+		TriggeredBy:      arg.TriggeredBy,
+		DiscrepancyCount: arg.DiscrepancyCount,
+		Discrepancies:    discrepancies,
+		CreatedAt:        timestamptz(q.now()),
+	}
+	putRow(t, t.reconciliationReports, report.ID, report)
+	return report, nil
+}
+
+func (q *memQueries) GetReconciliationReport(ctx context.Context, id int64) (ReconciliationReport, error) {
+	defer q.locked()()
+	return getRow(q.tables.reconciliationReports, id)
+}
+
+func (q *memQueries) ListReconciliationReports(ctx context.Context, arg ListReconciliationReportsParams) ([]ReconciliationReport, error) {
+	defer q.locked()()
+	reports := selectRows(q.tables.reconciliationReports, func(ReconciliationReport) bool { return true })
+	slices.Reverse(reports)
+	return limitRows(reports, arg.Limit, arg.Offset)
+}
+
+// scheduled_transfer.sql
+
+func checkScheduledTransfer(scheduled ScheduledTransfer) error {
+	if scheduled.Amount <= 0 {
+		return checkViolation("scheduled_transfers", "scheduled_transfers_amount_check")
+	}
+	return checkIn("scheduled_transfers", "scheduled_transfers_status_check", scheduled.Status,
+		ScheduledTransferActive, ScheduledTransferPaused, ScheduledTransferCancelled, ScheduledTransferCompleted)
+}
+
+func (q *memQueries) CreateScheduledTransfer(ctx context.Context, arg CreateScheduledTransferParams) (ScheduledTransfer, error) {
+	defer q.locked()()
+	t := q.tables
+	if !arg.StartAt.Valid {
+		return ScheduledTransfer{}, notNull("scheduled_transfers", "start_at")
+	}
+	now := timestamptz(q.now())
+	scheduled := ScheduledTransfer{
+		Owner:         arg.Owner,
+		FromAccountID: arg.FromAccountID,
+		ToAccountID:   arg.ToAccountID,
+		Amount:        arg.Amount,
+		Schedule:      arg.Schedule,
+		StartAt:       storedTimestamptz(arg.StartAt),
+		EndAt:         storedTimestamptz(arg.EndAt),
+		NextRunAt:     storedTimestamptz(arg.NextRunAt),
+		Status:        ScheduledTransferActive,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+		OwnerRole:     arg.OwnerRole,
+	}
+	if err := checkScheduledTransfer(scheduled); err != nil {
+		return ScheduledTransfer{}, err
+	}
+	if err := references(t.users, arg.Owner, "scheduled_transfers", "scheduled_transfers_owner_fkey"); err != nil {
+		return ScheduledTransfer{}, err
+	}
+	if err := references(t.accounts, arg.FromAccountID, "scheduled_transfers", "scheduled_transfers_from_account_id_fkey"); err != nil {
+		return ScheduledTransfer{}, err
+	}
+	if err := references(t.accounts, arg.ToAccountID, "scheduled_transfers", "scheduled_transfers_to_account_id_fkey"); err != nil {
+		return ScheduledTransfer{}, err
+	}
+
+	scheduled.ID = t.nextID("scheduled_transfers")
+	putRow(t, t.scheduledTransfers, scheduled.ID, scheduled)
+	return scheduled, nil
+}
+
+func (q *memQueries) GetScheduledTransfer(ctx context.Context, id int64) (ScheduledTransfer, error) {
+	defer q.locked()()
+	return getRow(q.tables.scheduledTransfers, id)
+}
+
+func (q *memQueries) GetScheduledTransferForUpdate(ctx context.Context, id int64) (ScheduledTransfer, error) {
+	defer q.locked()()
+	return getRow(q.tables.scheduledTransfers, id)
+}
+
+func (q *memQueries) ListScheduledTransfers(ctx context.Context, arg ListScheduledTransfersParams) ([]ScheduledTransfer, error) {
+	defer q.locked()()
+	scheduled := selectRows(q.tables.scheduledTransfers, func(scheduled ScheduledTransfer) bool {
+		return scheduled.Owner == arg.Owner
+	})
+	return limitRows(scheduled, arg.Limit, arg.Offset)
+}
+
+func (q *memQueries) ListDueScheduledTransfers(ctx context.Context, limit int32) ([]ScheduledTransfer, error) {
+	defer q.locked()()
+	now := q.now()
+	due := selectRows(q.tables.scheduledTransfers, func(scheduled ScheduledTransfer) bool {
+		return scheduled.Status == ScheduledTransferActive && scheduled.NextRunAt.Valid && !scheduled.NextRunAt.Time.After(now)
+	})
+	slices.SortStableFunc(due, func(a, b ScheduledTransfer) int {
+		return compareTimestamptz(a.NextRunAt, b.NextRunAt)
+	})
+	return limitRows(due, limit, 0)
+}
+
+func (q *memQueries) UpdateScheduledTransferStatus(ctx context.Context, arg UpdateScheduledTransferStatusParams) (ScheduledTransfer, error) {
+	defer q.locked()()
+	t := q.tables
+	scheduled, err := getRow(t.scheduledTransfers, arg.ID)
+	if err != nil {
+		return ScheduledTransfer{}, err
+	}
+	scheduled.Status = arg.Status
+	scheduled.NextRunAt = storedTimestamptz(arg.NextRunAt)
+	scheduled.UpdatedAt = timestamptz(q.now())
+	if err := checkScheduledTransfer(scheduled); err != nil {
+		return ScheduledTransfer{}, err
+	}
+
+	putRow(t, t.scheduledTransfers, scheduled.ID, scheduled)
+	return scheduled, nil
+}
+
+func (q *memQueries) CreateScheduledTransferExecution(ctx context.Context, arg CreateScheduledTransferExecutionParams) (ScheduledTransferExecution, error) {
+	defer q.locked()()
+	t := q.tables
+	if !arg.ScheduledFor.Valid {
+		return ScheduledTransferExecution{}, notNull("scheduled_transfer_executions", "scheduled_for")
+	}
+	if err := checkIn("scheduled_transfer_executions", "scheduled_transfer_executions_status_check", arg.Status,
+		ExecutionSucceeded, ExecutionFailed); err != nil {
+		return ScheduledTransferExecution{}, err
+	}
+
+	scheduledFor := storedTimestamptz(arg.ScheduledFor)
+	for _, execution := range t.scheduledTransferExecutions {
+		if execution.ScheduledTransferID == arg.ScheduledTransferID && execution.ScheduledFor.Time.Equal(scheduledFor.Time) {
+			// ON CONFLICT DO NOTHING returns no row
+			return ScheduledTransferExecution{}, ErrRecordNotFound
+		}
+	}
+	if err := references(t.scheduledTransfers, arg.ScheduledTransferID, "scheduled_transfer_executions",
+		"scheduled_transfer_executions_scheduled_transfer_id_fkey"); err != nil {
+		return ScheduledTransferExecution{}, err
+	}
+	if arg.TransferID.Valid {
+		if err := references(t.transfers, arg.TransferID.Int64, "scheduled_transfer_executions",
+			"scheduled_transfer_executions_transfer_id_fkey"); err != nil {
+			return ScheduledTransferExecution{}, err
+		}
+	}
+
+	execution := ScheduledTransferExecution{
+		ID:                  t.nextID("scheduled_transfer_executions"),
+		ScheduledTransferID: arg.ScheduledTransferID,
+		ScheduledFor:        scheduledFor,
+		Status:              arg.Status,
+		TransferID:          arg.TransferID,
+		FailureReason:       arg.FailureReason,
+		CreatedAt:           timestamptz(q.now()),
+	}
+	putRow(t, t.scheduledTransferExecutions, execution.ID, execution)
+	return execution, nil
+}
+
+func (q *memQueries) ListScheduledTransferExecutions(ctx context.Context, arg ListScheduledTransferExecutionsParams) ([]ScheduledTransferExecution, error) {
+	defer q.locked()()
+	executions := selectRows(q.tables.scheduledTransferExecutions, func(execution ScheduledTransferExecution) bool {
+		return execution.ScheduledTransferID == arg.ScheduledTransferID
+	})
+	slices.SortStableFunc(executions, func(a, b ScheduledTransferExecution) int {
+		return b.ScheduledFor.Time.Compare(a.ScheduledFor.Time)
+	})
+	return limitRows(executions, arg.Limit, arg.Offset)
+}
+
+// session.sql
+
+func (q *memQueries) CreateSession(ctx context.Context, arg CreateSessionParams) (Session, error) {
+	defer q.locked()()
+	t := q.tables
+	if !arg.ID.Valid {
+		return Session{}, notNull("sessions", "id")
+	}
+	if !arg.ExpiresAt.Valid {
+		return Session{}, notNull("sessions", "expires_at")
+	}
+	if _, ok := t.sessions[arg.ID.Bytes]; ok {
+		return Session{}, uniqueViolation("sessions_pkey")
+	}
+	if err := references(t.users, arg.Username, "sessions", "sessions_username_fkey"); err != nil {
+		return Session{}, err
+	}
+
+	session := Session{
+		ID:           arg.ID,
+		Username:     arg.Username,
+		RefreshToken: arg.RefreshToken,
+		UserAgent:    arg.UserAgent,
+		ClientIp:     arg.ClientIp,
+		IsBlocked:    arg.IsBlocked,
+		ExpiresAt:    storedTimestamptz(arg.ExpiresAt),
+		CreatedAt:    timestamptz(q.now()),
+	}
+	putRow(t, t.sessions, arg.ID.Bytes, session)
+	return session, nil
+}
+
+func (q *memQueries) GetSession(ctx context.Context, id pgtype.UUID) (Session, error) {
+	defer q.locked()()
+	if !id.Valid {
+		return Session{}, ErrRecordNotFound
+	}
+	return getRow(q.tables.sessions, sessionID(id.Bytes))
+}
+
+// transfer.sql
+
+func checkTransfer(transfer Transfer) error {
+	if transfer.ReversedAmount < 0 || transfer.ReversedAmount > transfer.Amount {
+		return checkViolation("transfers", "transfers_reversed_amount_check")
+	}
+	if err := checkIn("transfers", "transfers_status_check", transfer.Status,
+		TransferStatusCompleted, TransferStatusPartiallyReversed, TransferStatusReversed); err != nil {
+		return err
+	}
+	if transfer.FeeAmount < 0 {
+		return checkViolation("transfers", "transfers_fee_amount_check")
+	}
+	return nil
+}
+
+func (q *memQueries) CreateTransfer(ctx context.Context, arg CreateTransferParams) (Transfer, error) {
+	defer q.locked()()
+	t := q.tables
+	transfer := Transfer{
+		FromAccountID: arg.FromAccountID,
+		ToAccountID:   arg.ToAccountID,
+		Amount:        arg.Amount,
+		CreatedAt:     timestamptz(q.now()),
+		ToAmount:      arg.ToAmount,
+		ExchangeRate:  arg.ExchangeRate,
+		Status:        TransferStatusCompleted,
+		FeeAmount:     arg.FeeAmount,
+		FeeRuleID:     arg.FeeRuleID,
+		Memo:          arg.Memo,
+		Reference:     arg.Reference,
+		InitiatedBy:   arg.InitiatedBy,
+	}
+	if err := checkTransfer(transfer); err != nil {
+		return Transfer{}, err
+	}
+	if err := references(t.accounts, arg.FromAccountID, "transfers", "transfers_from_account_id_fkey"); err != nil {
+		return Transfer{}, err
+	}
+	if err := references(t.accounts, arg.ToAccountID, "transfers", "transfers_to_account_id_fkey"); err != nil {
+		return Transfer{}, err
+	}
+	if arg.FeeRuleID.Valid {
+		if err := references(t.feeRules, arg.FeeRuleID.Int64, "transfers", "transfers_fee_rule_id_fkey"); err != nil {
+			return Transfer{}, err
+		}
+	}
+	if err := references(t.users, arg.InitiatedBy, "transfers", "transfers_initiated_by_fkey"); err != nil {
+		return Transfer{}, err
+	}
+
+	transfer.ID = t.nextID("transfers")
+	putRow(t, t.transfers, transfer.ID, transfer)
+	return transfer, nil
+}
+
+func (q *memQueries) GetTransfer(ctx context.Context, id int64) (Transfer, error) {
+	defer q.locked()()
+	return getRow(q.tables.transfers, id)
+}
+
+func (q *memQueries) ListTransfers(ctx context.Context, arg ListTransfersParams) ([]Transfer, error) {
+	defer q.locked()()
+	transfers := selectRows(q.tables.transfers, func(transfer Transfer) bool {
+		outgoing := transfer.FromAccountID == arg.AccountID
+		incoming := transfer.ToAccountID == arg.AccountID
+		if !outgoing && !incoming ||
+			!inHistoryPage(transfer.ID, transfer.CreatedAt, arg.BeforeID, arg.StartTime, arg.EndTime) {
+			return false
+		}
+		if arg.Direction.Valid &&
+			!(arg.Direction.String == DirectionOut && outgoing) &&
+			!(arg.Direction.String == DirectionIn && incoming) {
+			return false
+		}
+		if arg.CounterpartyAccountID.Valid &&
+			!(outgoing && transfer.ToAccountID == arg.CounterpartyAccountID.Int64) &&
+			!(incoming && transfer.FromAccountID == arg.CounterpartyAccountID.Int64) {
+			return false
+		}
+
+		amount := transfer.ToAmount
+		if outgoing {
+			amount = transfer.Amount
+		}
+		return inAmountRange(amount, arg.MinAmount, arg.MaxAmount)
+	})
+	slices.Reverse(transfers)
+	return limitRows(transfers, arg.PageSize, 0)
+}
+
+func (q *memQueries) GetTransferForUpdate(ctx context.Context, id int64) (Transfer, error) {
+	defer q.locked()()
+	return getRow(q.tables.transfers, id)
+}
+
+func (q *memQueries) UpdateTransferReversal(ctx context.Context, arg UpdateTransferReversalParams) (Transfer, error) {
+	defer q.locked()()
+	t := q.tables
+	transfer, err := getRow(t.transfers, arg.ID)
+	if err != nil {
+		return Transfer{}, err
+	}
+	transfer.ReversedAmount = arg.ReversedAmount
+	transfer.Status = arg.Status
+	if err := checkTransfer(transfer); err != nil {
+		return Transfer{}, err
+	}
+
+	putRow(t, t.transfers, transfer.ID, transfer)
+	return transfer, nil
+}
+
+func (q *memQueries) SearchTransfers(ctx context.Context, arg SearchTransfersParams) ([]Transfer, error) {
+	defer q.locked()()
+	t := q.tables
+	query := parseSearchQuery(arg.Query)
+	held := func(accountID int64) bool {
+		return t.accounts[accountID].Owner == arg.Owner || t.isActiveHolder(accountID, arg.Owner)
+	}
+
+	transfers := selectRows(t.transfers, func(transfer Transfer) bool {
+		return (held(transfer.FromAccountID) || held(transfer.ToAccountID)) &&
+			query.matches(searchWords(transfer.Memo+" "+transfer.Reference)) &&
+			(!arg.CounterpartyAccountID.Valid ||
+				transfer.FromAccountID == arg.CounterpartyAccountID.Int64 ||
+				transfer.ToAccountID == arg.CounterpartyAccountID.Int64) &&
+			(!arg.BeforeID.Valid || transfer.ID < arg.BeforeID.Int64)
+	})
+	slices.Reverse(transfers)
+	return limitRows(transfers, arg.PageSize, 0)
+}
+
+// searchWords splits text into lower case words of letters and digits, as the simple text search
+// configuration does for plain words.
+func searchWords(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// searchQuery is a parsed web search style query: any of its alternatives must match.
+type searchQuery [][]searchTerm
+
+// searchTerm is a phrase that must, or if negated must not, appear in the text.
+type searchTerm struct {
+	phrase  []string
+	negated bool
+}
+
+// parseSearchQuery parses a query the way websearch_to_tsquery does: words and quoted phrases
+// must all appear, unless separated by or, and those prefixed with - must not.
+func parseSearchQuery(query string) searchQuery {
+	parsed := searchQuery{nil}
+	for len(query) > 0 {
+		query = strings.TrimLeftFunc(query, unicode.IsSpace)
+		if query == "" {
+			break
+		}
+
+		negated := strings.HasPrefix(query, "-")
+		if negated {
+			query = query[1:]
+		}
+
+		var text string
+		if strings.HasPrefix(query, `"`) {
+			end := strings.Index(query[1:], `"`)
+			if end < 0 {
+				text, query = query[1:], ""
+			} else {
+				text, query = query[1:end+1], query[end+2:]
+			}
+		} else {
+			end := strings.IndexFunc(query, unicode.IsSpace)
+			if end < 0 {
+				end = len(query)
+			}
+			text, query = query[:end], query[end:]
+			if !negated && strings.EqualFold(text, "or") {
+				parsed = append(parsed, nil)
+				continue
+			}
+		}
+
+		if words := searchWords(text); len(words) > 0 {
+			last := len(parsed) - 1
+			parsed[last] = append(parsed[last], searchTerm{phrase: words, negated: negated})
+		}
+	}
+	return parsed
+}
+
+// matches reports whether the words of a text match the query. A query without words matches nothing.
+func (query searchQuery) matches(words []string) bool {
+	for _, terms := range query {
+		if len(terms) == 0 {
+			continue
+		}
+		matched := true
+		for _, term := range terms {
+			if containsPhrase(words, term.phrase) == term.negated {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return true
+		}
+	}
+	return false
+}
+
+func containsPhrase(words, phrase []string) bool {
+	for i := 0; i+len(phrase) <= len(words); i++ {
+		if slices.Equal(words[i:i+len(phrase)], phrase) {
+			return true
+		}
+	}
+	return false
+}
+
+// transfer_approval.sql
+
+func (q *memQueries) CreateTransferApproval(ctx context.Context, arg CreateTransferApprovalParams) (TransferApproval, error) {
+	defer q.locked()()
+	t := q.tables
+	if !arg.ExpiresAt.Valid {
+		return TransferApproval{}, notNull("transfer_approvals", "expires_at")
+	}
+	approval := TransferApproval{
+		FromAccountID: arg.FromAccountID,
+		ToAccountID:   arg.ToAccountID,
+		Amount:        arg.Amount,
+		Memo:          arg.Memo,
+		Reference:     arg.Reference,
+		RequestedBy:   arg.RequestedBy,
+		RequesterRole: arg.RequesterRole,
+		HoldID:        arg.HoldID,
+		Status:        TransferApprovalStatusPending,
+		ExpiresAt:     storedTimestamptz(arg.ExpiresAt),
+		CreatedAt:     timestamptz(q.now()),
+	}
+	if err := checkTransferApproval(approval); err != nil {
+		return TransferApproval{}, err
+	}
+	for _, approvalOfHold := range t.transferApprovals {
+		if approvalOfHold.HoldID == arg.HoldID {
+			return TransferApproval{}, uniqueViolation("transfer_approvals_hold_id_idx")
+		}
+	}
+	for _, err := range []error{
+		references(t.accounts, arg.FromAccountID, "transfer_approvals", "transfer_approvals_from_account_id_fkey"),
+		references(t.accounts, arg.ToAccountID, "transfer_approvals", "transfer_approvals_to_account_id_fkey"),
+		references(t.users, arg.RequestedBy, "transfer_approvals", "transfer_approvals_requested_by_fkey"),
+		references(t.accountHolds, arg.HoldID, "transfer_approvals", "transfer_approvals_hold_id_fkey"),
+	} {
+		if err != nil {
+			return TransferApproval{}, err
+		}
+	}
+
+	approval.ID = t.nextID("transfer_approvals")
+	putRow(t, t.transferApprovals, approval.ID, approval)
+	return approval, nil
+}
+
+func (q *memQueries) GetTransferApproval(ctx context.Context, id int64) (TransferApproval, error) {
+	defer q.locked()()
+	return getRow(q.tables.transferApprovals, id)
+}
+
+func (q *memQueries) GetTransferApprovalForUpdate(ctx context.Context, id int64) (TransferApproval, error) {
+	defer q.locked()()
+	return getRow(q.tables.transferApprovals, id)
+}
+
+func (q *memQueries) GetTransferApprovalByHoldForUpdate(ctx context.Context, holdID int64) (TransferApproval, error) {
+	defer q.locked()()
+	return firstRow(selectRows(q.tables.transferApprovals, func(approval TransferApproval) bool {
+		return approval.HoldID == holdID
+	}))
+}
+
+func (q *memQueries) ListPendingTransferApprovals(ctx context.Context, arg ListPendingTransferApprovalsParams) ([]TransferApproval, error) {
+	defer q.locked()()
+	now := q.now()
+	approvals := selectRows(q.tables.transferApprovals, func(approval TransferApproval) bool {
+		return approval.Status == TransferApprovalStatusPending && approval.ExpiresAt.Time.After(now)
+	})
+	return limitRows(approvals, arg.Limit, arg.Offset)
+}
+
+func (q *memQueries) UpdateTransferApprovalStatus(ctx context.Context, arg UpdateTransferApprovalStatusParams) (TransferApproval, error) {
+	defer q.locked()()
+	t := q.tables
+	approval, err := getRow(t.transferApprovals, arg.ID)
+	if err != nil {
+		return TransferApproval{}, err
+	}
+	approval.Status = arg.Status
+	approval.DecidedBy = arg.DecidedBy
+	approval.Reason = arg.Reason
+	approval.TransferID = arg.TransferID
+	approval.DecidedAt = timestamptz(q.now())
+	if err := checkTransferApproval(approval); err != nil {
+		return TransferApproval{}, err
+	}
+	if approval.DecidedBy.Valid {
+		if err := references(t.users, approval.DecidedBy.String, "transfer_approvals", "transfer_approvals_decided_by_fkey"); err != nil {
+			return TransferApproval{}, err
+		}
+	}
+	if approval.TransferID.Valid {
+		if err := references(t.transfers, approval.TransferID.Int64, "transfer_approvals", "transfer_approvals_transfer_id_fkey"); err != nil {
+			return TransferApproval{}, err
+		}
+	}
+
+	putRow(t, t.transferApprovals, approval.ID, approval)
+	return approval, nil
+}
+
+// checkTransferApproval checks the constraints on transfer_approvals.
+func checkTransferApproval(approval TransferApproval) error {
+	if approval.Amount <= 0 {
+		return checkViolation("transfer_approvals", "transfer_approvals_amount_check")
+	}
+	if err := checkIn("transfer_approvals", "transfer_approvals_status_check", approval.Status,
+		TransferApprovalStatusPending, TransferApprovalStatusApproved,
+		TransferApprovalStatusRejected, TransferApprovalStatusExpired); err != nil {
+		return err
+	}
+	if approval.DecidedBy.Valid && approval.DecidedBy.String == approval.RequestedBy {
+		return checkViolation("transfer_approvals", "transfer_approvals_decided_by_check")
+	}
+	return nil
+}
+
+// transfer_limit.sql
+
+func checkTransferLimit(limit TransferLimit) error {
+	if limit.Username.Valid == limit.AccountID.Valid {
+		return checkViolation("transfer_limits", "transfer_limits_scope_check")
+	}
+	if limit.MaxSingle.Valid && limit.MaxSingle.Int64 < 0 ||
+		limit.MaxDailyTotal.Valid && limit.MaxDailyTotal.Int64 < 0 ||
+		limit.MaxDailyCount.Valid && limit.MaxDailyCount.Int32 < 0 {
+		return checkViolation("transfer_limits", "transfer_limits_values_check")
+	}
+	return nil
+}
+
+// upsertTransferLimit inserts the limit, or updates the one it conflicts with.
+func (t *memTables) upsertTransferLimit(limit TransferLimit, conflicts func(TransferLimit) bool) (TransferLimit, error) {
+	if err := checkTransferLimit(limit); err != nil {
+		return TransferLimit{}, err
+	}
+
+	if existing, err := firstRow(selectRows(t.transferLimits, conflicts)); err == nil {
+		existing.MaxSingle = limit.MaxSingle
+		existing.MaxDailyTotal = limit.MaxDailyTotal
+		existing.MaxDailyCount = limit.MaxDailyCount
+		existing.UpdatedBy = limit.UpdatedBy
+		existing.UpdatedAt = limit.UpdatedAt
+		if err := references(t.users, existing.UpdatedBy, "transfer_limits", "transfer_limits_updated_by_fkey"); err != nil {
+			return TransferLimit{}, err
+		}
+		putRow(t, t.transferLimits, existing.ID, existing)
+		return existing, nil
+	}
+
+	if limit.Username.Valid {
+		if err := references(t.users, limit.Username.String, "transfer_limits", "transfer_limits_username_fkey"); err != nil {
+			return TransferLimit{}, err
+		}
+	}
+	if limit.AccountID.Valid {
+		if err := references(t.accounts, limit.AccountID.Int64, "transfer_limits", "transfer_limits_account_id_fkey"); err != nil {
+			return TransferLimit{}, err
+		}
+	}
+	if err := references(t.users, limit.UpdatedBy, "transfer_limits", "transfer_limits_updated_by_fkey"); err != nil {
+		return TransferLimit{}, err
+	}
+
+	limit.ID = t.nextID("transfer_limits")
+	putRow(t, t.transferLimits, limit.ID, limit)
+	return limit, nil
+}
+
+func (q *memQueries) UpsertUserTransferLimit(ctx context.Context, arg UpsertUserTransferLimitParams) (TransferLimit, error) {
+	defer q.locked()()
+	return q.tables.upsertTransferLimit(TransferLimit{
+		Username:      arg.Username,
+		MaxSingle:     arg.MaxSingle,
+		MaxDailyTotal: arg.MaxDailyTotal,
+		MaxDailyCount: arg.MaxDailyCount,
+		UpdatedBy:     arg.UpdatedBy,
+		UpdatedAt:     timestamptz(q.now()),
+	}, func(limit TransferLimit) bool {
+		return limit.Username.Valid && limit.Username.String == arg.Username.String
+	})
+}
+
+func (q *memQueries) UpsertAccountTransferLimit(ctx context.Context, arg UpsertAccountTransferLimitParams) (TransferLimit, error) {
+	defer q.locked()()
+	return q.tables.upsertTransferLimit(TransferLimit{
+		AccountID:     arg.AccountID,
+		MaxSingle:     arg.MaxSingle,
+		MaxDailyTotal: arg.MaxDailyTotal,
+		MaxDailyCount: arg.MaxDailyCount,
+		UpdatedBy:     arg.UpdatedBy,
+		UpdatedAt:     timestamptz(q.now()),
+	}, func(limit TransferLimit) bool {
+		return limit.AccountID.Valid && limit.AccountID.Int64 == arg.AccountID.Int64
+	})
+}
+
+func (q *memQueries) ListTransferLimitOverrides(ctx context.Context, arg ListTransferLimitOverridesParams) ([]TransferLimit, error) {
+	defer q.locked()()
+	limits := selectRows(q.tables.transferLimits, func(limit TransferLimit) bool {
+		return limit.Username.Valid && limit.Username.String == arg.Username ||
+			limit.AccountID.Valid && limit.AccountID.Int64 == arg.AccountID
+	})
+	slices.SortStableFunc(limits, func(a, b TransferLimit) int {
+		switch {
+		case a.AccountID.Valid == b.AccountID.Valid:
+			return int(a.AccountID.Int64 - b.AccountID.Int64)
+		case !a.AccountID.Valid:
+			return -1
+		}
+		return 1
+	})
+	return limits, nil
+}
+
+func (q *memQueries) GetAccountOutgoingTransferTotals(ctx context.Context, arg GetAccountOutgoingTransferTotalsParams) (GetAccountOutgoingTransferTotalsRow, error) {
+	defer q.locked()()
+	var totals GetAccountOutgoingTransferTotalsRow
+	for _, transfer := range q.tables.transfers {
+		if transfer.FromAccountID != arg.FromAccountID || !arg.Since.Valid || transfer.CreatedAt.Time.Before(arg.Since.Time) {
+			continue
+		}
+		totals.Total += transfer.Amount
+		totals.Count++
+	}
+	return totals, nil
+}
+
+func (q *memQueries) GetOutgoingTransferTotals(ctx context.Context, arg GetOutgoingTransferTotalsParams) (GetOutgoingTransferTotalsRow, error) {
+	defer q.locked()()
+	var totals GetOutgoingTransferTotalsRow
+	for _, transfer := range q.tables.transfers {
+		if transfer.InitiatedBy != arg.InitiatedBy || !arg.Since.Valid || transfer.CreatedAt.Time.Before(arg.Since.Time) {
+			continue
+		}
+		if q.tables.accounts[transfer.FromAccountID].Currency == arg.Currency {
+			totals.Total += transfer.Amount
+			totals.Count++
+		}
+	}
+	return totals, nil
+}
+
+// LockUserTransferLimits has nothing to do, as the transaction already runs alone.
+func (q *memQueries) LockUserTransferLimits(ctx context.Context, username string) error {
+	return nil
+}
+
+// transfer_reversal.sql
+
+func (q *memQueries) CreateTransferReversal(ctx context.Context, arg CreateTransferReversalParams) (TransferReversal, error) {
+	defer q.locked()()
+	t := q.tables
+	if arg.Amount <= 0 {
+		return TransferReversal{}, checkViolation("transfer_reversals", "transfer_reversals_amount_check")
+	}
+	if err := references(t.transfers, arg.TransferID, "transfer_reversals", "transfer_reversals_transfer_id_fkey"); err != nil {
+		return TransferReversal{}, err
+	}
+	if err := references(t.users, arg.InitiatedBy, "transfer_reversals", "transfer_reversals_initiated_by_fkey"); err != nil {
+		return TransferReversal{}, err
+	}
+
+	reversal := TransferReversal{
+		ID:          t.nextID("transfer_reversals"),
+		TransferID:  arg.TransferID,
+		Amount:      arg.Amount,
+		ToAmount:    arg.ToAmount,
+		Reason:      arg.Reason,
+		InitiatedBy: arg.InitiatedBy,
+		CreatedAt:   timestamptz(q.now()),
+	}
+	putRow(t, t.transferReversals, reversal.ID, reversal)
+	return reversal, nil
+}
+
+func (q *memQueries) ListTransferReversals(ctx context.Context, transferID int64) ([]TransferReversal, error) {
+	defer q.locked()()
+	return selectRows(q.tables.transferReversals, func(reversal TransferReversal) bool {
+		return reversal.TransferID == transferID
+	}), nil
+}
+
+// user.sql
+
+// emailTaken reports whether a user other than username has the email.
+func (t *memTables) emailTaken(email, username string) bool {
+	for _, user := range t.users {
+		if user.Email == email && user.Username != username {
+			return true
+		}
+	}
+	return false
+}
+
+func (q *memQueries) CreateUser(ctx context.Context, arg CreateUserParams) (User, error) {
+	defer q.locked()()
+	t := q.tables
+	if _, ok := t.users[arg.Username]; ok {
+		return User{}, uniqueViolation("users_pkey")
+	}
+	if t.emailTaken(arg.Email, arg.Username) {
+		return User{}, uniqueViolation("users_email_key")
+	}
+
+	user := User{
+		Username:              arg.Username,
+		HashedPassword:        arg.HashedPassword,
+		FullName:              arg.FullName,
+		Email:                 arg.Email,
+		LastPasswordChangedAt: timestamptz(time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC)),
+		CreatedAt:             timestamptz(q.now()),
+		Role:                  util.DepositorRole,
+	}
+	putRow(t, t.users, user.Username, user)
+	return user, nil
+}
+
+func (q *memQueries) GetUser(ctx context.Context, username string) (User, error) {
+	defer q.locked()()
+	return getRow(q.tables.users, username)
+}
+
+func (q *memQueries) UpdateUser(ctx context.Context, arg UpdateUserParams) (User, error) {
+	defer q.locked()()
+	t := q.tables
+	user, err := getRow(t.users, arg.Username)
+	if err != nil {
+		return User{}, err
+	}
+	if arg.HashedPassword.Valid {
+		user.HashedPassword = arg.HashedPassword.String
+	}
+	if arg.FullName.Valid {
+		user.FullName = arg.FullName.String
+	}
+	if arg.Email.Valid {
+		user.Email = arg.Email.String
+	}
+	if arg.IsEmailVerified.Valid {
+		user.IsEmailVerified = arg.IsEmailVerified.Bool
+	}
+	if t.emailTaken(user.Email, user.Username) {
+		return User{}, uniqueViolation("users_email_key")
+	}
+
+	putRow(t, t.users, user.Username, user)
+	return user, nil
+}
+
+func (q *memQueries) ListUsersByRole(ctx context.Context, role string) ([]User, error) {
+	defer q.locked()()
+	return selectRows(q.tables.users, func(user User) bool {
+		return user.Role == role
+	}), nil
+}
+
+// verify_email.sql
+
+func (q *memQueries) CreateVerifyEmail(ctx context.Context, arg CreateVerifyEmailParams) (VerifyEmail, error) {
+	defer q.locked()()
+	t := q.tables
+	if err := references(t.users, arg.Username, "verify_emails", "verify_emails_username_fkey"); err != nil {
+		return VerifyEmail{}, err
+	}
+
+	now := q.now()
+	verifyEmail := VerifyEmail{
+		ID:         t.nextID("verify_emails"),
+		Username:   arg.Username,
+		Email:      arg.Email,
+		SecretCode: arg.SecretCode,
+		ExpiredAt:  timestamptz(now.Add(15 * time.Minute)),
+		CreatedAt:  timestamptz(now),
+	}
+	putRow(t, t.verifyEmails, verifyEmail.ID, verifyEmail)
+	return verifyEmail, nil
+}
+
+func (q *memQueries) UpdateVerifyEmail(ctx context.Context, arg UpdateVerifyEmailParams) (VerifyEmail, error) {
+	defer q.locked()()
+	t := q.tables
+	verifyEmail, ok := t.verifyEmails[arg.ID]
+	if !ok || verifyEmail.SecretCode != arg.SecretCode || verifyEmail.IsUsed || !verifyEmail.ExpiredAt.Time.After(q.now()) {
+		return VerifyEmail{}, ErrRecordNotFound
+	}
+	verifyEmail.IsUsed = true
+	putRow(t, t.verifyEmails, verifyEmail.ID, verifyEmail)
+	return verifyEmail, nil
+}
+
+// webhook.sql
+
+func (q *memQueries) CreateWebhookSubscription(ctx context.Context, arg CreateWebhookSubscriptionParams) (WebhookSubscription, error) {
+	defer q.locked()()
+	t := q.tables
+	if arg.EventTypes == nil {
+		return WebhookSubscription{}, notNull("webhook_subscriptions", "event_types")
+	}
+	if err := references(t.users, arg.Owner, "webhook_subscriptions", "webhook_subscriptions_owner_fkey"); err != nil {
+		return WebhookSubscription{}, err
+	}
+
+	subscription := WebhookSubscription{
+		ID:         t.nextID("webhook_subscriptions"),
+		Owner:      arg.Owner,
+		Url:        arg.Url,
+		EventTypes: slices.Clone(arg.EventTypes),
+		Secret:     arg.Secret,
+		Active:     true,
+		CreatedAt:  timestamptz(q.now()),
+	}
+	putRow(t, t.webhookSubscriptions, subscription.ID, subscription)
+	return subscription, nil
+}
+
+func (q *memQueries) GetWebhookSubscription(ctx context.Context, id int64) (WebhookSubscription, error) {
+	defer q.locked()()
+	return getRow(q.tables.webhookSubscriptions, id)
+}
+
+func (q *memQueries) ListWebhookSubscriptions(ctx context.Context, arg ListWebhookSubscriptionsParams) ([]WebhookSubscription, error) {
+	defer q.locked()()
+	subscriptions := selectRows(q.tables.webhookSubscriptions, func(subscription WebhookSubscription) bool {
+		return subscription.Owner == arg.Owner
+	})
+	return limitRows(subscriptions, arg.Limit, arg.Offset)
+}
+
+func (q *memQueries) ListWebhookSubscriptionsForEvent(ctx context.Context, arg ListWebhookSubscriptionsForEventParams) ([]WebhookSubscription, error) {
+	defer q.locked()()
+	return selectRows(q.tables.webhookSubscriptions, func(subscription WebhookSubscription) bool {
+		return subscription.Owner == arg.Owner && subscription.Active && slices.Contains(subscription.EventTypes, arg.EventType)
+	}), nil
+}
+
+func (q *memQueries) DeactivateWebhookSubscription(ctx context.Context, id int64) (WebhookSubscription, error) {
+	defer q.locked()()
+	subscription, ok := q.tables.webhookSubscriptions[id]
+	if !ok || !subscription.Active {
+		return WebhookSubscription{}, ErrRecordNotFound
+	}
+	subscription.Active = false
+	putRow(q.tables, q.tables.webhookSubscriptions, id, subscription)
+	return subscription, nil
+}
+
+func (q *memQueries) CreateWebhookDelivery(ctx context.Context, arg CreateWebhookDeliveryParams) (WebhookDelivery, error) {
+	defer q.locked()()
+	t := q.tables
+	payload, err := storedJSON("webhook_deliveries", "payload", arg.Payload, false)
+	if err != nil {
+		return WebhookDelivery{}, err
+	}
+	for _, delivery := range t.webhookDeliveries {
+		if delivery.SubscriptionID == arg.SubscriptionID && delivery.EventID == arg.EventID && delivery.EventType == arg.EventType {
+			return delivery, nil
+		}
+	}
+	if err := references(t.webhookSubscriptions, arg.SubscriptionID, "webhook_deliveries", "webhook_deliveries_subscription_id_fkey"); err != nil {
+		return WebhookDelivery{}, err
+	}
+	if err := references(t.outbox, arg.EventID, "webhook_deliveries", "webhook_deliveries_event_id_fkey"); err != nil {
+		return WebhookDelivery{}, err
+	}
+
+	delivery := WebhookDelivery{
+		ID:             t.nextID("webhook_deliveries"),
+		SubscriptionID: arg.SubscriptionID,
+		EventID:        arg.EventID,
+		EventType:      arg.EventType,
+		Payload:        payload,
+		Status:         WebhookDeliveryPending,
+		CreatedAt:      timestamptz(q.now()),
+	}
+	putRow(t, t.webhookDeliveries, delivery.ID, delivery)
+	return delivery, nil
+}
+
+func (q *memQueries) GetWebhookDelivery(ctx context.Context, id int64) (WebhookDelivery, error) {
+	defer q.locked()()
+	return getRow(q.tables.webhookDeliveries, id)
+}
+
+func (q *memQueries) ListWebhookDeliveries(ctx context.Context, arg ListWebhookDeliveriesParams) ([]WebhookDelivery, error) {
+	defer q.locked()()
+	deliveries := selectRows(q.tables.webhookDeliveries, func(delivery WebhookDelivery) bool {
+		return delivery.SubscriptionID == arg.SubscriptionID
+	})
+	slices.Reverse(deliveries)
+	return limitRows(deliveries, arg.Limit, arg.Offset)
+}
+
+func (q *memQueries) RecordWebhookDeliveryAttempt(ctx context.Context, arg RecordWebhookDeliveryAttemptParams) (WebhookDelivery, error) {
+	defer q.locked()()
+	t := q.tables
+	delivery, ok := t.webhookDeliveries[arg.ID]
+	if !ok || delivery.Status != WebhookDeliveryPending {
+		return WebhookDelivery{}, ErrRecordNotFound
+	}
+	if err := checkIn("webhook_deliveries", "webhook_deliveries_status_check", arg.Status,
+		WebhookDeliveryPending, WebhookDeliverySucceeded, WebhookDeliveryFailed); err != nil {
+		return WebhookDelivery{}, err
+	}
+
+	delivery.Status = arg.Status
+	delivery.Attempts++
+	delivery.ResponseStatus = arg.ResponseStatus
+	delivery.LastError = arg.LastError
+	if arg.Status == WebhookDeliverySucceeded {
+		delivery.DeliveredAt = timestamptz(q.now())
+	}
+	putRow(t, t.webhookDeliveries, delivery.ID, delivery)
+	return delivery, nil
+}
@@ -9,11 +9,103 @@ import (
 )
 
 type Account struct {
-	ID        int64              `json:"id"`
-	Owner     string             `json:"owner"`
-	Balance   int64              `json:"balance"`
-	Currency  string             `json:"currency"`
+	ID             int64              `json:"id"`
+	Owner          string             `json:"owner"`
+	Balance        int64              `json:"balance"`
+	Currency       string             `json:"currency"`
+	CreatedAt      pgtype.Timestamptz `json:"created_at"`
+	OverdraftLimit int64              `json:"overdraft_limit"`
+	// sum of the active holds on the account
+	HeldAmount int64 `json:"held_amount"`
+	// balance minus active holds
+	AvailableBalance int64 `json:"available_balance"`
+	// active, frozen, dormant or closed
+	Status string `json:"status"`
+	// checking or savings
+	Type string `json:"type"`
+}
+
+type AccountHold struct {
+	ID        int64 `json:"id"`
+	AccountID int64 `json:"account_id"`
+	// account the funds go to when the hold is captured
+	ToAccountID int64  `json:"to_account_id"`
+	Amount      int64  `json:"amount"`
+	Description string `json:"description"`
+	// active, captured, released or expired
+	Status         string             `json:"status"`
+	ExpiresAt      pgtype.Timestamptz `json:"expires_at"`
+	CapturedAmount int64              `json:"captured_amount"`
+	// transfer that captured the hold
+	TransferID pgtype.Int8        `json:"transfer_id"`
+	ResolvedAt pgtype.Timestamptz `json:"resolved_at"`
+	CreatedAt  pgtype.Timestamptz `json:"created_at"`
+	// user whose transfer limits the capture counts against
+	PlacedBy string `json:"placed_by"`
+	// role of the user who placed the hold, which picks the fee rule of the capture
+	PlacerRole string `json:"placer_role"`
+	// fee held on top of the amount for the capture
+	HeldFee int64 `json:"held_fee"`
+}
+
+type AccountHolder struct {
+	ID        int64  `json:"id"`
+	AccountID int64  `json:"account_id"`
+	Username  string `json:"username"`
+	// view, transfer or manage, each including the ones before it
+	Permission string `json:"permission"`
+	// largest single transfer the holder may make, NULL for no limit
+	TransferLimit pgtype.Int8 `json:"transfer_limit"`
+	// invited until the user accepts, then active
+	Status     string             `json:"status"`
+	InvitedBy  string             `json:"invited_by"`
+	CreatedAt  pgtype.Timestamptz `json:"created_at"`
+	AcceptedAt pgtype.Timestamptz `json:"accepted_at"`
+}
+
+type AccountStatusChange struct {
+	ID         int64              `json:"id"`
+	AccountID  int64              `json:"account_id"`
+	FromStatus string             `json:"from_status"`
+	ToStatus   string             `json:"to_status"`
+	ChangedBy  string             `json:"changed_by"`
+	Reason     string             `json:"reason"`
+	CreatedAt  pgtype.Timestamptz `json:"created_at"`
+}
+
+type AuditEvent struct {
+	ID int64 `json:"id"`
+	// username the change was made by, or system
+	Actor     string `json:"actor"`
+	Role      string `json:"role"`
+	ClientIp  string `json:"client_ip"`
+	UserAgent string `json:"user_agent"`
+	// what was done, e.g. transfer.create
+	Action     string `json:"action"`
+	TargetType string `json:"target_type"`
+	TargetID   string `json:"target_id"`
+	// json rather than jsonb so that the text the hash was computed over is kept as is
+	Before    []byte             `json:"before"`
+	After     []byte             `json:"after"`
 	CreatedAt pgtype.Timestamptz `json:"created_at"`
+	// hash of the previous event, 64 zeros for the first
+	PrevHash string `json:"prev_hash"`
+	// hex SHA-256 of prev_hash and the other columns but id
+	Hash string `json:"hash"`
+}
+
+type Currency struct {
+	// ISO 4217 code
+	Code string `json:"code"`
+	// digits of the minor unit amounts are stored in, e.g. 2 for cents
+	Exponent int32  `json:"exponent"`
+	Name     string `json:"name"`
+	// only enabled currencies can be used for new accounts and transfers
+	Enabled   bool               `json:"enabled"`
+	UpdatedBy pgtype.Text        `json:"updated_by"`
+	UpdatedAt pgtype.Timestamptz `json:"updated_at"`
+	// largest amount in minor units a transfer may move without a banker's approval, NULL for no approval
+	ApprovalThreshold pgtype.Int8 `json:"approval_threshold"`
 }
 
 type Entry struct {
@@ -22,6 +114,131 @@ type Entry struct {
 	// can be negative or positive
 	Amount    int64              `json:"amount"`
 	CreatedAt pgtype.Timestamptz `json:"created_at"`
+	// transfer, deposit, withdrawal, fee, adjustment, reversal or interest
+	Type string `json:"type"`
+	// transfer that produced the entry, if any
+	TransferID pgtype.Int8 `json:"transfer_id"`
+	// balance of the account once the entry was booked
+	BalanceAfter int64 `json:"balance_after"`
+}
+
+type ExchangeRate struct {
+	ID            int64  `json:"id"`
+	BaseCurrency  string `json:"base_currency"`
+	QuoteCurrency string `json:"quote_currency"`
+	// units of quote currency per unit of base currency
+	Rate        pgtype.Numeric     `json:"rate"`
+	EffectiveAt pgtype.Timestamptz `json:"effective_at"`
+	CreatedAt   pgtype.Timestamptz `json:"created_at"`
+}
+
+type FeeRule struct {
+	ID int64 `json:"id"`
+	// currency of the sending account, NULL for any
+	Currency pgtype.Text `json:"currency"`
+	// role of the user making the transfer, NULL for any
+	Role pgtype.Text `json:"role"`
+	// whether both accounts belong to the same owner, NULL for either
+	SameOwner pgtype.Bool `json:"same_owner"`
+	FlatFee   int64       `json:"flat_fee"`
+	// share of the amount charged on top of the flat fee, e.g. 0.005 for 0.5%
+	Percentage pgtype.Numeric `json:"percentage"`
+	MinFee     int64          `json:"min_fee"`
+	// NULL for no maximum
+	MaxFee    pgtype.Int8        `json:"max_fee"`
+	CreatedBy string             `json:"created_by"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+}
+
+type IdempotencyKey struct {
+	Username string `json:"username"`
+	Key      string `json:"key"`
+	// sha256 of the request payload the key was first used with
+	RequestHash string `json:"request_hash"`
+	// serialized result returned to replays of the same request
+	Response  []byte             `json:"response"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+	// store transaction the key was claimed by, e.g. transfer or transfer_approval
+	Operation string `json:"operation"`
+}
+
+type InterestAccrual struct {
+	ID          int64       `json:"id"`
+	AccountID   int64       `json:"account_id"`
+	AccrualDate pgtype.Date `json:"accrual_date"`
+	// balance the interest was accrued on
+	Balance    int64          `json:"balance"`
+	AnnualRate pgtype.Numeric `json:"annual_rate"`
+	// interest for the day in minor units, not rounded
+	Amount pgtype.Numeric `json:"amount"`
+	// interest entry that paid the accrual, NULL if the month rounded to zero
+	EntryID    pgtype.Int8        `json:"entry_id"`
+	CreditedAt pgtype.Timestamptz `json:"credited_at"`
+	CreatedAt  pgtype.Timestamptz `json:"created_at"`
+}
+
+type InterestRate struct {
+	ID          int64  `json:"id"`
+	AccountType string `json:"account_type"`
+	Currency    string `json:"currency"`
+	// yearly rate as a fraction, e.g. 0.025 for 2.5%
+	AnnualRate  pgtype.Numeric     `json:"annual_rate"`
+	EffectiveAt pgtype.Timestamptz `json:"effective_at"`
+	CreatedAt   pgtype.Timestamptz `json:"created_at"`
+}
+
+type Outbox struct {
+	ID int64 `json:"id"`
+	// user.created, transfer.completed or account.status_changed
+	EventType string `json:"event_type"`
+	Payload   []byte `json:"payload"`
+	// failed attempts to publish the event
+	Attempts  int32              `json:"attempts"`
+	LastError pgtype.Text        `json:"last_error"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+	// when the event was published, NULL until then
+	SentAt pgtype.Timestamptz `json:"sent_at"`
+}
+
+type ReconciliationReport struct {
+	ID int64 `json:"id"`
+	// username of the banker who ran it, or scheduler
+	TriggeredBy      string `json:"triggered_by"`
+	DiscrepancyCount int32  `json:"discrepancy_count"`
+	// list of ledger invariants that did not hold
+	Discrepancies []byte             `json:"discrepancies"`
+	CreatedAt     pgtype.Timestamptz `json:"created_at"`
+}
+
+type ScheduledTransfer struct {
+	ID            int64  `json:"id"`
+	Owner         string `json:"owner"`
+	FromAccountID int64  `json:"from_account_id"`
+	ToAccountID   int64  `json:"to_account_id"`
+	Amount        int64  `json:"amount"`
+	// cron expression or @every interval, evaluated in UTC
+	Schedule string             `json:"schedule"`
+	StartAt  pgtype.Timestamptz `json:"start_at"`
+	EndAt    pgtype.Timestamptz `json:"end_at"`
+	// null once the schedule is cancelled or completed
+	NextRunAt pgtype.Timestamptz `json:"next_run_at"`
+	// active, paused, cancelled or completed
+	Status    string             `json:"status"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt pgtype.Timestamptz `json:"updated_at"`
+	// role of the owner, which picks the fee rule of the transfers
+	OwnerRole string `json:"owner_role"`
+}
+
+type ScheduledTransferExecution struct {
+	ID                  int64              `json:"id"`
+	ScheduledTransferID int64              `json:"scheduled_transfer_id"`
+	ScheduledFor        pgtype.Timestamptz `json:"scheduled_for"`
+	Status              string             `json:"status"`
+	TransferID          pgtype.Int8        `json:"transfer_id"`
+	// why the transfer was not made, e.g. insufficient funds
+	FailureReason pgtype.Text        `json:"failure_reason"`
+	CreatedAt     pgtype.Timestamptz `json:"created_at"`
 }
 
 type Session struct {
@@ -42,6 +259,74 @@ type Transfer struct {
 	// only positive
 	Amount    int64              `json:"amount"`
 	CreatedAt pgtype.Timestamptz `json:"created_at"`
+	// amount credited, in the currency of the receiving account
+	ToAmount int64 `json:"to_amount"`
+	// rate applied when the accounts differ in currency
+	ExchangeRate pgtype.Numeric `json:"exchange_rate"`
+	// part of amount refunded to the sender so far
+	ReversedAmount int64 `json:"reversed_amount"`
+	// completed, partially_reversed or reversed
+	Status string `json:"status"`
+	// fee charged to the sender on top of amount, in its currency
+	FeeAmount int64       `json:"fee_amount"`
+	FeeRuleID pgtype.Int8 `json:"fee_rule_id"`
+	// description of the payment given by the sender
+	Memo string `json:"memo"`
+	// identifier of the payment in an external system, such as an invoice number
+	Reference string `json:"reference"`
+	// user whose transfer limits the transfer counts against
+	InitiatedBy string `json:"initiated_by"`
+}
+
+type TransferApproval struct {
+	ID            int64  `json:"id"`
+	FromAccountID int64  `json:"from_account_id"`
+	ToAccountID   int64  `json:"to_account_id"`
+	Amount        int64  `json:"amount"`
+	Memo          string `json:"memo"`
+	Reference     string `json:"reference"`
+	RequestedBy   string `json:"requested_by"`
+	// role of the requester, which picks the fee rule of the transfer
+	RequesterRole string `json:"requester_role"`
+	// hold keeping the amount and fee available until the transfer is decided
+	HoldID int64 `json:"hold_id"`
+	// pending until a banker other than the requester approves or rejects it, or it expires
+	Status    string      `json:"status"`
+	DecidedBy pgtype.Text `json:"decided_by"`
+	Reason    string      `json:"reason"`
+	// transfer made once approved
+	TransferID pgtype.Int8        `json:"transfer_id"`
+	ExpiresAt  pgtype.Timestamptz `json:"expires_at"`
+	DecidedAt  pgtype.Timestamptz `json:"decided_at"`
+	CreatedAt  pgtype.Timestamptz `json:"created_at"`
+}
+
+type TransferLimit struct {
+	ID int64 `json:"id"`
+	// set for an override of all the user's accounts
+	Username pgtype.Text `json:"username"`
+	// set for an override of one account
+	AccountID pgtype.Int8 `json:"account_id"`
+	// NULL to keep the default, 0 for no limit
+	MaxSingle pgtype.Int8 `json:"max_single"`
+	// outgoing total per UTC day, NULL to keep the default, 0 for no limit
+	MaxDailyTotal pgtype.Int8 `json:"max_daily_total"`
+	// outgoing transfers per UTC day, NULL to keep the default, 0 for no limit
+	MaxDailyCount pgtype.Int4        `json:"max_daily_count"`
+	UpdatedBy     string             `json:"updated_by"`
+	UpdatedAt     pgtype.Timestamptz `json:"updated_at"`
+}
+
+type TransferReversal struct {
+	ID         int64 `json:"id"`
+	TransferID int64 `json:"transfer_id"`
+	// credited back to the sender, in the currency of the transfer amount
+	Amount int64 `json:"amount"`
+	// debited from the receiver, in the currency of the receiving account
+	ToAmount    int64              `json:"to_amount"`
+	Reason      string             `json:"reason"`
+	InitiatedBy string             `json:"initiated_by"`
+	CreatedAt   pgtype.Timestamptz `json:"created_at"`
 }
 
 type User struct {
@@ -65,3 +350,32 @@ type VerifyEmail struct {
 	ExpiredAt  pgtype.Timestamptz `json:"expired_at"`
 	CreatedAt  pgtype.Timestamptz `json:"created_at"`
 }
+
+type WebhookDelivery struct {
+	ID             int64 `json:"id"`
+	SubscriptionID int64 `json:"subscription_id"`
+	// outbox event the delivery was made for
+	EventID   int64  `json:"event_id"`
+	EventType string `json:"event_type"`
+	Payload   []byte `json:"payload"`
+	// pending while being retried, then succeeded or failed
+	Status   string `json:"status"`
+	Attempts int32  `json:"attempts"`
+	// HTTP status of the last attempt, NULL if no response was received
+	ResponseStatus pgtype.Int4        `json:"response_status"`
+	LastError      pgtype.Text        `json:"last_error"`
+	CreatedAt      pgtype.Timestamptz `json:"created_at"`
+	DeliveredAt    pgtype.Timestamptz `json:"delivered_at"`
+}
+
+type WebhookSubscription struct {
+	ID    int64  `json:"id"`
+	Owner string `json:"owner"`
+	Url   string `json:"url"`
+	// transfer.sent and/or transfer.received
+	EventTypes []string `json:"event_types"`
+	// key the payloads are signed with using HMAC-SHA256
+	Secret    string             `json:"secret"`
+	Active    bool               `json:"active"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+}
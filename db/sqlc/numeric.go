@@ -0,0 +1,52 @@
+package db
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// NumericString formats a numeric column as a decimal string, or returns an empty string if it is NULL.
+func NumericString(n pgtype.Numeric) string {
+	if !n.Valid {
+		return ""
+	}
+	text, err := n.MarshalJSON()
+	if err != nil {
+		return ""
+	}
+	return string(text)
+}
+
+func numericFromString(value string) (pgtype.Numeric, error) {
+	var n pgtype.Numeric
+	if err := n.Scan(value); err != nil {
+		return pgtype.Numeric{}, fmt.Errorf("invalid numeric %q: %w", value, err)
+	}
+	return n, nil
+}
+
+func numericFromRat(r *big.Rat, scale int) (pgtype.Numeric, error) {
+	return numericFromString(r.FloatString(scale))
+}
+
+func ratFromNumeric(n pgtype.Numeric) (*big.Rat, error) {
+	if !n.Valid || n.NaN || n.InfinityModifier != pgtype.Finite {
+		return nil, fmt.Errorf("numeric is not a finite number")
+	}
+
+	r := new(big.Rat).SetInt(n.Int)
+	scale := new(big.Rat).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(abs32(n.Exp))), nil))
+	if n.Exp >= 0 {
+		return r.Mul(r, scale), nil
+	}
+	return r.Quo(r, scale), nil
+}
+
+func abs32(x int32) int32 {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
@@ -0,0 +1,69 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Types of the domain events recorded in the outbox.
+const (
+	EventUserCreated               = "user.created"
+	EventTransferCompleted         = "transfer.completed"
+	EventAccountStatusChanged      = "account.status_changed"
+	EventTransferApprovalRequested = "transfer_approval.requested"
+)
+
+// UserCreatedEvent is the payload of EventUserCreated.
+type UserCreatedEvent struct {
+	Username string `json:"username"`
+	Email    string `json:"email"`
+}
+
+// TransferCompletedEvent is the payload of EventTransferCompleted. Amount and FeeAmount are in the
+// sender's currency, ToAmount in the receiver's.
+type TransferCompletedEvent struct {
+	TransferID    int64  `json:"transfer_id"`
+	FromAccountID int64  `json:"from_account_id"`
+	ToAccountID   int64  `json:"to_account_id"`
+	Amount        int64  `json:"amount"`
+	ToAmount      int64  `json:"to_amount"`
+	FeeAmount     int64  `json:"fee_amount"`
+	Memo          string `json:"memo,omitempty"`
+	Reference     string `json:"reference,omitempty"`
+}
+
+// AccountStatusChangedEvent is the payload of EventAccountStatusChanged.
+type AccountStatusChangedEvent struct {
+	AccountID  int64  `json:"account_id"`
+	FromStatus string `json:"from_status"`
+	ToStatus   string `json:"to_status"`
+	ChangedBy  string `json:"changed_by"`
+	Reason     string `json:"reason"`
+}
+
+// TransferApprovalRequestedEvent is the payload of EventTransferApprovalRequested.
+type TransferApprovalRequestedEvent struct {
+	ApprovalID    int64     `json:"approval_id"`
+	FromAccountID int64     `json:"from_account_id"`
+	ToAccountID   int64     `json:"to_account_id"`
+	Amount        int64     `json:"amount"`
+	RequestedBy   string    `json:"requested_by"`
+	ExpiresAt     time.Time `json:"expires_at"`
+}
+
+// emitEvent records a domain event in the outbox. Called within a transaction, the event is
+// published by the outbox relay if and only if the transaction commits.
+func (q txQueries) emitEvent(ctx context.Context, eventType string, payload any) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("cannot marshal %s event: %w", eventType, err)
+	}
+
+	_, err = q.CreateOutboxEvent(ctx, CreateOutboxEventParams{
+		EventType: eventType,
+		Payload:   data,
+	})
+	return err
+}
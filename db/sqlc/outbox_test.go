@@ -0,0 +1,134 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/mahanth/simplebank/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateUserTxEmitsEvent(t *testing.T) {
+	store := NewStore(testDB)
+
+	hashedPassword, err := util.HashPassword(util.RandomString(6))
+	require.NoError(t, err)
+
+	result, err := store.CreateUserTx(context.Background(), CreateUserTxParams{
+		CreateUserParams: CreateUserParams{
+			Username:       util.RandomOwner(),
+			HashedPassword: hashedPassword,
+			FullName:       util.RandomOwner(),
+			Email:          util.RandomEmail(),
+		},
+	})
+	require.NoError(t, err)
+
+	event := findUnsentOutboxEvent(t, EventUserCreated, func(payload []byte) bool {
+		var created UserCreatedEvent
+		require.NoError(t, json.Unmarshal(payload, &created))
+		return created.Username == result.User.Username
+	})
+	require.Zero(t, event.Attempts)
+
+	var created UserCreatedEvent
+	require.NoError(t, json.Unmarshal(event.Payload, &created))
+	require.Equal(t, result.User.Email, created.Email)
+}
+
+func TestTransferTxEmitsEvent(t *testing.T) {
+	account1 := createRandomAccountWithCurrency(t, util.USD, 100)
+	account2 := createRandomAccountWithCurrency(t, util.USD, 0)
+
+	store := NewStore(testDB)
+
+	result, err := store.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        10,
+	})
+	require.NoError(t, err)
+
+	event := findUnsentOutboxEvent(t, EventTransferCompleted, func(payload []byte) bool {
+		var completed TransferCompletedEvent
+		require.NoError(t, json.Unmarshal(payload, &completed))
+		return completed.TransferID == result.Transfer.ID
+	})
+
+	var completed TransferCompletedEvent
+	require.NoError(t, json.Unmarshal(event.Payload, &completed))
+	require.Equal(t, account1.ID, completed.FromAccountID)
+	require.Equal(t, account2.ID, completed.ToAccountID)
+	require.Equal(t, int64(10), completed.Amount)
+}
+
+func TestTransferTxFailureEmitsNoEvent(t *testing.T) {
+	account1 := createRandomAccountWithCurrency(t, util.USD, 5)
+	account2 := createRandomAccountWithCurrency(t, util.USD, 0)
+
+	store := NewStore(testDB)
+
+	_, err := store.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        10,
+	})
+	require.ErrorIs(t, err, ErrInsufficientFunds)
+
+	// the event is rolled back with the transfer
+	events, err := testQueries.ListUnsentOutboxEvents(context.Background(), 10000)
+	require.NoError(t, err)
+	for _, event := range events {
+		if event.EventType != EventTransferCompleted {
+			continue
+		}
+		var completed TransferCompletedEvent
+		require.NoError(t, json.Unmarshal(event.Payload, &completed))
+		require.NotEqual(t, account1.ID, completed.FromAccountID)
+	}
+}
+
+func TestOutboxEventDelivery(t *testing.T) {
+	event, err := testQueries.CreateOutboxEvent(context.Background(), CreateOutboxEventParams{
+		EventType: util.RandomString(8),
+		Payload:   []byte(`{}`),
+	})
+	require.NoError(t, err)
+	require.False(t, event.SentAt.Valid)
+
+	err = testQueries.RecordOutboxEventFailure(context.Background(), RecordOutboxEventFailureParams{
+		ID:        event.ID,
+		LastError: pgtype.Text{String: "redis is down", Valid: true},
+	})
+	require.NoError(t, err)
+
+	failed := findUnsentOutboxEvent(t, event.EventType, func([]byte) bool { return true })
+	require.Equal(t, event.ID, failed.ID)
+	require.Equal(t, int32(1), failed.Attempts)
+	require.Equal(t, "redis is down", failed.LastError.String)
+
+	err = testQueries.MarkOutboxEventSent(context.Background(), event.ID)
+	require.NoError(t, err)
+
+	events, err := testQueries.ListUnsentOutboxEvents(context.Background(), 10000)
+	require.NoError(t, err)
+	for _, unsent := range events {
+		require.NotEqual(t, event.ID, unsent.ID)
+	}
+}
+
+// findUnsentOutboxEvent returns the unsent event of eventType whose payload matches.
+func findUnsentOutboxEvent(t *testing.T, eventType string, match func(payload []byte) bool) Outbox {
+	events, err := testQueries.ListUnsentOutboxEvents(context.Background(), 10000)
+	require.NoError(t, err)
+
+	for _, event := range events {
+		if event.EventType == eventType && match(event.Payload) {
+			return event
+		}
+	}
+	require.FailNow(t, "outbox event not found", "type %s", eventType)
+	return Outbox{}
+}
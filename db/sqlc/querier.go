@@ -11,26 +11,146 @@ import (
 )
 
 type Querier interface {
+	AcceptAccountHolder(ctx context.Context, arg AcceptAccountHolderParams) (AccountHolder, error)
 	AddAccountBalance(ctx context.Context, arg AddAccountBalanceParams) (Account, error)
+	AddAccountHeldAmount(ctx context.Context, arg AddAccountHeldAmountParams) (Account, error)
 	CreateAccount(ctx context.Context, arg CreateAccountParams) (Account, error)
+	CreateAccountHold(ctx context.Context, arg CreateAccountHoldParams) (AccountHold, error)
+	CreateAccountHolder(ctx context.Context, arg CreateAccountHolderParams) (AccountHolder, error)
+	CreateAccountStatusChange(ctx context.Context, arg CreateAccountStatusChangeParams) (AccountStatusChange, error)
+	CreateAuditEvent(ctx context.Context, arg CreateAuditEventParams) (AuditEvent, error)
+	// The account is locked before the entry is numbered and timestamped, so that the entries of an
+	// account are numbered and timestamped in the order they are booked, rather than stamped with the
+	// start of a transaction that may have waited for the lock. The caller applies the amount to the
+	// balance afterwards.
 	CreateEntry(ctx context.Context, arg CreateEntryParams) (Entry, error)
+	CreateFeeRule(ctx context.Context, arg CreateFeeRuleParams) (FeeRule, error)
+	CreateIdempotencyKey(ctx context.Context, arg CreateIdempotencyKeyParams) (IdempotencyKey, error)
+	CreateInterestAccrual(ctx context.Context, arg CreateInterestAccrualParams) (InterestAccrual, error)
+	CreateOutboxEvent(ctx context.Context, arg CreateOutboxEventParams) (Outbox, error)
+	CreateReconciliationReport(ctx context.Context, arg CreateReconciliationReportParams) (ReconciliationReport, error)
+	CreateScheduledTransfer(ctx context.Context, arg CreateScheduledTransferParams) (ScheduledTransfer, error)
+	CreateScheduledTransferExecution(ctx context.Context, arg CreateScheduledTransferExecutionParams) (ScheduledTransferExecution, error)
 	CreateSession(ctx context.Context, arg CreateSessionParams) (Session, error)
 	CreateTransfer(ctx context.Context, arg CreateTransferParams) (Transfer, error)
+	CreateTransferApproval(ctx context.Context, arg CreateTransferApprovalParams) (TransferApproval, error)
+	CreateTransferReversal(ctx context.Context, arg CreateTransferReversalParams) (TransferReversal, error)
 	CreateUser(ctx context.Context, arg CreateUserParams) (User, error)
 	CreateVerifyEmail(ctx context.Context, arg CreateVerifyEmailParams) (VerifyEmail, error)
+	// Returns the existing delivery when the event was already fanned out to the subscription.
+	CreateWebhookDelivery(ctx context.Context, arg CreateWebhookDeliveryParams) (WebhookDelivery, error)
+	CreateWebhookSubscription(ctx context.Context, arg CreateWebhookSubscriptionParams) (WebhookSubscription, error)
+	DeactivateWebhookSubscription(ctx context.Context, id int64) (WebhookSubscription, error)
 	DeleteAccount(ctx context.Context, id int64) error
+	DeleteAccountHolder(ctx context.Context, arg DeleteAccountHolderParams) (AccountHolder, error)
+	EnsureAccount(ctx context.Context, arg EnsureAccountParams) error
 	GetAccount(ctx context.Context, id int64) (Account, error)
 	GetAccountForUpdate(ctx context.Context, id int64) (Account, error)
+	GetAccountHold(ctx context.Context, id int64) (AccountHold, error)
+	GetAccountHoldForUpdate(ctx context.Context, id int64) (AccountHold, error)
+	GetAccountHolder(ctx context.Context, arg GetAccountHolderParams) (AccountHolder, error)
+	// What was sent since the time from the account, whoever made the transfers.
+	GetAccountOutgoingTransferTotals(ctx context.Context, arg GetAccountOutgoingTransferTotalsParams) (GetAccountOutgoingTransferTotalsRow, error)
+	// The balance after the last entry booked by the time, or before the first entry if none was.
+	// The entries of an account are booked in the order of both their id and created_at.
+	GetBalanceAt(ctx context.Context, arg GetBalanceAtParams) (int64, error)
+	// The interest accrued on the account that was credited, unrounded, and what its entries paid.
+	GetCreditedInterest(ctx context.Context, accountID int64) (GetCreditedInterestRow, error)
+	GetCurrency(ctx context.Context, code string) (Currency, error)
+	GetCurrencyForUpdate(ctx context.Context, code string) (Currency, error)
 	GetEntry(ctx context.Context, id int64) (Entry, error)
+	GetExchangeRate(ctx context.Context, arg GetExchangeRateParams) (ExchangeRate, error)
+	// the most specific rule matching the transfer applies; of equally specific rules, the newest
+	GetFeeRule(ctx context.Context, arg GetFeeRuleParams) (FeeRule, error)
+	GetIdempotencyKey(ctx context.Context, arg GetIdempotencyKeyParams) (IdempotencyKey, error)
+	GetInterestRate(ctx context.Context, arg GetInterestRateParams) (InterestRate, error)
+	GetLastAuditEvent(ctx context.Context) (AuditEvent, error)
+	// The latest day accrued on any account, NULL if none was.
+	GetLastInterestAccrualDate(ctx context.Context) (pgtype.Date, error)
+	// What a user sent since the time from accounts in the currency, whoever owns them.
+	GetOutgoingTransferTotals(ctx context.Context, arg GetOutgoingTransferTotalsParams) (GetOutgoingTransferTotalsRow, error)
+	GetOwnerAccount(ctx context.Context, arg GetOwnerAccountParams) (Account, error)
+	GetReconciliationReport(ctx context.Context, id int64) (ReconciliationReport, error)
+	GetScheduledTransfer(ctx context.Context, id int64) (ScheduledTransfer, error)
+	GetScheduledTransferForUpdate(ctx context.Context, id int64) (ScheduledTransfer, error)
 	GetSession(ctx context.Context, id pgtype.UUID) (Session, error)
 	GetTransfer(ctx context.Context, id int64) (Transfer, error)
+	GetTransferApproval(ctx context.Context, id int64) (TransferApproval, error)
+	GetTransferApprovalByHoldForUpdate(ctx context.Context, holdID int64) (TransferApproval, error)
+	GetTransferApprovalForUpdate(ctx context.Context, id int64) (TransferApproval, error)
+	GetTransferForUpdate(ctx context.Context, id int64) (Transfer, error)
 	GetUser(ctx context.Context, username string) (User, error)
+	GetWebhookDelivery(ctx context.Context, id int64) (WebhookDelivery, error)
+	GetWebhookSubscription(ctx context.Context, id int64) (WebhookSubscription, error)
+	ListAccountBalanceMismatches(ctx context.Context) ([]ListAccountBalanceMismatchesRow, error)
+	ListAccountHolds(ctx context.Context, arg ListAccountHoldsParams) ([]AccountHold, error)
+	ListAccountHolders(ctx context.Context, accountID int64) ([]AccountHolder, error)
+	ListAccountStatusChanges(ctx context.Context, arg ListAccountStatusChangesParams) ([]AccountStatusChange, error)
+	// Accounts the user owns or is an active holder of.
 	ListAccounts(ctx context.Context, arg ListAccountsParams) ([]Account, error)
+	// Events after the given id in the order they were chained.
+	ListAuditEvents(ctx context.Context, arg ListAuditEventsParams) ([]AuditEvent, error)
+	ListCurrencies(ctx context.Context) ([]Currency, error)
+	ListCurrencyImbalances(ctx context.Context) ([]ListCurrencyImbalancesRow, error)
+	ListDueScheduledTransfers(ctx context.Context, limit int32) ([]ScheduledTransfer, error)
+	// Entries of an account, newest first. Direction is in for credits and out for debits, amounts are
+	// filtered by their absolute value, and the counterparty is the other account of the entry's transfer.
 	ListEntries(ctx context.Context, arg ListEntriesParams) ([]Entry, error)
+	ListEntriesByTransfer(ctx context.Context, transferID pgtype.Int8) ([]Entry, error)
+	ListExchangeRates(ctx context.Context, arg ListExchangeRatesParams) ([]ExchangeRate, error)
+	ListExpiredAccountHolds(ctx context.Context, limit int32) ([]AccountHold, error)
+	ListFeeRules(ctx context.Context, arg ListFeeRulesParams) ([]FeeRule, error)
+	ListInterestAccruals(ctx context.Context, arg ListInterestAccrualsParams) ([]InterestAccrual, error)
+	ListInterestBearingAccounts(ctx context.Context, arg ListInterestBearingAccountsParams) ([]Account, error)
+	// Pending approvals that have not expired, oldest first.
+	ListPendingTransferApprovals(ctx context.Context, arg ListPendingTransferApprovalsParams) ([]TransferApproval, error)
+	ListReconciliationReports(ctx context.Context, arg ListReconciliationReportsParams) ([]ReconciliationReport, error)
+	ListScheduledTransferExecutions(ctx context.Context, arg ListScheduledTransferExecutionsParams) ([]ScheduledTransferExecution, error)
+	ListScheduledTransfers(ctx context.Context, arg ListScheduledTransfersParams) ([]ScheduledTransfer, error)
+	ListTransferReversals(ctx context.Context, transferID int64) ([]TransferReversal, error)
+	// the user's override first, so that the account's override is applied over it
+	ListTransferLimitOverrides(ctx context.Context, arg ListTransferLimitOverridesParams) ([]TransferLimit, error)
+	// Transfers in or out of an account, newest first. Amounts are filtered as the account sees them:
+	// the amount sent for outgoing transfers and the amount received for incoming ones.
 	ListTransfers(ctx context.Context, arg ListTransfersParams) ([]Transfer, error)
+	ListUncreditedInterestAccruals(ctx context.Context, arg ListUncreditedInterestAccrualsParams) ([]InterestAccrual, error)
+	ListUnmatchedTransfers(ctx context.Context) ([]ListUnmatchedTransfersRow, error)
+	// Events waiting to be published, oldest first.
+	ListUnsentOutboxEvents(ctx context.Context, limit int32) ([]Outbox, error)
+	ListUsersByRole(ctx context.Context, role string) ([]User, error)
+	ListWebhookDeliveries(ctx context.Context, arg ListWebhookDeliveriesParams) ([]WebhookDelivery, error)
+	ListWebhookSubscriptions(ctx context.Context, arg ListWebhookSubscriptionsParams) ([]WebhookSubscription, error)
+	// Active subscriptions of the user that include the event type.
+	ListWebhookSubscriptionsForEvent(ctx context.Context, arg ListWebhookSubscriptionsForEventParams) ([]WebhookSubscription, error)
+	// Serializes appends to the audit log until the transaction ends, so that each event
+	// chains to the one committed before it.
+	LockAuditChain(ctx context.Context) error
+	// Serializes the limit checks of a user's transfers until the transaction ends, so that
+	// transfers they make from different accounts at the same time are counted one after another.
+	LockUserTransferLimits(ctx context.Context, username string) error
+	MarkInterestAccrualsCredited(ctx context.Context, arg MarkInterestAccrualsCreditedParams) error
+	MarkOutboxEventSent(ctx context.Context, id int64) error
+	RecordOutboxEventFailure(ctx context.Context, arg RecordOutboxEventFailureParams) error
+	RecordWebhookDeliveryAttempt(ctx context.Context, arg RecordWebhookDeliveryAttemptParams) (WebhookDelivery, error)
+	// Transfers of the accounts the owner holds whose memo or reference match a web search style query,
+	// newest first, optionally only those with the counterparty account on either side.
+	SearchTransfers(ctx context.Context, arg SearchTransfersParams) ([]Transfer, error)
 	UpdateAccount(ctx context.Context, arg UpdateAccountParams) (Account, error)
+	UpdateAccountHoldStatus(ctx context.Context, arg UpdateAccountHoldStatusParams) (AccountHold, error)
+	UpdateAccountOverdraftLimit(ctx context.Context, arg UpdateAccountOverdraftLimitParams) (Account, error)
+	UpdateAccountStatus(ctx context.Context, arg UpdateAccountStatusParams) (Account, error)
+	UpdateCurrencyApprovalThreshold(ctx context.Context, arg UpdateCurrencyApprovalThresholdParams) (Currency, error)
+	UpdateCurrencyEnabled(ctx context.Context, arg UpdateCurrencyEnabledParams) (Currency, error)
+	UpdateIdempotencyKeyResponse(ctx context.Context, arg UpdateIdempotencyKeyResponseParams) error
+	UpdateScheduledTransferStatus(ctx context.Context, arg UpdateScheduledTransferStatusParams) (ScheduledTransfer, error)
+	UpdateTransferApprovalStatus(ctx context.Context, arg UpdateTransferApprovalStatusParams) (TransferApproval, error)
+	UpdateTransferReversal(ctx context.Context, arg UpdateTransferReversalParams) (Transfer, error)
 	UpdateUser(ctx context.Context, arg UpdateUserParams) (User, error)
 	UpdateVerifyEmail(ctx context.Context, arg UpdateVerifyEmailParams) (VerifyEmail, error)
+	UpsertAccountTransferLimit(ctx context.Context, arg UpsertAccountTransferLimitParams) (TransferLimit, error)
+	UpsertExchangeRate(ctx context.Context, arg UpsertExchangeRateParams) (ExchangeRate, error)
+	UpsertInterestRate(ctx context.Context, arg UpsertInterestRateParams) (InterestRate, error)
+	UpsertUserTransferLimit(ctx context.Context, arg UpsertUserTransferLimitParams) (TransferLimit, error)
 }
 
 var _ Querier = (*Queries)(nil)
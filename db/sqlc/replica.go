@@ -0,0 +1,90 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// replicaRetryInterval is how long reads stay on the primary after the replica failed, before
+// the replica is tried again.
+const replicaRetryInterval = 10 * time.Second
+
+// replica is a read-only copy of the database that serves reads which may lag behind the primary.
+type replica struct {
+	q Querier
+	// downUntil is when the replica may be tried again after a failure, in unix nanoseconds.
+	downUntil atomic.Int64
+}
+
+// WithReplica routes the store's read-only queries outside transactions to a replica, falling
+// back to the primary while the replica is unreachable. Transactions always run on the primary.
+func WithReplica(pool *pgxpool.Pool) StoreOption {
+	return func(store *SQLStore) {
+		store.replica = &replica{q: New(pool)}
+	}
+}
+
+// healthy reports whether the replica should be tried.
+func (r *replica) healthy(now time.Time) bool {
+	return now.UnixNano() >= r.downUntil.Load()
+}
+
+// markDown keeps reads off the replica until replicaRetryInterval has passed.
+func (r *replica) markDown(now time.Time) {
+	r.downUntil.Store(now.Add(replicaRetryInterval).UnixNano())
+}
+
+type readYourWritesKey struct{}
+
+// WithReadYourWrites returns a context under which the store reads from the primary, for callers
+// that must see what they or another request have just written.
+func WithReadYourWrites(ctx context.Context) context.Context {
+	return context.WithValue(ctx, readYourWritesKey{}, true)
+}
+
+// ReadYourWrites reports whether ctx was returned by WithReadYourWrites.
+func ReadYourWrites(ctx context.Context) bool {
+	readYourWrites, _ := ctx.Value(readYourWritesKey{}).(bool)
+	return readYourWrites
+}
+
+// isReplicaFailure reports whether err means the replica could not answer, rather than that the
+// query itself failed, so that the primary should be asked instead.
+func isReplicaFailure(ctx context.Context, err error) bool {
+	if err == nil || ctx.Err() != nil || errors.Is(err, ErrRecordNotFound) {
+		return false
+	}
+
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) {
+		// the connection could not be made or was lost
+		return true
+	}
+	// connection exceptions, insufficient resources and operator intervention such as a
+	// replica that is starting up or shutting down
+	return strings.HasPrefix(pgErr.Code, "08") ||
+		strings.HasPrefix(pgErr.Code, "53") ||
+		strings.HasPrefix(pgErr.Code, "57")
+}
+
+// readReplica runs a read-only query on the replica if the store has a healthy one and ctx does not
+// ask to read your writes, and on the primary otherwise or if the replica fails to answer.
+func readReplica[T any](ctx context.Context, store *SQLStore, read func(q Querier) (T, error)) (T, error) {
+	r := store.replica
+	if r == nil || ReadYourWrites(ctx) || !r.healthy(time.Now()) {
+		return read(store.q)
+	}
+
+	result, err := read(r.q)
+	if !isReplicaFailure(ctx, err) {
+		return result, err
+	}
+	r.markDown(time.Now())
+	return read(store.q)
+}
@@ -0,0 +1,153 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeDBTX answers every query with err, counting the queries it was sent.
+type fakeDBTX struct {
+	err     error
+	queries int
+}
+
+func (db *fakeDBTX) Exec(context.Context, string, ...interface{}) (pgconn.CommandTag, error) {
+	db.queries++
+	return pgconn.CommandTag{}, db.err
+}
+
+func (db *fakeDBTX) Query(context.Context, string, ...interface{}) (pgx.Rows, error) {
+	db.queries++
+	if db.err == nil {
+		return nil, errors.New("fakeDBTX does not return rows")
+	}
+	return nil, db.err
+}
+
+func (db *fakeDBTX) QueryRow(context.Context, string, ...interface{}) pgx.Row {
+	db.queries++
+	return fakeRow{err: db.err}
+}
+
+type fakeRow struct {
+	err error
+}
+
+func (row fakeRow) Scan(...any) error {
+	return row.err
+}
+
+func TestReadReplica(t *testing.T) {
+	unreachable := errors.New("dial tcp 10.0.0.2:5432: connect: connection refused")
+	shuttingDown := &pgconn.PgError{Code: "57P01"}
+	undefinedTable := &pgconn.PgError{Code: "42P01"}
+
+	testCases := []struct {
+		name            string
+		replicaErr      error
+		ctx             context.Context
+		expectedErr     error
+		replicaQueries  int
+		primaryQueries  int
+		expectedHealthy bool
+	}{
+		{
+			name:            "ReplicaAnswers",
+			ctx:             context.Background(),
+			replicaQueries:  1,
+			expectedHealthy: true,
+		},
+		{
+			name:            "NotFoundOnReplica",
+			replicaErr:      ErrRecordNotFound,
+			ctx:             context.Background(),
+			expectedErr:     ErrRecordNotFound,
+			replicaQueries:  1,
+			expectedHealthy: true,
+		},
+		{
+			name:            "QueryErrorOnReplica",
+			replicaErr:      undefinedTable,
+			ctx:             context.Background(),
+			expectedErr:     undefinedTable,
+			replicaQueries:  1,
+			expectedHealthy: true,
+		},
+		{
+			name:           "ReplicaUnreachable",
+			replicaErr:     unreachable,
+			ctx:            context.Background(),
+			replicaQueries: 1,
+			primaryQueries: 1,
+		},
+		{
+			name:           "ReplicaShuttingDown",
+			replicaErr:     shuttingDown,
+			ctx:            context.Background(),
+			replicaQueries: 1,
+			primaryQueries: 1,
+		},
+		{
+			name:            "ReadYourWrites",
+			ctx:             WithReadYourWrites(context.Background()),
+			primaryQueries:  1,
+			expectedHealthy: true,
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			primaryDB := &fakeDBTX{}
+			replicaDB := &fakeDBTX{err: tc.replicaErr}
+			store := &SQLStore{q: New(primaryDB), replica: &replica{q: New(replicaDB)}}
+
+			_, err := store.GetAccount(tc.ctx, 1)
+			require.ErrorIs(t, err, tc.expectedErr)
+			require.Equal(t, tc.replicaQueries, replicaDB.queries)
+			require.Equal(t, tc.primaryQueries, primaryDB.queries)
+			require.Equal(t, tc.expectedHealthy, store.replica.healthy(time.Now()))
+		})
+	}
+}
+
+func TestReadReplicaRecovers(t *testing.T) {
+	primaryDB := &fakeDBTX{}
+	replicaDB := &fakeDBTX{err: errors.New("connection reset by peer")}
+	store := &SQLStore{q: New(primaryDB), replica: &replica{q: New(replicaDB)}}
+
+	_, err := store.GetUser(context.Background(), "alice")
+	require.NoError(t, err)
+	require.Equal(t, 1, replicaDB.queries)
+	require.Equal(t, 1, primaryDB.queries)
+
+	// reads stay on the primary while the replica is down
+	_, err = store.GetAccount(context.Background(), 1)
+	require.NoError(t, err)
+	require.Equal(t, 1, replicaDB.queries)
+	require.Equal(t, 2, primaryDB.queries)
+
+	// and go back to the replica once it may have recovered
+	replicaDB.err = nil
+	store.replica.downUntil.Store(time.Now().Add(-time.Second).UnixNano())
+	_, err = store.GetUser(context.Background(), "alice")
+	require.NoError(t, err)
+	require.Equal(t, 2, replicaDB.queries)
+	require.Equal(t, 2, primaryDB.queries)
+	require.True(t, store.replica.healthy(time.Now()))
+}
+
+func TestReadsWithoutReplica(t *testing.T) {
+	primaryDB := &fakeDBTX{}
+	store := &SQLStore{q: New(primaryDB)}
+
+	_, err := store.GetAccount(context.Background(), 1)
+	require.NoError(t, err)
+	require.Equal(t, 1, primaryDB.queries)
+}
@@ -2,35 +2,105 @@ package db
 
 import (
 	"context"
+	"fmt"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/mahanth/simplebank/fx"
 )
 
 type Store interface {
 	Querier
 	TransferTx(ctx context.Context, arg TransferTxParams) (TransferTxResult, error)
+	CrossCurrencyTransferTx(ctx context.Context, arg TransferTxParams) (TransferTxResult, error)
+	AdjustBalanceTx(ctx context.Context, arg AdjustBalanceTxParams) (AdjustBalanceTxResult, error)
 	CreateUserTx(ctx context.Context, arg CreateUserTxParams) (CreateUserTxResult, error)
 	VerifyEmailTx(ctx context.Context, arg VerifyEmailTxParams) (VerifyEmailTxResult, error)
+	ReconcileLedgerTx(ctx context.Context, arg ReconcileLedgerTxParams) (ReconcileLedgerTxResult, error)
+	LoadExchangeRatesTx(ctx context.Context, rates []fx.Rate) (int, error)
+	RecordScheduledTransferExecutionTx(ctx context.Context, arg RecordScheduledTransferExecutionTxParams) (RecordScheduledTransferExecutionTxResult, error)
+	ReverseTransferTx(ctx context.Context, arg ReverseTransferTxParams) (ReverseTransferTxResult, error)
+	PlaceHoldTx(ctx context.Context, arg PlaceHoldTxParams) (PlaceHoldTxResult, error)
+	CaptureHoldTx(ctx context.Context, arg CaptureHoldTxParams) (CaptureHoldTxResult, error)
+	ReleaseHoldTx(ctx context.Context, arg ReleaseHoldTxParams) (ReleaseHoldTxResult, error)
+	RequestTransferApprovalTx(ctx context.Context, arg RequestTransferApprovalTxParams) (RequestTransferApprovalTxResult, error)
+	ApproveTransferTx(ctx context.Context, arg DecideTransferApprovalTxParams) (ApproveTransferTxResult, error)
+	RejectTransferTx(ctx context.Context, arg DecideTransferApprovalTxParams) (RejectTransferTxResult, error)
+	ChangeAccountStatusTx(ctx context.Context, arg ChangeAccountStatusTxParams) (ChangeAccountStatusTxResult, error)
+	AccrueInterestTx(ctx context.Context, arg AccrueInterestTxParams) (AccrueInterestTxResult, error)
+	CreditInterestTx(ctx context.Context, arg CreditInterestTxParams) (CreditInterestTxResult, error)
+	VerifyAuditLog(ctx context.Context) (AuditVerification, error)
+	TxStats() TxStats
 }
 
-// Inheritance from Queries struct
+// SQLStore runs the bank's operations as transactions on a database, Postgres for NewStore and
+// memory for NewMemoryStore.
 type SQLStore struct {
-	q  *Queries
-	db *pgxpool.Pool
+	q  Querier
+	db database
+	// transferLimits are the limits of accounts without an override, none unless set by WithTransferLimits.
+	transferLimits TransferLimits
+	// txRetry bounds how transactions failing with a transient error are retried.
+	txRetry TxRetryPolicy
+	txStats *txStats
+	// replica serves reads outside transactions, none unless set by WithReplica.
+	replica *replica
 }
 
-func NewStore(db *pgxpool.Pool) Store {
-	q := New(db)
-	return &SQLStore{
-		q:  q,
-		db: db,
+// database is where a store keeps its data. Its queries outside runTx each run on their own.
+type database interface {
+	Querier
+	// runTx makes a single attempt at running fn in a transaction, committing if fn succeeds.
+	runTx(ctx context.Context, opts pgx.TxOptions, fn func(Querier) error) error
+}
+
+// txQueries are the queries of a transaction, along with the steps the store's transactions share.
+type txQueries struct {
+	Querier
+}
+
+func NewStore(db *pgxpool.Pool, opts ...StoreOption) Store {
+	return newStore(&postgresDB{Queries: New(db), pool: db}, opts...)
+}
+
+func newStore(db database, opts ...StoreOption) *SQLStore {
+	store := &SQLStore{
+		q:       db,
+		db:      db,
+		txRetry: DefaultTxRetryPolicy,
+		txStats: &txStats{},
 	}
+	for _, opt := range opts {
+		opt(store)
+	}
+	return store
+}
+
+// execTx executes a function within a transaction context, at the isolation level set on ctx by
+// WithTxIsolation or the database default. The transaction is retried from the start if it fails
+// with a deadlock or serialization failure, so fn must not keep state from an earlier attempt.
+func (store *SQLStore) execTx(ctx context.Context, fn func(txQueries) error) error {
+	return store.execTxWithOptions(ctx, pgx.TxOptions{IsoLevel: TxIsolation(ctx)}, fn)
+}
+
+// execTxWithOptions is execTx with the transaction options given by the caller.
+func (store *SQLStore) execTxWithOptions(ctx context.Context, opts pgx.TxOptions, fn func(txQueries) error) error {
+	return retryTx(ctx, store.txRetry, store.txStats, func() error {
+		return store.db.runTx(ctx, opts, func(q Querier) error {
+			return fn(txQueries{q})
+		})
+	})
+}
+
+// postgresDB is a database in Postgres.
+type postgresDB struct {
+	*Queries
+	pool *pgxpool.Pool
 }
 
-// execTx executes a function within a transaction context.
-func (store *SQLStore) execTx(ctx context.Context, fn func(*Queries) error) error {
-	tx, err := store.db.Begin(ctx)
+func (db *postgresDB) runTx(ctx context.Context, opts pgx.TxOptions, fn func(Querier) error) error {
+	tx, err := db.pool.BeginTx(ctx, opts)
 	if err != nil {
 		return err
 	}
@@ -43,22 +113,39 @@ func (store *SQLStore) execTx(ctx context.Context, fn func(*Queries) error) erro
 		}
 		return err
 	}
-	if err = tx.Commit(ctx); err != nil {
-		if rbErr := tx.Rollback(ctx); rbErr != nil {
-			return rbErr
+	// a failed commit has already rolled the transaction back
+	return tx.Commit(ctx)
+}
+
+// lockAccountsForUpdate takes row locks on both accounts in the same order transferMoney
+// updates them, so that the funds check and the balance update see the same rows.
+func (q txQueries) lockAccountsForUpdate(ctx context.Context,
+	fromAccountID, toAccountID int64) (fromAccount Account, toAccount Account, err error) {
+	if fromAccountID > toAccountID {
+		fromAccount, err = q.GetAccountForUpdate(ctx, fromAccountID)
+		if err != nil {
+			return
 		}
-		return err
+		toAccount, err = q.GetAccountForUpdate(ctx, toAccountID)
+	} else {
+		toAccount, err = q.GetAccountForUpdate(ctx, toAccountID)
+		if err != nil {
+			return
+		}
+		fromAccount, err = q.GetAccountForUpdate(ctx, fromAccountID)
 	}
-	return nil
+	return
 }
 
-func (q *Queries) transferMoney(ctx context.Context,
-	fromAccountID, toAccountID int64, amount int64) (account1 Account, account2 Account, err error) {
+// transferMoney debits fromAmount from one account and credits toAmount to the other,
+// always updating the account with the higher id first to avoid deadlocks.
+func (q txQueries) transferMoney(ctx context.Context,
+	fromAccountID, toAccountID int64, fromAmount, toAmount int64) (account1 Account, account2 Account, err error) {
 	if fromAccountID > toAccountID {
 
 		account1, err = q.AddAccountBalance(ctx, AddAccountBalanceParams{
 			ID:     fromAccountID,
-			Amount: -amount,
+			Amount: -fromAmount,
 		})
 
 		if err != nil {
@@ -67,7 +154,7 @@ func (q *Queries) transferMoney(ctx context.Context,
 
 		account2, err = q.AddAccountBalance(ctx, AddAccountBalanceParams{
 			ID:     toAccountID,
-			Amount: amount,
+			Amount: toAmount,
 		})
 
 		if err != nil {
@@ -78,7 +165,7 @@ func (q *Queries) transferMoney(ctx context.Context,
 
 		account2, err = q.AddAccountBalance(ctx, AddAccountBalanceParams{
 			ID:     toAccountID,
-			Amount: amount,
+			Amount: toAmount,
 		})
 
 		if err != nil {
@@ -87,7 +174,7 @@ func (q *Queries) transferMoney(ctx context.Context,
 
 		account1, err = q.AddAccountBalance(ctx, AddAccountBalanceParams{
 			ID:     fromAccountID,
-			Amount: -amount,
+			Amount: -fromAmount,
 		})
 
 		if err != nil {
@@ -99,15 +186,51 @@ func (q *Queries) transferMoney(ctx context.Context,
 }
 
 func (store *SQLStore) GetAccount(ctx context.Context, id int64) (Account, error) {
-	account, err := store.q.GetAccount(ctx, id)
+	account, err := readReplica(ctx, store, func(q Querier) (Account, error) {
+		return q.GetAccount(ctx, id)
+	})
 	if err != nil {
 		return Account{}, err
 	}
 	return account, nil
 }
 
+// CreateAccount opens an account. A non-zero opening balance is booked as a deposit entry.
 func (store *SQLStore) CreateAccount(ctx context.Context, arg CreateAccountParams) (Account, error) {
-	account, err := store.q.CreateAccount(ctx, arg)
+	var account Account
+	err := store.execTx(ctx, func(q txQueries) error {
+		// the account opens empty so that the deposit is booked like any other entry
+		openingBalance := arg.Balance
+		createArg := arg
+		createArg.Balance = 0
+
+		var err error
+		account, err = q.CreateAccount(ctx, createArg)
+		if err != nil {
+			return err
+		}
+
+		if openingBalance != 0 {
+			_, err = q.CreateEntry(ctx, CreateEntryParams{
+				AccountID: account.ID,
+				Amount:    openingBalance,
+				Type:      EntryTypeDeposit,
+			})
+			if err != nil {
+				return err
+			}
+
+			account, err = q.AddAccountBalance(ctx, AddAccountBalanceParams{
+				ID:     account.ID,
+				Amount: openingBalance,
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		return q.recordAudit(ctx, AuditAccountCreate, "account", account.ID, nil, account)
+	})
 	if err != nil {
 		return Account{}, err
 	}
@@ -115,51 +238,220 @@ func (store *SQLStore) CreateAccount(ctx context.Context, arg CreateAccountParam
 }
 
 func (store *SQLStore) ListAccounts(ctx context.Context, arg ListAccountsParams) ([]Account, error) {
-	accounts, err := store.q.ListAccounts(ctx, arg)
+	accounts, err := readReplica(ctx, store, func(q Querier) ([]Account, error) {
+		return q.ListAccounts(ctx, arg)
+	})
 	if err != nil {
 		return nil, err
 	}
 	return accounts, nil
 }
+
+// AddAccountBalance books the amount as a deposit, or as a withdrawal when it is negative.
 func (store *SQLStore) AddAccountBalance(ctx context.Context, arg AddAccountBalanceParams) (Account, error) {
-	account, err := store.q.AddAccountBalance(ctx, arg)
+	entryType := EntryTypeDeposit
+	if arg.Amount < 0 {
+		entryType = EntryTypeWithdrawal
+	}
+
+	result, err := store.AdjustBalanceTx(ctx, AdjustBalanceTxParams{
+		AccountID: arg.ID,
+		Amount:    arg.Amount,
+		Type:      entryType,
+	})
 	if err != nil {
 		return Account{}, err
 	}
-	return account, nil
+	return result.Account, nil
 }
 
+// DeleteAccount deletes an account that holds no funds, under the same check as closing it.
+// An account with entries cannot be deleted either, as they keep referencing it.
 func (store *SQLStore) DeleteAccount(ctx context.Context, id int64) error {
-	err := store.q.DeleteAccount(ctx, id)
+	return store.execTx(ctx, func(q txQueries) error {
+		account, err := q.GetAccountForUpdate(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		if account.Balance != 0 || account.HeldAmount != 0 {
+			return fmt.Errorf("%w: account %d has balance %d and %d on hold",
+				ErrAccountNotEmpty, account.ID, account.Balance, account.HeldAmount)
+		}
+
+		if err = q.DeleteAccount(ctx, id); err != nil {
+			return err
+		}
+		return q.recordAudit(ctx, AuditAccountDelete, "account", id, account, nil)
+	})
+}
+
+// UpdateAccount sets the balance by booking the difference as an adjustment entry.
+func (store *SQLStore) UpdateAccount(ctx context.Context, arg UpdateAccountParams) (Account, error) {
+	var account Account
+	err := store.execTx(ctx, func(q txQueries) error {
+		current, err := q.GetAccountForUpdate(ctx, arg.ID)
+		if err != nil {
+			return err
+		}
+
+		account, _, err = q.adjustBalance(ctx, AdjustBalanceTxParams{
+			AccountID: arg.ID,
+			Amount:    arg.Balance - current.Balance,
+			Type:      EntryTypeAdjustment,
+		})
+		if err != nil {
+			return err
+		}
+		return q.recordAudit(ctx, AuditAccountUpdate, "account", account.ID, current, account)
+	})
 	if err != nil {
-		return err
+		return Account{}, err
 	}
-	return nil
+	return account, nil
 }
-func (store *SQLStore) UpdateAccount(ctx context.Context, arg UpdateAccountParams) (Account, error) {
-	account, err := store.q.UpdateAccount(ctx, arg)
+
+func (store *SQLStore) UpdateAccountOverdraftLimit(ctx context.Context, arg UpdateAccountOverdraftLimitParams) (Account, error) {
+	var account Account
+	err := store.execTx(ctx, func(q txQueries) error {
+		current, err := q.GetAccountForUpdate(ctx, arg.ID)
+		if err != nil {
+			return err
+		}
+
+		account, err = q.UpdateAccountOverdraftLimit(ctx, arg)
+		if err != nil {
+			return err
+		}
+		return q.recordAudit(ctx, AuditAccountUpdate, "account", account.ID, current, account)
+	})
+	if err != nil {
+		return Account{}, err
+	}
+	return account, nil
+}
+
+func (store *SQLStore) AddAccountHeldAmount(ctx context.Context, arg AddAccountHeldAmountParams) (Account, error) {
+	var account Account
+	err := store.execTx(ctx, func(q txQueries) error {
+		current, err := q.GetAccountForUpdate(ctx, arg.ID)
+		if err != nil {
+			return err
+		}
+
+		account, err = q.AddAccountHeldAmount(ctx, arg)
+		if err != nil {
+			return err
+		}
+		return q.recordAudit(ctx, AuditAccountUpdate, "account", account.ID, current, account)
+	})
+	if err != nil {
+		return Account{}, err
+	}
+	return account, nil
+}
+
+// UpdateAccountStatus changes the status through ChangeAccountStatusTx, so the change is checked
+// and recorded like any other. It is made by the audit actor of ctx, or by the bank's system user.
+func (store *SQLStore) UpdateAccountStatus(ctx context.Context, arg UpdateAccountStatusParams) (Account, error) {
+	changedBy := AuditActorFrom(ctx).Username
+	if changedBy == AuditSystemActor {
+		changedBy = SystemUsername
+	}
+
+	result, err := store.ChangeAccountStatusTx(ctx, ChangeAccountStatusTxParams{
+		AccountID: arg.ID,
+		Status:    arg.Status,
+		ChangedBy: changedBy,
+	})
+	if err != nil {
+		return Account{}, err
+	}
+	return result.Account, nil
+}
+
+func (store *SQLStore) CreateAccountStatusChange(ctx context.Context, arg CreateAccountStatusChangeParams) (AccountStatusChange, error) {
+	change, err := store.q.CreateAccountStatusChange(ctx, arg)
+	if err != nil {
+		return AccountStatusChange{}, err
+	}
+	return change, nil
+}
+
+func (store *SQLStore) ListAccountStatusChanges(ctx context.Context, arg ListAccountStatusChangesParams) ([]AccountStatusChange, error) {
+	changes, err := store.q.ListAccountStatusChanges(ctx, arg)
+	if err != nil {
+		return nil, err
+	}
+	return changes, nil
+}
+
+func (store *SQLStore) GetOwnerAccount(ctx context.Context, arg GetOwnerAccountParams) (Account, error) {
+	account, err := store.q.GetOwnerAccount(ctx, arg)
 	if err != nil {
 		return Account{}, err
 	}
 	return account, nil
 }
 
+func (store *SQLStore) EnsureAccount(ctx context.Context, arg EnsureAccountParams) error {
+	err := store.q.EnsureAccount(ctx, arg)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (store *SQLStore) ListInterestBearingAccounts(ctx context.Context, arg ListInterestBearingAccountsParams) ([]Account, error) {
+	accounts, err := store.q.ListInterestBearingAccounts(ctx, arg)
+	if err != nil {
+		return nil, err
+	}
+	return accounts, nil
+}
+
 func (store *SQLStore) GetEntry(ctx context.Context, id int64) (Entry, error) {
-	entry, err := store.q.GetEntry(ctx, id)
+	entry, err := readReplica(ctx, store, func(q Querier) (Entry, error) {
+		return q.GetEntry(ctx, id)
+	})
 	if err != nil {
 		return Entry{}, err
 	}
 	return entry, nil
 }
+
+// CreateEntry books the entry through AdjustBalanceTx, so that it is applied to the balance.
+// The entries of a transfer can only be booked by the transfer itself.
 func (store *SQLStore) CreateEntry(ctx context.Context, arg CreateEntryParams) (Entry, error) {
-	entry, err := store.q.CreateEntry(ctx, arg)
+	if arg.TransferID.Valid {
+		return Entry{}, fmt.Errorf("entries of transfer %d are booked by TransferTx", arg.TransferID.Int64)
+	}
+
+	result, err := store.AdjustBalanceTx(ctx, AdjustBalanceTxParams{
+		AccountID: arg.AccountID,
+		Amount:    arg.Amount,
+		Type:      arg.Type,
+	})
 	if err != nil {
 		return Entry{}, err
 	}
-	return entry, nil
+	return result.Entry, nil
 }
+
 func (store *SQLStore) ListEntries(ctx context.Context, arg ListEntriesParams) ([]Entry, error) {
-	entries, err := store.q.ListEntries(ctx, arg)
+	entries, err := readReplica(ctx, store, func(q Querier) ([]Entry, error) {
+		return q.ListEntries(ctx, arg)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+func (store *SQLStore) ListEntriesByTransfer(ctx context.Context, transferID pgtype.Int8) ([]Entry, error) {
+	entries, err := readReplica(ctx, store, func(q Querier) ([]Entry, error) {
+		return q.ListEntriesByTransfer(ctx, transferID)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -167,7 +459,9 @@ func (store *SQLStore) ListEntries(ctx context.Context, arg ListEntriesParams) (
 }
 
 func (store *SQLStore) GetTransfer(ctx context.Context, id int64) (Transfer, error) {
-	transfer, err := store.q.GetTransfer(ctx, id)
+	transfer, err := readReplica(ctx, store, func(q Querier) (Transfer, error) {
+		return q.GetTransfer(ctx, id)
+	})
 	if err != nil {
 		return Transfer{}, err
 	}
@@ -175,21 +469,64 @@ func (store *SQLStore) GetTransfer(ctx context.Context, id int64) (Transfer, err
 }
 
 func (store *SQLStore) ListTransfers(ctx context.Context, arg ListTransfersParams) ([]Transfer, error) {
-	transfers, err := store.q.ListTransfers(ctx, arg)
+	transfers, err := readReplica(ctx, store, func(q Querier) ([]Transfer, error) {
+		return q.ListTransfers(ctx, arg)
+	})
 	if err != nil {
 		return nil, err
 	}
 	return transfers, nil
 }
 
+// CreateTransfer makes the transfer through TransferTx, so that the money moves with it. The amount
+// received, exchange rate and fee are those TransferTx works out rather than the ones given.
 func (store *SQLStore) CreateTransfer(ctx context.Context, arg CreateTransferParams) (Transfer, error) {
-	transfer, err := store.q.CreateTransfer(ctx, arg)
+	result, err := store.TransferTx(ctx, TransferTxParams{
+		FromAccountID: arg.FromAccountID,
+		ToAccountID:   arg.ToAccountID,
+		Amount:        arg.Amount,
+		Memo:          arg.Memo,
+		Reference:     arg.Reference,
+		Username:      arg.InitiatedBy,
+	})
+	if err != nil {
+		return Transfer{}, err
+	}
+	return result.Transfer, nil
+}
+
+func (store *SQLStore) GetTransferForUpdate(ctx context.Context, id int64) (Transfer, error) {
+	transfer, err := store.q.GetTransferForUpdate(ctx, id)
 	if err != nil {
 		return Transfer{}, err
 	}
 	return transfer, nil
 }
 
+func (store *SQLStore) UpdateTransferReversal(ctx context.Context, arg UpdateTransferReversalParams) (Transfer, error) {
+	transfer, err := store.q.UpdateTransferReversal(ctx, arg)
+	if err != nil {
+		return Transfer{}, err
+	}
+	return transfer, nil
+}
+
+func (store *SQLStore) CreateTransferReversal(ctx context.Context, arg CreateTransferReversalParams) (TransferReversal, error) {
+	reversal, err := store.q.CreateTransferReversal(ctx, arg)
+	if err != nil {
+		return TransferReversal{}, err
+	}
+	return reversal, nil
+}
+
+func (store *SQLStore) ListTransferReversals(ctx context.Context, transferID int64) ([]TransferReversal, error) {
+	reversals, err := store.q.ListTransferReversals(ctx, transferID)
+	if err != nil {
+		return nil, err
+	}
+	return reversals, nil
+}
+
 func (store *SQLStore) GetAccountForUpdate(ctx context.Context, id int64) (Account, error) {
 	account, err := store.q.GetAccountForUpdate(ctx, id)
 	if err != nil {
@@ -199,7 +536,9 @@ func (store *SQLStore) GetAccountForUpdate(ctx context.Context, id int64) (Accou
 }
 
 func (store *SQLStore) GetUser(ctx context.Context, username string) (User, error) {
-	user, err := store.q.GetUser(ctx, username)
+	user, err := readReplica(ctx, store, func(q Querier) (User, error) {
+		return q.GetUser(ctx, username)
+	})
 	if err != nil {
 		return User{}, err
 	}
@@ -207,7 +546,15 @@ func (store *SQLStore) GetUser(ctx context.Context, username string) (User, erro
 }
 
 func (store *SQLStore) CreateUser(ctx context.Context, arg CreateUserParams) (User, error) {
-	user, err := store.q.CreateUser(ctx, arg)
+	var user User
+	err := store.execTx(ctx, func(q txQueries) error {
+		var err error
+		user, err = q.CreateUser(ctx, arg)
+		if err != nil {
+			return err
+		}
+		return q.recordAudit(ctx, AuditUserCreate, "user", user.Username, nil, auditUser(user))
+	})
 	if err != nil {
 		return User{}, err
 	}
@@ -215,7 +562,15 @@ func (store *SQLStore) CreateUser(ctx context.Context, arg CreateUserParams) (Us
 }
 
 func (store *SQLStore) CreateSession(ctx context.Context, arg CreateSessionParams) (Session, error) {
-	session, err := store.q.CreateSession(ctx, arg)
+	var session Session
+	err := store.execTx(ctx, func(q txQueries) error {
+		var err error
+		session, err = q.CreateSession(ctx, arg)
+		if err != nil {
+			return err
+		}
+		return q.recordAudit(ctx, AuditSessionCreate, "session", session.ID.String(), nil, auditSession(session))
+	})
 	if err != nil {
 		return Session{}, err
 	}
@@ -231,12 +586,22 @@ func (store *SQLStore) GetSession(ctx context.Context, id pgtype.UUID) (Session,
 }
 
 func (store *SQLStore) UpdateUser(ctx context.Context, arg UpdateUserParams) (User, error) {
+	var user User
+	err := store.execTx(ctx, func(q txQueries) error {
+		before, err := q.GetUser(ctx, arg.Username)
+		if err != nil {
+			return err
+		}
 
-	user, err := store.q.UpdateUser(ctx, arg)
+		user, err = q.UpdateUser(ctx, arg)
+		if err != nil {
+			return err
+		}
+		return q.recordAudit(ctx, AuditUserUpdate, "user", user.Username, auditUser(before), auditUser(user))
+	})
 	if err != nil {
 		return User{}, err
 	}
-
 	return user, err
 }
 
@@ -255,3 +620,765 @@ func (store SQLStore) UpdateVerifyEmail(ctx context.Context, arg UpdateVerifyEma
 	}
 	return verifyEmail, nil
 }
+
+func (store *SQLStore) ListUsersByRole(ctx context.Context, role string) ([]User, error) {
+	users, err := store.q.ListUsersByRole(ctx, role)
+	if err != nil {
+		return nil, err
+	}
+	return users, nil
+}
+
+func (store *SQLStore) CreateIdempotencyKey(ctx context.Context, arg CreateIdempotencyKeyParams) (IdempotencyKey, error) {
+	idempotencyKey, err := store.q.CreateIdempotencyKey(ctx, arg)
+	if err != nil {
+		return IdempotencyKey{}, err
+	}
+	return idempotencyKey, nil
+}
+
+func (store *SQLStore) GetIdempotencyKey(ctx context.Context, arg GetIdempotencyKeyParams) (IdempotencyKey, error) {
+	idempotencyKey, err := store.q.GetIdempotencyKey(ctx, arg)
+	if err != nil {
+		return IdempotencyKey{}, err
+	}
+	return idempotencyKey, nil
+}
+
+func (store *SQLStore) UpdateIdempotencyKeyResponse(ctx context.Context, arg UpdateIdempotencyKeyResponseParams) error {
+	return store.q.UpdateIdempotencyKeyResponse(ctx, arg)
+}
+
+func (store *SQLStore) ListAccountBalanceMismatches(ctx context.Context) ([]ListAccountBalanceMismatchesRow, error) {
+	mismatches, err := store.q.ListAccountBalanceMismatches(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return mismatches, nil
+}
+
+func (store *SQLStore) ListUnmatchedTransfers(ctx context.Context) ([]ListUnmatchedTransfersRow, error) {
+	transfers, err := store.q.ListUnmatchedTransfers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return transfers, nil
+}
+
+func (store *SQLStore) ListCurrencyImbalances(ctx context.Context) ([]ListCurrencyImbalancesRow, error) {
+	imbalances, err := store.q.ListCurrencyImbalances(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return imbalances, nil
+}
+
+func (store *SQLStore) CreateReconciliationReport(ctx context.Context, arg CreateReconciliationReportParams) (ReconciliationReport, error) {
+	report, err := store.q.CreateReconciliationReport(ctx, arg)
+	if err != nil {
+		return ReconciliationReport{}, err
+	}
+	return report, nil
+}
+
+func (store *SQLStore) GetReconciliationReport(ctx context.Context, id int64) (ReconciliationReport, error) {
+	report, err := store.q.GetReconciliationReport(ctx, id)
+	if err != nil {
+		return ReconciliationReport{}, err
+	}
+	return report, nil
+}
+
+func (store *SQLStore) ListReconciliationReports(ctx context.Context, arg ListReconciliationReportsParams) ([]ReconciliationReport, error) {
+	reports, err := store.q.ListReconciliationReports(ctx, arg)
+	if err != nil {
+		return nil, err
+	}
+	return reports, nil
+}
+
+func (store *SQLStore) GetExchangeRate(ctx context.Context, arg GetExchangeRateParams) (ExchangeRate, error) {
+	rate, err := store.q.GetExchangeRate(ctx, arg)
+	if err != nil {
+		return ExchangeRate{}, err
+	}
+	return rate, nil
+}
+
+func (store *SQLStore) ListExchangeRates(ctx context.Context, arg ListExchangeRatesParams) ([]ExchangeRate, error) {
+	rates, err := store.q.ListExchangeRates(ctx, arg)
+	if err != nil {
+		return nil, err
+	}
+	return rates, nil
+}
+
+func (store *SQLStore) UpsertExchangeRate(ctx context.Context, arg UpsertExchangeRateParams) (ExchangeRate, error) {
+	rate, err := store.q.UpsertExchangeRate(ctx, arg)
+	if err != nil {
+		return ExchangeRate{}, err
+	}
+	return rate, nil
+}
+
+func (store *SQLStore) CreateScheduledTransfer(ctx context.Context, arg CreateScheduledTransferParams) (ScheduledTransfer, error) {
+	var scheduledTransfer ScheduledTransfer
+	err := store.execTx(ctx, func(q txQueries) error {
+		var err error
+		scheduledTransfer, err = q.CreateScheduledTransfer(ctx, arg)
+		if err != nil {
+			return err
+		}
+		return q.recordAudit(ctx, AuditScheduledTransferCreate, "scheduled_transfer", scheduledTransfer.ID, nil, scheduledTransfer)
+	})
+	if err != nil {
+		return ScheduledTransfer{}, err
+	}
+	return scheduledTransfer, nil
+}
+
+func (store *SQLStore) GetScheduledTransfer(ctx context.Context, id int64) (ScheduledTransfer, error) {
+	scheduledTransfer, err := store.q.GetScheduledTransfer(ctx, id)
+	if err != nil {
+		return ScheduledTransfer{}, err
+	}
+	return scheduledTransfer, nil
+}
+
+func (store *SQLStore) GetScheduledTransferForUpdate(ctx context.Context, id int64) (ScheduledTransfer, error) {
+	scheduledTransfer, err := store.q.GetScheduledTransferForUpdate(ctx, id)
+	if err != nil {
+		return ScheduledTransfer{}, err
+	}
+	return scheduledTransfer, nil
+}
+
+func (store *SQLStore) ListScheduledTransfers(ctx context.Context, arg ListScheduledTransfersParams) ([]ScheduledTransfer, error) {
+	scheduledTransfers, err := store.q.ListScheduledTransfers(ctx, arg)
+	if err != nil {
+		return nil, err
+	}
+	return scheduledTransfers, nil
+}
+
+func (store *SQLStore) ListDueScheduledTransfers(ctx context.Context, limit int32) ([]ScheduledTransfer, error) {
+	scheduledTransfers, err := store.q.ListDueScheduledTransfers(ctx, limit)
+	if err != nil {
+		return nil, err
+	}
+	return scheduledTransfers, nil
+}
+
+func (store *SQLStore) UpdateScheduledTransferStatus(ctx context.Context, arg UpdateScheduledTransferStatusParams) (ScheduledTransfer, error) {
+	var scheduledTransfer ScheduledTransfer
+	err := store.execTx(ctx, func(q txQueries) error {
+		current, err := q.GetScheduledTransferForUpdate(ctx, arg.ID)
+		if err != nil {
+			return err
+		}
+
+		scheduledTransfer, err = q.UpdateScheduledTransferStatus(ctx, arg)
+		if err != nil {
+			return err
+		}
+		return q.recordAudit(ctx, AuditScheduledTransferUpdate, "scheduled_transfer", scheduledTransfer.ID, current, scheduledTransfer)
+	})
+	if err != nil {
+		return ScheduledTransfer{}, err
+	}
+	return scheduledTransfer, nil
+}
+
+func (store *SQLStore) CreateScheduledTransferExecution(ctx context.Context, arg CreateScheduledTransferExecutionParams) (ScheduledTransferExecution, error) {
+	execution, err := store.q.CreateScheduledTransferExecution(ctx, arg)
+	if err != nil {
+		return ScheduledTransferExecution{}, err
+	}
+	return execution, nil
+}
+
+func (store *SQLStore) ListScheduledTransferExecutions(ctx context.Context, arg ListScheduledTransferExecutionsParams) ([]ScheduledTransferExecution, error) {
+	executions, err := store.q.ListScheduledTransferExecutions(ctx, arg)
+	if err != nil {
+		return nil, err
+	}
+	return executions, nil
+}
+
+func (store *SQLStore) CreateAccountHold(ctx context.Context, arg CreateAccountHoldParams) (AccountHold, error) {
+	hold, err := store.q.CreateAccountHold(ctx, arg)
+	if err != nil {
+		return AccountHold{}, err
+	}
+	return hold, nil
+}
+
+func (store *SQLStore) GetAccountHold(ctx context.Context, id int64) (AccountHold, error) {
+	hold, err := store.q.GetAccountHold(ctx, id)
+	if err != nil {
+		return AccountHold{}, err
+	}
+	return hold, nil
+}
+
+func (store *SQLStore) GetAccountHoldForUpdate(ctx context.Context, id int64) (AccountHold, error) {
+	hold, err := store.q.GetAccountHoldForUpdate(ctx, id)
+	if err != nil {
+		return AccountHold{}, err
+	}
+	return hold, nil
+}
+
+func (store *SQLStore) ListAccountHolds(ctx context.Context, arg ListAccountHoldsParams) ([]AccountHold, error) {
+	holds, err := store.q.ListAccountHolds(ctx, arg)
+	if err != nil {
+		return nil, err
+	}
+	return holds, nil
+}
+
+func (store *SQLStore) ListExpiredAccountHolds(ctx context.Context, limit int32) ([]AccountHold, error) {
+	holds, err := store.q.ListExpiredAccountHolds(ctx, limit)
+	if err != nil {
+		return nil, err
+	}
+	return holds, nil
+}
+
+func (store *SQLStore) UpdateAccountHoldStatus(ctx context.Context, arg UpdateAccountHoldStatusParams) (AccountHold, error) {
+	hold, err := store.q.UpdateAccountHoldStatus(ctx, arg)
+	if err != nil {
+		return AccountHold{}, err
+	}
+	return hold, nil
+}
+
+func (store *SQLStore) UpsertInterestRate(ctx context.Context, arg UpsertInterestRateParams) (InterestRate, error) {
+	rate, err := store.q.UpsertInterestRate(ctx, arg)
+	if err != nil {
+		return InterestRate{}, err
+	}
+	return rate, nil
+}
+
+func (store *SQLStore) GetInterestRate(ctx context.Context, arg GetInterestRateParams) (InterestRate, error) {
+	rate, err := store.q.GetInterestRate(ctx, arg)
+	if err != nil {
+		return InterestRate{}, err
+	}
+	return rate, nil
+}
+
+func (store *SQLStore) CreateInterestAccrual(ctx context.Context, arg CreateInterestAccrualParams) (InterestAccrual, error) {
+	accrual, err := store.q.CreateInterestAccrual(ctx, arg)
+	if err != nil {
+		return InterestAccrual{}, err
+	}
+	return accrual, nil
+}
+
+func (store *SQLStore) ListInterestAccruals(ctx context.Context, arg ListInterestAccrualsParams) ([]InterestAccrual, error) {
+	accruals, err := store.q.ListInterestAccruals(ctx, arg)
+	if err != nil {
+		return nil, err
+	}
+	return accruals, nil
+}
+
+func (store *SQLStore) GetCreditedInterest(ctx context.Context, accountID int64) (GetCreditedInterestRow, error) {
+	credited, err := store.q.GetCreditedInterest(ctx, accountID)
+	if err != nil {
+		return GetCreditedInterestRow{}, err
+	}
+	return credited, nil
+}
+
+func (store *SQLStore) GetLastInterestAccrualDate(ctx context.Context) (pgtype.Date, error) {
+	date, err := store.q.GetLastInterestAccrualDate(ctx)
+	if err != nil {
+		return pgtype.Date{}, err
+	}
+	return date, nil
+}
+
+func (store *SQLStore) ListUncreditedInterestAccruals(ctx context.Context, arg ListUncreditedInterestAccrualsParams) ([]InterestAccrual, error) {
+	accruals, err := store.q.ListUncreditedInterestAccruals(ctx, arg)
+	if err != nil {
+		return nil, err
+	}
+	return accruals, nil
+}
+
+func (store *SQLStore) MarkInterestAccrualsCredited(ctx context.Context, arg MarkInterestAccrualsCreditedParams) error {
+	err := store.q.MarkInterestAccrualsCredited(ctx, arg)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (store *SQLStore) CreateFeeRule(ctx context.Context, arg CreateFeeRuleParams) (FeeRule, error) {
+	var rule FeeRule
+	err := store.execTx(ctx, func(q txQueries) error {
+		var err error
+		rule, err = q.CreateFeeRule(ctx, arg)
+		if err != nil {
+			return err
+		}
+		return q.recordAudit(ctx, AuditFeeRuleCreate, "fee_rule", rule.ID, nil, rule)
+	})
+	if err != nil {
+		return FeeRule{}, err
+	}
+	return rule, nil
+}
+
+func (store *SQLStore) GetFeeRule(ctx context.Context, arg GetFeeRuleParams) (FeeRule, error) {
+	rule, err := store.q.GetFeeRule(ctx, arg)
+	if err != nil {
+		return FeeRule{}, err
+	}
+	return rule, nil
+}
+
+func (store *SQLStore) ListFeeRules(ctx context.Context, arg ListFeeRulesParams) ([]FeeRule, error) {
+	rules, err := store.q.ListFeeRules(ctx, arg)
+	if err != nil {
+		return nil, err
+	}
+	return rules, nil
+}
+
+// UpsertUserTransferLimit replaces the override of a user's limits and appends the change to the
+// audit log. Concurrent changes of the same override are made one after another.
+func (store *SQLStore) UpsertUserTransferLimit(ctx context.Context, arg UpsertUserTransferLimitParams) (TransferLimit, error) {
+	var limit TransferLimit
+	err := store.execTx(ctx, func(q txQueries) error {
+		err := q.LockUserTransferLimits(ctx, arg.Username.String)
+		if err != nil {
+			return err
+		}
+
+		before, err := q.transferLimitOverride(ctx, ListTransferLimitOverridesParams{Username: arg.Username.String})
+		if err != nil {
+			return err
+		}
+
+		limit, err = q.UpsertUserTransferLimit(ctx, arg)
+		if err != nil {
+			return err
+		}
+		return q.recordTransferLimitAudit(ctx, before, limit)
+	})
+	if err != nil {
+		return TransferLimit{}, err
+	}
+	return limit, nil
+}
+
+// UpsertAccountTransferLimit is UpsertUserTransferLimit for the override of an account's limits.
+func (store *SQLStore) UpsertAccountTransferLimit(ctx context.Context, arg UpsertAccountTransferLimitParams) (TransferLimit, error) {
+	var limit TransferLimit
+	err := store.execTx(ctx, func(q txQueries) error {
+		_, err := q.GetAccountForUpdate(ctx, arg.AccountID.Int64)
+		if err != nil {
+			return err
+		}
+
+		before, err := q.transferLimitOverride(ctx, ListTransferLimitOverridesParams{AccountID: arg.AccountID.Int64})
+		if err != nil {
+			return err
+		}
+
+		limit, err = q.UpsertAccountTransferLimit(ctx, arg)
+		if err != nil {
+			return err
+		}
+		return q.recordTransferLimitAudit(ctx, before, limit)
+	})
+	if err != nil {
+		return TransferLimit{}, err
+	}
+	return limit, nil
+}
+
+func (store *SQLStore) ListTransferLimitOverrides(ctx context.Context, arg ListTransferLimitOverridesParams) ([]TransferLimit, error) {
+	limits, err := store.q.ListTransferLimitOverrides(ctx, arg)
+	if err != nil {
+		return nil, err
+	}
+	return limits, nil
+}
+
+func (store *SQLStore) GetAccountOutgoingTransferTotals(ctx context.Context, arg GetAccountOutgoingTransferTotalsParams) (GetAccountOutgoingTransferTotalsRow, error) {
+	totals, err := store.q.GetAccountOutgoingTransferTotals(ctx, arg)
+	if err != nil {
+		return GetAccountOutgoingTransferTotalsRow{}, err
+	}
+	return totals, nil
+}
+
+func (store *SQLStore) GetOutgoingTransferTotals(ctx context.Context, arg GetOutgoingTransferTotalsParams) (GetOutgoingTransferTotalsRow, error) {
+	totals, err := store.q.GetOutgoingTransferTotals(ctx, arg)
+	if err != nil {
+		return GetOutgoingTransferTotalsRow{}, err
+	}
+	return totals, nil
+}
+
+func (store *SQLStore) LockUserTransferLimits(ctx context.Context, username string) error {
+	err := store.q.LockUserTransferLimits(ctx, username)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (store *SQLStore) GetBalanceAt(ctx context.Context, arg GetBalanceAtParams) (int64, error) {
+	balance, err := store.q.GetBalanceAt(ctx, arg)
+	if err != nil {
+		return 0, err
+	}
+	return balance, nil
+}
+
+func (store *SQLStore) SearchTransfers(ctx context.Context, arg SearchTransfersParams) ([]Transfer, error) {
+	transfers, err := store.q.SearchTransfers(ctx, arg)
+	if err != nil {
+		return nil, err
+	}
+	return transfers, nil
+}
+
+func (store *SQLStore) GetCurrency(ctx context.Context, code string) (Currency, error) {
+	currency, err := store.q.GetCurrency(ctx, code)
+	if err != nil {
+		return Currency{}, err
+	}
+	return currency, nil
+}
+
+func (store *SQLStore) ListCurrencies(ctx context.Context) ([]Currency, error) {
+	currencies, err := store.q.ListCurrencies(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return currencies, nil
+}
+
+func (store *SQLStore) GetCurrencyForUpdate(ctx context.Context, code string) (Currency, error) {
+	currency, err := store.q.GetCurrencyForUpdate(ctx, code)
+	if err != nil {
+		return Currency{}, err
+	}
+	return currency, nil
+}
+
+func (store *SQLStore) UpdateCurrencyApprovalThreshold(ctx context.Context, arg UpdateCurrencyApprovalThresholdParams) (Currency, error) {
+	return store.updateCurrency(ctx, arg.Code, func(q txQueries) (Currency, error) {
+		return q.UpdateCurrencyApprovalThreshold(ctx, arg)
+	})
+}
+
+func (store *SQLStore) UpdateCurrencyEnabled(ctx context.Context, arg UpdateCurrencyEnabledParams) (Currency, error) {
+	return store.updateCurrency(ctx, arg.Code, func(q txQueries) (Currency, error) {
+		return q.UpdateCurrencyEnabled(ctx, arg)
+	})
+}
+
+// updateCurrency makes the update of a currency and appends it to the audit log.
+func (store *SQLStore) updateCurrency(ctx context.Context, code string, update func(q txQueries) (Currency, error)) (Currency, error) {
+	var currency Currency
+	err := store.execTx(ctx, func(q txQueries) error {
+		before, err := q.GetCurrencyForUpdate(ctx, code)
+		if err != nil {
+			return err
+		}
+
+		currency, err = update(q)
+		if err != nil {
+			return err
+		}
+		return q.recordAudit(ctx, AuditCurrencyUpdate, "currency", currency.Code, before, currency)
+	})
+	if err != nil {
+		return Currency{}, err
+	}
+	return currency, nil
+}
+
+func (store *SQLStore) CreateAccountHolder(ctx context.Context, arg CreateAccountHolderParams) (AccountHolder, error) {
+	var holder AccountHolder
+	err := store.execTx(ctx, func(q txQueries) error {
+		var err error
+		holder, err = q.CreateAccountHolder(ctx, arg)
+		if err != nil {
+			return err
+		}
+		return q.recordAudit(ctx, AuditAccountHolderCreate, "account_holder", holder.ID, nil, holder)
+	})
+	if err != nil {
+		return AccountHolder{}, err
+	}
+	return holder, nil
+}
+
+func (store *SQLStore) GetAccountHolder(ctx context.Context, arg GetAccountHolderParams) (AccountHolder, error) {
+	holder, err := store.q.GetAccountHolder(ctx, arg)
+	if err != nil {
+		return AccountHolder{}, err
+	}
+	return holder, nil
+}
+
+func (store *SQLStore) ListAccountHolders(ctx context.Context, accountID int64) ([]AccountHolder, error) {
+	holders, err := store.q.ListAccountHolders(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+	return holders, nil
+}
+
+// AcceptAccountHolder activates an invitation. Only an invited holder is updated, so the holder
+// read beforehand is the state the update starts from.
+func (store *SQLStore) AcceptAccountHolder(ctx context.Context, arg AcceptAccountHolderParams) (AccountHolder, error) {
+	var holder AccountHolder
+	err := store.execTx(ctx, func(q txQueries) error {
+		current, err := q.GetAccountHolder(ctx, GetAccountHolderParams(arg))
+		if err != nil {
+			return err
+		}
+
+		holder, err = q.AcceptAccountHolder(ctx, arg)
+		if err != nil {
+			return err
+		}
+		return q.recordAudit(ctx, AuditAccountHolderUpdate, "account_holder", holder.ID, current, holder)
+	})
+	if err != nil {
+		return AccountHolder{}, err
+	}
+	return holder, nil
+}
+
+func (store *SQLStore) DeleteAccountHolder(ctx context.Context, arg DeleteAccountHolderParams) (AccountHolder, error) {
+	var holder AccountHolder
+	err := store.execTx(ctx, func(q txQueries) error {
+		var err error
+		holder, err = q.DeleteAccountHolder(ctx, arg)
+		if err != nil {
+			return err
+		}
+		return q.recordAudit(ctx, AuditAccountHolderDelete, "account_holder", holder.ID, holder, nil)
+	})
+	if err != nil {
+		return AccountHolder{}, err
+	}
+	return holder, nil
+}
+
+func (store *SQLStore) CreateOutboxEvent(ctx context.Context, arg CreateOutboxEventParams) (Outbox, error) {
+	event, err := store.q.CreateOutboxEvent(ctx, arg)
+	if err != nil {
+		return Outbox{}, err
+	}
+	return event, nil
+}
+
+func (store *SQLStore) ListUnsentOutboxEvents(ctx context.Context, limit int32) ([]Outbox, error) {
+	events, err := store.q.ListUnsentOutboxEvents(ctx, limit)
+	if err != nil {
+		return nil, err
+	}
+	return events, nil
+}
+
+func (store *SQLStore) MarkOutboxEventSent(ctx context.Context, id int64) error {
+	err := store.q.MarkOutboxEventSent(ctx, id)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (store *SQLStore) RecordOutboxEventFailure(ctx context.Context, arg RecordOutboxEventFailureParams) error {
+	err := store.q.RecordOutboxEventFailure(ctx, arg)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (store *SQLStore) CreateWebhookSubscription(ctx context.Context, arg CreateWebhookSubscriptionParams) (WebhookSubscription, error) {
+	var subscription WebhookSubscription
+	err := store.execTx(ctx, func(q txQueries) error {
+		var err error
+		subscription, err = q.CreateWebhookSubscription(ctx, arg)
+		if err != nil {
+			return err
+		}
+		return q.recordAudit(ctx, AuditWebhookSubscriptionCreate, "webhook_subscription", subscription.ID,
+			nil, auditWebhookSubscription(subscription))
+	})
+	if err != nil {
+		return WebhookSubscription{}, err
+	}
+	return subscription, nil
+}
+
+func (store *SQLStore) GetWebhookSubscription(ctx context.Context, id int64) (WebhookSubscription, error) {
+	subscription, err := store.q.GetWebhookSubscription(ctx, id)
+	if err != nil {
+		return WebhookSubscription{}, err
+	}
+	return subscription, nil
+}
+
+func (store *SQLStore) ListWebhookSubscriptions(ctx context.Context, arg ListWebhookSubscriptionsParams) ([]WebhookSubscription, error) {
+	subscriptions, err := store.q.ListWebhookSubscriptions(ctx, arg)
+	if err != nil {
+		return nil, err
+	}
+	return subscriptions, nil
+}
+
+func (store *SQLStore) ListWebhookSubscriptionsForEvent(ctx context.Context, arg ListWebhookSubscriptionsForEventParams) ([]WebhookSubscription, error) {
+	subscriptions, err := store.q.ListWebhookSubscriptionsForEvent(ctx, arg)
+	if err != nil {
+		return nil, err
+	}
+	return subscriptions, nil
+}
+
+func (store *SQLStore) DeactivateWebhookSubscription(ctx context.Context, id int64) (WebhookSubscription, error) {
+	var subscription WebhookSubscription
+	err := store.execTx(ctx, func(q txQueries) error {
+		current, err := q.GetWebhookSubscription(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		subscription, err = q.DeactivateWebhookSubscription(ctx, id)
+		if err != nil {
+			return err
+		}
+		return q.recordAudit(ctx, AuditWebhookSubscriptionUpdate, "webhook_subscription", subscription.ID,
+			auditWebhookSubscription(current), auditWebhookSubscription(subscription))
+	})
+	if err != nil {
+		return WebhookSubscription{}, err
+	}
+	return subscription, nil
+}
+
+func (store *SQLStore) CreateWebhookDelivery(ctx context.Context, arg CreateWebhookDeliveryParams) (WebhookDelivery, error) {
+	delivery, err := store.q.CreateWebhookDelivery(ctx, arg)
+	if err != nil {
+		return WebhookDelivery{}, err
+	}
+	return delivery, nil
+}
+
+func (store *SQLStore) GetWebhookDelivery(ctx context.Context, id int64) (WebhookDelivery, error) {
+	delivery, err := store.q.GetWebhookDelivery(ctx, id)
+	if err != nil {
+		return WebhookDelivery{}, err
+	}
+	return delivery, nil
+}
+
+func (store *SQLStore) ListWebhookDeliveries(ctx context.Context, arg ListWebhookDeliveriesParams) ([]WebhookDelivery, error) {
+	deliveries, err := store.q.ListWebhookDeliveries(ctx, arg)
+	if err != nil {
+		return nil, err
+	}
+	return deliveries, nil
+}
+
+func (store *SQLStore) RecordWebhookDeliveryAttempt(ctx context.Context, arg RecordWebhookDeliveryAttemptParams) (WebhookDelivery, error) {
+	delivery, err := store.q.RecordWebhookDeliveryAttempt(ctx, arg)
+	if err != nil {
+		return WebhookDelivery{}, err
+	}
+	return delivery, nil
+}
+
+func (store *SQLStore) LockAuditChain(ctx context.Context) error {
+	err := store.q.LockAuditChain(ctx)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (store *SQLStore) GetLastAuditEvent(ctx context.Context) (AuditEvent, error) {
+	event, err := store.q.GetLastAuditEvent(ctx)
+	if err != nil {
+		return AuditEvent{}, err
+	}
+	return event, nil
+}
+
+func (store *SQLStore) CreateAuditEvent(ctx context.Context, arg CreateAuditEventParams) (AuditEvent, error) {
+	event, err := store.q.CreateAuditEvent(ctx, arg)
+	if err != nil {
+		return AuditEvent{}, err
+	}
+	return event, nil
+}
+
+func (store *SQLStore) ListAuditEvents(ctx context.Context, arg ListAuditEventsParams) ([]AuditEvent, error) {
+	events, err := store.q.ListAuditEvents(ctx, arg)
+	if err != nil {
+		return nil, err
+	}
+	return events, nil
+}
+
+func (store *SQLStore) CreateTransferApproval(ctx context.Context, arg CreateTransferApprovalParams) (TransferApproval, error) {
+	approval, err := store.q.CreateTransferApproval(ctx, arg)
+	if err != nil {
+		return TransferApproval{}, err
+	}
+	return approval, nil
+}
+
+func (store *SQLStore) GetTransferApproval(ctx context.Context, id int64) (TransferApproval, error) {
+	approval, err := store.q.GetTransferApproval(ctx, id)
+	if err != nil {
+		return TransferApproval{}, err
+	}
+	return approval, nil
+}
+
+func (store *SQLStore) GetTransferApprovalForUpdate(ctx context.Context, id int64) (TransferApproval, error) {
+	approval, err := store.q.GetTransferApprovalForUpdate(ctx, id)
+	if err != nil {
+		return TransferApproval{}, err
+	}
+	return approval, nil
+}
+
+func (store *SQLStore) GetTransferApprovalByHoldForUpdate(ctx context.Context, holdID int64) (TransferApproval, error) {
+	approval, err := store.q.GetTransferApprovalByHoldForUpdate(ctx, holdID)
+	if err != nil {
+		return TransferApproval{}, err
+	}
+	return approval, nil
+}
+
+func (store *SQLStore) ListPendingTransferApprovals(ctx context.Context, arg ListPendingTransferApprovalsParams) ([]TransferApproval, error) {
+	approvals, err := store.q.ListPendingTransferApprovals(ctx, arg)
+	if err != nil {
+		return nil, err
+	}
+	return approvals, nil
+}
+
+func (store *SQLStore) UpdateTransferApprovalStatus(ctx context.Context, arg UpdateTransferApprovalStatusParams) (TransferApproval, error) {
+	approval, err := store.q.UpdateTransferApprovalStatus(ctx, arg)
+	if err != nil {
+		return TransferApproval{}, err
+	}
+	return approval, nil
+}
@@ -0,0 +1,738 @@
+package db
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/mahanth/simplebank/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSQLStoreConformance(t *testing.T) {
+	testStoreConformance(t, NewStore(testDB))
+}
+
+func TestMemoryStoreConformance(t *testing.T) {
+	testStoreConformance(t, NewMemoryStore())
+}
+
+// testStoreConformance checks the behaviour callers rely on from every Store: the data the
+// queries return, the errors they fail with and the transactions' all-or-nothing effects. It does
+// not assume the store to be empty, so that it can run against a shared database.
+func testStoreConformance(t *testing.T, store Store) {
+	t.Run("Users", func(t *testing.T) {
+		user := createStoreUser(t, store)
+
+		got, err := store.GetUser(context.Background(), user.Username)
+		require.NoError(t, err)
+		require.Equal(t, user, got)
+		require.Equal(t, util.DepositorRole, got.Role)
+		require.False(t, got.IsEmailVerified)
+
+		_, err = store.CreateUser(context.Background(), CreateUserParams{
+			Username: user.Username,
+			FullName: util.RandomOwner(),
+			Email:    util.RandomEmail(),
+		})
+		require.Equal(t, UniqueViolation, ErrorCode(err))
+
+		_, err = store.CreateUser(context.Background(), CreateUserParams{
+			Username: util.RandomOwner(),
+			FullName: util.RandomOwner(),
+			Email:    user.Email,
+		})
+		require.Equal(t, UniqueViolation, ErrorCode(err))
+
+		updated, err := store.UpdateUser(context.Background(), UpdateUserParams{
+			Username: user.Username,
+			FullName: pgtype.Text{String: "Updated Name", Valid: true},
+		})
+		require.NoError(t, err)
+		require.Equal(t, "Updated Name", updated.FullName)
+		require.Equal(t, user.Email, updated.Email)
+
+		_, err = store.GetUser(context.Background(), util.RandomOwner())
+		require.ErrorIs(t, err, ErrRecordNotFound)
+
+		_, err = store.UpdateUser(context.Background(), UpdateUserParams{
+			Username: util.RandomOwner(),
+			FullName: pgtype.Text{String: "Nobody", Valid: true},
+		})
+		require.ErrorIs(t, err, ErrRecordNotFound)
+	})
+
+	t.Run("Accounts", func(t *testing.T) {
+		account := createStoreAccount(t, store, util.USD, 100)
+
+		got, err := store.GetAccount(context.Background(), account.ID)
+		require.NoError(t, err)
+		require.Equal(t, account, got)
+
+		accounts, err := store.ListAccounts(context.Background(), ListAccountsParams{
+			Owner: account.Owner,
+			Limit: 5,
+		})
+		require.NoError(t, err)
+		require.Equal(t, []Account{account}, accounts)
+
+		_, err = store.CreateAccount(context.Background(), CreateAccountParams{
+			Owner:    util.RandomOwner(),
+			Currency: util.USD,
+			Type:     AccountTypeChecking,
+		})
+		require.Equal(t, ForeignKeyViolation, ErrorCode(err))
+
+		// the opening balance is booked as a deposit, which keeps the account from being deleted
+		entries, err := store.ListEntries(context.Background(), ListEntriesParams{
+			AccountID: account.ID,
+			PageSize:  5,
+		})
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+		require.Equal(t, EntryTypeDeposit, entries[0].Type)
+		require.Equal(t, account.Balance, entries[0].Amount)
+
+		err = store.DeleteAccount(context.Background(), account.ID)
+		require.ErrorIs(t, err, ErrAccountNotEmpty)
+
+		_, err = store.AdjustBalanceTx(context.Background(), AdjustBalanceTxParams{
+			AccountID: account.ID,
+			Amount:    -account.Balance,
+			Type:      EntryTypeWithdrawal,
+		})
+		require.NoError(t, err)
+		err = store.DeleteAccount(context.Background(), account.ID)
+		require.Equal(t, ForeignKeyViolation, ErrorCode(err))
+
+		emptyAccount := createStoreAccount(t, store, util.USD, 0)
+		err = store.DeleteAccount(context.Background(), emptyAccount.ID)
+		require.NoError(t, err)
+
+		_, err = store.GetAccount(context.Background(), emptyAccount.ID)
+		require.ErrorIs(t, err, ErrRecordNotFound)
+	})
+
+	t.Run("LedgerWrites", func(t *testing.T) {
+		account1 := createStoreAccount(t, store, util.USD, 100)
+		account2 := createStoreAccount(t, store, util.USD, 0)
+
+		// entries and transfers written through the store move the balances with them
+		entry, err := store.CreateEntry(context.Background(), CreateEntryParams{
+			AccountID: account1.ID,
+			Amount:    20,
+			Type:      EntryTypeDeposit,
+		})
+		require.NoError(t, err)
+		require.Equal(t, int64(20), entry.Amount)
+
+		transfer, err := store.CreateTransfer(context.Background(), CreateTransferParams{
+			FromAccountID: account1.ID,
+			ToAccountID:   account2.ID,
+			Amount:        30,
+			InitiatedBy:   account1.Owner,
+		})
+		require.NoError(t, err)
+		require.Equal(t, int64(30), transfer.ToAmount)
+
+		got1, err := store.GetAccount(context.Background(), account1.ID)
+		require.NoError(t, err)
+		require.Equal(t, int64(90), got1.Balance)
+		got2, err := store.GetAccount(context.Background(), account2.ID)
+		require.NoError(t, err)
+		require.Equal(t, int64(30), got2.Balance)
+
+		// status changes are checked and recorded
+		_, err = store.UpdateAccountStatus(context.Background(), UpdateAccountStatusParams{
+			ID:     account2.ID,
+			Status: AccountStatusClosed,
+		})
+		require.ErrorIs(t, err, ErrAccountNotEmpty)
+
+		frozen, err := store.UpdateAccountStatus(context.Background(), UpdateAccountStatusParams{
+			ID:     account2.ID,
+			Status: AccountStatusFrozen,
+		})
+		require.NoError(t, err)
+		require.Equal(t, AccountStatusFrozen, frozen.Status)
+
+		changes, err := store.ListAccountStatusChanges(context.Background(), ListAccountStatusChangesParams{
+			AccountID: account2.ID,
+			Limit:     5,
+		})
+		require.NoError(t, err)
+		require.Len(t, changes, 1)
+		require.Equal(t, SystemUsername, changes[0].ChangedBy)
+	})
+
+	t.Run("TransferTx", func(t *testing.T) {
+		account1 := createStoreAccount(t, store, util.USD, 100)
+		account2 := createStoreAccount(t, store, util.USD, 100)
+
+		// transfers in both directions at once must neither deadlock nor lose an update
+		n := 10
+		amount := int64(10)
+		errs := make(chan error, n)
+		wg := sync.WaitGroup{}
+		for i := 0; i < n; i++ {
+			fromAccountID, toAccountID := account1.ID, account2.ID
+			if i%2 == 1 {
+				fromAccountID, toAccountID = account2.ID, account1.ID
+			}
+
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_, err := store.TransferTx(context.Background(), TransferTxParams{
+					FromAccountID: fromAccountID,
+					ToAccountID:   toAccountID,
+					Amount:        amount,
+				})
+				errs <- err
+			}()
+		}
+		wg.Wait()
+		for i := 0; i < n; i++ {
+			require.NoError(t, <-errs)
+		}
+
+		for _, account := range []Account{account1, account2} {
+			got, err := store.GetAccount(context.Background(), account.ID)
+			require.NoError(t, err)
+			require.Equal(t, account.Balance, got.Balance)
+
+			entries, err := store.ListEntries(context.Background(), ListEntriesParams{
+				AccountID: account.ID,
+				PageSize:  int32(n + 2),
+			})
+			require.NoError(t, err)
+			require.Len(t, entries, n+1)
+		}
+
+		result, err := store.TransferTx(context.Background(), TransferTxParams{
+			FromAccountID: account1.ID,
+			ToAccountID:   account2.ID,
+			Amount:        amount,
+		})
+		require.NoError(t, err)
+		require.Equal(t, account1.Balance-amount, result.FromAccount.Balance)
+		require.Equal(t, account2.Balance+amount, result.ToAccount.Balance)
+		require.Equal(t, -amount, result.FromEntry.Amount)
+		require.Equal(t, amount, result.ToEntry.Amount)
+
+		transfer, err := store.GetTransfer(context.Background(), result.Transfer.ID)
+		require.NoError(t, err)
+		require.Equal(t, result.Transfer, transfer)
+	})
+
+	t.Run("TransferTxRollsBack", func(t *testing.T) {
+		account1 := createStoreAccount(t, store, util.USD, 50)
+		account2 := createStoreAccount(t, store, util.USD, 0)
+		account3 := createStoreAccount(t, store, util.EUR, 0)
+
+		listEntries := func(accountID int64) []Entry {
+			entries, err := store.ListEntries(context.Background(), ListEntriesParams{
+				AccountID: accountID,
+				PageSize:  5,
+			})
+			require.NoError(t, err)
+			return entries
+		}
+		entriesBefore := map[int64][]Entry{}
+		for _, account := range []Account{account1, account2, account3} {
+			entriesBefore[account.ID] = listEntries(account.ID)
+		}
+
+		_, err := store.TransferTx(context.Background(), TransferTxParams{
+			FromAccountID: account1.ID,
+			ToAccountID:   account2.ID,
+			Amount:        51,
+		})
+		require.ErrorIs(t, err, ErrInsufficientFunds)
+
+		_, err = store.TransferTx(context.Background(), TransferTxParams{
+			FromAccountID: account1.ID,
+			ToAccountID:   account3.ID,
+			Amount:        10,
+		})
+		require.ErrorIs(t, err, ErrCurrencyMismatch)
+
+		_, err = store.TransferTx(context.Background(), TransferTxParams{
+			FromAccountID: account1.ID,
+			ToAccountID:   account1.ID + 1_000_000_000,
+			Amount:        10,
+		})
+		require.ErrorIs(t, err, ErrRecordNotFound)
+
+		// nothing must have been written by the failed transfers
+		for _, account := range []Account{account1, account2, account3} {
+			got, err := store.GetAccount(context.Background(), account.ID)
+			require.NoError(t, err)
+			require.Equal(t, account.Balance, got.Balance)
+			require.Equal(t, entriesBefore[account.ID], listEntries(account.ID))
+		}
+	})
+
+	t.Run("TransferTxIdempotencyKey", func(t *testing.T) {
+		account1 := createStoreAccount(t, store, util.USD, 100)
+		account2 := createStoreAccount(t, store, util.USD, 100)
+
+		arg := TransferTxParams{
+			FromAccountID:  account1.ID,
+			ToAccountID:    account2.ID,
+			Amount:         10,
+			Username:       account1.Owner,
+			IdempotencyKey: util.RandomString(16),
+		}
+		result1, err := store.TransferTx(context.Background(), arg)
+		require.NoError(t, err)
+
+		result2, err := store.TransferTx(context.Background(), arg)
+		require.NoError(t, err)
+		require.Equal(t, result1.Transfer.ID, result2.Transfer.ID)
+
+		arg.Amount = 20
+		_, err = store.TransferTx(context.Background(), arg)
+		require.ErrorIs(t, err, ErrIdempotencyKeyReused)
+
+		got, err := store.GetAccount(context.Background(), account1.ID)
+		require.NoError(t, err)
+		require.Equal(t, account1.Balance-10, got.Balance)
+	})
+
+	t.Run("AccountTransferLimits", func(t *testing.T) {
+		banker := createStoreUser(t, store)
+		holder := createStoreUser(t, store)
+		account1 := createStoreAccount(t, store, util.USD, 1000)
+		account2, err := store.CreateAccount(context.Background(), CreateAccountParams{
+			Owner:    account1.Owner,
+			Balance:  1000,
+			Currency: util.USD,
+			Type:     AccountTypeChecking,
+		})
+		require.NoError(t, err)
+		receiver := createStoreAccount(t, store, util.USD, 0)
+
+		_, err = store.UpsertAccountTransferLimit(context.Background(), UpsertAccountTransferLimitParams{
+			AccountID:     pgtype.Int8{Int64: account1.ID, Valid: true},
+			MaxDailyTotal: pgtype.Int8{Int64: 500, Valid: true},
+			UpdatedBy:     banker.Username,
+		})
+		require.NoError(t, err)
+
+		transfer := func(from Account, username string, amount int64) error {
+			_, err := store.TransferTx(context.Background(), TransferTxParams{
+				FromAccountID: from.ID,
+				ToAccountID:   receiver.ID,
+				Amount:        amount,
+				Username:      username,
+			})
+			return err
+		}
+
+		// what the owner sends from another account does not count against this one
+		require.NoError(t, transfer(account2, account1.Owner, 400))
+		require.NoError(t, transfer(account1, account1.Owner, 300))
+
+		// the holders of the account share its daily total
+		err = transfer(account1, holder.Username, 300)
+		var limitErr *TransferLimitError
+		require.ErrorAs(t, err, &limitErr)
+		require.Equal(t, LimitDailyTotal, limitErr.Limit)
+		require.Equal(t, LimitScopeAccount, limitErr.Scope)
+		require.Equal(t, holder.Username, limitErr.Username)
+		require.Equal(t, int64(300), limitErr.Used)
+		require.Equal(t, int64(200), limitErr.Headroom)
+
+		require.NoError(t, transfer(account1, holder.Username, 200))
+	})
+
+	t.Run("HolderTransferLimit", func(t *testing.T) {
+		account1 := createStoreAccount(t, store, util.USD, 100)
+		account2 := createStoreAccount(t, store, util.USD, 0)
+		holder := createStoreUser(t, store)
+
+		_, err := store.CreateAccountHolder(context.Background(), CreateAccountHolderParams{
+			AccountID:     account1.ID,
+			Username:      holder.Username,
+			Permission:    HolderPermissionTransfer,
+			TransferLimit: pgtype.Int8{Int64: 30, Valid: true},
+			InvitedBy:     account1.Owner,
+		})
+		require.NoError(t, err)
+		_, err = store.AcceptAccountHolder(context.Background(), AcceptAccountHolderParams{
+			AccountID: account1.ID,
+			Username:  holder.Username,
+		})
+		require.NoError(t, err)
+
+		transfer := TransferTxParams{
+			FromAccountID: account1.ID,
+			ToAccountID:   account2.ID,
+			Amount:        40,
+			Username:      holder.Username,
+		}
+		_, err = store.TransferTx(context.Background(), transfer)
+		var limitErr *TransferLimitError
+		require.ErrorAs(t, err, &limitErr)
+		require.Equal(t, LimitScopeHolder, limitErr.Scope)
+		require.Equal(t, int64(30), limitErr.Max)
+
+		_, err = store.PlaceHoldTx(context.Background(), PlaceHoldTxParams{
+			AccountID:   account1.ID,
+			ToAccountID: account2.ID,
+			Amount:      40,
+			ExpiresAt:   time.Now().Add(time.Hour),
+			PlacedBy:    holder.Username,
+		})
+		require.ErrorAs(t, err, &limitErr)
+
+		// the limit binds the holder, not the owner
+		transfer.Amount = 30
+		_, err = store.TransferTx(context.Background(), transfer)
+		require.NoError(t, err)
+		transfer.Amount = 40
+		transfer.Username = account1.Owner
+		_, err = store.TransferTx(context.Background(), transfer)
+		require.NoError(t, err)
+	})
+
+	t.Run("CreateUserTx", func(t *testing.T) {
+		user := createStoreUser(t, store)
+
+		arg := CreateUserTxParams{
+			CreateUserParams: CreateUserParams{
+				Username: util.RandomOwner(),
+				FullName: util.RandomOwner(),
+				Email:    util.RandomEmail(),
+			},
+		}
+		result, err := store.CreateUserTx(context.Background(), arg)
+		require.NoError(t, err)
+		require.Equal(t, arg.Username, result.User.Username)
+
+		got, err := store.GetUser(context.Background(), arg.Username)
+		require.NoError(t, err)
+		require.Equal(t, result.User, got)
+
+		// a user taking someone's email must not be created
+		arg.Username = util.RandomOwner()
+		arg.Email = user.Email
+		_, err = store.CreateUserTx(context.Background(), arg)
+		require.Equal(t, UniqueViolation, ErrorCode(err))
+
+		_, err = store.GetUser(context.Background(), arg.Username)
+		require.ErrorIs(t, err, ErrRecordNotFound)
+	})
+
+	t.Run("VerifyEmailTx", func(t *testing.T) {
+		user := createStoreUser(t, store)
+
+		verifyEmail, err := store.CreateVerifyEmail(context.Background(), CreateVerifyEmailParams{
+			Username:   user.Username,
+			Email:      user.Email,
+			SecretCode: util.RandomString(32),
+		})
+		require.NoError(t, err)
+		require.False(t, verifyEmail.IsUsed)
+
+		_, err = store.CreateVerifyEmail(context.Background(), CreateVerifyEmailParams{
+			Username:   util.RandomOwner(),
+			Email:      user.Email,
+			SecretCode: util.RandomString(32),
+		})
+		require.Equal(t, ForeignKeyViolation, ErrorCode(err))
+
+		_, err = store.VerifyEmailTx(context.Background(), VerifyEmailTxParams{
+			EmailId:    verifyEmail.ID,
+			SecretCode: util.RandomString(32),
+		})
+		require.ErrorIs(t, err, ErrRecordNotFound)
+
+		result, err := store.VerifyEmailTx(context.Background(), VerifyEmailTxParams{
+			EmailId:    verifyEmail.ID,
+			SecretCode: verifyEmail.SecretCode,
+		})
+		require.NoError(t, err)
+		require.True(t, result.VerifyEmail.IsUsed)
+
+		got, err := store.GetUser(context.Background(), user.Username)
+		require.NoError(t, err)
+		require.True(t, got.IsEmailVerified)
+
+		// a code can be used once
+		_, err = store.VerifyEmailTx(context.Background(), VerifyEmailTxParams{
+			EmailId:    verifyEmail.ID,
+			SecretCode: verifyEmail.SecretCode,
+		})
+		require.ErrorIs(t, err, ErrRecordNotFound)
+	})
+
+	t.Run("TransferApproval", func(t *testing.T) {
+		account1 := createStoreAccount(t, store, util.USD, 100)
+		account2 := createStoreAccount(t, store, util.USD, 0)
+		banker := createStoreUser(t, store)
+
+		requestApproval := func(amount int64, expiresAt time.Time) RequestTransferApprovalTxResult {
+			result, err := store.RequestTransferApprovalTx(context.Background(), RequestTransferApprovalTxParams{
+				TransferTxParams: TransferTxParams{
+					FromAccountID: account1.ID,
+					ToAccountID:   account2.ID,
+					Amount:        amount,
+					Username:      account1.Owner,
+				},
+				ExpiresAt: expiresAt,
+			})
+			require.NoError(t, err)
+			require.Equal(t, TransferApprovalStatusPending, result.Approval.Status)
+			require.Equal(t, result.Hold.ID, result.Approval.HoldID)
+			return result
+		}
+
+		requested := requestApproval(60, time.Now().Add(time.Hour))
+		require.Equal(t, int64(60), requested.FromAccount.HeldAmount)
+
+		// the held funds are not available to another transfer
+		_, err := store.TransferTx(context.Background(), TransferTxParams{
+			FromAccountID: account1.ID,
+			ToAccountID:   account2.ID,
+			Amount:        50,
+		})
+		require.ErrorIs(t, err, ErrInsufficientFunds)
+
+		// nor can the hold be settled other than by deciding the approval
+		_, err = store.CaptureHoldTx(context.Background(), CaptureHoldTxParams{HoldID: requested.Hold.ID, Amount: 60})
+		require.ErrorIs(t, err, ErrHoldAwaitingApproval)
+		_, err = store.ReleaseHoldTx(context.Background(), ReleaseHoldTxParams{HoldID: requested.Hold.ID})
+		require.ErrorIs(t, err, ErrHoldAwaitingApproval)
+
+		_, err = store.ApproveTransferTx(context.Background(), DecideTransferApprovalTxParams{
+			ApprovalID: requested.Approval.ID,
+			DecidedBy:  account1.Owner,
+		})
+		require.ErrorIs(t, err, ErrSelfApproval)
+
+		approved, err := store.ApproveTransferTx(context.Background(), DecideTransferApprovalTxParams{
+			ApprovalID: requested.Approval.ID,
+			DecidedBy:  banker.Username,
+		})
+		require.NoError(t, err)
+		require.Equal(t, TransferApprovalStatusApproved, approved.Approval.Status)
+		require.Equal(t, banker.Username, approved.Approval.DecidedBy.String)
+		require.Equal(t, approved.Transfer.ID, approved.Approval.TransferID.Int64)
+		require.Equal(t, int64(40), approved.FromAccount.Balance)
+		require.Zero(t, approved.FromAccount.HeldAmount)
+		require.Equal(t, int64(60), approved.ToAccount.Balance)
+
+		_, err = store.RejectTransferTx(context.Background(), DecideTransferApprovalTxParams{
+			ApprovalID: requested.Approval.ID,
+			DecidedBy:  banker.Username,
+		})
+		require.ErrorIs(t, err, ErrApprovalNotPending)
+
+		requested = requestApproval(30, time.Now().Add(time.Hour))
+		rejected, err := store.RejectTransferTx(context.Background(), DecideTransferApprovalTxParams{
+			ApprovalID: requested.Approval.ID,
+			DecidedBy:  banker.Username,
+			Reason:     "unexpected beneficiary",
+		})
+		require.NoError(t, err)
+		require.Equal(t, TransferApprovalStatusRejected, rejected.Approval.Status)
+		require.Equal(t, "unexpected beneficiary", rejected.Approval.Reason)
+		require.Equal(t, int64(40), rejected.FromAccount.Balance)
+		require.Zero(t, rejected.FromAccount.HeldAmount)
+
+		// an expired hold expires the approval it secured
+		requested = requestApproval(30, time.Now().Add(-time.Second))
+		_, err = store.ApproveTransferTx(context.Background(), DecideTransferApprovalTxParams{
+			ApprovalID: requested.Approval.ID,
+			DecidedBy:  banker.Username,
+		})
+		require.ErrorIs(t, err, ErrApprovalNotPending)
+
+		released, err := store.ReleaseHoldTx(context.Background(), ReleaseHoldTxParams{HoldID: requested.Hold.ID, Expired: true})
+		require.NoError(t, err)
+		require.Zero(t, released.Account.HeldAmount)
+
+		expired, err := store.GetTransferApproval(context.Background(), requested.Approval.ID)
+		require.NoError(t, err)
+		require.Equal(t, TransferApprovalStatusExpired, expired.Status)
+
+		pending, err := store.ListPendingTransferApprovals(context.Background(), ListPendingTransferApprovalsParams{
+			Limit: 100,
+		})
+		require.NoError(t, err)
+		for _, approval := range pending {
+			require.NotEqual(t, account1.ID, approval.FromAccountID)
+		}
+	})
+
+	t.Run("ApprovalThreshold", func(t *testing.T) {
+		currency, err := store.GetCurrency(context.Background(), util.CAD)
+		require.NoError(t, err)
+
+		setThreshold := func(threshold pgtype.Int8) {
+			_, err := store.UpdateCurrencyApprovalThreshold(context.Background(), UpdateCurrencyApprovalThresholdParams{
+				Code:              util.CAD,
+				ApprovalThreshold: threshold,
+			})
+			require.NoError(t, err)
+		}
+		setThreshold(pgtype.Int8{Int64: 50, Valid: true})
+		t.Cleanup(func() { setThreshold(currency.ApprovalThreshold) })
+
+		account1 := createStoreAccount(t, store, util.CAD, 100)
+		account2 := createStoreAccount(t, store, util.CAD, 0)
+		banker := createStoreUser(t, store)
+
+		transfer := TransferTxParams{
+			FromAccountID: account1.ID,
+			ToAccountID:   account2.ID,
+			Amount:        60,
+			Username:      account1.Owner,
+		}
+		_, err = store.TransferTx(context.Background(), transfer)
+		require.ErrorIs(t, err, ErrApprovalRequired)
+
+		hold := PlaceHoldTxParams{
+			AccountID:   account1.ID,
+			ToAccountID: account2.ID,
+			Amount:      60,
+			ExpiresAt:   time.Now().Add(time.Hour),
+		}
+		_, err = store.PlaceHoldTx(context.Background(), hold)
+		require.ErrorIs(t, err, ErrApprovalRequired)
+
+		// a hold is captured against the threshold in force at the time
+		hold.Amount = 40
+		placed, err := store.PlaceHoldTx(context.Background(), hold)
+		require.NoError(t, err)
+		setThreshold(pgtype.Int8{Int64: 30, Valid: true})
+		_, err = store.CaptureHoldTx(context.Background(), CaptureHoldTxParams{HoldID: placed.Hold.ID, Amount: 40})
+		require.ErrorIs(t, err, ErrApprovalRequired)
+		_, err = store.ReleaseHoldTx(context.Background(), ReleaseHoldTxParams{HoldID: placed.Hold.ID})
+		require.NoError(t, err)
+
+		// the transfer can still be made once a banker approves it
+		requested, err := store.RequestTransferApprovalTx(context.Background(), RequestTransferApprovalTxParams{
+			TransferTxParams: transfer,
+			ExpiresAt:        time.Now().Add(time.Hour),
+		})
+		require.NoError(t, err)
+		approved, err := store.ApproveTransferTx(context.Background(), DecideTransferApprovalTxParams{
+			ApprovalID: requested.Approval.ID,
+			DecidedBy:  banker.Username,
+		})
+		require.NoError(t, err)
+		require.Equal(t, int64(60), approved.Transfer.Amount)
+		require.Equal(t, int64(60), approved.ToAccount.Balance)
+	})
+
+	t.Run("AuditLog", func(t *testing.T) {
+		createStoreUser(t, store)
+
+		requireLastAudit := func(action, targetType string, targetID int64) {
+			event, err := store.GetLastAuditEvent(context.Background())
+			require.NoError(t, err)
+			require.Equal(t, action, event.Action)
+			require.Equal(t, targetType, event.TargetType)
+			require.Equal(t, strconv.FormatInt(targetID, 10), event.TargetID)
+		}
+
+		account1 := createStoreAccount(t, store, util.USD, 100)
+		account2 := createStoreAccount(t, store, util.USD, 0)
+
+		_, err := store.AdjustBalanceTx(context.Background(), AdjustBalanceTxParams{
+			AccountID: account1.ID,
+			Amount:    10,
+			Type:      EntryTypeDeposit,
+		})
+		require.NoError(t, err)
+		requireLastAudit(AuditAccountUpdate, "account", account1.ID)
+
+		placed, err := store.PlaceHoldTx(context.Background(), PlaceHoldTxParams{
+			AccountID:   account1.ID,
+			ToAccountID: account2.ID,
+			Amount:      20,
+			ExpiresAt:   time.Now().Add(time.Hour),
+		})
+		require.NoError(t, err)
+		requireLastAudit(AuditAccountHoldCreate, "account_hold", placed.Hold.ID)
+
+		_, err = store.ReleaseHoldTx(context.Background(), ReleaseHoldTxParams{HoldID: placed.Hold.ID})
+		require.NoError(t, err)
+		requireLastAudit(AuditAccountHoldUpdate, "account_hold", placed.Hold.ID)
+
+		_, err = store.ChangeAccountStatusTx(context.Background(), ChangeAccountStatusTxParams{
+			AccountID: account1.ID,
+			Status:    AccountStatusFrozen,
+			ChangedBy: account1.Owner,
+		})
+		require.NoError(t, err)
+		requireLastAudit(AuditAccountUpdate, "account", account1.ID)
+
+		holder := createStoreUser(t, store)
+		holderKey := GetAccountHolderParams{AccountID: account2.ID, Username: holder.Username}
+		invited, err := store.CreateAccountHolder(context.Background(), CreateAccountHolderParams{
+			AccountID:  account2.ID,
+			Username:   holder.Username,
+			Permission: HolderPermissionTransfer,
+			InvitedBy:  account2.Owner,
+		})
+		require.NoError(t, err)
+		requireLastAudit(AuditAccountHolderCreate, "account_holder", invited.ID)
+
+		_, err = store.AcceptAccountHolder(context.Background(), AcceptAccountHolderParams(holderKey))
+		require.NoError(t, err)
+		requireLastAudit(AuditAccountHolderUpdate, "account_holder", invited.ID)
+
+		_, err = store.DeleteAccountHolder(context.Background(), DeleteAccountHolderParams(holderKey))
+		require.NoError(t, err)
+		requireLastAudit(AuditAccountHolderDelete, "account_holder", invited.ID)
+
+		// the signing secret of a webhook is kept out of the log
+		subscription, err := store.CreateWebhookSubscription(context.Background(), CreateWebhookSubscriptionParams{
+			Owner:      account2.Owner,
+			Url:        "https://example.com/hooks",
+			EventTypes: []string{"transfer.received"},
+			Secret:     "webhook-signing-secret",
+		})
+		require.NoError(t, err)
+		requireLastAudit(AuditWebhookSubscriptionCreate, "webhook_subscription", subscription.ID)
+		event, err := store.GetLastAuditEvent(context.Background())
+		require.NoError(t, err)
+		require.NotContains(t, string(event.After), subscription.Secret)
+
+		result, err := store.VerifyAuditLog(context.Background())
+		require.NoError(t, err)
+		require.Nil(t, result.Tampered)
+		require.NotZero(t, result.EventsVerified)
+	})
+}
+
+func createStoreUser(t *testing.T, store Store) User {
+	result, err := store.CreateUserTx(context.Background(), CreateUserTxParams{
+		CreateUserParams: CreateUserParams{
+			Username:       util.RandomOwner(),
+			HashedPassword: util.RandomString(32),
+			FullName:       util.RandomOwner(),
+			Email:          util.RandomEmail(),
+		},
+	})
+	require.NoError(t, err)
+	return result.User
+}
+
+func createStoreAccount(t *testing.T, store Store, currency string, balance int64) Account {
+	account, err := store.CreateAccount(context.Background(), CreateAccountParams{
+		Owner:    createStoreUser(t, store).Username,
+		Balance:  balance,
+		Currency: currency,
+		Type:     AccountTypeChecking,
+	})
+	require.NoError(t, err)
+	return account
+}
@@ -5,18 +5,21 @@ import (
 	"fmt"
 	"sync"
 	"testing"
+	"time"
 
+	"github.com/jackc/pgx/v5"
+	"github.com/mahanth/simplebank/util"
 	"github.com/stretchr/testify/require"
 )
 
 func TestTransferTx(t *testing.T) {
 	// create two accounts to transfer between
-	account1 := createRandomAccount(t)
+	account1 := createRandomAccountWithCurrency(t, util.USD, util.RandomInt(100, 1000))
 	require.NotEmpty(t, account1)
 	require.NotZero(t, account1.ID)
 
 	// Create a second account to transfer to
-	account2 := createRandomAccount(t)
+	account2 := createRandomAccountWithCurrency(t, util.USD, util.RandomInt(100, 1000))
 
 	require.NotEmpty(t, account2)
 	require.NotZero(t, account2.ID)
@@ -27,8 +30,8 @@ func TestTransferTx(t *testing.T) {
 	//run a concurrent transfer transaction
 
 	totalTransfers := 5
-	errs := make(chan error)
-	results := make(chan TransferTxResult)
+	errs := make(chan error, totalTransfers)
+	results := make(chan TransferTxResult, totalTransfers)
 	amount := int64(10)
 
 	// Create a wait group to wait for all goroutines to finish
@@ -36,8 +39,8 @@ func TestTransferTx(t *testing.T) {
 
 	for i := 0; i < totalTransfers; i++ {
 
+		wg.Add(1)
 		go func() {
-			wg.Add(1)
 			defer wg.Done()
 			arg := TransferTxParams{
 				FromAccountID: account1.ID,
@@ -84,6 +87,10 @@ func TestTransferTx(t *testing.T) {
 		require.NotZero(t, result.ToEntry.ID)
 		require.NotZero(t, result.FromEntry.CreatedAt)
 		require.NotZero(t, result.ToEntry.CreatedAt)
+		require.Equal(t, EntryTypeTransfer, result.FromEntry.Type)
+		require.Equal(t, EntryTypeTransfer, result.ToEntry.Type)
+		require.Equal(t, result.Transfer.ID, result.FromEntry.TransferID.Int64)
+		require.Equal(t, result.Transfer.ID, result.ToEntry.TransferID.Int64)
 
 		//TODO: check the final balances of the accounts
 		//check accounts
@@ -123,12 +130,12 @@ func TestTransferTx(t *testing.T) {
 
 func TestTransferTxDeadlock(t *testing.T) {
 	// create two accounts to transfer between
-	account1 := createRandomAccount(t)
+	account1 := createRandomAccountWithCurrency(t, util.USD, util.RandomInt(100, 1000))
 	require.NotEmpty(t, account1)
 	require.NotZero(t, account1.ID)
 
 	// Create a second account to transfer to
-	account2 := createRandomAccount(t)
+	account2 := createRandomAccountWithCurrency(t, util.USD, util.RandomInt(100, 1000))
 
 	require.NotEmpty(t, account2)
 	require.NotZero(t, account2.ID)
@@ -192,3 +199,172 @@ func TestTransferTxDeadlock(t *testing.T) {
 
 	fmt.Println(">> after transfer: ", finalAccount1.Balance, finalAccount2.Balance)
 }
+
+func TestTransferTxSerializable(t *testing.T) {
+	account1 := createRandomAccountWithCurrency(t, util.USD, 1000)
+	account2 := createRandomAccountWithCurrency(t, util.USD, 1000)
+
+	// under serializable isolation, transfers that wait on each other's row locks fail with
+	// serialization failures and only succeed because they are retried
+	store := NewStore(testDB, WithTxRetryPolicy(TxRetryPolicy{
+		MaxRetries: 50,
+		BaseDelay:  time.Millisecond,
+		MaxDelay:   50 * time.Millisecond,
+	}))
+	ctx := WithTxIsolation(context.Background(), pgx.Serializable)
+
+	totalTransfers := 40
+	errs := make(chan error)
+	for i := 0; i < totalTransfers; i++ {
+		fromAccountID, toAccountID := account1.ID, account2.ID
+		if i%2 == 1 {
+			fromAccountID, toAccountID = toAccountID, fromAccountID
+		}
+		go func() {
+			_, err := store.TransferTx(ctx, TransferTxParams{
+				FromAccountID: fromAccountID,
+				ToAccountID:   toAccountID,
+				Amount:        10,
+			})
+			errs <- err
+		}()
+	}
+	for i := 0; i < totalTransfers; i++ {
+		require.NoError(t, <-errs)
+	}
+
+	for _, account := range []Account{account1, account2} {
+		updated, err := testQueries.GetAccount(context.Background(), account.ID)
+		require.NoError(t, err)
+		require.Equal(t, account.Balance, updated.Balance)
+	}
+
+	stats := store.TxStats()
+	require.Zero(t, stats.Exhausted)
+	require.Equal(t, stats.Deadlocks+stats.SerializationFailures, stats.Retries)
+	fmt.Println(">> retries: ", stats.Retries)
+}
+
+func TestTransferTxInsufficientFunds(t *testing.T) {
+	account1 := createRandomAccountWithCurrency(t, util.USD, 50)
+	account2 := createRandomAccountWithCurrency(t, util.USD, 0)
+
+	store := NewStore(testDB)
+
+	_, err := store.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        51,
+	})
+	require.Error(t, err)
+	require.ErrorIs(t, err, ErrInsufficientFunds)
+
+	// nothing must have been written by the failed transfer
+	finalAccount1, err := testQueries.GetAccount(context.Background(), account1.ID)
+	require.NoError(t, err)
+	require.Equal(t, account1.Balance, finalAccount1.Balance)
+
+	finalAccount2, err := testQueries.GetAccount(context.Background(), account2.ID)
+	require.NoError(t, err)
+	require.Equal(t, account2.Balance, finalAccount2.Balance)
+}
+
+func TestTransferTxOverdraftLimit(t *testing.T) {
+	account1 := createRandomAccountWithCurrency(t, util.USD, 30)
+	account2 := createRandomAccountWithCurrency(t, util.USD, 0)
+
+	account1, err := testQueries.UpdateAccountOverdraftLimit(context.Background(), UpdateAccountOverdraftLimitParams{
+		ID:             account1.ID,
+		OverdraftLimit: 20,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(20), account1.OverdraftLimit)
+
+	store := NewStore(testDB)
+
+	// 10 concurrent transfers of 10 against 30 + 20 of headroom: exactly 5 must succeed
+	totalTransfers := 10
+	amount := int64(10)
+	errs := make(chan error, totalTransfers)
+
+	for i := 0; i < totalTransfers; i++ {
+		go func() {
+			_, err := store.TransferTx(context.Background(), TransferTxParams{
+				FromAccountID: account1.ID,
+				ToAccountID:   account2.ID,
+				Amount:        amount,
+			})
+			errs <- err
+		}()
+	}
+
+	succeeded := 0
+	for i := 0; i < totalTransfers; i++ {
+		err := <-errs
+		if err == nil {
+			succeeded++
+			continue
+		}
+		require.ErrorIs(t, err, ErrInsufficientFunds)
+	}
+	require.Equal(t, 5, succeeded)
+
+	finalAccount1, err := testQueries.GetAccount(context.Background(), account1.ID)
+	require.NoError(t, err)
+	require.Equal(t, int64(-20), finalAccount1.Balance)
+
+	finalAccount2, err := testQueries.GetAccount(context.Background(), account2.ID)
+	require.NoError(t, err)
+	require.Equal(t, int64(50), finalAccount2.Balance)
+}
+
+func TestTransferTxIdempotencyKey(t *testing.T) {
+	account1 := createRandomAccountWithCurrency(t, util.USD, util.RandomInt(100, 1000))
+	account2 := createRandomAccountWithCurrency(t, util.USD, util.RandomInt(100, 1000))
+
+	store := NewStore(testDB)
+
+	arg := TransferTxParams{
+		FromAccountID:  account1.ID,
+		ToAccountID:    account2.ID,
+		Amount:         10,
+		Username:       account1.Owner,
+		IdempotencyKey: util.RandomString(16),
+	}
+
+	// concurrent retries with the same key must all see the same transfer
+	n := 5
+	errs := make(chan error, n)
+	results := make(chan TransferTxResult, n)
+	for i := 0; i < n; i++ {
+		go func() {
+			result, err := store.TransferTx(context.Background(), arg)
+			errs <- err
+			results <- result
+		}()
+	}
+
+	var transferID int64
+	for i := 0; i < n; i++ {
+		require.NoError(t, <-errs)
+		result := <-results
+		require.NotZero(t, result.Transfer.ID)
+		if transferID == 0 {
+			transferID = result.Transfer.ID
+		}
+		require.Equal(t, transferID, result.Transfer.ID)
+	}
+
+	finalAccount1, err := testQueries.GetAccount(context.Background(), account1.ID)
+	require.NoError(t, err)
+	require.Equal(t, account1.Balance-arg.Amount, finalAccount1.Balance)
+
+	// the same key with a different payload is a conflict
+	arg.Amount = 20
+	_, err = store.TransferTx(context.Background(), arg)
+	require.ErrorIs(t, err, ErrIdempotencyKeyReused)
+
+	finalAccount1, err = testQueries.GetAccount(context.Background(), account1.ID)
+	require.NoError(t, err)
+	require.Equal(t, account1.Balance-10, finalAccount1.Balance)
+}
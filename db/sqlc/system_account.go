@@ -0,0 +1,45 @@
+package db
+
+import "context"
+
+// The bank's own accounts belong to these users. Neither can be registered through the API or log in.
+const (
+	// SystemUsername owns the accounts interest is paid from.
+	SystemUsername = "bank-system"
+	// RevenueUsername owns the accounts transfer fees are paid into.
+	RevenueUsername = "bank-revenue"
+)
+
+// Types recorded in accounts.type.
+const (
+	AccountTypeChecking = "checking"
+	AccountTypeSavings  = "savings"
+)
+
+// IsSupportedAccountType reports whether accountType is one an account can be opened as.
+func IsSupportedAccountType(accountType string) bool {
+	switch accountType {
+	case AccountTypeChecking, AccountTypeSavings:
+		return true
+	}
+	return false
+}
+
+// bankAccount returns the account owner, one of the bank's users, holds in currency, opening it on first use.
+// The system account may go below zero: its balance is what the bank paid out of its own funds.
+func (q txQueries) bankAccount(ctx context.Context, owner, currency string) (Account, error) {
+	err := q.EnsureAccount(ctx, EnsureAccountParams{
+		Owner:    owner,
+		Currency: currency,
+		Type:     AccountTypeChecking,
+	})
+	if err != nil {
+		return Account{}, err
+	}
+
+	return q.GetOwnerAccount(ctx, GetOwnerAccountParams{
+		Owner:    owner,
+		Currency: currency,
+		Type:     AccountTypeChecking,
+	})
+}
@@ -7,26 +7,53 @@ package db
 
 import (
 	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 const createTransfer = `-- name: CreateTransfer :one
 INSERT INTO transfers (
     from_account_id,
     to_account_id,
-    amount
+    amount,
+    to_amount,
+    exchange_rate,
+    fee_amount,
+    fee_rule_id,
+    memo,
+    reference,
+    initiated_by
 ) VALUES (
-    $1, $2, $3
-) RETURNING id, from_account_id, to_account_id, amount, created_at
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10
+) RETURNING id, from_account_id, to_account_id, amount, created_at, to_amount, exchange_rate, reversed_amount, status, fee_amount, fee_rule_id, memo, reference, initiated_by
 `
 
 type CreateTransferParams struct {
-	FromAccountID int64 `json:"from_account_id"`
-	ToAccountID   int64 `json:"to_account_id"`
-	Amount        int64 `json:"amount"`
+	FromAccountID int64          `json:"from_account_id"`
+	ToAccountID   int64          `json:"to_account_id"`
+	Amount        int64          `json:"amount"`
+	ToAmount      int64          `json:"to_amount"`
+	ExchangeRate  pgtype.Numeric `json:"exchange_rate"`
+	FeeAmount     int64          `json:"fee_amount"`
+	FeeRuleID     pgtype.Int8    `json:"fee_rule_id"`
+	Memo          string         `json:"memo"`
+	Reference     string         `json:"reference"`
+	InitiatedBy   string         `json:"initiated_by"`
 }
 
 func (q *Queries) CreateTransfer(ctx context.Context, arg CreateTransferParams) (Transfer, error) {
-	row := q.db.QueryRow(ctx, createTransfer, arg.FromAccountID, arg.ToAccountID, arg.Amount)
+	row := q.db.QueryRow(ctx, createTransfer,
+		arg.FromAccountID,
+		arg.ToAccountID,
+		arg.Amount,
+		arg.ToAmount,
+		arg.ExchangeRate,
+		arg.FeeAmount,
+		arg.FeeRuleID,
+		arg.Memo,
+		arg.Reference,
+		arg.InitiatedBy,
+	)
 	var i Transfer
 	err := row.Scan(
 		&i.ID,
@@ -34,12 +61,21 @@ func (q *Queries) CreateTransfer(ctx context.Context, arg CreateTransferParams)
 		&i.ToAccountID,
 		&i.Amount,
 		&i.CreatedAt,
+		&i.ToAmount,
+		&i.ExchangeRate,
+		&i.ReversedAmount,
+		&i.Status,
+		&i.FeeAmount,
+		&i.FeeRuleID,
+		&i.Memo,
+		&i.Reference,
+		&i.InitiatedBy,
 	)
 	return i, err
 }
 
 const getTransfer = `-- name: GetTransfer :one
-SELECT id, from_account_id, to_account_id, amount, created_at FROM transfers WHERE id = $1 LIMIT 1
+SELECT id, from_account_id, to_account_id, amount, created_at, to_amount, exchange_rate, reversed_amount, status, fee_amount, fee_rule_id, memo, reference, initiated_by FROM transfers WHERE id = $1 LIMIT 1
 `
 
 func (q *Queries) GetTransfer(ctx context.Context, id int64) (Transfer, error) {
@@ -51,31 +87,92 @@ func (q *Queries) GetTransfer(ctx context.Context, id int64) (Transfer, error) {
 		&i.ToAccountID,
 		&i.Amount,
 		&i.CreatedAt,
+		&i.ToAmount,
+		&i.ExchangeRate,
+		&i.ReversedAmount,
+		&i.Status,
+		&i.FeeAmount,
+		&i.FeeRuleID,
+		&i.Memo,
+		&i.Reference,
+		&i.InitiatedBy,
+	)
+	return i, err
+}
+
+const getTransferForUpdate = `-- name: GetTransferForUpdate :one
+SELECT id, from_account_id, to_account_id, amount, created_at, to_amount, exchange_rate, reversed_amount, status, fee_amount, fee_rule_id, memo, reference, initiated_by FROM transfers
+WHERE id = $1 LIMIT 1
+FOR NO KEY UPDATE
+`
+
+func (q *Queries) GetTransferForUpdate(ctx context.Context, id int64) (Transfer, error) {
+	row := q.db.QueryRow(ctx, getTransferForUpdate, id)
+	var i Transfer
+	err := row.Scan(
+		&i.ID,
+		&i.FromAccountID,
+		&i.ToAccountID,
+		&i.Amount,
+		&i.CreatedAt,
+		&i.ToAmount,
+		&i.ExchangeRate,
+		&i.ReversedAmount,
+		&i.Status,
+		&i.FeeAmount,
+		&i.FeeRuleID,
+		&i.Memo,
+		&i.Reference,
+		&i.InitiatedBy,
 	)
 	return i, err
 }
 
 const listTransfers = `-- name: ListTransfers :many
-SELECT id, from_account_id, to_account_id, amount, created_at FROM transfers
-WHERE from_account_id = $1 OR to_account_id = $2
-ORDER BY id
-LIMIT $3
-OFFSET $4
+SELECT id, from_account_id, to_account_id, amount, created_at, to_amount, exchange_rate, reversed_amount, status, fee_amount, fee_rule_id, memo, reference, initiated_by FROM transfers
+WHERE (from_account_id = $1 OR to_account_id = $1)
+  AND ($2::bigint IS NULL OR id < $2)
+  AND ($3::timestamptz IS NULL OR created_at >= $3)
+  AND ($4::timestamptz IS NULL OR created_at < $4)
+  AND ($5::varchar IS NULL
+    OR ($5 = 'out' AND from_account_id = $1)
+    OR ($5 = 'in' AND to_account_id = $1))
+  AND ($6::bigint IS NULL
+    OR (from_account_id = $1 AND to_account_id = $6)
+    OR (to_account_id = $1 AND from_account_id = $6))
+  AND ($7::bigint IS NULL
+    OR CASE WHEN from_account_id = $1 THEN amount ELSE to_amount END >= $7)
+  AND ($8::bigint IS NULL
+    OR CASE WHEN from_account_id = $1 THEN amount ELSE to_amount END <= $8)
+ORDER BY id DESC
+LIMIT $9
 `
 
 type ListTransfersParams struct {
-	FromAccountID int64 `json:"from_account_id"`
-	ToAccountID   int64 `json:"to_account_id"`
-	Limit         int32 `json:"limit"`
-	Offset        int32 `json:"offset"`
+	AccountID             int64              `json:"account_id"`
+	BeforeID              pgtype.Int8        `json:"before_id"`
+	StartTime             pgtype.Timestamptz `json:"start_time"`
+	EndTime               pgtype.Timestamptz `json:"end_time"`
+	Direction             pgtype.Text        `json:"direction"`
+	CounterpartyAccountID pgtype.Int8        `json:"counterparty_account_id"`
+	MinAmount             pgtype.Int8        `json:"min_amount"`
+	MaxAmount             pgtype.Int8        `json:"max_amount"`
+	PageSize              int32              `json:"page_size"`
 }
 
+// Transfers in or out of an account, newest first. Amounts are filtered as the account sees them:
+// the amount sent for outgoing transfers and the amount received for incoming ones.
 func (q *Queries) ListTransfers(ctx context.Context, arg ListTransfersParams) ([]Transfer, error) {
 	rows, err := q.db.Query(ctx, listTransfers,
-		arg.FromAccountID,
-		arg.ToAccountID,
-		arg.Limit,
-		arg.Offset,
+		arg.AccountID,
+		arg.BeforeID,
+		arg.StartTime,
+		arg.EndTime,
+		arg.Direction,
+		arg.CounterpartyAccountID,
+		arg.MinAmount,
+		arg.MaxAmount,
+		arg.PageSize,
 	)
 	if err != nil {
 		return nil, err
@@ -90,6 +187,15 @@ func (q *Queries) ListTransfers(ctx context.Context, arg ListTransfersParams) ([
 			&i.ToAccountID,
 			&i.Amount,
 			&i.CreatedAt,
+			&i.ToAmount,
+			&i.ExchangeRate,
+			&i.ReversedAmount,
+			&i.Status,
+			&i.FeeAmount,
+			&i.FeeRuleID,
+			&i.Memo,
+			&i.Reference,
+			&i.InitiatedBy,
 		); err != nil {
 			return nil, err
 		}
@@ -100,3 +206,106 @@ func (q *Queries) ListTransfers(ctx context.Context, arg ListTransfersParams) ([
 	}
 	return items, nil
 }
+
+const searchTransfers = `-- name: SearchTransfers :many
+SELECT id, from_account_id, to_account_id, amount, created_at, to_amount, exchange_rate, reversed_amount, status, fee_amount, fee_rule_id, memo, reference, initiated_by FROM transfers
+WHERE (from_account_id IN (SELECT id FROM accounts WHERE owner = $1
+      UNION SELECT account_id FROM account_holders WHERE username = $1 AND status = 'active')
+    OR to_account_id IN (SELECT id FROM accounts WHERE owner = $1
+      UNION SELECT account_id FROM account_holders WHERE username = $1 AND status = 'active'))
+  AND to_tsvector('simple', memo || ' ' || reference) @@ websearch_to_tsquery('simple', $2)
+  AND ($3::bigint IS NULL
+    OR from_account_id = $3
+    OR to_account_id = $3)
+  AND ($4::bigint IS NULL OR id < $4)
+ORDER BY id DESC
+LIMIT $5
+`
+
+type SearchTransfersParams struct {
+	Owner                 string      `json:"owner"`
+	Query                 string      `json:"query"`
+	CounterpartyAccountID pgtype.Int8 `json:"counterparty_account_id"`
+	BeforeID              pgtype.Int8 `json:"before_id"`
+	PageSize              int32       `json:"page_size"`
+}
+
+// Transfers of the accounts the owner holds whose memo or reference match a web search style query,
+// newest first, optionally only those with the counterparty account on either side.
+func (q *Queries) SearchTransfers(ctx context.Context, arg SearchTransfersParams) ([]Transfer, error) {
+	rows, err := q.db.Query(ctx, searchTransfers,
+		arg.Owner,
+		arg.Query,
+		arg.CounterpartyAccountID,
+		arg.BeforeID,
+		arg.PageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Transfer{}
+	for rows.Next() {
+		var i Transfer
+		if err := rows.Scan(
+			&i.ID,
+			&i.FromAccountID,
+			&i.ToAccountID,
+			&i.Amount,
+			&i.CreatedAt,
+			&i.ToAmount,
+			&i.ExchangeRate,
+			&i.ReversedAmount,
+			&i.Status,
+			&i.FeeAmount,
+			&i.FeeRuleID,
+			&i.Memo,
+			&i.Reference,
+			&i.InitiatedBy,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateTransferReversal = `-- name: UpdateTransferReversal :one
+UPDATE transfers
+SET
+  reversed_amount = $2,
+  status = $3
+WHERE id = $1
+RETURNING id, from_account_id, to_account_id, amount, created_at, to_amount, exchange_rate, reversed_amount, status, fee_amount, fee_rule_id, memo, reference, initiated_by
+`
+
+type UpdateTransferReversalParams struct {
+	ID             int64  `json:"id"`
+	ReversedAmount int64  `json:"reversed_amount"`
+	Status         string `json:"status"`
+}
+
+func (q *Queries) UpdateTransferReversal(ctx context.Context, arg UpdateTransferReversalParams) (Transfer, error) {
+	row := q.db.QueryRow(ctx, updateTransferReversal, arg.ID, arg.ReversedAmount, arg.Status)
+	var i Transfer
+	err := row.Scan(
+		&i.ID,
+		&i.FromAccountID,
+		&i.ToAccountID,
+		&i.Amount,
+		&i.CreatedAt,
+		&i.ToAmount,
+		&i.ExchangeRate,
+		&i.ReversedAmount,
+		&i.Status,
+		&i.FeeAmount,
+		&i.FeeRuleID,
+		&i.Memo,
+		&i.Reference,
+		&i.InitiatedBy,
+	)
+	return i, err
+}
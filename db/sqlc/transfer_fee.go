@@ -0,0 +1,95 @@
+package db
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/mahanth/simplebank/fee"
+)
+
+// TransferFee is the fee charged on a transfer. It is debited from the sender in its currency on top
+// of the transfer amount and paid into the bank's revenue account by a pair of fee entries.
+// RuleID is zero and the entries are empty if no fee was charged.
+type TransferFee struct {
+	RuleID       int64         `json:"rule_id"`
+	Breakdown    fee.Breakdown `json:"breakdown"`
+	Entry        Entry         `json:"entry"`
+	RevenueEntry Entry         `json:"revenue_entry"`
+}
+
+// FeeRuleFromDB converts a stored fee rule into the rule the calculator applies.
+func FeeRuleFromDB(rule FeeRule) (fee.Rule, error) {
+	percentage, err := ratFromNumeric(rule.Percentage)
+	if err != nil {
+		return fee.Rule{}, err
+	}
+
+	return fee.Rule{
+		Flat:       rule.FlatFee,
+		Percentage: percentage,
+		Min:        rule.MinFee,
+		Max:        rule.MaxFee.Int64,
+	}, nil
+}
+
+// transferFee works out the fee on a transfer of amount between the accounts made by a user with role,
+// using the most specific fee rule that matches. It returns a zero fee if no rule matches.
+func (q txQueries) transferFee(ctx context.Context, role string, from, to Account, amount int64) (TransferFee, error) {
+	rule, err := q.GetFeeRule(ctx, GetFeeRuleParams{
+		Currency:  from.Currency,
+		Role:      role,
+		SameOwner: from.Owner == to.Owner,
+	})
+	if err != nil {
+		if errors.Is(err, ErrRecordNotFound) {
+			return TransferFee{}, nil
+		}
+		return TransferFee{}, err
+	}
+
+	calculator, err := FeeRuleFromDB(rule)
+	if err != nil {
+		return TransferFee{}, err
+	}
+
+	return TransferFee{
+		RuleID:    rule.ID,
+		Breakdown: calculator.Calculate(amount),
+	}, nil
+}
+
+// chargeTransferFee books the fee on a transfer and moves it from the sender to the revenue account
+// in the sender's currency. It returns the sender's updated account.
+func (q txQueries) chargeTransferFee(ctx context.Context, transfer Transfer, from Account, transferFee *TransferFee) (Account, error) {
+	revenue, err := q.bankAccount(ctx, RevenueUsername, from.Currency)
+	if err != nil {
+		return Account{}, err
+	}
+
+	transferID := pgtype.Int8{Int64: transfer.ID, Valid: true}
+	amount := transferFee.Breakdown.Total
+
+	transferFee.Entry, err = q.CreateEntry(ctx, CreateEntryParams{
+		AccountID:  from.ID,
+		Amount:     -amount,
+		Type:       EntryTypeFee,
+		TransferID: transferID,
+	})
+	if err != nil {
+		return Account{}, err
+	}
+
+	transferFee.RevenueEntry, err = q.CreateEntry(ctx, CreateEntryParams{
+		AccountID:  revenue.ID,
+		Amount:     amount,
+		Type:       EntryTypeFee,
+		TransferID: transferID,
+	})
+	if err != nil {
+		return Account{}, err
+	}
+
+	from, _, err = q.transferMoney(ctx, from.ID, revenue.ID, amount, amount)
+	return from, err
+}
@@ -0,0 +1,146 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/mahanth/simplebank/util"
+	"github.com/stretchr/testify/require"
+)
+
+// createTestFeeRule adds a rule for bankers only, which the transfers in other tests never match.
+func createTestFeeRule(t *testing.T, sameOwner bool, flat int64, percentage string, max int64) FeeRule {
+	arg := CreateFeeRuleParams{
+		Currency:  pgtype.Text{String: util.USD, Valid: true},
+		Role:      pgtype.Text{String: util.BankerRole, Valid: true},
+		SameOwner: pgtype.Bool{Bool: sameOwner, Valid: true},
+		FlatFee:   flat,
+		MaxFee:    pgtype.Int8{Int64: max, Valid: max > 0},
+		CreatedBy: createRandomUser(t).Username,
+	}
+	require.NoError(t, arg.Percentage.Scan(percentage))
+
+	rule, err := testQueries.CreateFeeRule(context.Background(), arg)
+	require.NoError(t, err)
+	return rule
+}
+
+func TestTransferTxWithFee(t *testing.T) {
+	store := NewStore(testDB)
+	rule := createTestFeeRule(t, false, 10, "0.01", 50)
+
+	account1 := createRandomAccountWithCurrency(t, util.USD, 5000)
+	account2 := createRandomAccountWithCurrency(t, util.USD, 0)
+
+	result, err := store.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        1000,
+		Role:          util.BankerRole,
+	})
+	require.NoError(t, err)
+
+	require.Equal(t, rule.ID, result.Fee.RuleID)
+	require.Equal(t, int64(10), result.Fee.Breakdown.Flat)
+	require.Equal(t, int64(10), result.Fee.Breakdown.Percentage)
+	require.Equal(t, int64(20), result.Fee.Breakdown.Total)
+	require.Equal(t, int64(20), result.Transfer.FeeAmount)
+	require.Equal(t, rule.ID, result.Transfer.FeeRuleID.Int64)
+
+	// the fee is paid on top of the amount, which the receiver gets in full
+	require.Equal(t, account1.Balance-1020, result.FromAccount.Balance)
+	require.Equal(t, account2.Balance+1000, result.ToAccount.Balance)
+
+	require.Equal(t, EntryTypeFee, result.Fee.Entry.Type)
+	require.Equal(t, account1.ID, result.Fee.Entry.AccountID)
+	require.Equal(t, int64(-20), result.Fee.Entry.Amount)
+	require.Equal(t, result.Transfer.ID, result.Fee.Entry.TransferID.Int64)
+	require.Equal(t, int64(20), result.Fee.RevenueEntry.Amount)
+
+	revenue, err := store.GetAccount(context.Background(), result.Fee.RevenueEntry.AccountID)
+	require.NoError(t, err)
+	require.Equal(t, RevenueUsername, revenue.Owner)
+	require.Equal(t, util.USD, revenue.Currency)
+
+	// the maximum caps the fee
+	result, err = store.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        3900,
+		Role:          util.BankerRole,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(50), result.Fee.Breakdown.Total)
+}
+
+func TestTransferTxFeeInsufficientFunds(t *testing.T) {
+	store := NewStore(testDB)
+	createTestFeeRule(t, false, 10, "0", 0)
+
+	account1 := createRandomAccountWithCurrency(t, util.USD, 1000)
+	account2 := createRandomAccountWithCurrency(t, util.USD, 0)
+
+	// the amount alone is covered, but not with the fee
+	_, err := store.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        1000,
+		Role:          util.BankerRole,
+	})
+	require.True(t, errors.Is(err, ErrInsufficientFunds))
+
+	// without a matching rule no fee is charged
+	result, err := store.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        1000,
+	})
+	require.NoError(t, err)
+	require.Zero(t, result.Fee.Breakdown.Total)
+	require.False(t, result.Transfer.FeeRuleID.Valid)
+	require.Zero(t, result.FromAccount.Balance)
+}
+
+func TestCaptureHoldTxWithFee(t *testing.T) {
+	store := NewStore(testDB)
+	rule := createTestFeeRule(t, false, 10, "0", 0)
+
+	account1 := createRandomAccountWithCurrency(t, util.USD, 1000)
+	account2 := createRandomAccountWithCurrency(t, util.USD, 0)
+
+	placed, err := store.PlaceHoldTx(context.Background(), PlaceHoldTxParams{
+		AccountID:   account1.ID,
+		ToAccountID: account2.ID,
+		Amount:      500,
+		Description: "hotel deposit",
+		ExpiresAt:   time.Now().Add(time.Hour),
+		Role:        util.BankerRole,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(500), placed.Hold.Amount)
+	require.Equal(t, int64(10), placed.Hold.HeldFee)
+	require.Equal(t, int64(510), placed.Account.HeldAmount)
+
+	// the rest of the funds can be spent without leaving the capture short of its fee
+	_, err = store.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        account1.Balance - 510,
+	})
+	require.NoError(t, err)
+
+	// the capture is charged the fee of the user who placed the hold
+	result, err := store.CaptureHoldTx(context.Background(), CaptureHoldTxParams{
+		HoldID: placed.Hold.ID,
+		Amount: 500,
+	})
+	require.NoError(t, err)
+	require.Equal(t, rule.ID, result.Fee.RuleID)
+	require.Equal(t, int64(10), result.Transfer.FeeAmount)
+	require.Zero(t, result.FromAccount.Balance)
+	require.Zero(t, result.FromAccount.HeldAmount)
+	require.Equal(t, int64(-10), result.Fee.Entry.Amount)
+}
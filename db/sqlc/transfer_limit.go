@@ -0,0 +1,304 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// Limits a TransferLimitError can report.
+const (
+	LimitSingle     = "single"
+	LimitDailyTotal = "daily_total"
+	LimitDailyCount = "daily_count"
+)
+
+// Scopes of a TransferLimitError for a daily limit: whose transfers it counts. LimitScopeHolder
+// marks the single transfer limit the account's owner set for one of its holders.
+const (
+	LimitScopeUser    = "user"
+	LimitScopeAccount = "account"
+	LimitScopeHolder  = "holder"
+)
+
+// TransferLimits bound what a user may send. Amounts are in minor units of the sending account's
+// currency, except for the defaults set by WithTransferLimits, and days are UTC calendar days.
+// A zero value means there is no limit.
+type TransferLimits struct {
+	MaxSingle     int64 `json:"max_single"`
+	MaxDailyTotal int64 `json:"max_daily_total"`
+	MaxDailyCount int32 `json:"max_daily_count"`
+}
+
+// apply overrides the limits the stored override sets, keeping the others.
+func (limits TransferLimits) apply(override TransferLimit) TransferLimits {
+	if override.MaxSingle.Valid {
+		limits.MaxSingle = override.MaxSingle.Int64
+	}
+	if override.MaxDailyTotal.Valid {
+		limits.MaxDailyTotal = override.MaxDailyTotal.Int64
+	}
+	if override.MaxDailyCount.Valid {
+		limits.MaxDailyCount = override.MaxDailyCount.Int32
+	}
+	return limits
+}
+
+// inMinorUnits converts limits with amounts in major units to the minor units of a currency with exponent.
+func (limits TransferLimits) inMinorUnits(exponent int32) TransferLimits {
+	for i := int32(0); i < exponent; i++ {
+		limits.MaxSingle *= 10
+		limits.MaxDailyTotal *= 10
+	}
+	return limits
+}
+
+// StoreOption configures a SQLStore built by NewStore.
+type StoreOption func(*SQLStore)
+
+// WithTransferLimits sets the limits that apply to every user and account without an override.
+// Their amounts are in major units, e.g. dollars, and are scaled to the minor units of the sending
+// account's currency, so that the same limits mean 10,000 dollars or 10,000 yen.
+func WithTransferLimits(limits TransferLimits) StoreOption {
+	return func(store *SQLStore) {
+		store.transferLimits = limits
+	}
+}
+
+// TransferLimitError is returned when a transfer would exceed one of the sender's limits.
+// It wraps ErrTransferLimitExceeded. Used counts what Username sent today from accounts in the
+// currency of AccountID, or for LimitScopeAccount what anyone sent today from AccountID. Scope is
+// empty for LimitSingle, unless it is the limit of a holder of AccountID.
+// Headroom is how much of the limit is left today, or the limit itself for LimitSingle;
+// for LimitDailyCount it counts transfers rather than an amount.
+type TransferLimitError struct {
+	Username  string `json:"username"`
+	AccountID int64  `json:"account_id"`
+	Limit     string `json:"limit"`
+	Scope     string `json:"scope"`
+	Max       int64  `json:"max"`
+	Used      int64  `json:"used"`
+	Requested int64  `json:"requested"`
+	Headroom  int64  `json:"headroom"`
+}
+
+func (err *TransferLimitError) Error() string {
+	if err.Scope == LimitScopeAccount {
+		switch err.Limit {
+		case LimitDailyCount:
+			return fmt.Sprintf("%s: account %d may make %d transfers a day and made %d today",
+				ErrTransferLimitExceeded, err.AccountID, err.Max, err.Used)
+		case LimitDailyTotal:
+			return fmt.Sprintf("%s: account %d may send %d a day and sent %d today, cannot send %d more",
+				ErrTransferLimitExceeded, err.AccountID, err.Max, err.Used, err.Requested)
+		}
+	}
+
+	if err.Scope == LimitScopeHolder {
+		return fmt.Sprintf("%s: %s may send at most %d in one transfer from account %d as its holder, cannot send %d",
+			ErrTransferLimitExceeded, err.Username, err.Max, err.AccountID, err.Requested)
+	}
+
+	switch err.Limit {
+	case LimitSingle:
+		return fmt.Sprintf("%s: %s may send at most %d in one transfer from account %d, cannot send %d",
+			ErrTransferLimitExceeded, err.Username, err.Max, err.AccountID, err.Requested)
+	case LimitDailyCount:
+		return fmt.Sprintf("%s: %s may make %d transfers a day from account %d and made %d today",
+			ErrTransferLimitExceeded, err.Username, err.Max, err.AccountID, err.Used)
+	}
+	return fmt.Sprintf("%s: %s may send %d a day from account %d and sent %d today, cannot send %d more",
+		ErrTransferLimitExceeded, err.Username, err.Max, err.AccountID, err.Used, err.Requested)
+}
+
+func (err *TransferLimitError) Unwrap() error {
+	return ErrTransferLimitExceeded
+}
+
+// accountTransferLimits returns the limits in force when username sends from account: the store's
+// defaults, overridden by any limits a banker set for the user and then by any set for the account.
+// On a joint account the limits of the holder making the transfer apply, not the owner's.
+// The account's own override, or nil, is returned too, as its daily limits count the account's transfers.
+func (q txQueries) accountTransferLimits(ctx context.Context,
+	defaults TransferLimits, username string, account Account) (TransferLimits, *TransferLimit, error) {
+	if defaults.MaxSingle > 0 || defaults.MaxDailyTotal > 0 {
+		currency, err := q.GetCurrency(ctx, account.Currency)
+		if err != nil {
+			return TransferLimits{}, nil, err
+		}
+		defaults = defaults.inMinorUnits(currency.Exponent)
+	}
+
+	overrides, err := q.ListTransferLimitOverrides(ctx, ListTransferLimitOverridesParams{
+		Username:  username,
+		AccountID: account.ID,
+	})
+	if err != nil {
+		return TransferLimits{}, nil, err
+	}
+
+	limits := defaults
+	var accountOverride *TransferLimit
+	for i, override := range overrides {
+		limits = limits.apply(override)
+		if override.AccountID.Valid {
+			accountOverride = &overrides[i]
+		}
+	}
+	return limits, accountOverride, nil
+}
+
+// transferLimitOverride returns the override of the user or of the account arg names, or nil if
+// there is none.
+func (q txQueries) transferLimitOverride(ctx context.Context, arg ListTransferLimitOverridesParams) (*TransferLimit, error) {
+	overrides, err := q.ListTransferLimitOverrides(ctx, arg)
+	if err != nil {
+		return nil, err
+	}
+	if len(overrides) == 0 {
+		return nil, nil
+	}
+	return &overrides[0], nil
+}
+
+// recordTransferLimitAudit appends the change of an override to the audit log, as its creation if
+// there was none before.
+func (q txQueries) recordTransferLimitAudit(ctx context.Context, before *TransferLimit, after TransferLimit) error {
+	if before == nil {
+		return q.recordAudit(ctx, AuditTransferLimitCreate, "transfer_limit", after.ID, nil, after)
+	}
+	return q.recordAudit(ctx, AuditTransferLimitUpdate, "transfer_limit", after.ID, *before, after)
+}
+
+// checkTransferLimits reports whether username may send amount from account now. The daily limits
+// count what the user sent today from all the accounts in its currency, so that spreading transfers
+// over several accounts does not multiply them. A daily limit set for the account itself counts what
+// anyone sent today from the account instead, so that its holders share it.
+// A holder of the account is also bound by the limit its owner set for them.
+// The caller must hold the lock on account, which serializes the transfers counted for the account.
+func (q txQueries) checkTransferLimits(ctx context.Context, defaults TransferLimits, username string, account Account, amount int64, now time.Time) error {
+	if err := q.checkHolderTransferLimit(ctx, username, account, amount); err != nil {
+		return err
+	}
+
+	limits, accountOverride, err := q.accountTransferLimits(ctx, defaults, username, account)
+	if err != nil {
+		return err
+	}
+
+	if limits.MaxSingle > 0 && amount > limits.MaxSingle {
+		return &TransferLimitError{
+			Username:  username,
+			AccountID: account.ID,
+			Limit:     LimitSingle,
+			Max:       limits.MaxSingle,
+			Requested: amount,
+			Headroom:  limits.MaxSingle,
+		}
+	}
+
+	totalScope, countScope := LimitScopeUser, LimitScopeUser
+	if accountOverride != nil && accountOverride.MaxDailyTotal.Valid {
+		totalScope = LimitScopeAccount
+	}
+	if accountOverride != nil && accountOverride.MaxDailyCount.Valid {
+		countScope = LimitScopeAccount
+	}
+
+	since := pgtype.Timestamptz{Time: AccrualDate(now), Valid: true}
+	totals := map[string]GetOutgoingTransferTotalsRow{}
+	sent := func(scope string) (GetOutgoingTransferTotalsRow, error) {
+		if used, ok := totals[scope]; ok {
+			return used, nil
+		}
+		used, err := q.outgoingTransferTotals(ctx, scope, username, account, since)
+		totals[scope] = used
+		return used, err
+	}
+
+	if limits.MaxDailyCount > 0 {
+		used, err := sent(countScope)
+		if err != nil {
+			return err
+		}
+		if used.Count >= limits.MaxDailyCount {
+			return &TransferLimitError{
+				Username:  username,
+				AccountID: account.ID,
+				Limit:     LimitDailyCount,
+				Scope:     countScope,
+				Max:       int64(limits.MaxDailyCount),
+				Used:      int64(used.Count),
+				Requested: 1,
+				Headroom:  0,
+			}
+		}
+	}
+
+	if limits.MaxDailyTotal > 0 {
+		used, err := sent(totalScope)
+		if err != nil {
+			return err
+		}
+		if used.Total+amount > limits.MaxDailyTotal {
+			return &TransferLimitError{
+				Username:  username,
+				AccountID: account.ID,
+				Limit:     LimitDailyTotal,
+				Scope:     totalScope,
+				Max:       limits.MaxDailyTotal,
+				Used:      used.Total,
+				Requested: amount,
+				Headroom:  max(limits.MaxDailyTotal-used.Total, 0),
+			}
+		}
+	}
+	return nil
+}
+
+// checkHolderTransferLimit reports whether username may send amount from account in one transfer
+// as a holder of it. Owners and users who do not hold the account have no such limit.
+func (q txQueries) checkHolderTransferLimit(ctx context.Context, username string, account Account, amount int64) error {
+	access, err := GetAccountAccess(ctx, q, account, username)
+	if err != nil {
+		return err
+	}
+
+	if access.TransferLimit > 0 && amount > access.TransferLimit {
+		return &TransferLimitError{
+			Username:  username,
+			AccountID: account.ID,
+			Limit:     LimitSingle,
+			Scope:     LimitScopeHolder,
+			Max:       access.TransferLimit,
+			Requested: amount,
+			Headroom:  access.TransferLimit,
+		}
+	}
+	return nil
+}
+
+// outgoingTransferTotals returns what was sent since the time in the scope of a daily limit: by the
+// user from accounts in the account's currency, which first serializes the checks of the user's
+// transfers, or by anyone from the account.
+func (q txQueries) outgoingTransferTotals(ctx context.Context,
+	scope, username string, account Account, since pgtype.Timestamptz) (GetOutgoingTransferTotalsRow, error) {
+	if scope == LimitScopeAccount {
+		totals, err := q.GetAccountOutgoingTransferTotals(ctx, GetAccountOutgoingTransferTotalsParams{
+			FromAccountID: account.ID,
+			Since:         since,
+		})
+		return GetOutgoingTransferTotalsRow(totals), err
+	}
+
+	if err := q.LockUserTransferLimits(ctx, username); err != nil {
+		return GetOutgoingTransferTotalsRow{}, err
+	}
+	return q.GetOutgoingTransferTotals(ctx, GetOutgoingTransferTotalsParams{
+		InitiatedBy: username,
+		Currency:    account.Currency,
+		Since:       since,
+	})
+}
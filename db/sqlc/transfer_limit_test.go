@@ -0,0 +1,225 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/mahanth/simplebank/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransferTxDefaultLimits(t *testing.T) {
+	// in dollars, checked in cents
+	store := NewStore(testDB, WithTransferLimits(TransferLimits{
+		MaxSingle:     5,
+		MaxDailyTotal: 8,
+		MaxDailyCount: 3,
+	}))
+
+	account1 := createRandomAccountWithCurrency(t, util.USD, 5000)
+	account2 := createRandomAccountWithCurrency(t, util.USD, 0)
+
+	transfer := func(amount int64) error {
+		_, err := store.TransferTx(context.Background(), TransferTxParams{
+			FromAccountID: account1.ID,
+			ToAccountID:   account2.ID,
+			Amount:        amount,
+		})
+		return err
+	}
+
+	err := transfer(600)
+	require.True(t, errors.Is(err, ErrTransferLimitExceeded))
+	var limitErr *TransferLimitError
+	require.True(t, errors.As(err, &limitErr))
+	require.Equal(t, LimitSingle, limitErr.Limit)
+	require.Equal(t, int64(500), limitErr.Headroom)
+
+	require.NoError(t, transfer(500))
+	require.NoError(t, transfer(200))
+
+	err = transfer(200)
+	require.True(t, errors.As(err, &limitErr))
+	require.Equal(t, LimitDailyTotal, limitErr.Limit)
+	require.Equal(t, int64(700), limitErr.Used)
+	require.Equal(t, int64(100), limitErr.Headroom)
+
+	require.NoError(t, transfer(100))
+
+	err = transfer(1)
+	require.True(t, errors.As(err, &limitErr))
+	require.Equal(t, LimitDailyCount, limitErr.Limit)
+	require.Equal(t, int64(3), limitErr.Used)
+	require.Zero(t, limitErr.Headroom)
+}
+
+func TestTransferTxDefaultLimitsPerCurrency(t *testing.T) {
+	store := NewStore(testDB, WithTransferLimits(TransferLimits{MaxSingle: 5}))
+
+	// five yen, as the yen has no minor unit, and five dinars in fils
+	for currency, maxSingle := range map[string]int64{util.JPY: 5, util.KWD: 5000} {
+		account1 := createRandomAccountWithCurrency(t, currency, 10000)
+		account2 := createRandomAccountWithCurrency(t, currency, 0)
+
+		_, err := store.TransferTx(context.Background(), TransferTxParams{
+			FromAccountID: account1.ID,
+			ToAccountID:   account2.ID,
+			Amount:        maxSingle + 1,
+		})
+		var limitErr *TransferLimitError
+		require.True(t, errors.As(err, &limitErr))
+		require.Equal(t, maxSingle, limitErr.Max)
+
+		_, err = store.TransferTx(context.Background(), TransferTxParams{
+			FromAccountID: account1.ID,
+			ToAccountID:   account2.ID,
+			Amount:        maxSingle,
+		})
+		require.NoError(t, err)
+	}
+}
+
+func TestTransferTxLimitOverrides(t *testing.T) {
+	store := NewStore(testDB, WithTransferLimits(TransferLimits{MaxSingle: 1}))
+	banker := createRandomUser(t)
+
+	account1 := createRandomAccountWithCurrency(t, util.USD, 5000)
+	account2 := createRandomAccountWithCurrency(t, util.USD, 0)
+
+	// the owner's override raises the limit for all their accounts
+	_, err := store.UpsertUserTransferLimit(context.Background(), UpsertUserTransferLimitParams{
+		Username:      pgtype.Text{String: account1.Owner, Valid: true},
+		MaxSingle:     pgtype.Int8{Int64: 1000, Valid: true},
+		MaxDailyCount: pgtype.Int4{Int32: 10, Valid: true},
+		UpdatedBy:     banker.Username,
+	})
+	require.NoError(t, err)
+
+	// and the account's override beats it, keeping the daily count of the owner's
+	_, err = store.UpsertAccountTransferLimit(context.Background(), UpsertAccountTransferLimitParams{
+		AccountID: pgtype.Int8{Int64: account1.ID, Valid: true},
+		MaxSingle: pgtype.Int8{Int64: 300, Valid: true},
+		UpdatedBy: banker.Username,
+	})
+	require.NoError(t, err)
+
+	limits, accountOverride, err := txQueries{testQueries}.accountTransferLimits(context.Background(), TransferLimits{MaxSingle: 1}, account1.Owner, account1)
+	require.NoError(t, err)
+	require.Equal(t, TransferLimits{MaxSingle: 300, MaxDailyCount: 10}, limits)
+	require.Equal(t, account1.ID, accountOverride.AccountID.Int64)
+
+	_, err = store.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        300,
+	})
+	require.NoError(t, err)
+
+	_, err = store.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        301,
+	})
+	require.True(t, errors.Is(err, ErrTransferLimitExceeded))
+
+	// the receiver, with no override, keeps the default
+	_, err = store.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: account2.ID,
+		ToAccountID:   account1.ID,
+		Amount:        101,
+	})
+	require.True(t, errors.Is(err, ErrTransferLimitExceeded))
+}
+
+func TestTransferTxLimitsPerUser(t *testing.T) {
+	store := NewStore(testDB, WithTransferLimits(TransferLimits{MaxDailyTotal: 5}))
+	banker := createRandomUser(t)
+
+	account1 := createRandomAccountWithCurrency(t, util.USD, 5000)
+	account2, err := testQueries.CreateAccount(context.Background(), CreateAccountParams{
+		Owner:    account1.Owner,
+		Balance:  5000,
+		Currency: util.USD,
+		Type:     AccountTypeChecking,
+	})
+	require.NoError(t, err)
+	receiver := createRandomAccountWithCurrency(t, util.USD, 0)
+
+	transfer := func(from Account, username string, amount int64) error {
+		_, err := store.TransferTx(context.Background(), TransferTxParams{
+			FromAccountID: from.ID,
+			ToAccountID:   receiver.ID,
+			Amount:        amount,
+			Username:      username,
+		})
+		return err
+	}
+
+	// the daily total covers all the owner's accounts in the currency
+	require.NoError(t, transfer(account1, account1.Owner, 300))
+	err = transfer(account2, account1.Owner, 300)
+	var limitErr *TransferLimitError
+	require.True(t, errors.As(err, &limitErr))
+	require.Equal(t, LimitDailyTotal, limitErr.Limit)
+	require.Equal(t, account1.Owner, limitErr.Username)
+	require.Equal(t, int64(300), limitErr.Used)
+
+	// a holder of the account sends within their own limits
+	holder := createRandomUser(t)
+	_, err = store.UpsertUserTransferLimit(context.Background(), UpsertUserTransferLimitParams{
+		Username:  pgtype.Text{String: holder.Username, Valid: true},
+		MaxSingle: pgtype.Int8{Int64: 100, Valid: true},
+		UpdatedBy: banker.Username,
+	})
+	require.NoError(t, err)
+
+	err = transfer(account2, holder.Username, 150)
+	require.True(t, errors.As(err, &limitErr))
+	require.Equal(t, LimitSingle, limitErr.Limit)
+	require.Equal(t, holder.Username, limitErr.Username)
+
+	require.NoError(t, transfer(account2, holder.Username, 100))
+}
+
+func TestCaptureHoldTxLimits(t *testing.T) {
+	store := NewStore(testDB, WithTransferLimits(TransferLimits{MaxDailyTotal: 5}))
+
+	account1 := createRandomAccountWithCurrency(t, util.USD, 5000)
+	account2 := createRandomAccountWithCurrency(t, util.USD, 0)
+
+	placed, err := store.PlaceHoldTx(context.Background(), PlaceHoldTxParams{
+		AccountID:   account1.ID,
+		ToAccountID: account2.ID,
+		Amount:      300,
+		Description: "hotel deposit",
+		ExpiresAt:   time.Now().Add(time.Hour),
+	})
+	require.NoError(t, err)
+	require.Equal(t, account1.Owner, placed.Hold.PlacedBy)
+
+	_, err = store.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        300,
+	})
+	require.NoError(t, err)
+
+	// the capture counts against the limits of who placed the hold
+	_, err = store.CaptureHoldTx(context.Background(), CaptureHoldTxParams{
+		HoldID: placed.Hold.ID,
+		Amount: 300,
+	})
+	var limitErr *TransferLimitError
+	require.True(t, errors.As(err, &limitErr))
+	require.Equal(t, LimitDailyTotal, limitErr.Limit)
+
+	result, err := store.CaptureHoldTx(context.Background(), CaptureHoldTxParams{
+		HoldID: placed.Hold.ID,
+		Amount: 200,
+	})
+	require.NoError(t, err)
+	require.Equal(t, account1.Owner, result.Transfer.InitiatedBy)
+}
@@ -3,7 +3,9 @@ package db
 import (
 	"context"
 	"testing"
+	"time"
 
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/mahanth/simplebank/util"
 
 	"github.com/stretchr/testify/require"
@@ -17,10 +19,13 @@ func TestCreateTransfer(t *testing.T) {
 	account2 := createRandomAccount(t)
 	require.NotEmpty(t, account2)
 	require.NotZero(t, account2.ID)
+	amount := util.RandomBalance()
 	arg := CreateTransferParams{
 		FromAccountID: account1.ID,
 		ToAccountID:   account2.ID,
-		Amount:        util.RandomBalance(),
+		Amount:        amount,
+		ToAmount:      amount,
+		InitiatedBy:   account1.Owner,
 	}
 	transfer, err := testQueries.CreateTransfer(context.Background(), arg)
 	require.NoError(t, err)
@@ -28,6 +33,9 @@ func TestCreateTransfer(t *testing.T) {
 	require.Equal(t, arg.FromAccountID, transfer.FromAccountID)
 	require.Equal(t, arg.ToAccountID, transfer.ToAccountID)
 	require.Equal(t, arg.Amount, transfer.Amount)
+	require.Equal(t, arg.ToAmount, transfer.ToAmount)
+	require.Equal(t, arg.InitiatedBy, transfer.InitiatedBy)
+	require.False(t, transfer.ExchangeRate.Valid)
 	require.NotZero(t, transfer.ID)
 	require.NotZero(t, transfer.CreatedAt)
 }
@@ -43,10 +51,13 @@ func TestGetTransfer(t *testing.T) {
 	require.NotEmpty(t, account2)
 	require.NotZero(t, account2.ID)
 
+	amount := util.RandomBalance()
 	arg := CreateTransferParams{
 		FromAccountID: account1.ID,
 		ToAccountID:   account2.ID,
-		Amount:        util.RandomBalance(),
+		Amount:        amount,
+		ToAmount:      amount,
+		InitiatedBy:   account1.Owner,
 	}
 
 	transfer1, err := testQueries.CreateTransfer(context.Background(), arg)
@@ -64,40 +75,129 @@ func TestGetTransfer(t *testing.T) {
 }
 
 func TestListTransfers(t *testing.T) {
-	// create two accounts to transfer between
+	// create three accounts to transfer between
 	account1 := createRandomAccount(t)
-	require.NotEmpty(t, account1)
-	require.NotZero(t, account1.ID)
 	account2 := createRandomAccount(t)
+	account3 := createRandomAccount(t)
 
-	require.NotEmpty(t, account2)
-	require.NotZero(t, account2.ID)
-
+	var created []Transfer
 	for i := 0; i < 10; i++ {
 		arg := CreateTransferParams{
 			FromAccountID: account1.ID,
 			ToAccountID:   account2.ID,
-			Amount:        util.RandomBalance(),
+			Amount:        int64(i + 1),
+			ToAmount:      int64(i + 1),
+			InitiatedBy:   account1.Owner,
+		}
+		switch i % 3 {
+		case 1:
+			arg.FromAccountID, arg.ToAccountID = account2.ID, account1.ID
+			arg.InitiatedBy = account2.Owner
+		case 2:
+			arg.ToAccountID = account3.ID
 		}
-		_, err := testQueries.CreateTransfer(context.Background(), arg)
+		transfer, err := testQueries.CreateTransfer(context.Background(), arg)
 		require.NoError(t, err)
+		created = append(created, transfer)
 	}
 
 	arg := ListTransfersParams{
-		FromAccountID: account1.ID,
-		ToAccountID:   account2.ID,
-		Limit:         5,
-		Offset:        5,
+		AccountID: account1.ID,
+		PageSize:  5,
 	}
 	transfers, err := testQueries.ListTransfers(context.Background(), arg)
 	require.NoError(t, err)
 	require.Len(t, transfers, 5)
-	for _, transfer := range transfers {
-		require.NotEmpty(t, transfer)
-		require.NotZero(t, transfer.ID)
+	for i, transfer := range transfers {
+		require.Equal(t, created[9-i].ID, transfer.ID)
 		require.NotZero(t, transfer.CreatedAt)
-		require.NotZero(t, transfer.FromAccountID)
-		require.NotZero(t, transfer.ToAccountID)
-		require.NotZero(t, transfer.Amount)
 	}
+
+	arg.BeforeID = pgtype.Int8{Int64: transfers[4].ID, Valid: true}
+	transfers, err = testQueries.ListTransfers(context.Background(), arg)
+	require.NoError(t, err)
+	require.Len(t, transfers, 5)
+	require.Equal(t, created[4].ID, transfers[0].ID)
+
+	// transfers 1, 4 and 7 came in from account2
+	transfers, err = testQueries.ListTransfers(context.Background(), ListTransfersParams{
+		AccountID: account1.ID,
+		Direction: pgtype.Text{String: DirectionIn, Valid: true},
+		PageSize:  10,
+	})
+	require.NoError(t, err)
+	require.Len(t, transfers, 3)
+	for _, transfer := range transfers {
+		require.Equal(t, account1.ID, transfer.ToAccountID)
+	}
+
+	// transfers 2, 5 and 8 went out to account3, of which only 5 and 8 are at least 6
+	transfers, err = testQueries.ListTransfers(context.Background(), ListTransfersParams{
+		AccountID:             account1.ID,
+		CounterpartyAccountID: pgtype.Int8{Int64: account3.ID, Valid: true},
+		MinAmount:             pgtype.Int8{Int64: 6, Valid: true},
+		PageSize:              10,
+	})
+	require.NoError(t, err)
+	require.Len(t, transfers, 2)
+	require.Equal(t, created[8].ID, transfers[0].ID)
+	require.Equal(t, created[5].ID, transfers[1].ID)
+
+	// an empty range returns nothing
+	transfers, err = testQueries.ListTransfers(context.Background(), ListTransfersParams{
+		AccountID: account1.ID,
+		EndTime:   pgtype.Timestamptz{Time: created[0].CreatedAt.Time.Add(-time.Hour), Valid: true},
+		PageSize:  10,
+	})
+	require.NoError(t, err)
+	require.Empty(t, transfers)
+}
+
+func TestSearchTransfers(t *testing.T) {
+	account1 := createRandomAccount(t)
+	account2 := createRandomAccount(t)
+	account3 := createRandomAccount(t)
+
+	createTransfer := func(from, to Account, memo, reference string) Transfer {
+		transfer, err := testQueries.CreateTransfer(context.Background(), CreateTransferParams{
+			FromAccountID: from.ID,
+			ToAccountID:   to.ID,
+			Amount:        10,
+			ToAmount:      10,
+			Memo:          memo,
+			Reference:     reference,
+			InitiatedBy:   from.Owner,
+		})
+		require.NoError(t, err)
+		require.Equal(t, memo, transfer.Memo)
+		require.Equal(t, reference, transfer.Reference)
+		return transfer
+	}
+
+	rent := createTransfer(account1, account2, "Rent for March", "")
+	refund := createTransfer(account3, account1, "rent deposit refund", "INV-42")
+	createTransfer(account1, account2, "groceries", "")
+	createTransfer(account2, account3, "rent", "")
+
+	search := func(arg SearchTransfersParams) []Transfer {
+		arg.Owner = account1.Owner
+		arg.PageSize = 10
+		transfers, err := testQueries.SearchTransfers(context.Background(), arg)
+		require.NoError(t, err)
+		return transfers
+	}
+
+	// only the owner's transfers match, newest first, whichever side the owner is on
+	require.Equal(t, []Transfer{refund, rent}, search(SearchTransfersParams{Query: "rent"}))
+	require.Equal(t, []Transfer{rent}, search(SearchTransfersParams{Query: "rent -refund"}))
+	require.Equal(t, []Transfer{refund}, search(SearchTransfersParams{Query: "INV-42"}))
+	require.Equal(t, []Transfer{refund}, search(SearchTransfersParams{
+		Query:                 "rent",
+		CounterpartyAccountID: pgtype.Int8{Int64: account3.ID, Valid: true},
+	}))
+	require.Equal(t, []Transfer{rent}, search(SearchTransfersParams{
+		Query:    "rent",
+		BeforeID: pgtype.Int8{Int64: refund.ID, Valid: true},
+	}))
+	require.Empty(t, search(SearchTransfersParams{Query: "salary"}))
 }
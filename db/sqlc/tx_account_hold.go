@@ -0,0 +1,303 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// Statuses recorded in account_holds.status.
+const (
+	HoldStatusActive   = "active"
+	HoldStatusCaptured = "captured"
+	HoldStatusReleased = "released"
+	HoldStatusExpired  = "expired"
+)
+
+// PlaceHoldTxParams contains the parameters for the PlaceHoldTx function.
+type PlaceHoldTxParams struct {
+	AccountID   int64     `json:"account_id"`
+	ToAccountID int64     `json:"to_account_id"`
+	Amount      int64     `json:"amount"`
+	Description string    `json:"description"`
+	ExpiresAt   time.Time `json:"expires_at"`
+	// PlacedBy is the user whose transfer limits the capture counts against, the account's owner if empty.
+	PlacedBy string `json:"placed_by"`
+	// Role is the role of PlacedBy, used to pick the fee rule of the capture.
+	Role string `json:"role"`
+}
+
+// PlaceHoldTxResult contains the hold and the account it was placed on.
+type PlaceHoldTxResult struct {
+	Hold    AccountHold `json:"hold"`
+	Account Account     `json:"account"`
+}
+
+// PlaceHoldTx reserves amount of an account's funds for a later capture by the to account, along
+// with the fee TransferTx would charge PlacedBy for it. The funds stay in the balance but no longer
+// count towards the available balance, so the account is subject to the same funds check as a transfer. Both accounts must be active and hold
+// the same currency, otherwise it returns ErrAccountNotActive or ErrCurrencyMismatch.
+// A hold above the approval threshold of the account's currency returns ErrApprovalRequired, as
+// its capture would move the funds without an approval, and one above the transfer limit set for
+// PlacedBy as a holder of the account returns a TransferLimitError.
+func (store *SQLStore) PlaceHoldTx(ctx context.Context, arg PlaceHoldTxParams) (PlaceHoldTxResult, error) {
+	var result PlaceHoldTxResult
+	err := store.execTx(ctx, func(q txQueries) error {
+		if arg.Amount <= 0 {
+			return fmt.Errorf("hold amount must be positive, got %d", arg.Amount)
+		}
+
+		account, err := q.GetAccountForUpdate(ctx, arg.AccountID)
+		if err != nil {
+			return err
+		}
+
+		toAccount, err := q.GetAccount(ctx, arg.ToAccountID)
+		if err != nil {
+			return err
+		}
+		if err = checkAccountsActive(account, toAccount); err != nil {
+			return err
+		}
+		if account.Currency != toAccount.Currency {
+			return fmt.Errorf("%w: account %d holds %s, account %d holds %s", ErrCurrencyMismatch,
+				account.ID, account.Currency, toAccount.ID, toAccount.Currency)
+		}
+
+		if err = q.checkApprovalNotRequired(ctx, account, arg.Amount); err != nil {
+			return err
+		}
+
+		// the fee the capture will be charged is held with the amount, so that it can be paid
+		transferFee, err := q.transferFee(ctx, arg.Role, account, toAccount, arg.Amount)
+		if err != nil {
+			return err
+		}
+
+		heldAmount := arg.Amount + transferFee.Breakdown.Total
+		if err = checkSufficientFunds(account, heldAmount); err != nil {
+			return err
+		}
+
+		placedBy := arg.PlacedBy
+		if placedBy == "" {
+			placedBy = account.Owner
+		}
+		if err = q.checkHolderTransferLimit(ctx, placedBy, account, arg.Amount); err != nil {
+			return err
+		}
+
+		result.Hold, err = q.CreateAccountHold(ctx, CreateAccountHoldParams{
+			AccountID:   arg.AccountID,
+			ToAccountID: arg.ToAccountID,
+			Amount:      arg.Amount,
+			Description: arg.Description,
+			ExpiresAt:   pgtype.Timestamptz{Time: arg.ExpiresAt, Valid: true},
+			PlacedBy:    placedBy,
+			PlacerRole:  arg.Role,
+			HeldFee:     transferFee.Breakdown.Total,
+		})
+		if err != nil {
+			return err
+		}
+
+		result.Account, err = q.AddAccountHeldAmount(ctx, AddAccountHeldAmountParams{
+			ID:     arg.AccountID,
+			Amount: heldAmount,
+		})
+		if err != nil {
+			return err
+		}
+		return q.recordAudit(ctx, AuditAccountHoldCreate, "account_hold", result.Hold.ID, nil, result.Hold)
+	})
+
+	if err != nil {
+		return PlaceHoldTxResult{}, err
+	}
+	return result, nil
+}
+
+// CaptureHoldTxParams contains the parameters for the CaptureHoldTx function.
+type CaptureHoldTxParams struct {
+	HoldID int64 `json:"hold_id"`
+	Amount int64 `json:"amount"`
+}
+
+// CaptureHoldTxResult contains the captured hold and the transfer that moved its funds.
+type CaptureHoldTxResult struct {
+	Hold AccountHold `json:"hold"`
+	TransferTxResult
+}
+
+// CaptureHoldTx transfers amount of an active hold to its to account and releases the rest.
+// The transfer counts against the transfer limits of the user who placed the hold and is charged
+// the fee TransferTx would charge them, on top of the amount. Like TransferTx, it returns
+// ErrApprovalRequired if amount is above the approval threshold.
+// A hold can be captured once and only before it expires, otherwise it returns ErrHoldNotActive.
+// It returns ErrCaptureExceedsHold if amount is more than the hold.
+func (store *SQLStore) CaptureHoldTx(ctx context.Context, arg CaptureHoldTxParams) (CaptureHoldTxResult, error) {
+	var result CaptureHoldTxResult
+	err := store.execTx(ctx, func(q txQueries) error {
+		// lock the hold first so that a concurrent capture or release sees its new status
+		hold, err := q.GetAccountHoldForUpdate(ctx, arg.HoldID)
+		if err != nil {
+			return err
+		}
+
+		if hold.Status != HoldStatusActive || !hold.ExpiresAt.Time.After(time.Now()) {
+			return fmt.Errorf("%w: hold %d is %s and expires at %s",
+				ErrHoldNotActive, hold.ID, hold.Status, hold.ExpiresAt.Time.Format(time.RFC3339))
+		}
+		if err = q.checkHoldNotAwaitingApproval(ctx, hold); err != nil {
+			return err
+		}
+		if arg.Amount <= 0 {
+			return fmt.Errorf("capture amount must be positive, got %d", arg.Amount)
+		}
+		if arg.Amount > hold.Amount {
+			return fmt.Errorf("%w: hold %d is for %d, cannot capture %d",
+				ErrCaptureExceedsHold, hold.ID, hold.Amount, arg.Amount)
+		}
+
+		result.FromAccount, result.ToAccount, err = q.lockAccountsForUpdate(ctx, hold.AccountID, hold.ToAccountID)
+		if err != nil {
+			return err
+		}
+
+		if err = checkAccountsActive(result.FromAccount, result.ToAccount); err != nil {
+			return err
+		}
+
+		// the whole hold is released; the captured part is then debited like any transfer
+		result.FromAccount, err = q.AddAccountHeldAmount(ctx, AddAccountHeldAmountParams{
+			ID:     hold.AccountID,
+			Amount: -(hold.Amount + hold.HeldFee),
+		})
+		if err != nil {
+			return err
+		}
+
+		err = q.checkTransferLimits(ctx, store.transferLimits, hold.PlacedBy, result.FromAccount, arg.Amount, time.Now())
+		if err != nil {
+			return err
+		}
+		if err = q.checkApprovalNotRequired(ctx, result.FromAccount, arg.Amount); err != nil {
+			return err
+		}
+
+		transferFee, err := q.transferFee(ctx, hold.PlacerRole, result.FromAccount, result.ToAccount, arg.Amount)
+		if err != nil {
+			return err
+		}
+
+		if err = checkSufficientFunds(result.FromAccount, arg.Amount+transferFee.Breakdown.Total); err != nil {
+			return err
+		}
+
+		result.TransferTxResult, err = q.bookTransfer(ctx, CreateTransferParams{
+			FromAccountID: hold.AccountID,
+			ToAccountID:   hold.ToAccountID,
+			Amount:        arg.Amount,
+			ToAmount:      arg.Amount,
+			InitiatedBy:   hold.PlacedBy,
+		}, transferFee)
+		if err != nil {
+			return err
+		}
+
+		result.Hold, err = q.UpdateAccountHoldStatus(ctx, UpdateAccountHoldStatusParams{
+			ID:             hold.ID,
+			Status:         HoldStatusCaptured,
+			CapturedAmount: arg.Amount,
+			TransferID:     pgtype.Int8{Int64: result.Transfer.ID, Valid: true},
+		})
+		if err != nil {
+			return err
+		}
+		return q.recordAudit(ctx, AuditAccountHoldUpdate, "account_hold", hold.ID, hold, result.Hold)
+	})
+
+	if err != nil {
+		return CaptureHoldTxResult{}, err
+	}
+	return result, nil
+}
+
+// ReleaseHoldTxParams contains the parameters for the ReleaseHoldTx function.
+// Expired marks the hold as expired rather than released.
+type ReleaseHoldTxParams struct {
+	HoldID  int64 `json:"hold_id"`
+	Expired bool  `json:"expired"`
+}
+
+// ReleaseHoldTxResult contains the released hold and the account it was placed on.
+type ReleaseHoldTxResult struct {
+	Hold    AccountHold `json:"hold"`
+	Account Account     `json:"account"`
+}
+
+// ReleaseHoldTx gives the funds of an active hold back to the available balance without moving them.
+// It returns ErrHoldNotActive if the hold was already captured or released. A hold securing a
+// pending transfer approval can only expire, which expires the approval with it; releasing it
+// otherwise returns ErrHoldAwaitingApproval.
+func (store *SQLStore) ReleaseHoldTx(ctx context.Context, arg ReleaseHoldTxParams) (ReleaseHoldTxResult, error) {
+	var result ReleaseHoldTxResult
+	err := store.execTx(ctx, func(q txQueries) error {
+		hold, err := q.GetAccountHoldForUpdate(ctx, arg.HoldID)
+		if err != nil {
+			return err
+		}
+
+		if hold.Status != HoldStatusActive {
+			return fmt.Errorf("%w: hold %d is %s", ErrHoldNotActive, hold.ID, hold.Status)
+		}
+
+		if !arg.Expired {
+			if err = q.checkHoldNotAwaitingApproval(ctx, hold); err != nil {
+				return err
+			}
+			result.Hold, result.Account, err = q.releaseHold(ctx, hold, HoldStatusReleased)
+			if err != nil {
+				return err
+			}
+		} else {
+			result.Hold, result.Account, err = q.releaseHold(ctx, hold, HoldStatusExpired)
+			if err != nil {
+				return err
+			}
+			if err = q.expireTransferApproval(ctx, hold); err != nil {
+				return err
+			}
+		}
+
+		return q.recordAudit(ctx, AuditAccountHoldUpdate, "account_hold", hold.ID, hold, result.Hold)
+	})
+
+	if err != nil {
+		return ReleaseHoldTxResult{}, err
+	}
+	return result, nil
+}
+
+// releaseHold gives the funds of an active hold, which the caller has locked, back to the available
+// balance and closes it with status.
+func (q txQueries) releaseHold(ctx context.Context, hold AccountHold, status string) (AccountHold, Account, error) {
+	account, err := q.AddAccountHeldAmount(ctx, AddAccountHeldAmountParams{
+		ID:     hold.AccountID,
+		Amount: -(hold.Amount + hold.HeldFee),
+	})
+	if err != nil {
+		return AccountHold{}, Account{}, err
+	}
+
+	hold, err = q.UpdateAccountHoldStatus(ctx, UpdateAccountHoldStatusParams{
+		ID:     hold.ID,
+		Status: status,
+	})
+	if err != nil {
+		return AccountHold{}, Account{}, err
+	}
+	return hold, account, nil
+}
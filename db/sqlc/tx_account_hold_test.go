@@ -0,0 +1,126 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/mahanth/simplebank/util"
+	"github.com/stretchr/testify/require"
+)
+
+func placeTestHold(t *testing.T, store Store, account, toAccount Account, amount int64, expiresAt time.Time) PlaceHoldTxResult {
+	result, err := store.PlaceHoldTx(context.Background(), PlaceHoldTxParams{
+		AccountID:   account.ID,
+		ToAccountID: toAccount.ID,
+		Amount:      amount,
+		Description: util.RandomString(10),
+		ExpiresAt:   expiresAt,
+	})
+	require.NoError(t, err)
+	return result
+}
+
+func TestPlaceHoldTx(t *testing.T) {
+	store := NewStore(testDB)
+	account1 := createRandomAccountWithCurrency(t, util.USD, 1000)
+	account2 := createRandomAccountWithCurrency(t, util.USD, 0)
+
+	result := placeTestHold(t, store, account1, account2, 600, time.Now().Add(time.Hour))
+	require.Equal(t, HoldStatusActive, result.Hold.Status)
+	require.Equal(t, int64(600), result.Hold.Amount)
+	require.Equal(t, account1.Balance, result.Account.Balance)
+	require.Equal(t, int64(600), result.Account.HeldAmount)
+	require.Equal(t, account1.Balance-600, result.Account.AvailableBalance)
+
+	// held funds cannot be spent or held again
+	_, err := store.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        500,
+	})
+	require.True(t, errors.Is(err, ErrInsufficientFunds))
+
+	_, err = store.PlaceHoldTx(context.Background(), PlaceHoldTxParams{
+		AccountID:   account1.ID,
+		ToAccountID: account2.ID,
+		Amount:      500,
+		Description: "too much",
+		ExpiresAt:   time.Now().Add(time.Hour),
+	})
+	require.True(t, errors.Is(err, ErrInsufficientFunds))
+
+	_, err = store.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        400,
+	})
+	require.NoError(t, err)
+}
+
+func TestCaptureHoldTx(t *testing.T) {
+	store := NewStore(testDB)
+	account1 := createRandomAccountWithCurrency(t, util.USD, 1000)
+	account2 := createRandomAccountWithCurrency(t, util.USD, 0)
+
+	placed := placeTestHold(t, store, account1, account2, 600, time.Now().Add(time.Hour))
+
+	_, err := store.CaptureHoldTx(context.Background(), CaptureHoldTxParams{HoldID: placed.Hold.ID, Amount: 601})
+	require.True(t, errors.Is(err, ErrCaptureExceedsHold))
+
+	result, err := store.CaptureHoldTx(context.Background(), CaptureHoldTxParams{HoldID: placed.Hold.ID, Amount: 450})
+	require.NoError(t, err)
+	require.Equal(t, HoldStatusCaptured, result.Hold.Status)
+	require.Equal(t, int64(450), result.Hold.CapturedAmount)
+	require.Equal(t, result.Transfer.ID, result.Hold.TransferID.Int64)
+	require.True(t, result.Hold.ResolvedAt.Valid)
+
+	// the uncaptured rest is available again
+	require.Equal(t, account1.Balance-450, result.FromAccount.Balance)
+	require.Zero(t, result.FromAccount.HeldAmount)
+	require.Equal(t, account1.Balance-450, result.FromAccount.AvailableBalance)
+	require.Equal(t, account2.Balance+450, result.ToAccount.Balance)
+	require.Equal(t, EntryTypeTransfer, result.FromEntry.Type)
+	require.Equal(t, int64(-450), result.FromEntry.Amount)
+
+	_, err = store.CaptureHoldTx(context.Background(), CaptureHoldTxParams{HoldID: placed.Hold.ID, Amount: 100})
+	require.True(t, errors.Is(err, ErrHoldNotActive))
+	_, err = store.ReleaseHoldTx(context.Background(), ReleaseHoldTxParams{HoldID: placed.Hold.ID})
+	require.True(t, errors.Is(err, ErrHoldNotActive))
+}
+
+func TestReleaseHoldTx(t *testing.T) {
+	store := NewStore(testDB)
+	account1 := createRandomAccountWithCurrency(t, util.USD, 1000)
+	account2 := createRandomAccountWithCurrency(t, util.USD, 0)
+
+	placed := placeTestHold(t, store, account1, account2, 300, time.Now().Add(time.Hour))
+
+	result, err := store.ReleaseHoldTx(context.Background(), ReleaseHoldTxParams{HoldID: placed.Hold.ID})
+	require.NoError(t, err)
+	require.Equal(t, HoldStatusReleased, result.Hold.Status)
+	require.Zero(t, result.Hold.CapturedAmount)
+	require.Equal(t, account1.Balance, result.Account.Balance)
+	require.Equal(t, account1.Balance, result.Account.AvailableBalance)
+}
+
+func TestExpiredHold(t *testing.T) {
+	store := NewStore(testDB)
+	account1 := createRandomAccountWithCurrency(t, util.USD, 1000)
+	account2 := createRandomAccountWithCurrency(t, util.USD, 0)
+
+	placed := placeTestHold(t, store, account1, account2, 300, time.Now().Add(-time.Second))
+
+	_, err := store.CaptureHoldTx(context.Background(), CaptureHoldTxParams{HoldID: placed.Hold.ID, Amount: 300})
+	require.True(t, errors.Is(err, ErrHoldNotActive))
+
+	expired, err := store.ListExpiredAccountHolds(context.Background(), 1000)
+	require.NoError(t, err)
+	require.Contains(t, expired, placed.Hold)
+
+	result, err := store.ReleaseHoldTx(context.Background(), ReleaseHoldTxParams{HoldID: placed.Hold.ID, Expired: true})
+	require.NoError(t, err)
+	require.Equal(t, HoldStatusExpired, result.Hold.Status)
+	require.Zero(t, result.Account.HeldAmount)
+}
@@ -0,0 +1,124 @@
+package db
+
+import (
+	"context"
+	"fmt"
+)
+
+// Statuses recorded in accounts.status.
+const (
+	AccountStatusActive  = "active"
+	AccountStatusFrozen  = "frozen"
+	AccountStatusDormant = "dormant"
+	AccountStatusClosed  = "closed"
+)
+
+// accountStatusChanges lists the statuses an account may move to from each status.
+// A closed account stays closed.
+var accountStatusChanges = map[string][]string{
+	AccountStatusActive:  {AccountStatusFrozen, AccountStatusDormant, AccountStatusClosed},
+	AccountStatusFrozen:  {AccountStatusActive},
+	AccountStatusDormant: {AccountStatusActive, AccountStatusFrozen, AccountStatusClosed},
+}
+
+// IsSupportedAccountStatus reports whether status is one an account can be in.
+func IsSupportedAccountStatus(status string) bool {
+	switch status {
+	case AccountStatusActive, AccountStatusFrozen, AccountStatusDormant, AccountStatusClosed:
+		return true
+	}
+	return false
+}
+
+// ChangeAccountStatusTxParams contains the parameters for the ChangeAccountStatusTx function.
+type ChangeAccountStatusTxParams struct {
+	AccountID int64  `json:"account_id"`
+	Status    string `json:"status"`
+	ChangedBy string `json:"changed_by"`
+	Reason    string `json:"reason"`
+}
+
+// ChangeAccountStatusTxResult contains the updated account and the record of the change.
+type ChangeAccountStatusTxResult struct {
+	Account Account             `json:"account"`
+	Change  AccountStatusChange `json:"change"`
+}
+
+// ChangeAccountStatusTx moves an account to a new status and records who changed it and why.
+// It returns ErrInvalidStatusChange if the account cannot move from its current status to the new one,
+// and ErrAccountNotEmpty when closing an account whose balance is not zero or that has funds on hold.
+func (store *SQLStore) ChangeAccountStatusTx(ctx context.Context, arg ChangeAccountStatusTxParams) (ChangeAccountStatusTxResult, error) {
+	var result ChangeAccountStatusTxResult
+	err := store.execTx(ctx, func(q txQueries) error {
+		account, err := q.GetAccountForUpdate(ctx, arg.AccountID)
+		if err != nil {
+			return err
+		}
+
+		if !canChangeAccountStatus(account.Status, arg.Status) {
+			return fmt.Errorf("%w: account %d is %s, cannot become %s",
+				ErrInvalidStatusChange, account.ID, account.Status, arg.Status)
+		}
+
+		if arg.Status == AccountStatusClosed && (account.Balance != 0 || account.HeldAmount != 0) {
+			return fmt.Errorf("%w: account %d has balance %d and %d on hold",
+				ErrAccountNotEmpty, account.ID, account.Balance, account.HeldAmount)
+		}
+
+		result.Change, err = q.CreateAccountStatusChange(ctx, CreateAccountStatusChangeParams{
+			AccountID:  account.ID,
+			FromStatus: account.Status,
+			ToStatus:   arg.Status,
+			ChangedBy:  arg.ChangedBy,
+			Reason:     arg.Reason,
+		})
+		if err != nil {
+			return err
+		}
+
+		result.Account, err = q.UpdateAccountStatus(ctx, UpdateAccountStatusParams{
+			ID:     account.ID,
+			Status: arg.Status,
+		})
+		if err != nil {
+			return err
+		}
+
+		err = q.emitEvent(ctx, EventAccountStatusChanged, AccountStatusChangedEvent{
+			AccountID:  account.ID,
+			FromStatus: result.Change.FromStatus,
+			ToStatus:   result.Change.ToStatus,
+			ChangedBy:  result.Change.ChangedBy,
+			Reason:     result.Change.Reason,
+		})
+		if err != nil {
+			return err
+		}
+
+		return q.recordAudit(ctx, AuditAccountUpdate, "account", account.ID, account, result.Account)
+	})
+
+	if err != nil {
+		return ChangeAccountStatusTxResult{}, err
+	}
+	return result, nil
+}
+
+func canChangeAccountStatus(from, to string) bool {
+	for _, status := range accountStatusChanges[from] {
+		if status == to {
+			return true
+		}
+	}
+	return false
+}
+
+// checkAccountsActive reports whether money may move in or out of every one of the accounts.
+func checkAccountsActive(accounts ...Account) error {
+	for _, account := range accounts {
+		if account.Status != AccountStatusActive {
+			return fmt.Errorf("%w: account %d is %s", ErrAccountNotActive, account.ID, account.Status)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,113 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/mahanth/simplebank/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChangeAccountStatusTx(t *testing.T) {
+	store := NewStore(testDB)
+	account1 := createRandomAccountWithCurrency(t, util.USD, 1000)
+	account2 := createRandomAccountWithCurrency(t, util.USD, 0)
+	banker := createRandomUser(t)
+	require.Equal(t, AccountStatusActive, account1.Status)
+
+	result, err := store.ChangeAccountStatusTx(context.Background(), ChangeAccountStatusTxParams{
+		AccountID: account1.ID,
+		Status:    AccountStatusFrozen,
+		ChangedBy: banker.Username,
+		Reason:    "suspected fraud",
+	})
+	require.NoError(t, err)
+	require.Equal(t, AccountStatusFrozen, result.Account.Status)
+	require.Equal(t, AccountStatusActive, result.Change.FromStatus)
+	require.Equal(t, AccountStatusFrozen, result.Change.ToStatus)
+	require.Equal(t, banker.Username, result.Change.ChangedBy)
+	require.Equal(t, "suspected fraud", result.Change.Reason)
+
+	// no money moves in or out of a frozen account
+	_, err = store.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        10,
+	})
+	require.True(t, errors.Is(err, ErrAccountNotActive))
+
+	_, err = store.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: account2.ID,
+		ToAccountID:   account1.ID,
+		Amount:        10,
+	})
+	require.True(t, errors.Is(err, ErrAccountNotActive))
+
+	_, err = store.AddAccountBalance(context.Background(), AddAccountBalanceParams{ID: account1.ID, Amount: 10})
+	require.True(t, errors.Is(err, ErrAccountNotActive))
+
+	// bankers may still correct it
+	_, err = store.AdjustBalanceTx(context.Background(), AdjustBalanceTxParams{
+		AccountID: account1.ID,
+		Amount:    -1000,
+		Type:      EntryTypeAdjustment,
+	})
+	require.NoError(t, err)
+
+	// a frozen account has to be unfrozen before it can be closed
+	_, err = store.ChangeAccountStatusTx(context.Background(), ChangeAccountStatusTxParams{
+		AccountID: account1.ID,
+		Status:    AccountStatusClosed,
+		ChangedBy: banker.Username,
+		Reason:    "customer request",
+	})
+	require.True(t, errors.Is(err, ErrInvalidStatusChange))
+
+	_, err = store.ChangeAccountStatusTx(context.Background(), ChangeAccountStatusTxParams{
+		AccountID: account1.ID,
+		Status:    AccountStatusActive,
+		ChangedBy: banker.Username,
+		Reason:    "cleared",
+	})
+	require.NoError(t, err)
+
+	result, err = store.ChangeAccountStatusTx(context.Background(), ChangeAccountStatusTxParams{
+		AccountID: account1.ID,
+		Status:    AccountStatusClosed,
+		ChangedBy: banker.Username,
+		Reason:    "customer request",
+	})
+	require.NoError(t, err)
+	require.Equal(t, AccountStatusClosed, result.Account.Status)
+
+	changes, err := store.ListAccountStatusChanges(context.Background(), ListAccountStatusChangesParams{
+		AccountID: account1.ID,
+		Limit:     10,
+	})
+	require.NoError(t, err)
+	require.Len(t, changes, 3)
+
+	// a closed account stays closed
+	_, err = store.ChangeAccountStatusTx(context.Background(), ChangeAccountStatusTxParams{
+		AccountID: account1.ID,
+		Status:    AccountStatusActive,
+		ChangedBy: banker.Username,
+		Reason:    "reopen",
+	})
+	require.True(t, errors.Is(err, ErrInvalidStatusChange))
+}
+
+func TestCloseAccountWithBalance(t *testing.T) {
+	store := NewStore(testDB)
+	account := createRandomAccountWithCurrency(t, util.USD, 1000)
+	banker := createRandomUser(t)
+
+	_, err := store.ChangeAccountStatusTx(context.Background(), ChangeAccountStatusTxParams{
+		AccountID: account.ID,
+		Status:    AccountStatusClosed,
+		ChangedBy: banker.Username,
+		Reason:    "customer request",
+	})
+	require.True(t, errors.Is(err, ErrAccountNotEmpty))
+}
@@ -0,0 +1,94 @@
+package db
+
+import (
+	"context"
+	"fmt"
+)
+
+// AdjustBalanceTxParams contains the parameters for the AdjustBalanceTx function.
+type AdjustBalanceTxParams struct {
+	AccountID int64  `json:"account_id"`
+	Amount    int64  `json:"amount"`
+	Type      string `json:"type"`
+}
+
+// AdjustBalanceTxResult contains the updated account and the entry recording the change.
+type AdjustBalanceTxResult struct {
+	Account Account `json:"account"`
+	Entry   Entry   `json:"entry"`
+}
+
+// AdjustBalanceTx books a single entry against an account and applies it to the balance,
+// so that the account's entries always add up to its balance.
+// Deposits must be positive and withdrawals negative; withdrawals and fees are subject to the
+// same funds check as transfers, while bank adjustments may take the balance anywhere.
+// Only adjustments may be booked against an account that is not active, and never against a closed one.
+func (store *SQLStore) AdjustBalanceTx(ctx context.Context, arg AdjustBalanceTxParams) (AdjustBalanceTxResult, error) {
+	var result AdjustBalanceTxResult
+	err := store.execTx(ctx, func(q txQueries) error {
+		before, err := q.GetAccountForUpdate(ctx, arg.AccountID)
+		if err != nil {
+			return err
+		}
+
+		result.Account, result.Entry, err = q.adjustBalance(ctx, arg)
+		if err != nil {
+			return err
+		}
+		return q.recordAudit(ctx, AuditAccountUpdate, "account", result.Account.ID, before, result.Account)
+	})
+
+	if err != nil {
+		return AdjustBalanceTxResult{}, err
+	}
+	return result, nil
+}
+
+func (q txQueries) adjustBalance(ctx context.Context, arg AdjustBalanceTxParams) (account Account, entry Entry, err error) {
+	switch arg.Type {
+	case EntryTypeDeposit:
+		if arg.Amount <= 0 {
+			return account, entry, fmt.Errorf("deposit amount must be positive, got %d", arg.Amount)
+		}
+	case EntryTypeWithdrawal, EntryTypeFee:
+		if arg.Amount >= 0 {
+			return account, entry, fmt.Errorf("%s amount must be negative, got %d", arg.Type, arg.Amount)
+		}
+	case EntryTypeAdjustment:
+	default:
+		return account, entry, fmt.Errorf("entry type %q cannot be used to adjust a balance", arg.Type)
+	}
+
+	account, err = q.GetAccountForUpdate(ctx, arg.AccountID)
+	if err != nil {
+		return
+	}
+
+	// bankers may still correct the balance of a frozen or dormant account, but not of a closed one
+	if arg.Type != EntryTypeAdjustment || account.Status == AccountStatusClosed {
+		if err = checkAccountsActive(account); err != nil {
+			return
+		}
+	}
+
+	if arg.Type != EntryTypeAdjustment && arg.Amount < 0 {
+		if err = checkSufficientFunds(account, -arg.Amount); err != nil {
+			return
+		}
+	}
+
+	entry, err = q.CreateEntry(ctx, CreateEntryParams{
+		AccountID: arg.AccountID,
+		Amount:    arg.Amount,
+		Type:      arg.Type,
+	})
+	if err != nil {
+		return
+	}
+
+	account, err = q.AddAccountBalance(ctx, AddAccountBalanceParams{
+		ID:     arg.AccountID,
+		Amount: arg.Amount,
+	})
+	return
+}
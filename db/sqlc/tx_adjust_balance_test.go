@@ -0,0 +1,110 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/mahanth/simplebank/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdjustBalanceTx(t *testing.T) {
+	store := NewStore(testDB)
+	account := createRandomAccountWithBalance(t, 100)
+
+	result, err := store.AdjustBalanceTx(context.Background(), AdjustBalanceTxParams{
+		AccountID: account.ID,
+		Amount:    50,
+		Type:      EntryTypeDeposit,
+	})
+	require.NoError(t, err)
+	require.Equal(t, account.Balance+50, result.Account.Balance)
+	require.Equal(t, account.ID, result.Entry.AccountID)
+	require.Equal(t, int64(50), result.Entry.Amount)
+	require.Equal(t, EntryTypeDeposit, result.Entry.Type)
+	require.False(t, result.Entry.TransferID.Valid)
+
+	// withdrawals are subject to the funds check
+	_, err = store.AdjustBalanceTx(context.Background(), AdjustBalanceTxParams{
+		AccountID: account.ID,
+		Amount:    -(result.Account.Balance + 1),
+		Type:      EntryTypeWithdrawal,
+	})
+	require.Error(t, err)
+	require.True(t, errors.Is(err, ErrInsufficientFunds))
+
+	// the sign must match the entry type
+	_, err = store.AdjustBalanceTx(context.Background(), AdjustBalanceTxParams{
+		AccountID: account.ID,
+		Amount:    -10,
+		Type:      EntryTypeDeposit,
+	})
+	require.Error(t, err)
+
+	_, err = store.AdjustBalanceTx(context.Background(), AdjustBalanceTxParams{
+		AccountID: account.ID,
+		Amount:    10,
+		Type:      EntryTypeTransfer,
+	})
+	require.Error(t, err)
+}
+
+func TestEntriesSumToBalance(t *testing.T) {
+	store := NewStore(testDB)
+	user := createRandomUser(t)
+
+	account, err := store.CreateAccount(context.Background(), CreateAccountParams{
+		Owner:    user.Username,
+		Balance:  util.RandomInt(100, 1000),
+		Currency: util.USD,
+		Type:     AccountTypeChecking,
+	})
+	require.NoError(t, err)
+	other := createRandomAccountWithCurrency(t, util.USD, 1000)
+
+	_, err = store.AddAccountBalance(context.Background(), AddAccountBalanceParams{
+		ID:     account.ID,
+		Amount: util.RandomInt(1, 100),
+	})
+	require.NoError(t, err)
+
+	_, err = store.AddAccountBalance(context.Background(), AddAccountBalanceParams{
+		ID:     account.ID,
+		Amount: -util.RandomInt(1, 100),
+	})
+	require.NoError(t, err)
+
+	transfer, err := store.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: other.ID,
+		ToAccountID:   account.ID,
+		Amount:        10,
+	})
+	require.NoError(t, err)
+
+	account, err = store.UpdateAccount(context.Background(), UpdateAccountParams{
+		ID:      account.ID,
+		Balance: util.RandomInt(0, 1000),
+	})
+	require.NoError(t, err)
+
+	entries, err := store.ListEntries(context.Background(), ListEntriesParams{
+		AccountID: account.ID,
+		PageSize:  100,
+	})
+	require.NoError(t, err)
+	require.Len(t, entries, 5)
+
+	var sum int64
+	for _, entry := range entries {
+		sum += entry.Amount
+	}
+	require.Equal(t, account.Balance, sum)
+
+	transferEntries, err := store.ListEntriesByTransfer(context.Background(), pgtype.Int8{Int64: transfer.Transfer.ID, Valid: true})
+	require.NoError(t, err)
+	require.Len(t, transferEntries, 2)
+	require.Equal(t, transfer.FromEntry.ID, transferEntries[0].ID)
+	require.Equal(t, transfer.ToEntry.ID, transferEntries[1].ID)
+}
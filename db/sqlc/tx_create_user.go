@@ -4,26 +4,33 @@ import "context"
 
 type CreateUserTxParams struct {
 	CreateUserParams
-	AfterCreate func(user User) error
 }
 
 type CreateUserTxResult struct {
 	User User
 }
 
-// TranferTx performs a money transfer from one account to another within a transaction context.
+// CreateUserTx creates a user and records EventUserCreated in the outbox, so that the
+// verification email is sent once the user is committed, and only then. The user is also
+// recorded in the audit log.
 func (store *SQLStore) CreateUserTx(ctx context.Context, arg CreateUserTxParams) (CreateUserTxResult, error) {
 	var result CreateUserTxResult
-	err := store.execTx(ctx, func(q *Queries) error {
+	err := store.execTx(ctx, func(q txQueries) error {
 		var err error
 		result.User, err = q.CreateUser(ctx, arg.CreateUserParams)
 		if err != nil {
 			return err
 		}
 
-		err = arg.AfterCreate(result.User)
+		err = q.emitEvent(ctx, EventUserCreated, UserCreatedEvent{
+			Username: result.User.Username,
+			Email:    result.User.Email,
+		})
+		if err != nil {
+			return err
+		}
 
-		return err
+		return q.recordAudit(ctx, AuditUserCreate, "user", result.User.Username, nil, auditUser(result.User))
 	})
 
 	return result, err
@@ -0,0 +1,241 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/mahanth/simplebank/fx"
+)
+
+const (
+	// interestDaysPerYear is the day count an annual rate is divided by to accrue a day of interest.
+	interestDaysPerYear = 365
+
+	// accrualScale is the number of decimal places of a minor unit a daily accrual is kept to.
+	accrualScale = 10
+)
+
+// AccrualDate returns the UTC calendar day t falls on.
+func AccrualDate(t time.Time) time.Time {
+	year, month, day := t.UTC().Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+// AccrueInterestTxParams contains the parameters for the AccrueInterestTx function.
+type AccrueInterestTxParams struct {
+	AccountID   int64     `json:"account_id"`
+	AccrualDate time.Time `json:"accrual_date"`
+}
+
+// AccrueInterestTxResult contains the accrual recorded for the day. Accrued is false if nothing was
+// recorded, because the account earns no interest or the day had already been accrued.
+type AccrueInterestTxResult struct {
+	Accrual InterestAccrual `json:"accrual"`
+	Accrued bool            `json:"accrued"`
+}
+
+// AccrueInterestTx records a day of interest on an active savings account that ended the day with
+// a positive balance, at the rate in effect for its type and currency at the start of the day.
+// Interest is accrued unrounded and paid by CreditInterestTx. A day is accrued at most once per
+// account, so running it again for the same day does nothing.
+func (store *SQLStore) AccrueInterestTx(ctx context.Context, arg AccrueInterestTxParams) (AccrueInterestTxResult, error) {
+	var result AccrueInterestTxResult
+	err := store.execTx(ctx, func(q txQueries) error {
+		account, err := q.GetAccount(ctx, arg.AccountID)
+		if err != nil {
+			return err
+		}
+
+		date := AccrualDate(arg.AccrualDate)
+		dayEnd := date.AddDate(0, 0, 1).Add(-time.Microsecond)
+		if account.Type != AccountTypeSavings || account.Status != AccountStatusActive ||
+			account.CreatedAt.Time.After(dayEnd) {
+			return nil
+		}
+
+		// the day may be accrued late, so it is accrued on the balance it ended with
+		balance, err := q.GetBalanceAt(ctx, GetBalanceAtParams{
+			AccountID: account.ID,
+			At:        pgtype.Timestamptz{Time: dayEnd, Valid: true},
+		})
+		if err != nil {
+			return err
+		}
+		if balance <= 0 {
+			return nil
+		}
+
+		rate, err := q.GetInterestRate(ctx, GetInterestRateParams{
+			AccountType: account.Type,
+			Currency:    account.Currency,
+			At:          pgtype.Timestamptz{Time: date, Valid: true},
+		})
+		if err != nil {
+			if errors.Is(err, ErrRecordNotFound) {
+				return nil
+			}
+			return err
+		}
+
+		annualRate, err := ratFromNumeric(rate.AnnualRate)
+		if err != nil {
+			return err
+		}
+
+		daily := new(big.Rat).Mul(new(big.Rat).SetInt64(balance), annualRate)
+		daily.Quo(daily, big.NewRat(interestDaysPerYear, 1))
+		amount, err := numericFromRat(daily, accrualScale)
+		if err != nil {
+			return err
+		}
+
+		result.Accrual, err = q.CreateInterestAccrual(ctx, CreateInterestAccrualParams{
+			AccountID:   account.ID,
+			AccrualDate: pgtype.Date{Time: date, Valid: true},
+			Balance:     balance,
+			AnnualRate:  rate.AnnualRate,
+			Amount:      amount,
+		})
+		if err != nil {
+			// the day was accrued before
+			if errors.Is(err, ErrRecordNotFound) {
+				return nil
+			}
+			return err
+		}
+
+		result.Accrued = true
+		return nil
+	})
+
+	if err != nil {
+		return AccrueInterestTxResult{}, err
+	}
+	return result, nil
+}
+
+// CreditInterestTxParams contains the parameters for the CreditInterestTx function.
+// Through is the last accrual date to pay, normally the last day of a month.
+type CreditInterestTxParams struct {
+	AccountID int64     `json:"account_id"`
+	Through   time.Time `json:"through"`
+}
+
+// CreditInterestTxResult contains the interest paid, the entries booking it and the updated accounts.
+// Amount is zero if there was nothing to pay.
+type CreditInterestTxResult struct {
+	Amount        int64   `json:"amount"`
+	Account       Account `json:"account"`
+	SystemAccount Account `json:"system_account"`
+	Entry         Entry   `json:"entry"`
+	SystemEntry   Entry   `json:"system_entry"`
+}
+
+// CreditInterestTx pays the interest accrued on an account up to and including Through that was
+// not paid yet. All interest credited to the account is rounded half to even and what its earlier
+// credits did not pay yet is booked as a pair of interest entries that move it from the bank's system
+// account in the same currency, so the ledger stays balanced and no remainder of a minor unit is lost.
+// Accruals are marked as paid in the same transaction, so running it again pays nothing.
+// It fails with ErrAccountNotActive if the account is not active, leaving its accruals unpaid.
+func (store *SQLStore) CreditInterestTx(ctx context.Context, arg CreditInterestTxParams) (CreditInterestTxResult, error) {
+	var result CreditInterestTxResult
+	err := store.execTx(ctx, func(q txQueries) error {
+		through := pgtype.Date{Time: AccrualDate(arg.Through), Valid: true}
+
+		// the accruals stay locked until they are marked as paid
+		accruals, err := q.ListUncreditedInterestAccruals(ctx, ListUncreditedInterestAccrualsParams{
+			AccountID: arg.AccountID,
+			Through:   through,
+		})
+		if err != nil || len(accruals) == 0 {
+			return err
+		}
+
+		account, err := q.GetAccount(ctx, arg.AccountID)
+		if err != nil {
+			return err
+		}
+
+		system, err := q.bankAccount(ctx, SystemUsername, account.Currency)
+		if err != nil {
+			return err
+		}
+
+		result.SystemAccount, result.Account, err = q.lockAccountsForUpdate(ctx, system.ID, account.ID)
+		if err != nil {
+			return err
+		}
+		account = result.Account
+
+		// the accruals stay unpaid until the account is active again
+		if err := checkAccountsActive(result.SystemAccount, account); err != nil {
+			return err
+		}
+
+		credited, err := q.GetCreditedInterest(ctx, account.ID)
+		if err != nil {
+			return err
+		}
+
+		total, err := ratFromNumeric(credited.Accrued)
+		if err != nil {
+			return err
+		}
+		for _, accrual := range accruals {
+			amount, err := ratFromNumeric(accrual.Amount)
+			if err != nil {
+				return err
+			}
+			total.Add(total, amount)
+		}
+		// what rounding left unpaid before is paid once it adds up to a minor unit
+		result.Amount = max(fx.Round(total)-credited.Paid, 0)
+
+		var entryID pgtype.Int8
+		if result.Amount > 0 {
+			result.SystemEntry, err = q.CreateEntry(ctx, CreateEntryParams{
+				AccountID: system.ID,
+				Amount:    -result.Amount,
+				Type:      EntryTypeInterest,
+			})
+			if err != nil {
+				return err
+			}
+
+			result.Entry, err = q.CreateEntry(ctx, CreateEntryParams{
+				AccountID: account.ID,
+				Amount:    result.Amount,
+				Type:      EntryTypeInterest,
+			})
+			if err != nil {
+				return err
+			}
+
+			result.SystemAccount, result.Account, err = q.transferMoney(ctx,
+				system.ID, account.ID, result.Amount, result.Amount)
+			if err != nil {
+				return err
+			}
+
+			entryID = pgtype.Int8{Int64: result.Entry.ID, Valid: true}
+		}
+
+		err = q.MarkInterestAccrualsCredited(ctx, MarkInterestAccrualsCreditedParams{
+			AccountID: arg.AccountID,
+			EntryID:   entryID,
+			Through:   through,
+		})
+		if err != nil || result.Amount == 0 {
+			return err
+		}
+		return q.recordAudit(ctx, AuditAccountUpdate, "account", account.ID, account, result.Account)
+	})
+
+	if err != nil {
+		return CreditInterestTxResult{}, err
+	}
+	return result, nil
+}
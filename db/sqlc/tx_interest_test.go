@@ -0,0 +1,219 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/mahanth/simplebank/util"
+	"github.com/stretchr/testify/require"
+)
+
+// testAnnualRate accrues exactly 1 a day on a balance of 10000.
+const testAnnualRate = "0.0365"
+
+func setTestInterestRate(t *testing.T, currency string, effectiveAt time.Time) {
+	var rate pgtype.Numeric
+	require.NoError(t, rate.Scan(testAnnualRate))
+
+	_, err := testQueries.UpsertInterestRate(context.Background(), UpsertInterestRateParams{
+		AccountType: AccountTypeSavings,
+		Currency:    currency,
+		AnnualRate:  rate,
+		EffectiveAt: pgtype.Timestamptz{Time: effectiveAt, Valid: true},
+	})
+	require.NoError(t, err)
+}
+
+func TestAccrueInterestTx(t *testing.T) {
+	store := NewStore(testDB)
+	date := time.Date(2100, 3, 1, 0, 0, 0, 0, time.UTC)
+	setTestInterestRate(t, util.USD, date.AddDate(0, -1, 0))
+
+	account := createRandomAccountWithType(t, AccountTypeSavings, util.USD, 10000)
+
+	result, err := store.AccrueInterestTx(context.Background(), AccrueInterestTxParams{
+		AccountID:   account.ID,
+		AccrualDate: date.Add(13 * time.Hour),
+	})
+	require.NoError(t, err)
+	require.True(t, result.Accrued)
+	require.Equal(t, account.ID, result.Accrual.AccountID)
+	require.Equal(t, date, result.Accrual.AccrualDate.Time)
+	require.Equal(t, account.Balance, result.Accrual.Balance)
+	require.False(t, result.Accrual.CreditedAt.Valid)
+
+	amount, err := ratFromNumeric(result.Accrual.Amount)
+	require.NoError(t, err)
+	require.Equal(t, "1.0000000000", amount.FloatString(accrualScale))
+
+	// a day is accrued once
+	result, err = store.AccrueInterestTx(context.Background(), AccrueInterestTxParams{
+		AccountID:   account.ID,
+		AccrualDate: date,
+	})
+	require.NoError(t, err)
+	require.False(t, result.Accrued)
+}
+
+func TestAccrueInterestTxSkipsCheckingAccounts(t *testing.T) {
+	store := NewStore(testDB)
+	date := time.Date(2100, 3, 1, 0, 0, 0, 0, time.UTC)
+	setTestInterestRate(t, util.USD, date.AddDate(0, -1, 0))
+
+	account := createRandomAccountWithCurrency(t, util.USD, 10000)
+
+	result, err := store.AccrueInterestTx(context.Background(), AccrueInterestTxParams{
+		AccountID:   account.ID,
+		AccrualDate: date,
+	})
+	require.NoError(t, err)
+	require.False(t, result.Accrued)
+}
+
+func TestCreditInterestTx(t *testing.T) {
+	store := NewStore(testDB)
+	start := time.Date(2100, 4, 1, 0, 0, 0, 0, time.UTC)
+	setTestInterestRate(t, util.EUR, start.AddDate(0, -1, 0))
+
+	account := createRandomAccountWithType(t, AccountTypeSavings, util.EUR, 10000)
+
+	days := 30
+	for i := 0; i < days; i++ {
+		result, err := store.AccrueInterestTx(context.Background(), AccrueInterestTxParams{
+			AccountID:   account.ID,
+			AccrualDate: start.AddDate(0, 0, i),
+		})
+		require.NoError(t, err)
+		require.True(t, result.Accrued)
+	}
+
+	through := start.AddDate(0, 0, days-1)
+	result, err := store.CreditInterestTx(context.Background(), CreditInterestTxParams{
+		AccountID: account.ID,
+		Through:   through,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(days), result.Amount)
+	require.Equal(t, account.Balance+int64(days), result.Account.Balance)
+
+	require.Equal(t, EntryTypeInterest, result.Entry.Type)
+	require.Equal(t, int64(days), result.Entry.Amount)
+	require.Equal(t, EntryTypeInterest, result.SystemEntry.Type)
+	require.Equal(t, -int64(days), result.SystemEntry.Amount)
+	require.Equal(t, SystemUsername, result.SystemAccount.Owner)
+	require.Equal(t, util.EUR, result.SystemAccount.Currency)
+
+	accruals, err := store.ListInterestAccruals(context.Background(), ListInterestAccrualsParams{
+		AccountID: account.ID,
+		Limit:     int32(days),
+		Offset:    0,
+	})
+	require.NoError(t, err)
+	require.Len(t, accruals, days)
+	for _, accrual := range accruals {
+		require.True(t, accrual.CreditedAt.Valid)
+		require.Equal(t, result.Entry.ID, accrual.EntryID.Int64)
+	}
+
+	// interest is paid once
+	result, err = store.CreditInterestTx(context.Background(), CreditInterestTxParams{
+		AccountID: account.ID,
+		Through:   through,
+	})
+	require.NoError(t, err)
+	require.Zero(t, result.Amount)
+}
+
+func TestCreditInterestTxCarriesRemainder(t *testing.T) {
+	store := NewStore(testDB)
+	start := time.Date(2100, 4, 1, 0, 0, 0, 0, time.UTC)
+	setTestInterestRate(t, util.EUR, start.AddDate(0, -1, 0))
+
+	// half a minor unit accrues a day
+	account := createRandomAccountWithType(t, AccountTypeSavings, util.EUR, 5000)
+
+	for i, want := range []int64{0, 1} {
+		date := start.AddDate(0, 0, i)
+		accrued, err := store.AccrueInterestTx(context.Background(), AccrueInterestTxParams{
+			AccountID:   account.ID,
+			AccrualDate: date,
+		})
+		require.NoError(t, err)
+		require.True(t, accrued.Accrued)
+
+		result, err := store.CreditInterestTx(context.Background(), CreditInterestTxParams{
+			AccountID: account.ID,
+			Through:   date,
+		})
+		require.NoError(t, err)
+		require.Equal(t, want, result.Amount)
+	}
+
+	account, err := store.GetAccount(context.Background(), account.ID)
+	require.NoError(t, err)
+	require.Equal(t, int64(5001), account.Balance)
+}
+
+func TestCreditInterestTxAccountNotActive(t *testing.T) {
+	store := NewStore(testDB)
+	start := time.Date(2100, 4, 1, 0, 0, 0, 0, time.UTC)
+	setTestInterestRate(t, util.EUR, start.AddDate(0, -1, 0))
+
+	account := createRandomAccountWithType(t, AccountTypeSavings, util.EUR, 10000)
+	_, err := store.AccrueInterestTx(context.Background(), AccrueInterestTxParams{
+		AccountID:   account.ID,
+		AccrualDate: start,
+	})
+	require.NoError(t, err)
+
+	_, err = store.ChangeAccountStatusTx(context.Background(), ChangeAccountStatusTxParams{
+		AccountID: account.ID,
+		Status:    AccountStatusFrozen,
+		ChangedBy: account.Owner,
+		Reason:    "suspicious activity",
+	})
+	require.NoError(t, err)
+
+	_, err = store.CreditInterestTx(context.Background(), CreditInterestTxParams{
+		AccountID: account.ID,
+		Through:   start,
+	})
+	require.ErrorIs(t, err, ErrAccountNotActive)
+
+	// the interest is paid once the account is active again
+	accruals, err := store.ListInterestAccruals(context.Background(), ListInterestAccrualsParams{
+		AccountID: account.ID,
+		Limit:     1,
+	})
+	require.NoError(t, err)
+	require.Len(t, accruals, 1)
+	require.False(t, accruals[0].CreditedAt.Valid)
+}
+
+func TestAccrueInterestTxAccountNotFound(t *testing.T) {
+	store := NewStore(testDB)
+
+	_, err := store.AccrueInterestTx(context.Background(), AccrueInterestTxParams{
+		AccountID:   -1,
+		AccrualDate: time.Now(),
+	})
+	require.True(t, errors.Is(err, ErrRecordNotFound))
+}
+
+func TestAccrueInterestTxSkipsDaysBeforeOpening(t *testing.T) {
+	store := NewStore(testDB)
+	date := AccrualDate(time.Now()).AddDate(0, 0, -1)
+	setTestInterestRate(t, util.CAD, date.AddDate(0, -1, 0))
+
+	account := createRandomAccountWithType(t, AccountTypeSavings, util.CAD, 10000)
+
+	result, err := store.AccrueInterestTx(context.Background(), AccrueInterestTxParams{
+		AccountID:   account.ID,
+		AccrualDate: date,
+	})
+	require.NoError(t, err)
+	require.False(t, result.Accrued)
+}
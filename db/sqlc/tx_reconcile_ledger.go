@@ -0,0 +1,125 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// Kinds of discrepancy reported by ReconcileLedgerTx.
+const (
+	DiscrepancyAccountBalance    = "account_balance"
+	DiscrepancyUnmatchedTransfer = "unmatched_transfer"
+	DiscrepancyCurrencyImbalance = "currency_imbalance"
+)
+
+// Discrepancy describes one ledger invariant that did not hold.
+type Discrepancy struct {
+	Kind       string `json:"kind"`
+	AccountID  int64  `json:"account_id,omitempty"`
+	TransferID int64  `json:"transfer_id,omitempty"`
+	Currency   string `json:"currency,omitempty"`
+	Expected   int64  `json:"expected"`
+	Actual     int64  `json:"actual"`
+	Detail     string `json:"detail"`
+}
+
+type ReconcileLedgerTxParams struct {
+	TriggeredBy string `json:"triggered_by"`
+}
+
+type ReconcileLedgerTxResult struct {
+	Report        ReconciliationReport `json:"report"`
+	Discrepancies []Discrepancy        `json:"discrepancies"`
+}
+
+// ReconcileLedgerTx checks the ledger invariants and stores the outcome as a reconciliation report:
+// every account balance equals the sum of its entries, every transfer has exactly one debit and one
+// credit entry and reversal entries matching its reversed amount, and entries posted by
+// same-currency transfers net to zero in each currency.
+// The checks run against a single snapshot so that transfers committed meanwhile cannot show up
+// as false discrepancies.
+func (store *SQLStore) ReconcileLedgerTx(ctx context.Context, arg ReconcileLedgerTxParams) (ReconcileLedgerTxResult, error) {
+	var result ReconcileLedgerTxResult
+	err := store.execTxWithOptions(ctx, pgx.TxOptions{IsoLevel: pgx.RepeatableRead}, func(q txQueries) error {
+		var err error
+		result.Discrepancies, err = q.findDiscrepancies(ctx)
+		if err != nil {
+			return err
+		}
+
+		discrepancies, err := json.Marshal(result.Discrepancies)
+		if err != nil {
+			return fmt.Errorf("failed to marshal discrepancies: %w", err)
+		}
+
+		result.Report, err = q.CreateReconciliationReport(ctx, CreateReconciliationReportParams{
+			TriggeredBy:      arg.TriggeredBy,
+			DiscrepancyCount: int32(len(result.Discrepancies)),
+			Discrepancies:    discrepancies,
+		})
+		return err
+	})
+
+	if err != nil {
+		return ReconcileLedgerTxResult{}, err
+	}
+	return result, nil
+}
+
+func (q txQueries) findDiscrepancies(ctx context.Context) ([]Discrepancy, error) {
+	discrepancies := []Discrepancy{}
+
+	mismatches, err := q.ListAccountBalanceMismatches(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, mismatch := range mismatches {
+		discrepancies = append(discrepancies, Discrepancy{
+			Kind:      DiscrepancyAccountBalance,
+			AccountID: mismatch.ID,
+			Currency:  mismatch.Currency,
+			Expected:  mismatch.EntriesTotal,
+			Actual:    mismatch.Balance,
+			Detail: fmt.Sprintf("account %d has balance %d but its entries sum to %d",
+				mismatch.ID, mismatch.Balance, mismatch.EntriesTotal),
+		})
+	}
+
+	transfers, err := q.ListUnmatchedTransfers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, transfer := range transfers {
+		discrepancies = append(discrepancies, Discrepancy{
+			Kind:       DiscrepancyUnmatchedTransfer,
+			TransferID: transfer.ID,
+			Expected:   2,
+			Actual:     int64(transfer.EntryCount),
+			Detail: fmt.Sprintf("transfer %d of %d from account %d to account %d has %d debit and %d credit entries out of %d, "+
+				"reversal entries refunding %d of the %d reversed and fee entries charging %d of the %d fee",
+				transfer.ID, transfer.Amount, transfer.FromAccountID, transfer.ToAccountID,
+				transfer.DebitCount, transfer.CreditCount, transfer.EntryCount,
+				transfer.RefundedTotal, transfer.ReversedAmount, transfer.FeeTotal, transfer.FeeAmount),
+		})
+	}
+
+	imbalances, err := q.ListCurrencyImbalances(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, imbalance := range imbalances {
+		discrepancies = append(discrepancies, Discrepancy{
+			Kind:     DiscrepancyCurrencyImbalance,
+			Currency: imbalance.Currency,
+			Expected: imbalance.Debits,
+			Actual:   imbalance.Credits,
+			Detail: fmt.Sprintf("transfers in %s debited %d but credited %d",
+				imbalance.Currency, imbalance.Debits, imbalance.Credits),
+		})
+	}
+
+	return discrepancies, nil
+}
@@ -0,0 +1,45 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReconcileLedgerTx(t *testing.T) {
+	store := NewStore(testDB)
+	account := createRandomAccountWithBalance(t, 100)
+
+	// bypass the store so that the balance no longer matches the entries
+	_, err := testQueries.AddAccountBalance(context.Background(), AddAccountBalanceParams{
+		ID:     account.ID,
+		Amount: 10,
+	})
+	require.NoError(t, err)
+
+	result, err := store.ReconcileLedgerTx(context.Background(), ReconcileLedgerTxParams{
+		TriggeredBy: "tester",
+	})
+	require.NoError(t, err)
+	require.NotZero(t, result.Report.ID)
+	require.Equal(t, "tester", result.Report.TriggeredBy)
+	require.Equal(t, int32(len(result.Discrepancies)), result.Report.DiscrepancyCount)
+
+	var found *Discrepancy
+	for i := range result.Discrepancies {
+		if result.Discrepancies[i].Kind == DiscrepancyAccountBalance && result.Discrepancies[i].AccountID == account.ID {
+			found = &result.Discrepancies[i]
+		}
+	}
+	require.NotNil(t, found)
+	require.Equal(t, account.Balance+10, found.Actual)
+
+	report, err := store.GetReconciliationReport(context.Background(), result.Report.ID)
+	require.NoError(t, err)
+
+	var stored []Discrepancy
+	require.NoError(t, json.Unmarshal(report.Discrepancies, &stored))
+	require.Equal(t, result.Discrepancies, stored)
+}
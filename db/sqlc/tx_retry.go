@@ -0,0 +1,124 @@
+package db
+
+import (
+	"context"
+	"math/rand/v2"
+	"sync/atomic"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// TxRetryPolicy bounds how a transaction that fails with a deadlock or serialization failure is
+// retried. The delay before the nth retry is drawn between half and all of BaseDelay doubled n-1
+// times, capped at MaxDelay, so that transactions that collided once do not collide again.
+type TxRetryPolicy struct {
+	MaxRetries int           `json:"max_retries"`
+	BaseDelay  time.Duration `json:"base_delay"`
+	MaxDelay   time.Duration `json:"max_delay"`
+}
+
+// DefaultTxRetryPolicy is the policy of a store built without WithTxRetryPolicy.
+var DefaultTxRetryPolicy = TxRetryPolicy{
+	MaxRetries: 5,
+	BaseDelay:  10 * time.Millisecond,
+	MaxDelay:   500 * time.Millisecond,
+}
+
+// WithTxRetryPolicy sets how transactions are retried. A policy with no retries disables them.
+func WithTxRetryPolicy(policy TxRetryPolicy) StoreOption {
+	return func(store *SQLStore) {
+		store.txRetry = policy
+	}
+}
+
+// delay returns how long to wait before the given retry, counted from 1.
+func (policy TxRetryPolicy) delay(retry int) time.Duration {
+	delay := policy.BaseDelay
+	for i := 1; i < retry && delay < policy.MaxDelay; i++ {
+		delay *= 2
+	}
+	delay = min(delay, policy.MaxDelay)
+	if delay <= 0 {
+		return 0
+	}
+	return delay/2 + rand.N(delay/2+1)
+}
+
+// TxStats counts the retries of a store's transactions since it was built.
+type TxStats struct {
+	// Retries is the number of attempts made after a transient failure.
+	Retries int64 `json:"retries"`
+	// Deadlocks and SerializationFailures count the transient failures by their cause.
+	Deadlocks             int64 `json:"deadlocks"`
+	SerializationFailures int64 `json:"serialization_failures"`
+	// Exhausted is the number of transactions that still failed after the last retry.
+	Exhausted int64 `json:"exhausted"`
+}
+
+type txStats struct {
+	retries               atomic.Int64
+	deadlocks             atomic.Int64
+	serializationFailures atomic.Int64
+	exhausted             atomic.Int64
+}
+
+// TxStats returns the retry counts of the store's transactions.
+func (store *SQLStore) TxStats() TxStats {
+	return TxStats{
+		Retries:               store.txStats.retries.Load(),
+		Deadlocks:             store.txStats.deadlocks.Load(),
+		SerializationFailures: store.txStats.serializationFailures.Load(),
+		Exhausted:             store.txStats.exhausted.Load(),
+	}
+}
+
+type txIsolationKey struct{}
+
+// WithTxIsolation returns a context under which the store's transactions run at the given isolation
+// level instead of the database default. Transactions that need a particular level set it themselves.
+func WithTxIsolation(ctx context.Context, level pgx.TxIsoLevel) context.Context {
+	return context.WithValue(ctx, txIsolationKey{}, level)
+}
+
+// TxIsolation returns the isolation level set on ctx by WithTxIsolation, or an empty level for the default.
+func TxIsolation(ctx context.Context) pgx.TxIsoLevel {
+	level, _ := ctx.Value(txIsolationKey{}).(pgx.TxIsoLevel)
+	return level
+}
+
+// isTransient reports whether err is a failure that the same transaction may not meet again.
+func isTransient(err error) bool {
+	code := ErrorCode(err)
+	return code == DeadlockDetected || code == SerializationFailure
+}
+
+// retryTx calls attempt until it succeeds, fails with an error that is not transient, runs out of
+// retries or ctx is done. It returns the error of the last attempt.
+func retryTx(ctx context.Context, policy TxRetryPolicy, stats *txStats, attempt func() error) error {
+	for retry := 1; ; retry++ {
+		err := attempt()
+		if err == nil || !isTransient(err) {
+			return err
+		}
+
+		if ErrorCode(err) == DeadlockDetected {
+			stats.deadlocks.Add(1)
+		} else {
+			stats.serializationFailures.Add(1)
+		}
+		if retry > policy.MaxRetries {
+			stats.exhausted.Add(1)
+			return err
+		}
+
+		timer := time.NewTimer(policy.delay(retry))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		case <-timer.C:
+		}
+		stats.retries.Add(1)
+	}
+}
@@ -0,0 +1,143 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRetryTx(t *testing.T) {
+	policy := TxRetryPolicy{MaxRetries: 3, BaseDelay: time.Microsecond, MaxDelay: time.Millisecond}
+	deadlock := &pgconn.PgError{Code: DeadlockDetected}
+	serializationFailure := &pgconn.PgError{Code: SerializationFailure}
+
+	testCases := []struct {
+		name          string
+		errs          []error
+		expectedErr   error
+		expectedStats TxStats
+	}{
+		{
+			name: "NoError",
+			errs: []error{nil},
+		},
+		{
+			name:          "RetriedUntilCommitted",
+			errs:          []error{deadlock, serializationFailure, nil},
+			expectedStats: TxStats{Retries: 2, Deadlocks: 1, SerializationFailures: 1},
+		},
+		{
+			name:          "WrappedTransientError",
+			errs:          []error{fmt.Errorf("cannot lock account: %w", deadlock), nil},
+			expectedStats: TxStats{Retries: 1, Deadlocks: 1},
+		},
+		{
+			name:        "NotTransient",
+			errs:        []error{ErrInsufficientFunds},
+			expectedErr: ErrInsufficientFunds,
+		},
+		{
+			name:          "Exhausted",
+			errs:          []error{deadlock, deadlock, deadlock, deadlock},
+			expectedErr:   deadlock,
+			expectedStats: TxStats{Retries: 3, Deadlocks: 4, Exhausted: 1},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			stats := &txStats{}
+			store := &SQLStore{txStats: stats}
+
+			attempts := 0
+			err := retryTx(context.Background(), policy, stats, func() error {
+				err := tc.errs[attempts]
+				attempts++
+				return err
+			})
+			require.ErrorIs(t, err, tc.expectedErr)
+			require.Equal(t, len(tc.errs), attempts)
+			require.Equal(t, tc.expectedStats, store.TxStats())
+		})
+	}
+}
+
+func TestRetryTxCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	attempts := 0
+	err := retryTx(ctx, DefaultTxRetryPolicy, &txStats{}, func() error {
+		attempts++
+		return &pgconn.PgError{Code: SerializationFailure}
+	})
+	require.Equal(t, SerializationFailure, ErrorCode(err))
+	require.Equal(t, 1, attempts)
+}
+
+func TestTxRetryPolicyDelay(t *testing.T) {
+	policy := TxRetryPolicy{MaxRetries: 10, BaseDelay: 10 * time.Millisecond, MaxDelay: 50 * time.Millisecond}
+
+	for retry, maxDelay := range []time.Duration{10, 20, 40, 50, 50} {
+		maxDelay *= time.Millisecond
+		delay := policy.delay(retry + 1)
+		require.GreaterOrEqual(t, delay, maxDelay/2)
+		require.LessOrEqual(t, delay, maxDelay)
+	}
+
+	require.Zero(t, TxRetryPolicy{}.delay(1))
+}
+
+func TestWithTxIsolation(t *testing.T) {
+	ctx := context.Background()
+	require.Empty(t, TxIsolation(ctx))
+	require.Equal(t, pgx.Serializable, TxIsolation(WithTxIsolation(ctx, pgx.Serializable)))
+}
+
+// deadlockOnceDB rolls back the first transaction it runs with a deadlock, after fn succeeded.
+type deadlockOnceDB struct {
+	database
+	deadlocked bool
+}
+
+func (db *deadlockOnceDB) runTx(ctx context.Context, opts pgx.TxOptions, fn func(Querier) error) error {
+	return db.database.runTx(ctx, opts, func(q Querier) error {
+		if err := fn(q); err != nil {
+			return err
+		}
+		if !db.deadlocked {
+			db.deadlocked = true
+			return &pgconn.PgError{Code: DeadlockDetected}
+		}
+		return nil
+	})
+}
+
+func TestRetriedTxStartsAfresh(t *testing.T) {
+	db := &deadlockOnceDB{database: newMemoryDB(), deadlocked: true}
+	store := newStore(db, WithTxRetryPolicy(TxRetryPolicy{MaxRetries: 1}))
+	user := createStoreUser(t, store)
+
+	// the first attempt zeroing the balance it was given must not leave the retry without a deposit
+	db.deadlocked = false
+	account, err := store.CreateAccount(context.Background(), CreateAccountParams{
+		Owner:    user.Username,
+		Balance:  100,
+		Currency: "USD",
+		Type:     AccountTypeChecking,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(100), account.Balance)
+	require.Equal(t, TxStats{Retries: 1, Deadlocks: 1}, store.TxStats())
+
+	// the account the rolled back attempt created is gone
+	accounts, err := store.ListAccounts(context.Background(), ListAccountsParams{Owner: user.Username, Limit: 5})
+	require.NoError(t, err)
+	require.Equal(t, []Account{account}, accounts)
+}
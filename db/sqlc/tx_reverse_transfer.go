@@ -0,0 +1,151 @@
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/mahanth/simplebank/fx"
+)
+
+// Statuses recorded in transfers.status.
+const (
+	TransferStatusCompleted         = "completed"
+	TransferStatusPartiallyReversed = "partially_reversed"
+	TransferStatusReversed          = "reversed"
+)
+
+// ReverseTransferTxParams contains the parameters for the ReverseTransferTx function.
+// Amount is in the currency the original transfer was debited in.
+type ReverseTransferTxParams struct {
+	TransferID  int64  `json:"transfer_id"`
+	Amount      int64  `json:"amount"`
+	Reason      string `json:"reason"`
+	InitiatedBy string `json:"initiated_by"`
+}
+
+// ReverseTransferTxResult contains the updated original transfer, the reversal, and the accounts
+// and entries it touched. FromEntry credits the original sender and ToEntry debits the original receiver.
+type ReverseTransferTxResult struct {
+	Transfer    Transfer         `json:"transfer"`
+	Reversal    TransferReversal `json:"reversal"`
+	FromAccount Account          `json:"from_account"`
+	ToAccount   Account          `json:"to_account"`
+	FromEntry   Entry            `json:"from_entry"`
+	ToEntry     Entry            `json:"to_entry"`
+}
+
+// ReverseTransferTx refunds all or part of a transfer: it debits the receiver, credits the sender and
+// books both as reversal entries linked to the original transfer, which is marked partially or fully
+// reversed. The refunds of a transfer can never add up to more than its amount, otherwise it returns
+// ErrReversalExceedsTransfer. The receiver is subject to the same funds check as a transfer.
+// The fee charged on the transfer is not refunded.
+func (store *SQLStore) ReverseTransferTx(ctx context.Context, arg ReverseTransferTxParams) (ReverseTransferTxResult, error) {
+	var result ReverseTransferTxResult
+	err := store.execTx(ctx, func(q txQueries) error {
+		// lock the transfer first so that concurrent refunds see each other's reversed amount
+		transfer, err := q.GetTransferForUpdate(ctx, arg.TransferID)
+		if err != nil {
+			return err
+		}
+
+		if arg.Amount <= 0 {
+			return fmt.Errorf("reversal amount must be positive, got %d", arg.Amount)
+		}
+		if arg.Amount > transfer.Amount-transfer.ReversedAmount {
+			return fmt.Errorf("%w: transfer %d of %d has %d left to reverse, cannot reverse %d",
+				ErrReversalExceedsTransfer, transfer.ID, transfer.Amount, transfer.Amount-transfer.ReversedAmount, arg.Amount)
+		}
+
+		reversedAmount := transfer.ReversedAmount + arg.Amount
+		toAmount := reversedToAmount(transfer, reversedAmount) - reversedToAmount(transfer, transfer.ReversedAmount)
+
+		// the money flows back, so the receiver is debited
+		result.ToAccount, result.FromAccount, err = q.lockAccountsForUpdate(ctx, transfer.ToAccountID, transfer.FromAccountID)
+		if err != nil {
+			return err
+		}
+
+		if err = checkAccountsActive(result.ToAccount, result.FromAccount); err != nil {
+			return err
+		}
+
+		if err = checkSufficientFunds(result.ToAccount, toAmount); err != nil {
+			return err
+		}
+
+		result.Reversal, err = q.CreateTransferReversal(ctx, CreateTransferReversalParams{
+			TransferID:  transfer.ID,
+			Amount:      arg.Amount,
+			ToAmount:    toAmount,
+			Reason:      arg.Reason,
+			InitiatedBy: arg.InitiatedBy,
+		})
+		if err != nil {
+			return err
+		}
+
+		transferID := pgtype.Int8{Int64: transfer.ID, Valid: true}
+
+		result.FromEntry, err = q.CreateEntry(ctx, CreateEntryParams{
+			AccountID:  transfer.FromAccountID,
+			Amount:     arg.Amount,
+			Type:       EntryTypeReversal,
+			TransferID: transferID,
+		})
+		if err != nil {
+			return err
+		}
+
+		result.ToEntry, err = q.CreateEntry(ctx, CreateEntryParams{
+			AccountID:  transfer.ToAccountID,
+			Amount:     -toAmount,
+			Type:       EntryTypeReversal,
+			TransferID: transferID,
+		})
+		if err != nil {
+			return err
+		}
+
+		result.ToAccount, result.FromAccount, err = q.transferMoney(ctx,
+			transfer.ToAccountID, transfer.FromAccountID, toAmount, arg.Amount)
+		if err != nil {
+			return err
+		}
+
+		status := TransferStatusPartiallyReversed
+		if reversedAmount == transfer.Amount {
+			status = TransferStatusReversed
+		}
+
+		result.Transfer, err = q.UpdateTransferReversal(ctx, UpdateTransferReversalParams{
+			ID:             transfer.ID,
+			ReversedAmount: reversedAmount,
+			Status:         status,
+		})
+		if err != nil {
+			return err
+		}
+
+		err = q.recordAudit(ctx, AuditTransferReversalCreate, "transfer_reversal", result.Reversal.ID, nil, result.Reversal)
+		if err != nil {
+			return err
+		}
+		return q.recordAudit(ctx, AuditTransferUpdate, "transfer", transfer.ID, transfer, result.Transfer)
+	})
+
+	if err != nil {
+		return ReverseTransferTxResult{}, err
+	}
+	return result, nil
+}
+
+// reversedToAmount returns how much of the credited to_amount corresponds to reversedAmount of the
+// transfer amount. Computing it on the running total rather than per refund makes the partial
+// refunds of a cross-currency transfer add up to exactly its to_amount.
+func reversedToAmount(transfer Transfer, reversedAmount int64) int64 {
+	if transfer.ToAmount == transfer.Amount {
+		return reversedAmount
+	}
+	return fx.Prorate(transfer.ToAmount, reversedAmount, transfer.Amount)
+}
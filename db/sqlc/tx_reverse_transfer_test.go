@@ -0,0 +1,146 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/mahanth/simplebank/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReverseTransferTx(t *testing.T) {
+	store := NewStore(testDB)
+	account1 := createRandomAccountWithCurrency(t, util.USD, 1000)
+	account2 := createRandomAccountWithCurrency(t, util.USD, 0)
+	banker := createRandomUser(t)
+
+	transfer, err := store.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        100,
+	})
+	require.NoError(t, err)
+
+	// partial refund
+	result, err := store.ReverseTransferTx(context.Background(), ReverseTransferTxParams{
+		TransferID:  transfer.Transfer.ID,
+		Amount:      40,
+		Reason:      "wrong amount",
+		InitiatedBy: banker.Username,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(40), result.Transfer.ReversedAmount)
+	require.Equal(t, TransferStatusPartiallyReversed, result.Transfer.Status)
+	require.Equal(t, int64(40), result.Reversal.Amount)
+	require.Equal(t, int64(40), result.Reversal.ToAmount)
+	require.Equal(t, banker.Username, result.Reversal.InitiatedBy)
+	require.Equal(t, account1.Balance-100+40, result.FromAccount.Balance)
+	require.Equal(t, account2.Balance+100-40, result.ToAccount.Balance)
+
+	for _, entry := range []Entry{result.FromEntry, result.ToEntry} {
+		require.Equal(t, EntryTypeReversal, entry.Type)
+		require.Equal(t, transfer.Transfer.ID, entry.TransferID.Int64)
+	}
+	require.Equal(t, int64(40), result.FromEntry.Amount)
+	require.Equal(t, int64(-40), result.ToEntry.Amount)
+
+	// refunds never add up to more than the transfer
+	_, err = store.ReverseTransferTx(context.Background(), ReverseTransferTxParams{
+		TransferID:  transfer.Transfer.ID,
+		Amount:      61,
+		Reason:      "too much",
+		InitiatedBy: banker.Username,
+	})
+	require.Error(t, err)
+	require.True(t, errors.Is(err, ErrReversalExceedsTransfer))
+
+	result, err = store.ReverseTransferTx(context.Background(), ReverseTransferTxParams{
+		TransferID:  transfer.Transfer.ID,
+		Amount:      60,
+		Reason:      "rest of it",
+		InitiatedBy: banker.Username,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(100), result.Transfer.ReversedAmount)
+	require.Equal(t, TransferStatusReversed, result.Transfer.Status)
+	require.Equal(t, account1.Balance, result.FromAccount.Balance)
+	require.Equal(t, account2.Balance, result.ToAccount.Balance)
+
+	entries, err := store.ListEntriesByTransfer(context.Background(), pgtype.Int8{Int64: transfer.Transfer.ID, Valid: true})
+	require.NoError(t, err)
+	require.Len(t, entries, 6)
+
+	reversals, err := store.ListTransferReversals(context.Background(), transfer.Transfer.ID)
+	require.NoError(t, err)
+	require.Len(t, reversals, 2)
+}
+
+func TestReverseTransferTxInsufficientFunds(t *testing.T) {
+	store := NewStore(testDB)
+	account1 := createRandomAccountWithCurrency(t, util.USD, 1000)
+	account2 := createRandomAccountWithCurrency(t, util.USD, 0)
+	account3 := createRandomAccountWithCurrency(t, util.USD, 0)
+
+	transfer, err := store.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        100,
+	})
+	require.NoError(t, err)
+
+	// the receiver already spent most of it
+	_, err = store.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: account2.ID,
+		ToAccountID:   account3.ID,
+		Amount:        80,
+	})
+	require.NoError(t, err)
+
+	_, err = store.ReverseTransferTx(context.Background(), ReverseTransferTxParams{
+		TransferID:  transfer.Transfer.ID,
+		Amount:      100,
+		Reason:      "mistake",
+		InitiatedBy: account2.Owner,
+	})
+	require.Error(t, err)
+	require.True(t, errors.Is(err, ErrInsufficientFunds))
+
+	updated, err := store.GetTransfer(context.Background(), transfer.Transfer.ID)
+	require.NoError(t, err)
+	require.Zero(t, updated.ReversedAmount)
+	require.Equal(t, TransferStatusCompleted, updated.Status)
+}
+
+func TestReverseCrossCurrencyTransferTx(t *testing.T) {
+	store := NewStore(testDB)
+	loadTestExchangeRates(t, store)
+	account1 := createRandomAccountWithCurrency(t, util.EUR, 10000)
+	account2 := createRandomAccountWithCurrency(t, util.USD, 0)
+
+	transfer, err := store.CrossCurrencyTransferTx(context.Background(), TransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        1001,
+	})
+	require.NoError(t, err)
+
+	// three refunds whose converted amounts have to round
+	var debited int64
+	for _, amount := range []int64{333, 333, 335} {
+		result, err := store.ReverseTransferTx(context.Background(), ReverseTransferTxParams{
+			TransferID:  transfer.Transfer.ID,
+			Amount:      amount,
+			Reason:      "refund",
+			InitiatedBy: account2.Owner,
+		})
+		require.NoError(t, err)
+		debited += result.Reversal.ToAmount
+	}
+	require.Equal(t, transfer.Transfer.ToAmount, debited)
+
+	account2, err = store.GetAccount(context.Background(), account2.ID)
+	require.NoError(t, err)
+	require.Zero(t, account2.Balance)
+}
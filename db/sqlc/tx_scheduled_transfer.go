@@ -0,0 +1,104 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/mahanth/simplebank/util"
+)
+
+// Statuses recorded in scheduled_transfers.status.
+const (
+	ScheduledTransferActive    = "active"
+	ScheduledTransferPaused    = "paused"
+	ScheduledTransferCancelled = "cancelled"
+	ScheduledTransferCompleted = "completed"
+)
+
+// Statuses recorded in scheduled_transfer_executions.status.
+const (
+	ExecutionSucceeded = "succeeded"
+	ExecutionFailed    = "failed"
+)
+
+// RecordScheduledTransferExecutionTxParams describes the outcome of one run of a scheduled transfer.
+// Exactly one of TransferID and FailureReason should be set.
+type RecordScheduledTransferExecutionTxParams struct {
+	ScheduledTransferID int64     `json:"scheduled_transfer_id"`
+	ScheduledFor        time.Time `json:"scheduled_for"`
+	TransferID          int64     `json:"transfer_id"`
+	FailureReason       string    `json:"failure_reason"`
+}
+
+type RecordScheduledTransferExecutionTxResult struct {
+	ScheduledTransfer ScheduledTransfer          `json:"scheduled_transfer"`
+	Execution         ScheduledTransferExecution `json:"execution"`
+}
+
+// RecordScheduledTransferExecutionTx stores the outcome of a run in the history of the scheduled
+// transfer and, if it was the run the schedule was waiting for, advances next_run_at to the next
+// run after now. A schedule whose next run falls after end_at is marked completed.
+// Recording the same run twice keeps the first outcome.
+func (store *SQLStore) RecordScheduledTransferExecutionTx(ctx context.Context, arg RecordScheduledTransferExecutionTxParams) (RecordScheduledTransferExecutionTxResult, error) {
+	var result RecordScheduledTransferExecutionTxResult
+	err := store.execTx(ctx, func(q txQueries) error {
+		var err error
+
+		result.ScheduledTransfer, err = q.GetScheduledTransferForUpdate(ctx, arg.ScheduledTransferID)
+		if err != nil {
+			return err
+		}
+
+		status := ExecutionSucceeded
+		if arg.FailureReason != "" {
+			status = ExecutionFailed
+		}
+
+		result.Execution, err = q.CreateScheduledTransferExecution(ctx, CreateScheduledTransferExecutionParams{
+			ScheduledTransferID: arg.ScheduledTransferID,
+			ScheduledFor:        pgtype.Timestamptz{Time: arg.ScheduledFor, Valid: true},
+			Status:              status,
+			TransferID:          pgtype.Int8{Int64: arg.TransferID, Valid: arg.TransferID != 0},
+			FailureReason:       pgtype.Text{String: arg.FailureReason, Valid: arg.FailureReason != ""},
+		})
+		if err != nil {
+			if errors.Is(err, ErrRecordNotFound) {
+				// this run was already recorded
+				return nil
+			}
+			return err
+		}
+
+		scheduled := result.ScheduledTransfer
+		if scheduled.Status != ScheduledTransferActive || !scheduled.NextRunAt.Time.Equal(arg.ScheduledFor) {
+			return nil
+		}
+
+		schedule, err := util.ParseSchedule(scheduled.Schedule)
+		if err != nil {
+			return fmt.Errorf("scheduled transfer %d has an invalid schedule: %w", scheduled.ID, err)
+		}
+
+		next := util.NextRun(schedule, arg.ScheduledFor, time.Now())
+		update := UpdateScheduledTransferStatusParams{
+			ID:        scheduled.ID,
+			Status:    ScheduledTransferActive,
+			NextRunAt: pgtype.Timestamptz{Time: next, Valid: true},
+		}
+		if scheduled.EndAt.Valid && next.After(scheduled.EndAt.Time) {
+			update.Status = ScheduledTransferCompleted
+			update.NextRunAt = pgtype.Timestamptz{}
+		}
+
+		result.ScheduledTransfer, err = q.UpdateScheduledTransferStatus(ctx, update)
+		return err
+	})
+
+	if err != nil {
+		return RecordScheduledTransferExecutionTxResult{}, err
+	}
+	return result, nil
+}
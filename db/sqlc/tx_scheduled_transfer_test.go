@@ -0,0 +1,117 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/mahanth/simplebank/util"
+	"github.com/stretchr/testify/require"
+)
+
+func createRandomScheduledTransfer(t *testing.T, schedule string, nextRunAt time.Time, endAt pgtype.Timestamptz) ScheduledTransfer {
+	account1 := createRandomAccountWithCurrency(t, util.USD, 1000)
+	account2 := createRandomAccountWithCurrency(t, util.USD, 0)
+
+	arg := CreateScheduledTransferParams{
+		Owner:         account1.Owner,
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        10,
+		Schedule:      schedule,
+		StartAt:       pgtype.Timestamptz{Time: nextRunAt, Valid: true},
+		EndAt:         endAt,
+		NextRunAt:     pgtype.Timestamptz{Time: nextRunAt, Valid: true},
+	}
+
+	scheduled, err := testQueries.CreateScheduledTransfer(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, arg.Owner, scheduled.Owner)
+	require.Equal(t, arg.FromAccountID, scheduled.FromAccountID)
+	require.Equal(t, arg.ToAccountID, scheduled.ToAccountID)
+	require.Equal(t, arg.Amount, scheduled.Amount)
+	require.Equal(t, arg.Schedule, scheduled.Schedule)
+	require.Equal(t, ScheduledTransferActive, scheduled.Status)
+	require.WithinDuration(t, nextRunAt, scheduled.NextRunAt.Time, time.Second)
+	return scheduled
+}
+
+func TestListDueScheduledTransfers(t *testing.T) {
+	due := createRandomScheduledTransfer(t, "@every 1h", time.Now().Add(-time.Minute).Truncate(time.Second), pgtype.Timestamptz{})
+	notDue := createRandomScheduledTransfer(t, "@every 1h", time.Now().Add(time.Hour).Truncate(time.Second), pgtype.Timestamptz{})
+
+	scheduledTransfers, err := testQueries.ListDueScheduledTransfers(context.Background(), 1000)
+	require.NoError(t, err)
+
+	ids := make(map[int64]bool)
+	for _, scheduled := range scheduledTransfers {
+		ids[scheduled.ID] = true
+	}
+	require.True(t, ids[due.ID])
+	require.False(t, ids[notDue.ID])
+}
+
+func TestRecordScheduledTransferExecutionTx(t *testing.T) {
+	store := NewStore(testDB)
+	scheduledFor := time.Now().Add(-90 * time.Minute).Truncate(time.Second)
+	scheduled := createRandomScheduledTransfer(t, "@every 1h", scheduledFor, pgtype.Timestamptz{})
+
+	result, err := store.RecordScheduledTransferExecutionTx(context.Background(), RecordScheduledTransferExecutionTxParams{
+		ScheduledTransferID: scheduled.ID,
+		ScheduledFor:        scheduledFor,
+		FailureReason:       "insufficient funds",
+	})
+	require.NoError(t, err)
+	require.Equal(t, ExecutionFailed, result.Execution.Status)
+	require.Equal(t, "insufficient funds", result.Execution.FailureReason.String)
+	require.False(t, result.Execution.TransferID.Valid)
+
+	// the missed run 30 minutes ago is skipped
+	require.Equal(t, ScheduledTransferActive, result.ScheduledTransfer.Status)
+	require.WithinDuration(t, scheduledFor.Add(2*time.Hour), result.ScheduledTransfer.NextRunAt.Time, time.Second)
+
+	// recording the same run again changes nothing
+	again, err := store.RecordScheduledTransferExecutionTx(context.Background(), RecordScheduledTransferExecutionTxParams{
+		ScheduledTransferID: scheduled.ID,
+		ScheduledFor:        scheduledFor,
+		FailureReason:       "insufficient funds",
+	})
+	require.NoError(t, err)
+	require.Zero(t, again.Execution.ID)
+	require.Equal(t, result.ScheduledTransfer.NextRunAt, again.ScheduledTransfer.NextRunAt)
+
+	executions, err := store.ListScheduledTransferExecutions(context.Background(), ListScheduledTransferExecutionsParams{
+		ScheduledTransferID: scheduled.ID,
+		Limit:               10,
+		Offset:              0,
+	})
+	require.NoError(t, err)
+	require.Len(t, executions, 1)
+	require.Equal(t, result.Execution.ID, executions[0].ID)
+}
+
+func TestRecordScheduledTransferExecutionTxCompletes(t *testing.T) {
+	store := NewStore(testDB)
+	scheduledFor := time.Now().Add(-time.Minute).Truncate(time.Second)
+	endAt := pgtype.Timestamptz{Time: scheduledFor.Add(30 * time.Minute), Valid: true}
+	scheduled := createRandomScheduledTransfer(t, "@every 1h", scheduledFor, endAt)
+
+	transfer, err := store.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: scheduled.FromAccountID,
+		ToAccountID:   scheduled.ToAccountID,
+		Amount:        scheduled.Amount,
+	})
+	require.NoError(t, err)
+
+	result, err := store.RecordScheduledTransferExecutionTx(context.Background(), RecordScheduledTransferExecutionTxParams{
+		ScheduledTransferID: scheduled.ID,
+		ScheduledFor:        scheduledFor,
+		TransferID:          transfer.Transfer.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, ExecutionSucceeded, result.Execution.Status)
+	require.Equal(t, transfer.Transfer.ID, result.Execution.TransferID.Int64)
+	require.Equal(t, ScheduledTransferCompleted, result.ScheduledTransfer.Status)
+	require.False(t, result.ScheduledTransfer.NextRunAt.Valid)
+}
@@ -1,73 +1,266 @@
 package db
 
-import "context"
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/mahanth/simplebank/fx"
+)
 
 // TransferTxParams contains the parameters for the TransferTx function.
 type TransferTxParams struct {
 	FromAccountID int64 `json:"from_account_id"`
 	ToAccountID   int64 `json:"to_account_id"`
 	Amount        int64 `json:"amount"`
+	// Memo and Reference are optional and recorded on the transfer as they are given.
+	Memo      string `json:"memo,omitempty"`
+	Reference string `json:"reference,omitempty"`
+	// IdempotencyKey is optional. When set, a retry by the same Username with the same
+	// key and payload returns the original result instead of moving the money again.
+	// The transfer counts against the transfer limits of Username, or of the sender's owner if empty.
+	Username       string `json:"username"`
+	IdempotencyKey string `json:"idempotency_key"`
+	// Role is the role of the user making the transfer, used to pick its fee rule.
+	// Fee rules for a specific role do not apply if it is empty.
+	Role string `json:"role,omitempty"`
+	// approved is set for a transfer a banker approved, which may exceed the approval threshold.
+	approved bool
+}
+
+// payload returns the part of the params an idempotency key is bound to.
+func (arg TransferTxParams) payload() TransferTxParams {
+	arg.Username = ""
+	arg.IdempotencyKey = ""
+	arg.Role = ""
+	return arg
+}
+
+// initiator returns the user whose transfer limits the transfer counts against: Username, or the
+// owner of the sending account for a transfer made on no one's behalf.
+func (arg TransferTxParams) initiator(from Account) string {
+	if arg.Username != "" {
+		return arg.Username
+	}
+	return from.Owner
 }
 
 // TransferTxResult contains the result of the TransferTx function.
 // It includes the transfer details, the accounts involved, and the entries created for the transaction.
 type TransferTxResult struct {
-	Transfer    Transfer `json:"transfer"`
-	FromAccount Account  `json:"from_account"`
-	ToAccount   Account  `json:"to_account"`
-	FromEntry   Entry    `json:"from_entry"`
-	ToEntry     Entry    `json:"to_entry"`
+	Transfer    Transfer    `json:"transfer"`
+	FromAccount Account     `json:"from_account"`
+	ToAccount   Account     `json:"to_account"`
+	FromEntry   Entry       `json:"from_entry"`
+	ToEntry     Entry       `json:"to_entry"`
+	Fee         TransferFee `json:"fee"`
 }
 
 // TranferTx performs a money transfer from one account to another within a transaction context.
+// Both accounts must be active, otherwise it returns ErrAccountNotActive, and hold the same currency,
+// otherwise it returns ErrCurrencyMismatch.
+// The fee of the most specific matching fee rule is charged to the sender on top of the amount.
+// It returns ErrInsufficientFunds if the transfer and its fee would take the sender below its overdraft limit,
+// and a *TransferLimitError wrapping ErrTransferLimitExceeded if it would exceed one of the sender's transfer limits.
+// An amount above the approval threshold of the sender's currency returns ErrApprovalRequired;
+// such a transfer is made through RequestTransferApprovalTx instead. A retry with the IdempotencyKey
+// of such a request returns ErrApprovalRequired again, and RequestTransferApprovalTx replays the approval.
 func (store *SQLStore) TransferTx(ctx context.Context, arg TransferTxParams) (TransferTxResult, error) {
+	return store.transferTx(ctx, arg, false)
+}
+
+// CrossCurrencyTransferTx is TransferTx for accounts that may hold different currencies.
+// Amount is debited in the sender's currency and converted at the exchange rate in effect;
+// the rate and the converted amount are recorded on the transfer.
+// It returns ErrExchangeRateNotFound if no rate is known for the pair of currencies.
+func (store *SQLStore) CrossCurrencyTransferTx(ctx context.Context, arg TransferTxParams) (TransferTxResult, error) {
+	return store.transferTx(ctx, arg, true)
+}
+
+func (store *SQLStore) transferTx(ctx context.Context, arg TransferTxParams, convert bool) (TransferTxResult, error) {
 	var result TransferTxResult
-	err := store.execTx(ctx, func(q *Queries) error {
+	err := store.execTx(ctx, func(q txQueries) error {
+		if arg.IdempotencyKey != "" {
+			replayed, err := q.claimIdempotencyKey(ctx, idempotencyOperationTransfer,
+				arg.Username, arg.IdempotencyKey, arg.payload(), &result)
+			// the key was claimed by the approval request made when the transfer first needed approval
+			var operationErr *idempotencyOperationError
+			if errors.As(err, &operationErr) && operationErr.operation == idempotencyOperationTransferApproval {
+				return ErrApprovalRequired
+			}
+			if err != nil || replayed {
+				return err
+			}
+		}
+
 		var err error
-		result.Transfer, err = q.CreateTransfer(ctx, CreateTransferParams{
-			FromAccountID: arg.FromAccountID,
-			ToAccountID:   arg.ToAccountID,
-			Amount:        arg.Amount,
-		})
+		result, err = q.transfer(ctx, store.transferLimits, arg, convert)
 		if err != nil {
 			return err
 		}
 
-		result.FromAccount, err = q.GetAccount(ctx, arg.FromAccountID)
+		if arg.IdempotencyKey != "" {
+			return q.saveIdempotentResponse(ctx, arg.Username, arg.IdempotencyKey, result)
+		}
+		return nil
+	})
 
-		if err != nil {
-			return err
+	if err != nil {
+		return TransferTxResult{}, err
+	}
+	return result, nil
+}
+
+// transfer makes a transfer within the running transaction: it locks both accounts, checks them,
+// the sender's transfer limits, the approval threshold unless the transfer was approved and the
+// funds, and books the transfer and its fee.
+func (q txQueries) transfer(ctx context.Context, limits TransferLimits, arg TransferTxParams, convert bool) (result TransferTxResult, err error) {
+	// lock both accounts first so that concurrent transfers cannot overdraw the sender
+	result.FromAccount, result.ToAccount, err = q.lockAccountsForUpdate(ctx, arg.FromAccountID, arg.ToAccountID)
+	if err != nil {
+		return TransferTxResult{}, err
+	}
+
+	if err = checkAccountsActive(result.FromAccount, result.ToAccount); err != nil {
+		return TransferTxResult{}, err
+	}
+
+	initiatedBy := arg.initiator(result.FromAccount)
+	err = q.checkTransferLimits(ctx, limits, initiatedBy, result.FromAccount, arg.Amount, time.Now())
+	if err != nil {
+		return TransferTxResult{}, err
+	}
+
+	if !arg.approved {
+		if err = q.checkApprovalNotRequired(ctx, result.FromAccount, arg.Amount); err != nil {
+			return TransferTxResult{}, err
 		}
+	}
 
-		result.ToAccount, err = q.GetAccount(ctx, arg.ToAccountID)
+	transferFee, err := q.transferFee(ctx, arg.Role, result.FromAccount, result.ToAccount, arg.Amount)
+	if err != nil {
+		return TransferTxResult{}, err
+	}
 
-		if err != nil {
-			return err
+	if err = checkSufficientFunds(result.FromAccount, arg.Amount+transferFee.Breakdown.Total); err != nil {
+		return TransferTxResult{}, err
+	}
+
+	toAmount := arg.Amount
+	var exchangeRate pgtype.Numeric
+	if result.FromAccount.Currency != result.ToAccount.Currency {
+		if !convert {
+			return TransferTxResult{}, fmt.Errorf("%w: account %d holds %s, account %d holds %s", ErrCurrencyMismatch,
+				result.FromAccount.ID, result.FromAccount.Currency, result.ToAccount.ID, result.ToAccount.Currency)
 		}
 
-		result.FromEntry, err = q.CreateEntry(ctx, CreateEntryParams{
-			AccountID: arg.FromAccountID,
-			Amount:    -arg.Amount,
-		})
+		rate, err := q.exchangeRate(ctx, result.FromAccount.Currency, result.ToAccount.Currency)
 		if err != nil {
-			return err
+			return TransferTxResult{}, err
+		}
+		toAmount, err = fx.Convert(arg.Amount, result.FromAccount.Currency, result.ToAccount.Currency, rate)
+		if err != nil {
+			return TransferTxResult{}, err
+		}
+		exchangeRate, err = numericFromRat(rate, fx.RateScale)
+		if err != nil {
+			return TransferTxResult{}, err
 		}
+	}
 
-		result.ToEntry, err = q.CreateEntry(ctx, CreateEntryParams{
-			AccountID: arg.ToAccountID,
-			Amount:    arg.Amount,
-		})
+	result, err = q.bookTransfer(ctx, CreateTransferParams{
+		FromAccountID: arg.FromAccountID,
+		ToAccountID:   arg.ToAccountID,
+		Amount:        arg.Amount,
+		ToAmount:      toAmount,
+		ExchangeRate:  exchangeRate,
+		Memo:          arg.Memo,
+		Reference:     arg.Reference,
+		InitiatedBy:   initiatedBy,
+	}, transferFee)
+	if err != nil {
+		return TransferTxResult{}, err
+	}
+	return result, nil
+}
 
-		//update accounts' balance and handle deadlock avoidance very important
+// bookTransfer records a transfer with its two entries, moves the money between the accounts,
+// charges transferFee, emits EventTransferCompleted and appends the transfer to the audit log.
+// The caller is expected to have locked both accounts and checked the sender's funds.
+func (q txQueries) bookTransfer(ctx context.Context, arg CreateTransferParams, transferFee TransferFee) (result TransferTxResult, err error) {
+	arg.FeeAmount = transferFee.Breakdown.Total
+	arg.FeeRuleID = pgtype.Int8{Int64: transferFee.RuleID, Valid: transferFee.RuleID != 0}
 
-		result.FromAccount, result.ToAccount, err = q.transferMoney(ctx,
-			arg.FromAccountID, arg.ToAccountID, arg.Amount)
+	result.Transfer, err = q.CreateTransfer(ctx, arg)
+	if err != nil {
+		return
+	}
+
+	transferID := pgtype.Int8{Int64: result.Transfer.ID, Valid: true}
+
+	result.FromEntry, err = q.CreateEntry(ctx, CreateEntryParams{
+		AccountID:  arg.FromAccountID,
+		Amount:     -arg.Amount,
+		Type:       EntryTypeTransfer,
+		TransferID: transferID,
+	})
+	if err != nil {
+		return
+	}
 
-		return err
+	result.ToEntry, err = q.CreateEntry(ctx, CreateEntryParams{
+		AccountID:  arg.ToAccountID,
+		Amount:     arg.ToAmount,
+		Type:       EntryTypeTransfer,
+		TransferID: transferID,
 	})
+	if err != nil {
+		return
+	}
+
+	//update accounts' balance and handle deadlock avoidance very important
 
+	result.FromAccount, result.ToAccount, err = q.transferMoney(ctx,
+		arg.FromAccountID, arg.ToAccountID, arg.Amount, arg.ToAmount)
 	if err != nil {
-		return TransferTxResult{}, err
+		return
 	}
-	return result, nil
+
+	// the revenue account is locked before the audit chain, which every transaction locks last
+	if transferFee.Breakdown.Total > 0 {
+		result.FromAccount, err = q.chargeTransferFee(ctx, result.Transfer, result.FromAccount, &transferFee)
+		if err != nil {
+			return
+		}
+	}
+	result.Fee = transferFee
+
+	err = q.emitEvent(ctx, EventTransferCompleted, TransferCompletedEvent{
+		TransferID:    result.Transfer.ID,
+		FromAccountID: result.Transfer.FromAccountID,
+		ToAccountID:   result.Transfer.ToAccountID,
+		Amount:        result.Transfer.Amount,
+		ToAmount:      result.Transfer.ToAmount,
+		FeeAmount:     result.Transfer.FeeAmount,
+		Memo:          result.Transfer.Memo,
+		Reference:     result.Transfer.Reference,
+	})
+	if err != nil {
+		return
+	}
+
+	err = q.recordAudit(ctx, AuditTransferCreate, "transfer", result.Transfer.ID, nil, result.Transfer)
+	return
+}
+
+// checkSufficientFunds reports whether the account can be debited by amount without its
+// available balance, which excludes funds on hold, falling below the negative of its overdraft limit.
+func checkSufficientFunds(account Account, amount int64) error {
+	if account.AvailableBalance-amount < -account.OverdraftLimit {
+		return fmt.Errorf("%w: account %d has available balance %d and overdraft limit %d, cannot debit %d",
+			ErrInsufficientFunds, account.ID, account.AvailableBalance, account.OverdraftLimit, amount)
+	}
+	return nil
 }
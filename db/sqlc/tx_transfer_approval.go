@@ -0,0 +1,347 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// Statuses recorded in transfer_approvals.status.
+const (
+	TransferApprovalStatusPending  = "pending"
+	TransferApprovalStatusApproved = "approved"
+	TransferApprovalStatusRejected = "rejected"
+	TransferApprovalStatusExpired  = "expired"
+)
+
+// RequestTransferApprovalTxParams contains the transfer awaiting approval and when the request expires.
+type RequestTransferApprovalTxParams struct {
+	TransferTxParams
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// RequestTransferApprovalTxResult contains the pending approval and the hold securing its funds.
+type RequestTransferApprovalTxResult struct {
+	Approval    TransferApproval `json:"approval"`
+	Hold        AccountHold      `json:"hold"`
+	FromAccount Account          `json:"from_account"`
+}
+
+// RequestTransferApprovalTx records a transfer that may only be made once a banker other than
+// the requester approves it. The transfer is checked like TransferTx would check it now, and its
+// amount and fee are put on hold in favour of the receiver until it is approved, rejected or
+// expires at ExpiresAt. EventTransferApprovalRequested is emitted to notify the bankers.
+// An IdempotencyKey replays the original request as it does for TransferTx.
+func (store *SQLStore) RequestTransferApprovalTx(ctx context.Context, arg RequestTransferApprovalTxParams) (RequestTransferApprovalTxResult, error) {
+	var result RequestTransferApprovalTxResult
+	err := store.execTx(ctx, func(q txQueries) error {
+		if arg.IdempotencyKey != "" {
+			replayed, err := q.claimIdempotencyKey(ctx, idempotencyOperationTransferApproval,
+				arg.Username, arg.IdempotencyKey, arg.payload(), &result)
+			if err != nil || replayed {
+				return err
+			}
+		}
+
+		fromAccount, toAccount, err := q.lockAccountsForUpdate(ctx, arg.FromAccountID, arg.ToAccountID)
+		if err != nil {
+			return err
+		}
+
+		if err = checkAccountsActive(fromAccount, toAccount); err != nil {
+			return err
+		}
+
+		requestedBy := arg.initiator(fromAccount)
+		err = q.checkTransferLimits(ctx, store.transferLimits, requestedBy, fromAccount, arg.Amount, time.Now())
+		if err != nil {
+			return err
+		}
+
+		if fromAccount.Currency != toAccount.Currency {
+			if _, err = q.exchangeRate(ctx, fromAccount.Currency, toAccount.Currency); err != nil {
+				return err
+			}
+		}
+
+		transferFee, err := q.transferFee(ctx, arg.Role, fromAccount, toAccount, arg.Amount)
+		if err != nil {
+			return err
+		}
+
+		heldAmount := arg.Amount + transferFee.Breakdown.Total
+		if err = checkSufficientFunds(fromAccount, heldAmount); err != nil {
+			return err
+		}
+
+		result.Hold, err = q.CreateAccountHold(ctx, CreateAccountHoldParams{
+			AccountID:   arg.FromAccountID,
+			ToAccountID: arg.ToAccountID,
+			Amount:      arg.Amount,
+			Description: "transfer awaiting approval",
+			ExpiresAt:   pgtype.Timestamptz{Time: arg.ExpiresAt, Valid: true},
+			PlacedBy:    requestedBy,
+			PlacerRole:  arg.Role,
+			HeldFee:     transferFee.Breakdown.Total,
+		})
+		if err != nil {
+			return err
+		}
+
+		result.FromAccount, err = q.AddAccountHeldAmount(ctx, AddAccountHeldAmountParams{
+			ID:     arg.FromAccountID,
+			Amount: heldAmount,
+		})
+		if err != nil {
+			return err
+		}
+
+		result.Approval, err = q.CreateTransferApproval(ctx, CreateTransferApprovalParams{
+			FromAccountID: arg.FromAccountID,
+			ToAccountID:   arg.ToAccountID,
+			Amount:        arg.Amount,
+			Memo:          arg.Memo,
+			Reference:     arg.Reference,
+			RequestedBy:   requestedBy,
+			RequesterRole: arg.Role,
+			HoldID:        result.Hold.ID,
+			ExpiresAt:     result.Hold.ExpiresAt,
+		})
+		if err != nil {
+			return err
+		}
+
+		err = q.emitEvent(ctx, EventTransferApprovalRequested, TransferApprovalRequestedEvent{
+			ApprovalID:    result.Approval.ID,
+			FromAccountID: result.Approval.FromAccountID,
+			ToAccountID:   result.Approval.ToAccountID,
+			Amount:        result.Approval.Amount,
+			RequestedBy:   result.Approval.RequestedBy,
+			ExpiresAt:     result.Approval.ExpiresAt.Time,
+		})
+		if err != nil {
+			return err
+		}
+
+		err = q.recordAudit(ctx, AuditAccountHoldCreate, "account_hold", result.Hold.ID, nil, result.Hold)
+		if err != nil {
+			return err
+		}
+		err = q.recordAudit(ctx, AuditTransferApprovalCreate, "transfer_approval", result.Approval.ID, nil, result.Approval)
+		if err != nil {
+			return err
+		}
+
+		if arg.IdempotencyKey != "" {
+			return q.saveIdempotentResponse(ctx, arg.Username, arg.IdempotencyKey, result)
+		}
+		return nil
+	})
+
+	if err != nil {
+		return RequestTransferApprovalTxResult{}, err
+	}
+	return result, nil
+}
+
+// DecideTransferApprovalTxParams contains the parameters for ApproveTransferTx and RejectTransferTx.
+// Reason is only recorded for a rejection.
+type DecideTransferApprovalTxParams struct {
+	ApprovalID int64  `json:"approval_id"`
+	DecidedBy  string `json:"decided_by"`
+	Reason     string `json:"reason"`
+}
+
+// ApproveTransferTxResult contains the approved approval and the transfer it made.
+type ApproveTransferTxResult struct {
+	Approval TransferApproval `json:"approval"`
+	TransferTxResult
+}
+
+// ApproveTransferTx releases the hold of a pending approval and makes its transfer as TransferTx
+// would, converting the amount if the accounts hold different currencies. The transfer is checked
+// again, so it fails if the accounts or limits have changed since it was requested, but it may
+// exceed the approval threshold.
+// It returns ErrApprovalNotPending once the approval was decided or expired, and ErrSelfApproval
+// if DecidedBy requested it.
+func (store *SQLStore) ApproveTransferTx(ctx context.Context, arg DecideTransferApprovalTxParams) (ApproveTransferTxResult, error) {
+	var result ApproveTransferTxResult
+	err := store.execTx(ctx, func(q txQueries) error {
+		approval, hold, err := q.lockPendingApproval(ctx, arg.ApprovalID, arg.DecidedBy)
+		if err != nil {
+			return err
+		}
+
+		// the hold is released before the funds check, which would otherwise count it against the transfer
+		_, _, err = q.lockAccountsForUpdate(ctx, approval.FromAccountID, approval.ToAccountID)
+		if err != nil {
+			return err
+		}
+		released, _, err := q.releaseHold(ctx, hold, HoldStatusReleased)
+		if err != nil {
+			return err
+		}
+
+		result.TransferTxResult, err = q.transfer(ctx, store.transferLimits, TransferTxParams{
+			FromAccountID: approval.FromAccountID,
+			ToAccountID:   approval.ToAccountID,
+			Amount:        approval.Amount,
+			Memo:          approval.Memo,
+			Reference:     approval.Reference,
+			Username:      approval.RequestedBy,
+			Role:          approval.RequesterRole,
+			approved:      true,
+		}, true)
+		if err != nil {
+			return err
+		}
+
+		result.Approval, err = q.UpdateTransferApprovalStatus(ctx, UpdateTransferApprovalStatusParams{
+			ID:         approval.ID,
+			Status:     TransferApprovalStatusApproved,
+			DecidedBy:  pgtype.Text{String: arg.DecidedBy, Valid: true},
+			TransferID: pgtype.Int8{Int64: result.Transfer.ID, Valid: true},
+		})
+		if err != nil {
+			return err
+		}
+
+		err = q.recordAudit(ctx, AuditAccountHoldUpdate, "account_hold", hold.ID, hold, released)
+		if err != nil {
+			return err
+		}
+		return q.recordAudit(ctx, AuditTransferApprovalUpdate, "transfer_approval", approval.ID, approval, result.Approval)
+	})
+
+	if err != nil {
+		return ApproveTransferTxResult{}, err
+	}
+	return result, nil
+}
+
+// RejectTransferTxResult contains the rejected approval and the account its hold was released on.
+type RejectTransferTxResult struct {
+	Approval    TransferApproval `json:"approval"`
+	FromAccount Account          `json:"from_account"`
+}
+
+// RejectTransferTx releases the hold of a pending approval without making its transfer.
+// It fails like ApproveTransferTx.
+func (store *SQLStore) RejectTransferTx(ctx context.Context, arg DecideTransferApprovalTxParams) (RejectTransferTxResult, error) {
+	var result RejectTransferTxResult
+	err := store.execTx(ctx, func(q txQueries) error {
+		approval, hold, err := q.lockPendingApproval(ctx, arg.ApprovalID, arg.DecidedBy)
+		if err != nil {
+			return err
+		}
+
+		var released AccountHold
+		released, result.FromAccount, err = q.releaseHold(ctx, hold, HoldStatusReleased)
+		if err != nil {
+			return err
+		}
+
+		result.Approval, err = q.UpdateTransferApprovalStatus(ctx, UpdateTransferApprovalStatusParams{
+			ID:        approval.ID,
+			Status:    TransferApprovalStatusRejected,
+			DecidedBy: pgtype.Text{String: arg.DecidedBy, Valid: true},
+			Reason:    arg.Reason,
+		})
+		if err != nil {
+			return err
+		}
+
+		err = q.recordAudit(ctx, AuditAccountHoldUpdate, "account_hold", hold.ID, hold, released)
+		if err != nil {
+			return err
+		}
+		return q.recordAudit(ctx, AuditTransferApprovalUpdate, "transfer_approval", approval.ID, approval, result.Approval)
+	})
+
+	if err != nil {
+		return RejectTransferTxResult{}, err
+	}
+	return result, nil
+}
+
+// lockPendingApproval locks an approval and its hold, in the order ReleaseHoldTx locks them, and
+// checks that decidedBy may still decide it.
+func (q txQueries) lockPendingApproval(ctx context.Context, id int64, decidedBy string) (TransferApproval, AccountHold, error) {
+	approval, err := q.GetTransferApproval(ctx, id)
+	if err != nil {
+		return TransferApproval{}, AccountHold{}, err
+	}
+
+	hold, err := q.GetAccountHoldForUpdate(ctx, approval.HoldID)
+	if err != nil {
+		return TransferApproval{}, AccountHold{}, err
+	}
+
+	approval, err = q.GetTransferApprovalForUpdate(ctx, id)
+	if err != nil {
+		return TransferApproval{}, AccountHold{}, err
+	}
+
+	if approval.Status != TransferApprovalStatusPending || !approval.ExpiresAt.Time.After(time.Now()) {
+		return TransferApproval{}, AccountHold{}, fmt.Errorf("%w: approval %d is %s and expires at %s",
+			ErrApprovalNotPending, approval.ID, approval.Status, approval.ExpiresAt.Time.Format(time.RFC3339))
+	}
+	if approval.RequestedBy == decidedBy {
+		return TransferApproval{}, AccountHold{}, fmt.Errorf("%w: approval %d was requested by %s",
+			ErrSelfApproval, approval.ID, decidedBy)
+	}
+	return approval, hold, nil
+}
+
+// checkApprovalNotRequired returns ErrApprovalRequired if amount is above the approval threshold of
+// the account's currency, in which case it may only be sent once a banker approves it.
+func (q txQueries) checkApprovalNotRequired(ctx context.Context, account Account, amount int64) error {
+	currency, err := q.GetCurrency(ctx, account.Currency)
+	if err != nil {
+		return err
+	}
+	if currency.ApprovalThreshold.Valid && amount > currency.ApprovalThreshold.Int64 {
+		return fmt.Errorf("%w: %d is above the approval threshold of %d %s, cannot send it from account %d",
+			ErrApprovalRequired, amount, currency.ApprovalThreshold.Int64, currency.Code, account.ID)
+	}
+	return nil
+}
+
+// checkHoldNotAwaitingApproval returns ErrHoldAwaitingApproval if the hold secures a transfer
+// approval, whose funds may only move or be released by deciding the approval.
+func (q txQueries) checkHoldNotAwaitingApproval(ctx context.Context, hold AccountHold) error {
+	approval, err := q.GetTransferApprovalByHoldForUpdate(ctx, hold.ID)
+	if err != nil {
+		if errors.Is(err, ErrRecordNotFound) {
+			return nil
+		}
+		return err
+	}
+	return fmt.Errorf("%w: hold %d secures transfer approval %d", ErrHoldAwaitingApproval, hold.ID, approval.ID)
+}
+
+// expireTransferApproval marks the pending approval the expired hold secured, if any, as expired.
+func (q txQueries) expireTransferApproval(ctx context.Context, hold AccountHold) error {
+	approval, err := q.GetTransferApprovalByHoldForUpdate(ctx, hold.ID)
+	if err != nil {
+		if errors.Is(err, ErrRecordNotFound) {
+			return nil
+		}
+		return err
+	}
+	if approval.Status != TransferApprovalStatusPending {
+		return nil
+	}
+
+	expired, err := q.UpdateTransferApprovalStatus(ctx, UpdateTransferApprovalStatusParams{
+		ID:     approval.ID,
+		Status: TransferApprovalStatusExpired,
+	})
+	if err != nil {
+		return err
+	}
+	return q.recordAudit(ctx, AuditTransferApprovalUpdate, "transfer_approval", approval.ID, approval, expired)
+}
@@ -19,7 +19,7 @@ type VerifyEmailTxResult struct {
 // TranferTx performs a money transfer from one account to another within a transaction context.
 func (store *SQLStore) VerifyEmailTx(ctx context.Context, arg VerifyEmailTxParams) (VerifyEmailTxResult, error) {
 	var result VerifyEmailTxResult
-	err := store.execTx(ctx, func(q *Queries) error {
+	err := store.execTx(ctx, func(q txQueries) error {
 		var err error
 		result.VerifyEmail, err = q.UpdateVerifyEmail(ctx, UpdateVerifyEmailParams{
 			ID:         arg.EmailId,
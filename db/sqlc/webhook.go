@@ -0,0 +1,25 @@
+package db
+
+// Event types a webhook subscription can ask for.
+const (
+	// WebhookTransferSent is delivered to the owner of the account a transfer was sent from.
+	WebhookTransferSent = "transfer.sent"
+	// WebhookTransferReceived is delivered to the owner of the account a transfer landed in.
+	WebhookTransferReceived = "transfer.received"
+)
+
+// Statuses recorded in webhook_deliveries.status.
+const (
+	WebhookDeliveryPending   = "pending"
+	WebhookDeliverySucceeded = "succeeded"
+	WebhookDeliveryFailed    = "failed"
+)
+
+// IsSupportedWebhookEvent reports whether a subscription can ask for eventType.
+func IsSupportedWebhookEvent(eventType string) bool {
+	switch eventType {
+	case WebhookTransferSent, WebhookTransferReceived:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,117 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/mahanth/simplebank/util"
+	"github.com/stretchr/testify/require"
+)
+
+func createRandomWebhookSubscription(t *testing.T, owner string, eventTypes ...string) WebhookSubscription {
+	arg := CreateWebhookSubscriptionParams{
+		Owner:      owner,
+		Url:        "https://example.com/hooks/" + util.RandomString(6),
+		EventTypes: eventTypes,
+		Secret:     util.RandomString(32),
+	}
+
+	subscription, err := testQueries.CreateWebhookSubscription(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, arg.Owner, subscription.Owner)
+	require.Equal(t, arg.Url, subscription.Url)
+	require.Equal(t, arg.EventTypes, subscription.EventTypes)
+	require.True(t, subscription.Active)
+	return subscription
+}
+
+func TestListWebhookSubscriptionsForEvent(t *testing.T) {
+	user := createRandomUser(t)
+	received := createRandomWebhookSubscription(t, user.Username, WebhookTransferReceived)
+	both := createRandomWebhookSubscription(t, user.Username, WebhookTransferSent, WebhookTransferReceived)
+
+	subscriptions, err := testQueries.ListWebhookSubscriptionsForEvent(context.Background(), ListWebhookSubscriptionsForEventParams{
+		Owner:     user.Username,
+		EventType: WebhookTransferSent,
+	})
+	require.NoError(t, err)
+	require.Len(t, subscriptions, 1)
+	require.Equal(t, both.ID, subscriptions[0].ID)
+
+	_, err = testQueries.DeactivateWebhookSubscription(context.Background(), both.ID)
+	require.NoError(t, err)
+
+	_, err = testQueries.DeactivateWebhookSubscription(context.Background(), both.ID)
+	require.ErrorIs(t, err, ErrRecordNotFound)
+
+	subscriptions, err = testQueries.ListWebhookSubscriptionsForEvent(context.Background(), ListWebhookSubscriptionsForEventParams{
+		Owner:     user.Username,
+		EventType: WebhookTransferReceived,
+	})
+	require.NoError(t, err)
+	require.Len(t, subscriptions, 1)
+	require.Equal(t, received.ID, subscriptions[0].ID)
+}
+
+func TestWebhookDeliveryLifecycle(t *testing.T) {
+	user := createRandomUser(t)
+	subscription := createRandomWebhookSubscription(t, user.Username, WebhookTransferReceived)
+
+	event, err := testQueries.CreateOutboxEvent(context.Background(), CreateOutboxEventParams{
+		EventType: EventTransferCompleted,
+		Payload:   []byte(`{"transfer_id":1}`),
+	})
+	require.NoError(t, err)
+
+	arg := CreateWebhookDeliveryParams{
+		SubscriptionID: subscription.ID,
+		EventID:        event.ID,
+		EventType:      WebhookTransferReceived,
+		Payload:        event.Payload,
+	}
+	delivery, err := testQueries.CreateWebhookDelivery(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, WebhookDeliveryPending, delivery.Status)
+	require.Zero(t, delivery.Attempts)
+
+	// fanning the same event out again finds the existing delivery
+	again, err := testQueries.CreateWebhookDelivery(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, delivery.ID, again.ID)
+
+	delivery, err = testQueries.RecordWebhookDeliveryAttempt(context.Background(), RecordWebhookDeliveryAttemptParams{
+		ID:             delivery.ID,
+		Status:         WebhookDeliveryPending,
+		ResponseStatus: pgtype.Int4{Int32: 503, Valid: true},
+		LastError:      pgtype.Text{String: "unavailable", Valid: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, int32(1), delivery.Attempts)
+	require.False(t, delivery.DeliveredAt.Valid)
+
+	delivery, err = testQueries.RecordWebhookDeliveryAttempt(context.Background(), RecordWebhookDeliveryAttemptParams{
+		ID:             delivery.ID,
+		Status:         WebhookDeliverySucceeded,
+		ResponseStatus: pgtype.Int4{Int32: 200, Valid: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, int32(2), delivery.Attempts)
+	require.True(t, delivery.DeliveredAt.Valid)
+	require.False(t, delivery.LastError.Valid)
+
+	// a late retry does not touch a finished delivery
+	_, err = testQueries.RecordWebhookDeliveryAttempt(context.Background(), RecordWebhookDeliveryAttemptParams{
+		ID:     delivery.ID,
+		Status: WebhookDeliveryFailed,
+	})
+	require.ErrorIs(t, err, ErrRecordNotFound)
+
+	deliveries, err := testQueries.ListWebhookDeliveries(context.Background(), ListWebhookDeliveriesParams{
+		SubscriptionID: subscription.ID,
+		Limit:          5,
+	})
+	require.NoError(t, err)
+	require.Len(t, deliveries, 1)
+	require.Equal(t, WebhookDeliverySucceeded, deliveries[0].Status)
+}
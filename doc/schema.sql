@@ -28,14 +28,22 @@ CREATE TABLE "accounts" (
   "owner" varchar NOT NULL,
   "balance" bigint NOT NULL,
   "currency" varchar NOT NULL,
-  "created_at" timestamptz NOT NULL DEFAULT (now())
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  "overdraft_limit" bigint NOT NULL DEFAULT 0,
+  "held_amount" bigint NOT NULL DEFAULT 0,
+  "available_balance" bigint GENERATED ALWAYS AS ("balance" - "held_amount") STORED,
+  "status" varchar NOT NULL DEFAULT 'active',
+  "type" varchar NOT NULL DEFAULT 'checking'
 );
 
 CREATE TABLE "entries" (
   "id" bigserial PRIMARY KEY,
   "account_id" bigint NOT NULL,
   "amount" bigint NOT NULL,
-  "created_at" timestamptz NOT NULL DEFAULT (now())
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  "type" varchar NOT NULL,
+  "transfer_id" bigint,
+  "balance_after" bigint NOT NULL
 );
 
 CREATE TABLE "transfers" (
@@ -43,6 +51,95 @@ CREATE TABLE "transfers" (
   "from_account_id" bigint NOT NULL,
   "to_account_id" bigint NOT NULL,
   "amount" bigint NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  "to_amount" bigint NOT NULL,
+  "exchange_rate" numeric,
+  "reversed_amount" bigint NOT NULL DEFAULT 0,
+  "status" varchar NOT NULL DEFAULT 'completed',
+  "fee_amount" bigint NOT NULL DEFAULT 0,
+  "fee_rule_id" bigint,
+  "memo" varchar NOT NULL DEFAULT '',
+  "reference" varchar NOT NULL DEFAULT '',
+  "initiated_by" varchar NOT NULL
+);
+
+CREATE TABLE "transfer_reversals" (
+  "id" bigserial PRIMARY KEY,
+  "transfer_id" bigint NOT NULL,
+  "amount" bigint NOT NULL,
+  "to_amount" bigint NOT NULL,
+  "reason" varchar NOT NULL,
+  "initiated_by" varchar NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "account_status_changes" (
+  "id" bigserial PRIMARY KEY,
+  "account_id" bigint NOT NULL,
+  "from_status" varchar NOT NULL,
+  "to_status" varchar NOT NULL,
+  "changed_by" varchar NOT NULL,
+  "reason" varchar NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "account_holds" (
+  "id" bigserial PRIMARY KEY,
+  "account_id" bigint NOT NULL,
+  "to_account_id" bigint NOT NULL,
+  "amount" bigint NOT NULL,
+  "description" varchar NOT NULL,
+  "status" varchar NOT NULL DEFAULT 'active',
+  "expires_at" timestamptz NOT NULL,
+  "captured_amount" bigint NOT NULL DEFAULT 0,
+  "transfer_id" bigint,
+  "resolved_at" timestamptz,
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  "placed_by" varchar NOT NULL,
+  "placer_role" varchar NOT NULL,
+  "held_fee" bigint NOT NULL DEFAULT 0
+);
+
+CREATE TABLE "exchange_rates" (
+  "id" bigserial PRIMARY KEY,
+  "base_currency" varchar NOT NULL,
+  "quote_currency" varchar NOT NULL,
+  "rate" numeric NOT NULL,
+  "effective_at" timestamptz NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "reconciliation_reports" (
+  "id" bigserial PRIMARY KEY,
+  "triggered_by" varchar NOT NULL,
+  "discrepancy_count" integer NOT NULL,
+  "discrepancies" jsonb NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "scheduled_transfers" (
+  "id" bigserial PRIMARY KEY,
+  "owner" varchar NOT NULL,
+  "from_account_id" bigint NOT NULL,
+  "to_account_id" bigint NOT NULL,
+  "amount" bigint NOT NULL,
+  "schedule" varchar NOT NULL,
+  "start_at" timestamptz NOT NULL,
+  "end_at" timestamptz,
+  "next_run_at" timestamptz,
+  "status" varchar NOT NULL DEFAULT 'active',
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  "updated_at" timestamptz NOT NULL DEFAULT (now()),
+  "owner_role" varchar NOT NULL
+);
+
+CREATE TABLE "scheduled_transfer_executions" (
+  "id" bigserial PRIMARY KEY,
+  "scheduled_transfer_id" bigint NOT NULL,
+  "scheduled_for" timestamptz NOT NULL,
+  "status" varchar NOT NULL,
+  "transfer_id" bigint,
+  "failure_reason" varchar,
   "created_at" timestamptz NOT NULL DEFAULT (now())
 );
 
@@ -57,34 +154,452 @@ CREATE TABLE "sessions" (
   "created_at" timestamptz NOT NULL DEFAULT (now())
 );
 
+CREATE TABLE "idempotency_keys" (
+  "username" varchar NOT NULL,
+  "key" varchar NOT NULL,
+  "request_hash" varchar NOT NULL,
+  "response" jsonb,
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  "operation" varchar NOT NULL DEFAULT 'transfer',
+  PRIMARY KEY ("username", "key")
+);
+
+CREATE TABLE "interest_rates" (
+  "id" bigserial PRIMARY KEY,
+  "account_type" varchar NOT NULL,
+  "currency" varchar NOT NULL,
+  "annual_rate" numeric NOT NULL,
+  "effective_at" timestamptz NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "interest_accruals" (
+  "id" bigserial PRIMARY KEY,
+  "account_id" bigint NOT NULL,
+  "accrual_date" date NOT NULL,
+  "balance" bigint NOT NULL,
+  "annual_rate" numeric NOT NULL,
+  "amount" numeric NOT NULL,
+  "entry_id" bigint,
+  "credited_at" timestamptz,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "fee_rules" (
+  "id" bigserial PRIMARY KEY,
+  "currency" varchar,
+  "role" varchar,
+  "same_owner" boolean,
+  "flat_fee" bigint NOT NULL DEFAULT 0,
+  "percentage" numeric NOT NULL DEFAULT 0,
+  "min_fee" bigint NOT NULL DEFAULT 0,
+  "max_fee" bigint,
+  "created_by" varchar NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "transfer_limits" (
+  "id" bigserial PRIMARY KEY,
+  "username" varchar UNIQUE,
+  "account_id" bigint UNIQUE,
+  "max_single" bigint,
+  "max_daily_total" bigint,
+  "max_daily_count" int,
+  "updated_by" varchar NOT NULL,
+  "updated_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "currencies" (
+  "code" varchar PRIMARY KEY,
+  "exponent" int NOT NULL,
+  "name" varchar NOT NULL,
+  "enabled" boolean NOT NULL DEFAULT false,
+  "updated_by" varchar,
+  "updated_at" timestamptz NOT NULL DEFAULT (now()),
+  "approval_threshold" bigint
+);
+
+CREATE TABLE "account_holders" (
+  "id" bigserial PRIMARY KEY,
+  "account_id" bigint NOT NULL,
+  "username" varchar NOT NULL,
+  "permission" varchar NOT NULL,
+  "transfer_limit" bigint,
+  "status" varchar NOT NULL DEFAULT 'invited',
+  "invited_by" varchar NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  "accepted_at" timestamptz
+);
+
+CREATE TABLE "outbox" (
+  "id" bigserial PRIMARY KEY,
+  "event_type" varchar NOT NULL,
+  "payload" jsonb NOT NULL,
+  "attempts" int NOT NULL DEFAULT 0,
+  "last_error" varchar,
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  "sent_at" timestamptz
+);
+
+CREATE TABLE "webhook_subscriptions" (
+  "id" bigserial PRIMARY KEY,
+  "owner" varchar NOT NULL,
+  "url" varchar NOT NULL,
+  "event_types" varchar[] NOT NULL,
+  "secret" varchar NOT NULL,
+  "active" boolean NOT NULL DEFAULT true,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "webhook_deliveries" (
+  "id" bigserial PRIMARY KEY,
+  "subscription_id" bigint NOT NULL,
+  "event_id" bigint NOT NULL,
+  "event_type" varchar NOT NULL,
+  "payload" jsonb NOT NULL,
+  "status" varchar NOT NULL DEFAULT 'pending',
+  "attempts" int NOT NULL DEFAULT 0,
+  "response_status" int,
+  "last_error" varchar,
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  "delivered_at" timestamptz
+);
+
+CREATE TABLE "audit_events" (
+  "id" bigserial PRIMARY KEY,
+  "actor" varchar NOT NULL,
+  "role" varchar NOT NULL,
+  "client_ip" varchar NOT NULL,
+  "user_agent" varchar NOT NULL,
+  "action" varchar NOT NULL,
+  "target_type" varchar NOT NULL,
+  "target_id" varchar NOT NULL,
+  "before" json,
+  "after" json,
+  "created_at" timestamptz NOT NULL,
+  "prev_hash" varchar NOT NULL,
+  "hash" varchar UNIQUE NOT NULL
+);
+
+CREATE TABLE "transfer_approvals" (
+  "id" bigserial PRIMARY KEY,
+  "from_account_id" bigint NOT NULL,
+  "to_account_id" bigint NOT NULL,
+  "amount" bigint NOT NULL,
+  "memo" varchar NOT NULL DEFAULT '',
+  "reference" varchar NOT NULL DEFAULT '',
+  "requested_by" varchar NOT NULL,
+  "requester_role" varchar NOT NULL,
+  "hold_id" bigint NOT NULL,
+  "status" varchar NOT NULL DEFAULT 'pending',
+  "decided_by" varchar,
+  "reason" varchar NOT NULL DEFAULT '',
+  "transfer_id" bigint,
+  "expires_at" timestamptz NOT NULL,
+  "decided_at" timestamptz,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
 CREATE INDEX ON "accounts" ("owner");
 
-CREATE UNIQUE INDEX ON "accounts" ("owner", "currency");
+CREATE UNIQUE INDEX "accounts_bank_owner_currency_type_key" ON "accounts" ("owner", "currency", "type") WHERE "owner" IN ('bank-system', 'bank-revenue');
 
 CREATE INDEX ON "entries" ("account_id");
 
+CREATE INDEX ON "entries" ("transfer_id");
+
+CREATE INDEX ON "entries" ("account_id", "created_at");
+
+CREATE INDEX ON "entries" ("account_id", "id");
+
 CREATE INDEX ON "transfers" ("from_account_id");
 
 CREATE INDEX ON "transfers" ("to_account_id");
 
 CREATE INDEX ON "transfers" ("from_account_id", "to_account_id");
 
+CREATE INDEX ON "transfers" ("from_account_id", "created_at");
+
+CREATE INDEX ON "transfers" ("initiated_by", "created_at");
+
+CREATE INDEX ON "transfers" ("from_account_id", "id");
+
+CREATE INDEX ON "transfers" ("to_account_id", "id");
+
+CREATE UNIQUE INDEX ON "account_holders" ("account_id", "username");
+
+CREATE INDEX ON "account_holders" ("username");
+
+CREATE INDEX "outbox_unsent_idx" ON "outbox" ("id") WHERE "sent_at" IS NULL;
+
+CREATE INDEX ON "webhook_subscriptions" ("owner");
+
+CREATE UNIQUE INDEX ON "webhook_deliveries" ("subscription_id", "event_id", "event_type");
+
+CREATE INDEX ON "audit_events" ("target_type", "target_id");
+
+CREATE INDEX ON "transfer_approvals" ("status", "expires_at");
+
+CREATE UNIQUE INDEX ON "transfer_approvals" ("hold_id");
+
+CREATE INDEX "transfers_search_idx" ON "transfers" USING GIN (to_tsvector('simple', "memo" || ' ' || "reference"));
+
+CREATE INDEX ON "transfer_reversals" ("transfer_id");
+
+CREATE INDEX ON "account_status_changes" ("account_id");
+
+CREATE INDEX ON "account_holds" ("account_id");
+
+CREATE INDEX ON "account_holds" ("status", "expires_at");
+
+CREATE UNIQUE INDEX ON "exchange_rates" ("base_currency", "quote_currency", "effective_at");
+
+CREATE INDEX ON "reconciliation_reports" ("created_at");
+
+CREATE INDEX ON "scheduled_transfers" ("owner");
+
+CREATE INDEX ON "scheduled_transfers" ("status", "next_run_at");
+
+CREATE UNIQUE INDEX ON "scheduled_transfer_executions" ("scheduled_transfer_id", "scheduled_for");
+
+CREATE UNIQUE INDEX ON "interest_rates" ("account_type", "currency", "effective_at");
+
+CREATE UNIQUE INDEX ON "interest_accruals" ("account_id", "accrual_date");
+
 COMMENT ON COLUMN "users"."role" IS 'can be depositor or banker';
 
 COMMENT ON COLUMN "verify_emails"."secret_code" IS 'used to verify email';
 
+COMMENT ON COLUMN "accounts"."overdraft_limit" IS 'how far below zero the balance may go, set by bankers';
+
+COMMENT ON COLUMN "accounts"."held_amount" IS 'sum of the active holds on the account';
+
+COMMENT ON COLUMN "accounts"."available_balance" IS 'balance minus active holds';
+
+COMMENT ON COLUMN "accounts"."status" IS 'active, frozen, dormant or closed';
+
+COMMENT ON COLUMN "accounts"."type" IS 'checking or savings';
+
+COMMENT ON COLUMN "interest_rates"."annual_rate" IS 'yearly rate as a fraction, e.g. 0.025 for 2.5%';
+
+COMMENT ON COLUMN "interest_accruals"."balance" IS 'balance the interest was accrued on';
+
+COMMENT ON COLUMN "interest_accruals"."amount" IS 'interest for the day in minor units, not rounded';
+
+COMMENT ON COLUMN "interest_accruals"."entry_id" IS 'interest entry that paid the accrual, NULL if the month rounded to zero';
+
+COMMENT ON COLUMN "fee_rules"."currency" IS 'currency of the sending account, NULL for any';
+
+COMMENT ON COLUMN "fee_rules"."role" IS 'role of the user making the transfer, NULL for any';
+
+COMMENT ON COLUMN "fee_rules"."same_owner" IS 'whether both accounts belong to the same owner, NULL for either';
+
+COMMENT ON COLUMN "fee_rules"."percentage" IS 'share of the amount charged on top of the flat fee, e.g. 0.005 for 0.5%';
+
+COMMENT ON COLUMN "fee_rules"."max_fee" IS 'NULL for no maximum';
+
+COMMENT ON COLUMN "transfer_limits"."username" IS 'set for an override of all the user''s accounts';
+
+COMMENT ON COLUMN "transfer_limits"."account_id" IS 'set for an override of one account';
+
+COMMENT ON COLUMN "transfer_limits"."max_single" IS 'NULL to keep the default, 0 for no limit';
+
+COMMENT ON COLUMN "transfer_limits"."max_daily_total" IS 'outgoing total per UTC day, NULL to keep the default, 0 for no limit';
+
+COMMENT ON COLUMN "transfer_limits"."max_daily_count" IS 'outgoing transfers per UTC day, NULL to keep the default, 0 for no limit';
+
+COMMENT ON COLUMN "currencies"."code" IS 'ISO 4217 code';
+
+COMMENT ON COLUMN "currencies"."exponent" IS 'digits of the minor unit amounts are stored in, e.g. 2 for cents';
+
+COMMENT ON COLUMN "currencies"."enabled" IS 'only enabled currencies can be used for new accounts and transfers';
+
+COMMENT ON COLUMN "currencies"."approval_threshold" IS 'largest amount in minor units a transfer may move without a banker''s approval, NULL for no approval';
+
+COMMENT ON COLUMN "account_holders"."permission" IS 'view, transfer or manage, each including the ones before it';
+
+COMMENT ON COLUMN "account_holders"."transfer_limit" IS 'largest single transfer the holder may make, NULL for no limit';
+
+COMMENT ON COLUMN "account_holders"."status" IS 'invited until the user accepts, then active';
+
+COMMENT ON COLUMN "outbox"."event_type" IS 'user.created, transfer.completed or account.status_changed';
+
+COMMENT ON COLUMN "outbox"."attempts" IS 'failed attempts to publish the event';
+
+COMMENT ON COLUMN "outbox"."sent_at" IS 'when the event was published, NULL until then';
+
+COMMENT ON COLUMN "webhook_subscriptions"."event_types" IS 'transfer.sent and/or transfer.received';
+
+COMMENT ON COLUMN "webhook_subscriptions"."secret" IS 'key the payloads are signed with using HMAC-SHA256';
+
+COMMENT ON COLUMN "webhook_deliveries"."event_id" IS 'outbox event the delivery was made for';
+
+COMMENT ON COLUMN "webhook_deliveries"."status" IS 'pending while being retried, then succeeded or failed';
+
+COMMENT ON COLUMN "webhook_deliveries"."response_status" IS 'HTTP status of the last attempt, NULL if no response was received';
+
+COMMENT ON COLUMN "audit_events"."actor" IS 'username the change was made by, or system';
+
+COMMENT ON COLUMN "audit_events"."action" IS 'what was done, e.g. transfer.create';
+
+COMMENT ON COLUMN "audit_events"."before" IS 'json rather than jsonb so that the text the hash was computed over is kept as is';
+
+COMMENT ON COLUMN "audit_events"."prev_hash" IS 'hash of the previous event, 64 zeros for the first';
+
+COMMENT ON COLUMN "audit_events"."hash" IS 'hex SHA-256 of prev_hash and the other columns but id';
+
+COMMENT ON COLUMN "transfer_approvals"."requester_role" IS 'role of the requester, which picks the fee rule of the transfer';
+
+COMMENT ON COLUMN "transfer_approvals"."hold_id" IS 'hold keeping the amount and fee available until the transfer is decided';
+
+COMMENT ON COLUMN "transfer_approvals"."status" IS 'pending until a banker other than the requester approves or rejects it, or it expires';
+
+COMMENT ON COLUMN "transfer_approvals"."transfer_id" IS 'transfer made once approved';
+
+COMMENT ON COLUMN "idempotency_keys"."request_hash" IS 'sha256 of the request payload the key was first used with';
+
+COMMENT ON COLUMN "idempotency_keys"."response" IS 'serialized result returned to replays of the same request';
+
+COMMENT ON COLUMN "idempotency_keys"."operation" IS 'store transaction the key was claimed by, e.g. transfer or transfer_approval';
+
+COMMENT ON COLUMN "reconciliation_reports"."triggered_by" IS 'username of the banker who ran it, or scheduler';
+
+COMMENT ON COLUMN "reconciliation_reports"."discrepancies" IS 'list of ledger invariants that did not hold';
+
+COMMENT ON COLUMN "scheduled_transfers"."schedule" IS 'cron expression or @every interval, evaluated in UTC';
+
+COMMENT ON COLUMN "scheduled_transfers"."next_run_at" IS 'null once the schedule is cancelled or completed';
+
+COMMENT ON COLUMN "scheduled_transfers"."status" IS 'active, paused, cancelled or completed';
+
+COMMENT ON COLUMN "scheduled_transfers"."owner_role" IS 'role of the owner, which picks the fee rule of the transfers';
+
+COMMENT ON COLUMN "scheduled_transfer_executions"."failure_reason" IS 'why the transfer was not made, e.g. insufficient funds';
+
 COMMENT ON COLUMN "entries"."amount" IS 'can be negative or positive';
 
+COMMENT ON COLUMN "entries"."type" IS 'transfer, deposit, withdrawal, fee, adjustment, reversal or interest';
+
+COMMENT ON COLUMN "entries"."transfer_id" IS 'transfer that produced the entry, if any';
+
+COMMENT ON COLUMN "entries"."balance_after" IS 'balance of the account once the entry was booked';
+
 COMMENT ON COLUMN "transfers"."amount" IS 'only positive';
 
+COMMENT ON COLUMN "transfers"."to_amount" IS 'amount credited, in the currency of the receiving account';
+
+COMMENT ON COLUMN "transfers"."exchange_rate" IS 'rate applied when the accounts differ in currency';
+
+COMMENT ON COLUMN "transfers"."reversed_amount" IS 'part of amount refunded to the sender so far';
+
+COMMENT ON COLUMN "transfers"."status" IS 'completed, partially_reversed or reversed';
+
+COMMENT ON COLUMN "transfers"."fee_amount" IS 'fee charged to the sender on top of amount, in its currency';
+
+COMMENT ON COLUMN "transfers"."memo" IS 'description of the payment given by the sender';
+
+COMMENT ON COLUMN "transfers"."reference" IS 'identifier of the payment in an external system, such as an invoice number';
+
+COMMENT ON COLUMN "transfers"."initiated_by" IS 'user whose transfer limits the transfer counts against';
+
+COMMENT ON COLUMN "transfer_reversals"."amount" IS 'credited back to the sender, in the currency of the transfer amount';
+
+COMMENT ON COLUMN "transfer_reversals"."to_amount" IS 'debited from the receiver, in the currency of the receiving account';
+
+COMMENT ON COLUMN "account_holds"."to_account_id" IS 'account the funds go to when the hold is captured';
+
+COMMENT ON COLUMN "account_holds"."status" IS 'active, captured, released or expired';
+
+COMMENT ON COLUMN "account_holds"."transfer_id" IS 'transfer that captured the hold';
+
+COMMENT ON COLUMN "account_holds"."placed_by" IS 'user whose transfer limits the capture counts against';
+
+COMMENT ON COLUMN "account_holds"."placer_role" IS 'role of the user who placed the hold, which picks the fee rule of the capture';
+
+COMMENT ON COLUMN "account_holds"."held_fee" IS 'fee held on top of the amount for the capture';
+
+COMMENT ON COLUMN "exchange_rates"."rate" IS 'units of quote currency per unit of base currency';
+
 ALTER TABLE "verify_emails" ADD FOREIGN KEY ("username") REFERENCES "users" ("username");
 
 ALTER TABLE "accounts" ADD FOREIGN KEY ("owner") REFERENCES "users" ("username");
 
 ALTER TABLE "entries" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
 
+ALTER TABLE "entries" ADD FOREIGN KEY ("transfer_id") REFERENCES "transfers" ("id");
+
 ALTER TABLE "transfers" ADD FOREIGN KEY ("from_account_id") REFERENCES "accounts" ("id");
 
 ALTER TABLE "transfers" ADD FOREIGN KEY ("to_account_id") REFERENCES "accounts" ("id");
 
+ALTER TABLE "transfers" ADD FOREIGN KEY ("initiated_by") REFERENCES "users" ("username");
+
 ALTER TABLE "sessions" ADD FOREIGN KEY ("username") REFERENCES "users" ("username");
+
+ALTER TABLE "idempotency_keys" ADD FOREIGN KEY ("username") REFERENCES "users" ("username");
+
+ALTER TABLE "scheduled_transfers" ADD FOREIGN KEY ("owner") REFERENCES "users" ("username");
+
+ALTER TABLE "scheduled_transfers" ADD FOREIGN KEY ("from_account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "scheduled_transfers" ADD FOREIGN KEY ("to_account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "scheduled_transfer_executions" ADD FOREIGN KEY ("scheduled_transfer_id") REFERENCES "scheduled_transfers" ("id");
+
+ALTER TABLE "scheduled_transfer_executions" ADD FOREIGN KEY ("transfer_id") REFERENCES "transfers" ("id");
+
+ALTER TABLE "transfer_reversals" ADD FOREIGN KEY ("transfer_id") REFERENCES "transfers" ("id");
+
+ALTER TABLE "transfer_reversals" ADD FOREIGN KEY ("initiated_by") REFERENCES "users" ("username");
+
+ALTER TABLE "account_holds" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "account_holds" ADD FOREIGN KEY ("to_account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "account_holds" ADD FOREIGN KEY ("transfer_id") REFERENCES "transfers" ("id");
+
+ALTER TABLE "account_holds" ADD FOREIGN KEY ("placed_by") REFERENCES "users" ("username");
+
+ALTER TABLE "account_status_changes" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "account_status_changes" ADD FOREIGN KEY ("changed_by") REFERENCES "users" ("username");
+
+ALTER TABLE "interest_accruals" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "interest_accruals" ADD FOREIGN KEY ("entry_id") REFERENCES "entries" ("id");
+
+ALTER TABLE "transfers" ADD FOREIGN KEY ("fee_rule_id") REFERENCES "fee_rules" ("id");
+
+ALTER TABLE "fee_rules" ADD FOREIGN KEY ("created_by") REFERENCES "users" ("username");
+
+ALTER TABLE "transfer_limits" ADD FOREIGN KEY ("username") REFERENCES "users" ("username");
+
+ALTER TABLE "transfer_limits" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "transfer_limits" ADD FOREIGN KEY ("updated_by") REFERENCES "users" ("username");
+
+ALTER TABLE "currencies" ADD FOREIGN KEY ("updated_by") REFERENCES "users" ("username");
+
+ALTER TABLE "account_holders" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "account_holders" ADD FOREIGN KEY ("username") REFERENCES "users" ("username");
+
+ALTER TABLE "account_holders" ADD FOREIGN KEY ("invited_by") REFERENCES "users" ("username");
+
+ALTER TABLE "webhook_subscriptions" ADD FOREIGN KEY ("owner") REFERENCES "users" ("username");
+
+ALTER TABLE "webhook_deliveries" ADD FOREIGN KEY ("subscription_id") REFERENCES "webhook_subscriptions" ("id");
+
+ALTER TABLE "webhook_deliveries" ADD FOREIGN KEY ("event_id") REFERENCES "outbox" ("id");
+
+ALTER TABLE "transfer_approvals" ADD FOREIGN KEY ("from_account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "transfer_approvals" ADD FOREIGN KEY ("to_account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "transfer_approvals" ADD FOREIGN KEY ("requested_by") REFERENCES "users" ("username");
+
+ALTER TABLE "transfer_approvals" ADD FOREIGN KEY ("hold_id") REFERENCES "account_holds" ("id");
+
+ALTER TABLE "transfer_approvals" ADD FOREIGN KEY ("decided_by") REFERENCES "users" ("username");
+
+ALTER TABLE "transfer_approvals" ADD FOREIGN KEY ("transfer_id") REFERENCES "transfers" ("id");
@@ -0,0 +1,61 @@
+package fee
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/mahanth/simplebank/fx"
+)
+
+// Rule describes how the fee on a transfer is worked out. Amounts are in minor units of the
+// currency the transfer is debited in.
+type Rule struct {
+	// Flat is charged on every transfer.
+	Flat int64
+	// Percentage is the share of the amount charged on top of Flat, e.g. 0.005 for 0.5%.
+	// A nil Percentage charges nothing.
+	Percentage *big.Rat
+	// Min and Max bound the total fee. A Max of zero means there is no maximum.
+	Min int64
+	Max int64
+}
+
+// Breakdown is the fee charged on a transfer and how it was made up. Total is Flat plus
+// Percentage, raised to the rule's minimum or lowered to its maximum.
+type Breakdown struct {
+	Flat       int64 `json:"flat"`
+	Percentage int64 `json:"percentage"`
+	Total      int64 `json:"total"`
+}
+
+// Validate reports whether the rule can be applied.
+func (rule Rule) Validate() error {
+	if rule.Flat < 0 || rule.Min < 0 || rule.Max < 0 {
+		return fmt.Errorf("fees must not be negative")
+	}
+	if rule.Percentage != nil && (rule.Percentage.Sign() < 0 || rule.Percentage.Cmp(big.NewRat(1, 1)) >= 0) {
+		return fmt.Errorf("percentage must be at least 0 and less than 1")
+	}
+	if rule.Max > 0 && rule.Max < rule.Min {
+		return fmt.Errorf("maximum fee %d is less than minimum fee %d", rule.Max, rule.Min)
+	}
+	return nil
+}
+
+// Calculate returns the fee on a transfer of amount. The percentage part is rounded half to even.
+func (rule Rule) Calculate(amount int64) Breakdown {
+	breakdown := Breakdown{Flat: rule.Flat}
+	if rule.Percentage != nil {
+		share := new(big.Rat).Mul(new(big.Rat).SetInt64(amount), rule.Percentage)
+		breakdown.Percentage = fx.Round(share)
+	}
+
+	breakdown.Total = breakdown.Flat + breakdown.Percentage
+	if breakdown.Total < rule.Min {
+		breakdown.Total = rule.Min
+	}
+	if rule.Max > 0 && breakdown.Total > rule.Max {
+		breakdown.Total = rule.Max
+	}
+	return breakdown
+}
@@ -0,0 +1,74 @@
+package fee
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCalculate(t *testing.T) {
+	testCases := []struct {
+		name   string
+		rule   Rule
+		amount int64
+		want   Breakdown
+	}{
+		{
+			name:   "Flat",
+			rule:   Rule{Flat: 25},
+			amount: 10000,
+			want:   Breakdown{Flat: 25, Total: 25},
+		},
+		{
+			name:   "Percentage",
+			rule:   Rule{Percentage: big.NewRat(5, 1000)},
+			amount: 10000,
+			want:   Breakdown{Percentage: 50, Total: 50},
+		},
+		{
+			name:   "FlatAndPercentage",
+			rule:   Rule{Flat: 10, Percentage: big.NewRat(1, 100)},
+			amount: 1234,
+			want:   Breakdown{Flat: 10, Percentage: 12, Total: 22},
+		},
+		{
+			name:   "PercentageHalfToEven",
+			rule:   Rule{Percentage: big.NewRat(1, 100)},
+			amount: 250,
+			want:   Breakdown{Percentage: 2, Total: 2},
+		},
+		{
+			name:   "Minimum",
+			rule:   Rule{Percentage: big.NewRat(1, 100), Min: 100},
+			amount: 1000,
+			want:   Breakdown{Percentage: 10, Total: 100},
+		},
+		{
+			name:   "Maximum",
+			rule:   Rule{Percentage: big.NewRat(1, 100), Min: 100, Max: 500},
+			amount: 1000000,
+			want:   Breakdown{Percentage: 10000, Total: 500},
+		},
+		{
+			name:   "Free",
+			rule:   Rule{},
+			amount: 1000,
+			want:   Breakdown{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.NoError(t, tc.rule.Validate())
+			require.Equal(t, tc.want, tc.rule.Calculate(tc.amount))
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	require.Error(t, Rule{Flat: -1}.Validate())
+	require.Error(t, Rule{Percentage: big.NewRat(-1, 100)}.Validate())
+	require.Error(t, Rule{Percentage: big.NewRat(1, 1)}.Validate())
+	require.Error(t, Rule{Min: 500, Max: 100}.Validate())
+}
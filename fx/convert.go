@@ -0,0 +1,86 @@
+package fx
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/mahanth/simplebank/util"
+)
+
+// RateScale is the number of decimal places a rate is rounded to before it is applied and recorded.
+const RateScale = 10
+
+// CrossRate returns how many units of to one unit of from buys, given the rates of both
+// currencies against the same base. The result is rounded to RateScale decimal places.
+func CrossRate(fromRate, toRate *big.Rat) (*big.Rat, error) {
+	if fromRate.Sign() <= 0 || toRate.Sign() <= 0 {
+		return nil, fmt.Errorf("exchange rates must be positive")
+	}
+	return RoundRate(new(big.Rat).Quo(toRate, fromRate)), nil
+}
+
+// RoundRate rounds a rate to RateScale decimal places.
+func RoundRate(rate *big.Rat) *big.Rat {
+	rounded, _ := new(big.Rat).SetString(rate.FloatString(RateScale))
+	return rounded
+}
+
+// Convert converts an amount in minor units of from into minor units of to at the given rate.
+// Amounts are rounded half to even, so the same inputs always give the same result
+// and rounding does not drift in either direction over many transfers.
+func Convert(amount int64, from, to string, rate *big.Rat) (int64, error) {
+	if rate.Sign() <= 0 {
+		return 0, fmt.Errorf("exchange rate must be positive")
+	}
+
+	converted := new(big.Rat).Mul(new(big.Rat).SetInt64(amount), rate)
+	shift := util.CurrencyExponent(to) - util.CurrencyExponent(from)
+	scale := new(big.Rat).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(abs(shift))), nil))
+	if shift >= 0 {
+		converted.Mul(converted, scale)
+	} else {
+		converted.Quo(converted, scale)
+	}
+
+	result := roundHalfEven(converted)
+	if !result.IsInt64() {
+		return 0, fmt.Errorf("converted amount of %d %s overflows", amount, from)
+	}
+	return result.Int64(), nil
+}
+
+// Prorate returns the share part/whole of total, rounded half to even. It splits an amount
+// that was already converted in proportion to a part of the original amount.
+func Prorate(total, part, whole int64) int64 {
+	share := new(big.Rat).SetFrac(big.NewInt(part), big.NewInt(whole))
+	share.Mul(share, new(big.Rat).SetInt64(total))
+	return roundHalfEven(share).Int64()
+}
+
+// Round rounds a fractional amount in minor units to a whole amount, half to even.
+func Round(amount *big.Rat) int64 {
+	return roundHalfEven(amount).Int64()
+}
+
+func roundHalfEven(x *big.Rat) *big.Int {
+	quotient, remainder := new(big.Int).QuoRem(x.Num(), x.Denom(), new(big.Int))
+
+	// compare twice the remainder with the denominator to find which way to round
+	twice := new(big.Int).Mul(new(big.Int).Abs(remainder), big.NewInt(2))
+	cmp := twice.Cmp(x.Denom())
+	if cmp > 0 || (cmp == 0 && quotient.Bit(0) == 1) {
+		if x.Sign() < 0 {
+			quotient.Sub(quotient, big.NewInt(1))
+		} else {
+			quotient.Add(quotient, big.NewInt(1))
+		}
+	}
+	return quotient
+}
+
+func abs(x int32) int32 {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
@@ -0,0 +1,79 @@
+package fx
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func rat(t *testing.T, value string) *big.Rat {
+	r, ok := new(big.Rat).SetString(value)
+	require.True(t, ok)
+	return r
+}
+
+func TestConvert(t *testing.T) {
+	testCases := []struct {
+		name   string
+		amount int64
+		from   string
+		to     string
+		rate   string
+		want   int64
+	}{
+		{name: "SameExponent", amount: 10000, from: "EUR", to: "USD", rate: "1.0956", want: 10956},
+		{name: "RoundDown", amount: 1001, from: "EUR", to: "USD", rate: "1.0956", want: 1097},
+		{name: "RoundUp", amount: 1005, from: "EUR", to: "USD", rate: "1.0956", want: 1101},
+		{name: "HalfToEvenDown", amount: 1, from: "USD", to: "EUR", rate: "2.5", want: 2},
+		{name: "HalfToEvenUp", amount: 3, from: "USD", to: "EUR", rate: "0.5", want: 2},
+		{name: "ToFewerMinorUnits", amount: 10000, from: "EUR", to: "JPY", rate: "155.77", want: 15577},
+		{name: "ToMoreMinorUnits", amount: 15577, from: "JPY", to: "EUR", rate: "0.0064197214", want: 10000},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := Convert(tc.amount, tc.from, tc.to, rat(t, tc.rate))
+			require.NoError(t, err)
+			require.Equal(t, tc.want, got)
+		})
+	}
+
+	_, err := Convert(100, "EUR", "USD", big.NewRat(0, 1))
+	require.Error(t, err)
+}
+
+func TestCrossRate(t *testing.T) {
+	// USD -> CAD through EUR: 1.4565 / 1.0956
+	rate, err := CrossRate(rat(t, "1.0956"), rat(t, "1.4565"))
+	require.NoError(t, err)
+	require.Equal(t, "1.3294085433", rate.FloatString(RateScale))
+
+	_, err = CrossRate(big.NewRat(0, 1), rat(t, "1.4565"))
+	require.Error(t, err)
+}
+
+func TestProrate(t *testing.T) {
+	require.Equal(t, int64(500), Prorate(1000, 1, 2))
+	require.Equal(t, int64(333), Prorate(1000, 1, 3))
+	require.Equal(t, int64(667), Prorate(1000, 2, 3))
+	// ties round to even
+	require.Equal(t, int64(2), Prorate(5, 1, 2))
+	require.Equal(t, int64(4), Prorate(7, 1, 2))
+
+	// shares computed on running totals add up to the whole
+	total, whole := int64(9173), int64(10000)
+	require.Equal(t, total, Prorate(total, 3333, whole)+(Prorate(total, 6666, whole)-Prorate(total, 3333, whole))+
+		(Prorate(total, whole, whole)-Prorate(total, 6666, whole)))
+}
+
+func TestRound(t *testing.T) {
+	require.Equal(t, int64(30), Round(rat(t, "30.0000000000")))
+	require.Equal(t, int64(2), Round(rat(t, "2.4999999999")))
+	require.Equal(t, int64(3), Round(rat(t, "2.5000000001")))
+	// ties round to even
+	require.Equal(t, int64(2), Round(rat(t, "2.5")))
+	require.Equal(t, int64(4), Round(rat(t, "3.5")))
+	require.Equal(t, int64(-2), Round(rat(t, "-2.5")))
+}
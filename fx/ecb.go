@@ -0,0 +1,104 @@
+package fx
+
+import (
+	"encoding/csv"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// Date layouts used by the ECB: the daily files spell out the month, the historical ones are ISO dates.
+var ecbDateLayouts = []string{"2006-01-02", "02 January 2006", "2 January 2006"}
+
+type ecbEnvelope struct {
+	Days []struct {
+		Time  string `xml:"time,attr"`
+		Rates []struct {
+			Currency string `xml:"currency,attr"`
+			Rate     string `xml:"rate,attr"`
+		} `xml:"Cube"`
+	} `xml:"Cube>Cube"`
+}
+
+// ParseECBXML parses the ECB euro foreign exchange reference rates in their XML format
+// (eurofxref-daily.xml, eurofxref-hist.xml).
+func ParseECBXML(r io.Reader) ([]Rate, error) {
+	var envelope ecbEnvelope
+	if err := xml.NewDecoder(r).Decode(&envelope); err != nil {
+		return nil, fmt.Errorf("failed to decode ECB rates: %w", err)
+	}
+
+	var rates []Rate
+	for _, day := range envelope.Days {
+		effectiveAt, err := parseECBDate(day.Time)
+		if err != nil {
+			return nil, err
+		}
+		for _, cube := range day.Rates {
+			rate, err := newRate(cube.Currency, cube.Rate, effectiveAt)
+			if err != nil {
+				return nil, err
+			}
+			rates = append(rates, rate)
+		}
+	}
+	return rates, nil
+}
+
+// ParseECBCSV parses the ECB euro foreign exchange reference rates in their CSV format
+// (eurofxref.csv, eurofxref-hist.csv): a header of currencies followed by one row of rates per day.
+// Currencies without a rate for the day are marked N/A and skipped.
+func ParseECBCSV(r io.Reader) ([]Rate, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read ECB rates header: %w", err)
+	}
+	if len(header) == 0 || !strings.EqualFold(strings.TrimSpace(header[0]), "date") {
+		return nil, fmt.Errorf("ECB rates header must start with Date")
+	}
+
+	var rates []Rate
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read ECB rates: %w", err)
+		}
+
+		effectiveAt, err := parseECBDate(record[0])
+		if err != nil {
+			return nil, err
+		}
+		for i := 1; i < len(record) && i < len(header); i++ {
+			currency := strings.TrimSpace(header[i])
+			value := strings.TrimSpace(record[i])
+			if currency == "" || value == "" || value == "N/A" {
+				continue
+			}
+			rate, err := newRate(currency, value, effectiveAt)
+			if err != nil {
+				return nil, err
+			}
+			rates = append(rates, rate)
+		}
+	}
+	return rates, nil
+}
+
+func parseECBDate(value string) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	for _, layout := range ecbDateLayouts {
+		if date, err := time.Parse(layout, value); err == nil {
+			return date, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid ECB rate date %q", value)
+}
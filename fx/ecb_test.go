@@ -0,0 +1,97 @@
+package fx
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+const ecbDailyXML = `<?xml version="1.0" encoding="UTF-8"?>
+<gesmes:Envelope xmlns:gesmes="http://www.gesmes.org/xml/2002-08-01" xmlns="http://www.ecb.int/vocabulary/2002-08-01/eurofxref">
+	<gesmes:subject>Reference rates</gesmes:subject>
+	<gesmes:Sender>
+		<gesmes:name>European Central Bank</gesmes:name>
+	</gesmes:Sender>
+	<Cube>
+		<Cube time='2024-01-02'>
+			<Cube currency='USD' rate='1.0956'/>
+			<Cube currency='JPY' rate='155.77'/>
+			<Cube currency='CAD' rate='1.4565'/>
+		</Cube>
+	</Cube>
+</gesmes:Envelope>`
+
+const ecbDailyCSV = `Date, USD, JPY, CAD, 
+02 January 2024, 1.0956, 155.77, 1.4565, 
+`
+
+const ecbHistoricalCSV = `Date,USD,JPY,CYP,
+2024-01-03,1.0919,155.11,N/A,
+2024-01-02,1.0956,155.77,N/A,
+`
+
+func TestParseECBXML(t *testing.T) {
+	rates, err := ParseECBXML(strings.NewReader(ecbDailyXML))
+	require.NoError(t, err)
+	require.Len(t, rates, 3)
+
+	date := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+	require.Equal(t, Rate{Base: "EUR", Quote: "USD", Rate: "1.0956", EffectiveAt: date}, rates[0])
+	require.Equal(t, Rate{Base: "EUR", Quote: "JPY", Rate: "155.77", EffectiveAt: date}, rates[1])
+	require.Equal(t, Rate{Base: "EUR", Quote: "CAD", Rate: "1.4565", EffectiveAt: date}, rates[2])
+}
+
+func TestParseECBCSV(t *testing.T) {
+	daily, err := ParseECBCSV(strings.NewReader(ecbDailyCSV))
+	require.NoError(t, err)
+
+	xmlRates, err := ParseECBXML(strings.NewReader(ecbDailyXML))
+	require.NoError(t, err)
+	require.Equal(t, xmlRates, daily)
+
+	historical, err := ParseECBCSV(strings.NewReader(ecbHistoricalCSV))
+	require.NoError(t, err)
+	require.Len(t, historical, 4)
+	require.Equal(t, time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC), historical[0].EffectiveAt)
+	require.Equal(t, "1.0919", historical[0].Rate)
+	for _, rate := range historical {
+		require.NotEqual(t, "CYP", rate.Quote)
+	}
+}
+
+func TestParseECBInvalid(t *testing.T) {
+	_, err := ParseECBCSV(strings.NewReader("Currency, USD\n02 January 2024, 1.0956\n"))
+	require.Error(t, err)
+
+	_, err = ParseECBCSV(strings.NewReader("Date, USD\nyesterday, 1.0956\n"))
+	require.Error(t, err)
+
+	_, err = ParseECBCSV(strings.NewReader("Date, USD\n02 January 2024, -1\n"))
+	require.Error(t, err)
+
+	_, err = ParseECBXML(strings.NewReader("<Envelope><Cube><Cube time='2024-01-02'><Cube currency='USD' rate='abc'/></Cube></Cube></Envelope>"))
+	require.Error(t, err)
+}
+
+func TestLoadFile(t *testing.T) {
+	dir := t.TempDir()
+
+	xmlPath := filepath.Join(dir, "eurofxref-daily.xml")
+	require.NoError(t, os.WriteFile(xmlPath, []byte(ecbDailyXML), 0o600))
+	rates, err := LoadFile(xmlPath)
+	require.NoError(t, err)
+	require.Len(t, rates, 3)
+
+	csvPath := filepath.Join(dir, "eurofxref.csv")
+	require.NoError(t, os.WriteFile(csvPath, []byte(ecbDailyCSV), 0o600))
+	rates, err = LoadFile(csvPath)
+	require.NoError(t, err)
+	require.Len(t, rates, 3)
+
+	_, err = LoadFile(filepath.Join(dir, "rates.json"))
+	require.Error(t, err)
+}
@@ -0,0 +1,53 @@
+package fx
+
+import (
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// ECBBaseCurrency is the base of every rate published by the European Central Bank.
+// Rates between two other currencies are crossed through it.
+const ECBBaseCurrency = "EUR"
+
+// Rate is an exchange rate published for a day: one unit of Base buys Rate units of Quote.
+type Rate struct {
+	Base        string
+	Quote       string
+	Rate        string
+	EffectiveAt time.Time
+}
+
+func newRate(quote string, rate string, effectiveAt time.Time) (Rate, error) {
+	r, ok := new(big.Rat).SetString(rate)
+	if !ok || r.Sign() <= 0 {
+		return Rate{}, fmt.Errorf("invalid %s rate %q", quote, rate)
+	}
+	return Rate{
+		Base:        ECBBaseCurrency,
+		Quote:       quote,
+		Rate:        rate,
+		EffectiveAt: effectiveAt,
+	}, nil
+}
+
+// LoadFile reads rates from an ECB reference rate file, in XML or CSV format depending on its extension.
+func LoadFile(path string) ([]Rate, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".xml":
+		return ParseECBXML(file)
+	case ".csv":
+		return ParseECBCSV(file)
+	default:
+		return nil, fmt.Errorf("unsupported exchange rate file %s: expected .xml or .csv", path)
+	}
+}
@@ -2,11 +2,16 @@ package gapi
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 
+	db "github.com/mahanth/simplebank/db/sqlc"
 	"github.com/mahanth/simplebank/token"
+	"github.com/mahanth/simplebank/util"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 )
 
 const (
@@ -63,3 +68,104 @@ func hasPermission(role string, accessibleRoles []string) bool {
 	}
 	return false
 }
+
+// getAccount loads an account and converts store errors to gRPC statuses.
+func (server *Server) getAccount(ctx context.Context, accountID int64) (db.Account, error) {
+	account, err := server.store.GetAccount(ctx, accountID)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return db.Account{}, status.Errorf(codes.NotFound, "account %d not found", accountID)
+		}
+		return db.Account{}, status.Errorf(codes.Internal, "failed to get account %d: %s", accountID, err)
+	}
+	return account, nil
+}
+
+// checkAccountActive returns a FailedPrecondition error if money cannot move in or out of the account.
+func checkAccountActive(account db.Account) error {
+	if account.Status != db.AccountStatusActive {
+		return status.Errorf(codes.FailedPrecondition, "account %d is %s", account.ID, account.Status)
+	}
+	return nil
+}
+
+// authorizeAccount returns what the user may do with the account, or a PermissionDenied error
+// unless they own it or are an active holder of it with at least permission.
+func (server *Server) authorizeAccount(ctx context.Context, payload *token.Payload, account db.Account, permission string) (db.AccountAccess, error) {
+	access, err := db.GetAccountAccess(ctx, server.store, account, payload.Username)
+	if err != nil {
+		return db.AccountAccess{}, status.Errorf(codes.Internal, "failed to get holder of account %d: %s", account.ID, err)
+	}
+	if !access.Allows(permission) {
+		return db.AccountAccess{}, status.Errorf(codes.PermissionDenied, "authenticated user is not allowed to %s account %d", permission, account.ID)
+	}
+	return access, nil
+}
+
+// authorizeAccountView returns a PermissionDenied error unless the user can read the account and
+// its history. Bankers can view every account, depositors the ones they own or hold.
+func (server *Server) authorizeAccountView(ctx context.Context, payload *token.Payload, account db.Account) error {
+	if payload.Role == util.BankerRole {
+		return nil
+	}
+	_, err := server.authorizeAccount(ctx, payload, account, db.HolderPermissionView)
+	return err
+}
+
+// getScheduledTransfer loads a scheduled transfer the user may manage: its owner or any banker.
+func (server *Server) getScheduledTransfer(ctx context.Context, payload *token.Payload, id int64) (db.ScheduledTransfer, error) {
+	scheduled, err := server.store.GetScheduledTransfer(ctx, id)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return db.ScheduledTransfer{}, status.Errorf(codes.NotFound, "scheduled transfer %d not found", id)
+		}
+		return db.ScheduledTransfer{}, status.Errorf(codes.Internal, "failed to get scheduled transfer %d: %s", id, err)
+	}
+
+	if payload.Role != util.BankerRole && scheduled.Owner != payload.Username {
+		return db.ScheduledTransfer{}, status.Errorf(codes.PermissionDenied, "scheduled transfer doesn't belong to the authenticated user")
+	}
+	return scheduled, nil
+}
+
+// getAccountHold loads a hold the user may capture or release: whoever may transfer from the
+// account it is in favour of, or any banker. The payer placed the hold and cannot take it back.
+func (server *Server) getAccountHold(ctx context.Context, payload *token.Payload, id int64) (db.AccountHold, error) {
+	hold, err := server.store.GetAccountHold(ctx, id)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return db.AccountHold{}, status.Errorf(codes.NotFound, "hold %d not found", id)
+		}
+		return db.AccountHold{}, status.Errorf(codes.Internal, "failed to get hold %d: %s", id, err)
+	}
+
+	if payload.Role != util.BankerRole {
+		toAccount, err := server.getAccount(ctx, hold.ToAccountID)
+		if err != nil {
+			return db.AccountHold{}, err
+		}
+		if _, err = server.authorizeAccount(ctx, payload, toAccount, db.HolderPermissionTransfer); err != nil {
+			if status.Code(err) != codes.PermissionDenied {
+				return db.AccountHold{}, err
+			}
+			return db.AccountHold{}, status.Errorf(codes.PermissionDenied, "only the beneficiary or a banker can settle a hold")
+		}
+	}
+	return hold, nil
+}
+
+// getWebhookSubscription loads a webhook subscription the user may manage: its owner or any banker.
+func (server *Server) getWebhookSubscription(ctx context.Context, payload *token.Payload, id int64) (db.WebhookSubscription, error) {
+	subscription, err := server.store.GetWebhookSubscription(ctx, id)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return db.WebhookSubscription{}, status.Errorf(codes.NotFound, "webhook subscription %d not found", id)
+		}
+		return db.WebhookSubscription{}, status.Errorf(codes.Internal, "failed to get webhook subscription %d: %s", id, err)
+	}
+
+	if payload.Role != util.BankerRole && subscription.Owner != payload.Username {
+		return db.WebhookSubscription{}, status.Errorf(codes.PermissionDenied, "webhook subscription doesn't belong to the authenticated user")
+	}
+	return subscription, nil
+}
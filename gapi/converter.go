@@ -3,6 +3,7 @@ package gapi
 import (
 	db "github.com/mahanth/simplebank/db/sqlc"
 	"github.com/mahanth/simplebank/pb"
+	"github.com/mahanth/simplebank/util"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -14,3 +15,308 @@ func convertDBUserToPBUser(user db.User) *pb.User {
 		CreatedAt: timestamppb.New(user.CreatedAt.Time),
 	}
 }
+
+func convertAccount(account db.Account) *pb.Account {
+	return &pb.Account{
+		Id:                    account.ID,
+		Owner:                 account.Owner,
+		Balance:               account.Balance,
+		Currency:              account.Currency,
+		OverdraftLimit:        account.OverdraftLimit,
+		CreatedAt:             timestamppb.New(account.CreatedAt.Time),
+		AvailableBalance:      account.AvailableBalance,
+		Status:                account.Status,
+		Type:                  account.Type,
+		BalanceMoney:          convertMoney(account.Balance, account.Currency),
+		AvailableBalanceMoney: convertMoney(account.AvailableBalance, account.Currency),
+	}
+}
+
+func convertMoney(amount int64, currency string) *pb.Money {
+	money := util.Money{Amount: amount, Currency: currency}
+	return &pb.Money{
+		Amount:   money.FormatAmount(),
+		Currency: money.Currency,
+	}
+}
+
+func convertCurrency(currency db.Currency) *pb.Currency {
+	pbCurrency := &pb.Currency{
+		Code:      currency.Code,
+		Exponent:  currency.Exponent,
+		Name:      currency.Name,
+		Enabled:   currency.Enabled,
+		UpdatedBy: currency.UpdatedBy.String,
+		UpdatedAt: timestamppb.New(currency.UpdatedAt.Time),
+	}
+	if currency.ApprovalThreshold.Valid {
+		pbCurrency.ApprovalThreshold = &currency.ApprovalThreshold.Int64
+	}
+	return pbCurrency
+}
+
+func convertAccountHolder(holder db.AccountHolder) *pb.AccountHolder {
+	pbHolder := &pb.AccountHolder{
+		Id:         holder.ID,
+		AccountId:  holder.AccountID,
+		Username:   holder.Username,
+		Permission: holder.Permission,
+		Status:     holder.Status,
+		InvitedBy:  holder.InvitedBy,
+		CreatedAt:  timestamppb.New(holder.CreatedAt.Time),
+	}
+	if holder.TransferLimit.Valid {
+		pbHolder.TransferLimit = &holder.TransferLimit.Int64
+	}
+	if holder.AcceptedAt.Valid {
+		pbHolder.AcceptedAt = timestamppb.New(holder.AcceptedAt.Time)
+	}
+	return pbHolder
+}
+
+func convertAccountStatusChange(change db.AccountStatusChange) *pb.AccountStatusChange {
+	return &pb.AccountStatusChange{
+		Id:         change.ID,
+		AccountId:  change.AccountID,
+		FromStatus: change.FromStatus,
+		ToStatus:   change.ToStatus,
+		ChangedBy:  change.ChangedBy,
+		Reason:     change.Reason,
+		CreatedAt:  timestamppb.New(change.CreatedAt.Time),
+	}
+}
+
+func convertAccountHold(hold db.AccountHold) *pb.AccountHold {
+	return &pb.AccountHold{
+		Id:             hold.ID,
+		AccountId:      hold.AccountID,
+		ToAccountId:    hold.ToAccountID,
+		Amount:         hold.Amount,
+		Description:    hold.Description,
+		Status:         hold.Status,
+		ExpiresAt:      timestamppb.New(hold.ExpiresAt.Time),
+		CapturedAmount: hold.CapturedAmount,
+		TransferId:     hold.TransferID.Int64,
+		CreatedAt:      timestamppb.New(hold.CreatedAt.Time),
+	}
+}
+
+func convertTransfer(transfer db.Transfer) *pb.Transfer {
+	return &pb.Transfer{
+		Id:             transfer.ID,
+		FromAccountId:  transfer.FromAccountID,
+		ToAccountId:    transfer.ToAccountID,
+		Amount:         transfer.Amount,
+		CreatedAt:      timestamppb.New(transfer.CreatedAt.Time),
+		ToAmount:       transfer.ToAmount,
+		ExchangeRate:   db.NumericString(transfer.ExchangeRate),
+		ReversedAmount: transfer.ReversedAmount,
+		Status:         transfer.Status,
+		FeeAmount:      transfer.FeeAmount,
+		Memo:           transfer.Memo,
+		Reference:      transfer.Reference,
+	}
+}
+
+func convertTransferApproval(approval db.TransferApproval) *pb.TransferApproval {
+	pbApproval := &pb.TransferApproval{
+		Id:            approval.ID,
+		FromAccountId: approval.FromAccountID,
+		ToAccountId:   approval.ToAccountID,
+		Amount:        approval.Amount,
+		Memo:          approval.Memo,
+		Reference:     approval.Reference,
+		RequestedBy:   approval.RequestedBy,
+		HoldId:        approval.HoldID,
+		Status:        approval.Status,
+		DecidedBy:     approval.DecidedBy.String,
+		Reason:        approval.Reason,
+		TransferId:    approval.TransferID.Int64,
+		ExpiresAt:     timestamppb.New(approval.ExpiresAt.Time),
+		CreatedAt:     timestamppb.New(approval.CreatedAt.Time),
+	}
+	if approval.DecidedAt.Valid {
+		pbApproval.DecidedAt = timestamppb.New(approval.DecidedAt.Time)
+	}
+	return pbApproval
+}
+
+func convertTransferFee(transferFee db.TransferFee) *pb.TransferFee {
+	pbFee := &pb.TransferFee{
+		RuleId:     transferFee.RuleID,
+		Flat:       transferFee.Breakdown.Flat,
+		Percentage: transferFee.Breakdown.Percentage,
+		Total:      transferFee.Breakdown.Total,
+	}
+	if transferFee.Entry.ID != 0 {
+		pbFee.Entry = convertEntry(transferFee.Entry)
+		pbFee.RevenueEntry = convertEntry(transferFee.RevenueEntry)
+	}
+	return pbFee
+}
+
+func convertFeeRule(rule db.FeeRule) *pb.FeeRule {
+	pbRule := &pb.FeeRule{
+		Id:         rule.ID,
+		Currency:   rule.Currency.String,
+		Role:       rule.Role.String,
+		FlatFee:    rule.FlatFee,
+		Percentage: db.NumericString(rule.Percentage),
+		MinFee:     rule.MinFee,
+		CreatedBy:  rule.CreatedBy,
+		CreatedAt:  timestamppb.New(rule.CreatedAt.Time),
+	}
+	if rule.SameOwner.Valid {
+		pbRule.SameOwner = &rule.SameOwner.Bool
+	}
+	if rule.MaxFee.Valid {
+		pbRule.MaxFee = &rule.MaxFee.Int64
+	}
+	return pbRule
+}
+
+func convertTransferLimit(limit db.TransferLimit) *pb.TransferLimit {
+	pbLimit := &pb.TransferLimit{
+		Id:        limit.ID,
+		Username:  limit.Username.String,
+		AccountId: limit.AccountID.Int64,
+		UpdatedBy: limit.UpdatedBy,
+		UpdatedAt: timestamppb.New(limit.UpdatedAt.Time),
+	}
+	if limit.MaxSingle.Valid {
+		pbLimit.MaxSingle = &limit.MaxSingle.Int64
+	}
+	if limit.MaxDailyTotal.Valid {
+		pbLimit.MaxDailyTotal = &limit.MaxDailyTotal.Int64
+	}
+	if limit.MaxDailyCount.Valid {
+		pbLimit.MaxDailyCount = &limit.MaxDailyCount.Int32
+	}
+	return pbLimit
+}
+
+func convertTransferReversal(reversal db.TransferReversal) *pb.TransferReversal {
+	return &pb.TransferReversal{
+		Id:          reversal.ID,
+		TransferId:  reversal.TransferID,
+		Amount:      reversal.Amount,
+		ToAmount:    reversal.ToAmount,
+		Reason:      reversal.Reason,
+		InitiatedBy: reversal.InitiatedBy,
+		CreatedAt:   timestamppb.New(reversal.CreatedAt.Time),
+	}
+}
+
+func convertEntry(entry db.Entry) *pb.Entry {
+	pbEntry := &pb.Entry{
+		Id:        entry.ID,
+		AccountId: entry.AccountID,
+		Amount:    entry.Amount,
+		CreatedAt: timestamppb.New(entry.CreatedAt.Time),
+		Type:      entry.Type,
+	}
+	if entry.TransferID.Valid {
+		pbEntry.TransferId = &entry.TransferID.Int64
+	}
+	return pbEntry
+}
+
+func convertReconciliationReport(report db.ReconciliationReport, discrepancies []db.Discrepancy) *pb.ReconciliationReport {
+	pbReport := &pb.ReconciliationReport{
+		Id:               report.ID,
+		TriggeredBy:      report.TriggeredBy,
+		DiscrepancyCount: report.DiscrepancyCount,
+		CreatedAt:        timestamppb.New(report.CreatedAt.Time),
+	}
+	for _, discrepancy := range discrepancies {
+		pbReport.Discrepancies = append(pbReport.Discrepancies, &pb.Discrepancy{
+			Kind:       discrepancy.Kind,
+			AccountId:  discrepancy.AccountID,
+			TransferId: discrepancy.TransferID,
+			Currency:   discrepancy.Currency,
+			Expected:   discrepancy.Expected,
+			Actual:     discrepancy.Actual,
+			Detail:     discrepancy.Detail,
+		})
+	}
+	return pbReport
+}
+
+func convertScheduledTransfer(scheduled db.ScheduledTransfer) *pb.ScheduledTransfer {
+	pbScheduled := &pb.ScheduledTransfer{
+		Id:            scheduled.ID,
+		Owner:         scheduled.Owner,
+		FromAccountId: scheduled.FromAccountID,
+		ToAccountId:   scheduled.ToAccountID,
+		Amount:        scheduled.Amount,
+		Schedule:      scheduled.Schedule,
+		StartAt:       timestamppb.New(scheduled.StartAt.Time),
+		Status:        scheduled.Status,
+		CreatedAt:     timestamppb.New(scheduled.CreatedAt.Time),
+		UpdatedAt:     timestamppb.New(scheduled.UpdatedAt.Time),
+	}
+	if scheduled.EndAt.Valid {
+		pbScheduled.EndAt = timestamppb.New(scheduled.EndAt.Time)
+	}
+	if scheduled.NextRunAt.Valid {
+		pbScheduled.NextRunAt = timestamppb.New(scheduled.NextRunAt.Time)
+	}
+	return pbScheduled
+}
+
+func convertScheduledTransferExecution(execution db.ScheduledTransferExecution) *pb.ScheduledTransferExecution {
+	pbExecution := &pb.ScheduledTransferExecution{
+		Id:                  execution.ID,
+		ScheduledTransferId: execution.ScheduledTransferID,
+		ScheduledFor:        timestamppb.New(execution.ScheduledFor.Time),
+		Status:              execution.Status,
+		FailureReason:       execution.FailureReason.String,
+		CreatedAt:           timestamppb.New(execution.CreatedAt.Time),
+	}
+	if execution.TransferID.Valid {
+		pbExecution.TransferId = &execution.TransferID.Int64
+	}
+	return pbExecution
+}
+
+func convertInterestRate(rate db.InterestRate) *pb.InterestRate {
+	return &pb.InterestRate{
+		Id:          rate.ID,
+		AccountType: rate.AccountType,
+		Currency:    rate.Currency,
+		AnnualRate:  db.NumericString(rate.AnnualRate),
+		EffectiveAt: timestamppb.New(rate.EffectiveAt.Time),
+		CreatedAt:   timestamppb.New(rate.CreatedAt.Time),
+	}
+}
+
+func convertWebhookSubscription(subscription db.WebhookSubscription) *pb.WebhookSubscription {
+	return &pb.WebhookSubscription{
+		Id:         subscription.ID,
+		Owner:      subscription.Owner,
+		Url:        subscription.Url,
+		EventTypes: subscription.EventTypes,
+		Active:     subscription.Active,
+		CreatedAt:  timestamppb.New(subscription.CreatedAt.Time),
+	}
+}
+
+func convertWebhookDelivery(delivery db.WebhookDelivery) *pb.WebhookDelivery {
+	pbDelivery := &pb.WebhookDelivery{
+		Id:             delivery.ID,
+		SubscriptionId: delivery.SubscriptionID,
+		EventType:      delivery.EventType,
+		Payload:        string(delivery.Payload),
+		Status:         delivery.Status,
+		Attempts:       delivery.Attempts,
+		LastError:      delivery.LastError.String,
+		CreatedAt:      timestamppb.New(delivery.CreatedAt.Time),
+	}
+	if delivery.ResponseStatus.Valid {
+		pbDelivery.ResponseStatus = &delivery.ResponseStatus.Int32
+	}
+	if delivery.DeliveredAt.Valid {
+		pbDelivery.DeliveredAt = timestamppb.New(delivery.DeliveredAt.Time)
+	}
+	return pbDelivery
+}
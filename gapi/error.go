@@ -1,6 +1,10 @@
 package gapi
 
 import (
+	"errors"
+	"strconv"
+
+	db "github.com/mahanth/simplebank/db/sqlc"
 	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -26,3 +30,50 @@ func invalidArgumentError(violations []*errdetails.BadRequest_FieldViolation) er
 func unauthenticatedError(err error) error {
 	return status.Errorf(codes.Unauthenticated, "unauthorized %s", err)
 }
+
+// transferError maps an error returned by a money-moving store transaction to a gRPC status.
+func transferError(err error) error {
+	var limitErr *db.TransferLimitError
+	if errors.As(err, &limitErr) {
+		return transferLimitError(limitErr)
+	}
+	if errors.Is(err, db.ErrInsufficientFunds) || errors.Is(err, db.ErrExchangeRateNotFound) ||
+		errors.Is(err, db.ErrReversalExceedsTransfer) || errors.Is(err, db.ErrHoldNotActive) ||
+		errors.Is(err, db.ErrCaptureExceedsHold) || errors.Is(err, db.ErrAccountNotActive) ||
+		errors.Is(err, db.ErrInvalidStatusChange) || errors.Is(err, db.ErrAccountNotEmpty) ||
+		errors.Is(err, db.ErrApprovalNotPending) || errors.Is(err, db.ErrHoldAwaitingApproval) ||
+		errors.Is(err, db.ErrCurrencyMismatch) || errors.Is(err, db.ErrApprovalRequired) {
+		return status.Errorf(codes.FailedPrecondition, "%s", err)
+	}
+	if errors.Is(err, db.ErrSelfApproval) {
+		return status.Errorf(codes.PermissionDenied, "%s", err)
+	}
+	if errors.Is(err, db.ErrIdempotencyKeyReused) {
+		return status.Errorf(codes.AlreadyExists, "%s", err)
+	}
+	return status.Errorf(codes.Internal, "failed to transfer money: %s", err)
+}
+
+// transferLimitError reports which transfer limit was hit as ResourceExhausted, with the
+// limit, whose transfers it counts, its maximum and the headroom left in the metadata of an ErrorInfo detail.
+func transferLimitError(limitErr *db.TransferLimitError) error {
+	errorInfo := &errdetails.ErrorInfo{
+		Reason: "TRANSFER_LIMIT_EXCEEDED",
+		Domain: "simplebank",
+		Metadata: map[string]string{
+			"account_id": strconv.FormatInt(limitErr.AccountID, 10),
+			"limit":      limitErr.Limit,
+			"scope":      limitErr.Scope,
+			"max":        strconv.FormatInt(limitErr.Max, 10),
+			"used":       strconv.FormatInt(limitErr.Used, 10),
+			"requested":  strconv.FormatInt(limitErr.Requested, 10),
+			"headroom":   strconv.FormatInt(limitErr.Headroom, 10),
+		},
+	}
+	statusExhausted := status.New(codes.ResourceExhausted, limitErr.Error())
+	statusDetails, err := statusExhausted.WithDetails(errorInfo)
+	if err != nil {
+		return statusExhausted.Err()
+	}
+	return statusDetails.Err()
+}
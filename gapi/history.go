@@ -0,0 +1,84 @@
+package gapi
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	db "github.com/mahanth/simplebank/db/sqlc"
+	"github.com/mahanth/simplebank/pb"
+	"github.com/mahanth/simplebank/util"
+	"github.com/mahanth/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+// validateHistoryPage checks the paging and filter fields shared by ListTransfers and ListEntries.
+func validateHistoryPage(pageSize int32, pageToken string, filter *pb.HistoryFilter) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidatePageSize(pageSize); err != nil {
+		violations = append(violations, fieldViolation("page_size", err))
+	}
+	if pageToken != "" {
+		if _, err := util.DecodePageToken(pageToken); err != nil {
+			violations = append(violations, fieldViolation("page_token", err))
+		}
+	}
+	if filter == nil {
+		return violations
+	}
+
+	if filter.StartTime != nil {
+		if err := filter.GetStartTime().CheckValid(); err != nil {
+			violations = append(violations, fieldViolation("filter.start_time", err))
+		}
+	}
+	if filter.EndTime != nil {
+		if err := filter.GetEndTime().CheckValid(); err != nil {
+			violations = append(violations, fieldViolation("filter.end_time", err))
+		} else if filter.StartTime != nil && !filter.GetEndTime().AsTime().After(filter.GetStartTime().AsTime()) {
+			violations = append(violations, fieldViolation("filter.end_time", errors.New("must be after start_time")))
+		}
+	}
+	if filter.MinAmount != nil && filter.GetMinAmount() < 0 {
+		violations = append(violations, fieldViolation("filter.min_amount", errors.New("must not be negative")))
+	}
+	if filter.MaxAmount != nil && filter.GetMaxAmount() < filter.GetMinAmount() {
+		violations = append(violations, fieldViolation("filter.max_amount", errors.New("must not be less than min_amount")))
+	}
+	if filter.GetDirection() != "" && !db.IsSupportedDirection(filter.GetDirection()) {
+		violations = append(violations, fieldViolation("filter.direction", fmt.Errorf("must be %s or %s", db.DirectionIn, db.DirectionOut)))
+	}
+	if filter.CounterpartyAccountId != nil {
+		if err := val.ValidateID(filter.GetCounterpartyAccountId()); err != nil {
+			violations = append(violations, fieldViolation("filter.counterparty_account_id", err))
+		}
+	}
+	return violations
+}
+
+// historyParams returns the parameters of a validated history page. ListEntriesParams has the same
+// fields as ListTransfersParams, so the result can be converted to it. One row more than the page
+// size is fetched, to tell whether there is a next page.
+func historyParams(accountID int64, pageSize int32, pageToken string, filter *pb.HistoryFilter) db.ListTransfersParams {
+	if filter == nil {
+		filter = &pb.HistoryFilter{}
+	}
+	arg := db.ListTransfersParams{
+		AccountID:             accountID,
+		Direction:             pgtype.Text{String: filter.GetDirection(), Valid: filter.GetDirection() != ""},
+		CounterpartyAccountID: pgtype.Int8{Int64: filter.GetCounterpartyAccountId(), Valid: filter.CounterpartyAccountId != nil},
+		MinAmount:             pgtype.Int8{Int64: filter.GetMinAmount(), Valid: filter.MinAmount != nil},
+		MaxAmount:             pgtype.Int8{Int64: filter.GetMaxAmount(), Valid: filter.MaxAmount != nil},
+		PageSize:              pageSize + 1,
+	}
+	if pageToken != "" {
+		lastID, _ := util.DecodePageToken(pageToken)
+		arg.BeforeID = pgtype.Int8{Int64: lastID, Valid: true}
+	}
+	if filter.StartTime != nil {
+		arg.StartTime = pgtype.Timestamptz{Time: filter.GetStartTime().AsTime(), Valid: true}
+	}
+	if filter.EndTime != nil {
+		arg.EndTime = pgtype.Timestamptz{Time: filter.GetEndTime().AsTime(), Valid: true}
+	}
+	return arg
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"log"
 
+	db "github.com/mahanth/simplebank/db/sqlc"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/peer"
 )
@@ -43,3 +44,15 @@ func (server *Server) extractMetadata(ctx context.Context) *Metadata {
 	}
 	return mtdt
 }
+
+// auditContext returns ctx carrying who makes the request and from where, for the store to
+// record in the audit log.
+func (server *Server) auditContext(ctx context.Context, username string, role string) context.Context {
+	mtdt := server.extractMetadata(ctx)
+	return db.WithAuditActor(ctx, db.AuditActor{
+		Username:  username,
+		Role:      role,
+		ClientIP:  mtdt.ClientIP,
+		UserAgent: mtdt.UserAgent,
+	})
+}
@@ -0,0 +1,50 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+
+	db "github.com/mahanth/simplebank/db/sqlc"
+	"github.com/mahanth/simplebank/pb"
+	"github.com/mahanth/simplebank/util"
+	"github.com/mahanth/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) AcceptAccountHolder(ctx context.Context, req *pb.AcceptAccountHolderRequest) (*pb.AcceptAccountHolderResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, []string{util.DepositorRole, util.BankerRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateAcceptAccountHolderRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	// only the invited user can accept, so the invitation is looked up by their username
+	holder, err := server.store.AcceptAccountHolder(server.auditContext(ctx, authPayload.Username, authPayload.Role), db.AcceptAccountHolderParams{
+		AccountID: req.GetAccountId(),
+		Username:  authPayload.Username,
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "no pending invitation to account %d", req.GetAccountId())
+		}
+		return nil, status.Errorf(codes.Internal, "failed to accept invitation: %s", err)
+	}
+
+	response := &pb.AcceptAccountHolderResponse{
+		Holder: convertAccountHolder(holder),
+	}
+	return response, nil
+}
+
+func validateAcceptAccountHolderRequest(req *pb.AcceptAccountHolderRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateID(req.GetAccountId()); err != nil {
+		violations = append(violations, fieldViolation("account_id", err))
+	}
+	return violations
+}
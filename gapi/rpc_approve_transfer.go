@@ -0,0 +1,50 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+
+	db "github.com/mahanth/simplebank/db/sqlc"
+	"github.com/mahanth/simplebank/pb"
+	"github.com/mahanth/simplebank/util"
+	"github.com/mahanth/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) ApproveTransfer(ctx context.Context, req *pb.ApproveTransferRequest) (*pb.ApproveTransferResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, []string{util.BankerRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateApproveTransferRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	result, err := server.store.ApproveTransferTx(server.auditContext(ctx, authPayload.Username, authPayload.Role), db.DecideTransferApprovalTxParams{
+		ApprovalID: req.GetApprovalId(),
+		DecidedBy:  authPayload.Username,
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "transfer approval %d not found", req.GetApprovalId())
+		}
+		return nil, transferError(err)
+	}
+
+	response := &pb.ApproveTransferResponse{
+		Approval: convertTransferApproval(result.Approval),
+		Transfer: convertTransfer(result.Transfer),
+	}
+	return response, nil
+}
+
+func validateApproveTransferRequest(req *pb.ApproveTransferRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateID(req.GetApprovalId()); err != nil {
+		violations = append(violations, fieldViolation("approval_id", err))
+	}
+	return violations
+}
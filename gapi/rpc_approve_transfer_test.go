@@ -0,0 +1,152 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/jackc/pgx/v5/pgtype"
+	mockdb "github.com/mahanth/simplebank/db/mock"
+	db "github.com/mahanth/simplebank/db/sqlc"
+	"github.com/mahanth/simplebank/pb"
+	"github.com/mahanth/simplebank/token"
+	"github.com/mahanth/simplebank/util"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+)
+
+func TestApproveTransferAPI(t *testing.T) {
+	approval := db.TransferApproval{
+		ID:            util.RandomInt(1, 1000),
+		FromAccountID: 1,
+		ToAccountID:   2,
+		Amount:        util.RandomBalance(),
+		RequestedBy:   "requester",
+		Status:        db.TransferApprovalStatusPending,
+	}
+
+	testCases := []struct {
+		name          string
+		request       *pb.ApproveTransferRequest
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, resp *pb.ApproveTransferResponse, err error)
+	}{
+		{
+			name:    "OK",
+			request: &pb.ApproveTransferRequest{ApprovalId: approval.ID},
+			buildStubs: func(store *mockdb.MockStore) {
+				approved := approval
+				approved.Status = db.TransferApprovalStatusApproved
+				approved.DecidedBy = pgtype.Text{String: "checker", Valid: true}
+				approved.TransferID = pgtype.Int8{Int64: 5, Valid: true}
+
+				arg := db.DecideTransferApprovalTxParams{ApprovalID: approval.ID, DecidedBy: "checker"}
+				store.EXPECT().ApproveTransferTx(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(db.ApproveTransferTxResult{
+						Approval:         approved,
+						TransferTxResult: db.TransferTxResult{Transfer: db.Transfer{ID: 5, Amount: approval.Amount}},
+					}, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, "checker", util.BankerRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, resp *pb.ApproveTransferResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, db.TransferApprovalStatusApproved, resp.GetApproval().GetStatus())
+				require.Equal(t, "checker", resp.GetApproval().GetDecidedBy())
+				require.Equal(t, int64(5), resp.GetTransfer().GetId())
+			},
+		},
+		{
+			name:    "SelfApproval",
+			request: &pb.ApproveTransferRequest{ApprovalId: approval.ID},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ApproveTransferTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.ApproveTransferTxResult{}, fmt.Errorf("%w: approval %d was requested by requester", db.ErrSelfApproval, approval.ID))
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, approval.RequestedBy, util.BankerRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, resp *pb.ApproveTransferResponse, err error) {
+				requireStatusCode(t, codes.PermissionDenied, err)
+			},
+		},
+		{
+			name:    "DepositorCannotApprove",
+			request: &pb.ApproveTransferRequest{ApprovalId: approval.ID},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ApproveTransferTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, "checker", util.DepositorRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, resp *pb.ApproveTransferResponse, err error) {
+				requireStatusCode(t, codes.Unauthenticated, err)
+			},
+		},
+		{
+			name:    "NotPending",
+			request: &pb.ApproveTransferRequest{ApprovalId: approval.ID},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ApproveTransferTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.ApproveTransferTxResult{}, fmt.Errorf("%w: approval %d is expired", db.ErrApprovalNotPending, approval.ID))
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, "checker", util.BankerRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, resp *pb.ApproveTransferResponse, err error) {
+				requireStatusCode(t, codes.FailedPrecondition, err)
+			},
+		},
+		{
+			name:    "NotFound",
+			request: &pb.ApproveTransferRequest{ApprovalId: approval.ID},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ApproveTransferTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.ApproveTransferTxResult{}, db.ErrRecordNotFound)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, "checker", util.BankerRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, resp *pb.ApproveTransferResponse, err error) {
+				requireStatusCode(t, codes.NotFound, err)
+			},
+		},
+		{
+			name:    "InvalidID",
+			request: &pb.ApproveTransferRequest{},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ApproveTransferTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, "checker", util.BankerRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, resp *pb.ApproveTransferResponse, err error) {
+				requireStatusCode(t, codes.InvalidArgument, err)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil)
+			ctx := tc.buildContext(t, server.tokenMaker)
+			res, err := server.ApproveTransfer(ctx, tc.request)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
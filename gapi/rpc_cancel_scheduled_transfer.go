@@ -0,0 +1,56 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	db "github.com/mahanth/simplebank/db/sqlc"
+	"github.com/mahanth/simplebank/pb"
+	"github.com/mahanth/simplebank/util"
+	"github.com/mahanth/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) CancelScheduledTransfer(ctx context.Context, req *pb.CancelScheduledTransferRequest) (*pb.CancelScheduledTransferResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, []string{util.DepositorRole, util.BankerRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateCancelScheduledTransferRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	scheduled, err := server.getScheduledTransfer(ctx, authPayload, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	if scheduled.Status != db.ScheduledTransferActive && scheduled.Status != db.ScheduledTransferPaused {
+		return nil, status.Errorf(codes.FailedPrecondition, "scheduled transfer %d is already %s", scheduled.ID, scheduled.Status)
+	}
+
+	scheduled, err = server.store.UpdateScheduledTransferStatus(server.auditContext(ctx, authPayload.Username, authPayload.Role), db.UpdateScheduledTransferStatusParams{
+		ID:        scheduled.ID,
+		Status:    db.ScheduledTransferCancelled,
+		NextRunAt: pgtype.Timestamptz{},
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to cancel scheduled transfer: %s", err)
+	}
+
+	response := &pb.CancelScheduledTransferResponse{
+		ScheduledTransfer: convertScheduledTransfer(scheduled),
+	}
+	return response, nil
+}
+
+func validateCancelScheduledTransferRequest(req *pb.CancelScheduledTransferRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateID(req.GetId()); err != nil {
+		violations = append(violations, fieldViolation("id", err))
+	}
+	return violations
+}
@@ -0,0 +1,59 @@
+package gapi
+
+import (
+	"context"
+
+	db "github.com/mahanth/simplebank/db/sqlc"
+	"github.com/mahanth/simplebank/pb"
+	"github.com/mahanth/simplebank/util"
+	"github.com/mahanth/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+func (server *Server) CaptureHold(ctx context.Context, req *pb.CaptureHoldRequest) (*pb.CaptureHoldResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, []string{util.DepositorRole, util.BankerRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateCaptureHoldRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	hold, err := server.getAccountHold(ctx, authPayload, req.GetHoldId())
+	if err != nil {
+		return nil, err
+	}
+
+	amount := hold.Amount
+	if req.Amount != nil {
+		amount = req.GetAmount()
+	}
+
+	result, err := server.store.CaptureHoldTx(server.auditContext(ctx, authPayload.Username, authPayload.Role), db.CaptureHoldTxParams{
+		HoldID: hold.ID,
+		Amount: amount,
+	})
+	if err != nil {
+		return nil, transferError(err)
+	}
+
+	response := &pb.CaptureHoldResponse{
+		Hold:     convertAccountHold(result.Hold),
+		Transfer: convertTransfer(result.Transfer),
+	}
+	return response, nil
+}
+
+func validateCaptureHoldRequest(req *pb.CaptureHoldRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateID(req.GetHoldId()); err != nil {
+		violations = append(violations, fieldViolation("hold_id", err))
+	}
+	if req.Amount != nil {
+		if err := val.ValidateAmount(req.GetAmount()); err != nil {
+			violations = append(violations, fieldViolation("amount", err))
+		}
+	}
+	return violations
+}
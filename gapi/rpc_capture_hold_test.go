@@ -0,0 +1,148 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	mockdb "github.com/mahanth/simplebank/db/mock"
+	db "github.com/mahanth/simplebank/db/sqlc"
+	"github.com/mahanth/simplebank/pb"
+	"github.com/mahanth/simplebank/token"
+	"github.com/mahanth/simplebank/util"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+)
+
+func TestCaptureHoldAPI(t *testing.T) {
+	user, _ := randomUser()
+	merchant, _ := randomUser()
+	account := randomAccount(user.Username)
+	toAccount := randomAccount(merchant.Username)
+	toAccount.ID = account.ID + 1
+
+	hold := db.AccountHold{
+		ID:          util.RandomInt(1, 1000),
+		AccountID:   account.ID,
+		ToAccountID: toAccount.ID,
+		Amount:      500,
+		Status:      db.HoldStatusActive,
+	}
+	partial := int64(350)
+
+	testCases := []struct {
+		name          string
+		request       *pb.CaptureHoldRequest
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, resp *pb.CaptureHoldResponse, err error)
+	}{
+		{
+			name:    "BeneficiaryCapturesAll",
+			request: &pb.CaptureHoldRequest{HoldId: hold.ID},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccountHold(gomock.Any(), gomock.Eq(hold.ID)).Times(1).Return(hold, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(toAccount.ID)).Times(1).Return(toAccount, nil)
+
+				captured := hold
+				captured.Status = db.HoldStatusCaptured
+				captured.CapturedAmount = hold.Amount
+				store.EXPECT().CaptureHoldTx(gomock.Any(), gomock.Eq(db.CaptureHoldTxParams{HoldID: hold.ID, Amount: hold.Amount})).
+					Times(1).
+					Return(db.CaptureHoldTxResult{
+						Hold:             captured,
+						TransferTxResult: db.TransferTxResult{Transfer: db.Transfer{ID: 1, Amount: hold.Amount}},
+					}, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, merchant.Username, util.DepositorRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, resp *pb.CaptureHoldResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, db.HoldStatusCaptured, resp.GetHold().GetStatus())
+				require.Equal(t, hold.Amount, resp.GetTransfer().GetAmount())
+			},
+		},
+		{
+			name:    "BankerCapturesPart",
+			request: &pb.CaptureHoldRequest{HoldId: hold.ID, Amount: &partial},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccountHold(gomock.Any(), gomock.Eq(hold.ID)).Times(1).Return(hold, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().CaptureHoldTx(gomock.Any(), gomock.Eq(db.CaptureHoldTxParams{HoldID: hold.ID, Amount: partial})).
+					Times(1).
+					Return(db.CaptureHoldTxResult{Hold: hold}, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, "banker", util.BankerRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, resp *pb.CaptureHoldResponse, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name:    "AccountHolderCannotCapture",
+			request: &pb.CaptureHoldRequest{HoldId: hold.ID},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccountHold(gomock.Any(), gomock.Eq(hold.ID)).Times(1).Return(hold, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(toAccount.ID)).Times(1).Return(toAccount, nil)
+				store.EXPECT().GetAccountHolder(gomock.Any(), gomock.Any()).Times(1).Return(db.AccountHolder{}, db.ErrRecordNotFound)
+				store.EXPECT().CaptureHoldTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, util.DepositorRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, resp *pb.CaptureHoldResponse, err error) {
+				requireStatusCode(t, codes.PermissionDenied, err)
+			},
+		},
+		{
+			name:    "HoldNotActive",
+			request: &pb.CaptureHoldRequest{HoldId: hold.ID},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccountHold(gomock.Any(), gomock.Eq(hold.ID)).Times(1).Return(hold, nil)
+				store.EXPECT().CaptureHoldTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.CaptureHoldTxResult{}, fmt.Errorf("%w: hold %d is expired", db.ErrHoldNotActive, hold.ID))
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, "banker", util.BankerRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, resp *pb.CaptureHoldResponse, err error) {
+				requireStatusCode(t, codes.FailedPrecondition, err)
+			},
+		},
+		{
+			name:    "NotFound",
+			request: &pb.CaptureHoldRequest{HoldId: hold.ID},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccountHold(gomock.Any(), gomock.Eq(hold.ID)).Times(1).Return(db.AccountHold{}, db.ErrRecordNotFound)
+				store.EXPECT().CaptureHoldTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, "banker", util.BankerRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, resp *pb.CaptureHoldResponse, err error) {
+				requireStatusCode(t, codes.NotFound, err)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil)
+			ctx := tc.buildContext(t, server.tokenMaker)
+			res, err := server.CaptureHold(ctx, tc.request)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
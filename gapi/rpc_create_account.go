@@ -0,0 +1,59 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+
+	db "github.com/mahanth/simplebank/db/sqlc"
+	"github.com/mahanth/simplebank/pb"
+	"github.com/mahanth/simplebank/util"
+	"github.com/mahanth/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) CreateAccount(ctx context.Context, req *pb.CreateAccountRequest) (*pb.CreateAccountResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, []string{util.DepositorRole, util.BankerRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateCreateAccountRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	accountType := db.AccountTypeChecking
+	if req.AccountType != nil {
+		accountType = req.GetAccountType()
+	}
+
+	account, err := server.store.CreateAccount(server.auditContext(ctx, authPayload.Username, authPayload.Role), db.CreateAccountParams{
+		Owner:    authPayload.Username,
+		Balance:  0,
+		Currency: req.GetCurrency(),
+		Type:     accountType,
+	})
+	if err != nil {
+		if db.ErrorCode(err) == db.UniqueViolation {
+			return nil, status.Errorf(codes.AlreadyExists, "user already has a %s %s account", req.GetCurrency(), accountType)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to create account %s", err)
+	}
+
+	response := &pb.CreateAccountResponse{
+		Account: convertAccount(account),
+	}
+	return response, nil
+}
+
+func validateCreateAccountRequest(req *pb.CreateAccountRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateCurrency(req.GetCurrency()); err != nil {
+		violations = append(violations, fieldViolation("currency", err))
+	}
+	if req.AccountType != nil && !db.IsSupportedAccountType(req.GetAccountType()) {
+		violations = append(violations, fieldViolation("account_type", fmt.Errorf("unsupported account type %q", req.GetAccountType())))
+	}
+	return violations
+}
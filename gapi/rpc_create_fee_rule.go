@@ -0,0 +1,87 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	db "github.com/mahanth/simplebank/db/sqlc"
+	"github.com/mahanth/simplebank/fee"
+	"github.com/mahanth/simplebank/pb"
+	"github.com/mahanth/simplebank/util"
+	"github.com/mahanth/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) CreateFeeRule(ctx context.Context, req *pb.CreateFeeRuleRequest) (*pb.CreateFeeRuleResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, []string{util.BankerRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateCreateFeeRuleRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	arg := db.CreateFeeRuleParams{
+		Currency:  pgtype.Text{String: req.GetCurrency(), Valid: req.Currency != nil},
+		Role:      pgtype.Text{String: req.GetRole(), Valid: req.Role != nil},
+		SameOwner: pgtype.Bool{Bool: req.GetSameOwner(), Valid: req.SameOwner != nil},
+		FlatFee:   req.GetFlatFee(),
+		MinFee:    req.GetMinFee(),
+		MaxFee:    pgtype.Int8{Int64: req.GetMaxFee(), Valid: req.MaxFee != nil},
+		CreatedBy: authPayload.Username,
+	}
+
+	// the percentage was validated above
+	percentage := "0"
+	if req.Percentage != nil {
+		percentage = req.GetPercentage()
+	}
+	_ = arg.Percentage.Scan(percentage)
+
+	rule, err := server.store.CreateFeeRule(server.auditContext(ctx, authPayload.Username, authPayload.Role), arg)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create fee rule: %s", err)
+	}
+
+	response := &pb.CreateFeeRuleResponse{
+		FeeRule: convertFeeRule(rule),
+	}
+	return response, nil
+}
+
+func validateCreateFeeRuleRequest(req *pb.CreateFeeRuleRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if req.Currency != nil {
+		if err := val.ValidateCurrency(req.GetCurrency()); err != nil {
+			violations = append(violations, fieldViolation("currency", err))
+		}
+	}
+	if req.Role != nil && !util.IsSupportedRole(req.GetRole()) {
+		violations = append(violations, fieldViolation("role", fmt.Errorf("unsupported role %q", req.GetRole())))
+	}
+
+	rule := fee.Rule{
+		Flat: req.GetFlatFee(),
+		Min:  req.GetMinFee(),
+		Max:  req.GetMaxFee(),
+	}
+	if req.Percentage != nil {
+		if err := val.ValidateDecimal(req.GetPercentage()); err != nil {
+			violations = append(violations, fieldViolation("percentage", err))
+			return violations
+		}
+		rule.Percentage, _ = new(big.Rat).SetString(req.GetPercentage())
+	}
+	if req.MaxFee != nil && req.GetMaxFee() <= 0 {
+		violations = append(violations, fieldViolation("max_fee", fmt.Errorf("must be greater than zero")))
+	}
+	if err := rule.Validate(); err != nil {
+		violations = append(violations, fieldViolation("fee", err))
+	}
+	return violations
+}
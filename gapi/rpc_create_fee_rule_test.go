@@ -0,0 +1,146 @@
+package gapi
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/jackc/pgx/v5/pgtype"
+	mockdb "github.com/mahanth/simplebank/db/mock"
+	db "github.com/mahanth/simplebank/db/sqlc"
+	"github.com/mahanth/simplebank/pb"
+	"github.com/mahanth/simplebank/token"
+	"github.com/mahanth/simplebank/util"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+)
+
+func TestCreateFeeRuleAPI(t *testing.T) {
+	user, _ := randomUser()
+
+	currency := util.USD
+	sameOwner := false
+	percentage := "0.005"
+	maxFee := int64(500)
+
+	testCases := []struct {
+		name          string
+		request       *pb.CreateFeeRuleRequest
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, resp *pb.CreateFeeRuleResponse, err error)
+	}{
+		{
+			name: "OK",
+			request: &pb.CreateFeeRuleRequest{
+				Currency:   &currency,
+				SameOwner:  &sameOwner,
+				FlatFee:    25,
+				Percentage: &percentage,
+				MinFee:     50,
+				MaxFee:     &maxFee,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.CreateFeeRuleParams{
+					Currency:  pgtype.Text{String: util.USD, Valid: true},
+					SameOwner: pgtype.Bool{Bool: false, Valid: true},
+					FlatFee:   25,
+					MinFee:    50,
+					MaxFee:    pgtype.Int8{Int64: 500, Valid: true},
+					CreatedBy: "banker",
+				}
+				require.NoError(t, arg.Percentage.Scan(percentage))
+
+				rule := db.FeeRule{
+					ID:         1,
+					Currency:   arg.Currency,
+					SameOwner:  arg.SameOwner,
+					FlatFee:    arg.FlatFee,
+					Percentage: arg.Percentage,
+					MinFee:     arg.MinFee,
+					MaxFee:     arg.MaxFee,
+					CreatedBy:  arg.CreatedBy,
+				}
+				store.EXPECT().CreateFeeRule(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(rule, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, "banker", util.BankerRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, resp *pb.CreateFeeRuleResponse, err error) {
+				require.NoError(t, err)
+				rule := resp.GetFeeRule()
+				require.Equal(t, util.USD, rule.GetCurrency())
+				require.Empty(t, rule.GetRole())
+				require.NotNil(t, rule.SameOwner)
+				require.False(t, rule.GetSameOwner())
+				require.Equal(t, "0.005", rule.GetPercentage())
+				require.Equal(t, int64(500), rule.GetMaxFee())
+			},
+		},
+		{
+			name: "DepositorCannotCreateRule",
+			request: &pb.CreateFeeRuleRequest{
+				FlatFee: 25,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateFeeRule(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, util.DepositorRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, resp *pb.CreateFeeRuleResponse, err error) {
+				requireStatusCode(t, codes.Unauthenticated, err)
+			},
+		},
+		{
+			name: "MaxBelowMin",
+			request: &pb.CreateFeeRuleRequest{
+				MinFee: 600,
+				MaxFee: &maxFee,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateFeeRule(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, "banker", util.BankerRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, resp *pb.CreateFeeRuleResponse, err error) {
+				requireStatusCode(t, codes.InvalidArgument, err)
+			},
+		},
+		{
+			name: "InvalidPercentage",
+			request: &pb.CreateFeeRuleRequest{
+				Percentage: func() *string { p := "150%"; return &p }(),
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateFeeRule(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, "banker", util.BankerRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, resp *pb.CreateFeeRuleResponse, err error) {
+				requireStatusCode(t, codes.InvalidArgument, err)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil)
+			ctx := tc.buildContext(t, server.tokenMaker)
+			res, err := server.CreateFeeRule(ctx, tc.request)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
@@ -0,0 +1,130 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	db "github.com/mahanth/simplebank/db/sqlc"
+	"github.com/mahanth/simplebank/pb"
+	"github.com/mahanth/simplebank/util"
+	"github.com/mahanth/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) CreateScheduledTransfer(ctx context.Context, req *pb.CreateScheduledTransferRequest) (*pb.CreateScheduledTransferResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, []string{util.DepositorRole, util.BankerRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	now := time.Now()
+	violations := validateCreateScheduledTransferRequest(req, now)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	fromAccount, err := server.getAccount(ctx, req.GetFromAccountId())
+	if err != nil {
+		return nil, err
+	}
+
+	// like a one-off transfer, only the owner or a holder allowed to may schedule money out of an account
+	if _, err = server.authorizeAccount(ctx, authPayload, fromAccount, db.HolderPermissionTransfer); err != nil {
+		return nil, err
+	}
+
+	toAccount, err := server.getAccount(ctx, req.GetToAccountId())
+	if err != nil {
+		return nil, err
+	}
+
+	for _, account := range []db.Account{fromAccount, toAccount} {
+		if err = checkAccountActive(account); err != nil {
+			return nil, err
+		}
+	}
+
+	// scheduled transfers are not converted, since the rate at each run is unknown up front
+	for _, account := range []db.Account{fromAccount, toAccount} {
+		if account.Currency != req.GetCurrency() {
+			return nil, status.Errorf(codes.InvalidArgument, "account %d currency mismatch: expected %s, got %s",
+				account.ID, req.GetCurrency(), account.Currency)
+		}
+	}
+
+	startAt := now
+	if req.StartAt != nil {
+		startAt = req.GetStartAt().AsTime()
+	}
+
+	// the schedule was validated above
+	schedule, _ := util.ParseSchedule(req.GetSchedule())
+	firstRun := util.FirstRun(schedule, startAt)
+
+	arg := db.CreateScheduledTransferParams{
+		Owner:         authPayload.Username,
+		FromAccountID: fromAccount.ID,
+		ToAccountID:   toAccount.ID,
+		Amount:        req.GetAmount(),
+		Schedule:      req.GetSchedule(),
+		StartAt:       pgtype.Timestamptz{Time: startAt, Valid: true},
+		NextRunAt:     pgtype.Timestamptz{Time: firstRun, Valid: true},
+		OwnerRole:     authPayload.Role,
+	}
+	if req.EndAt != nil {
+		endAt := req.GetEndAt().AsTime()
+		if firstRun.After(endAt) {
+			return nil, invalidArgumentError([]*errdetails.BadRequest_FieldViolation{
+				fieldViolation("end_at", fmt.Errorf("must not be before the first run at %s", firstRun.Format(time.RFC3339))),
+			})
+		}
+		arg.EndAt = pgtype.Timestamptz{Time: endAt, Valid: true}
+	}
+
+	scheduled, err := server.store.CreateScheduledTransfer(server.auditContext(ctx, authPayload.Username, authPayload.Role), arg)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create scheduled transfer: %s", err)
+	}
+
+	response := &pb.CreateScheduledTransferResponse{
+		ScheduledTransfer: convertScheduledTransfer(scheduled),
+	}
+	return response, nil
+}
+
+func validateCreateScheduledTransferRequest(req *pb.CreateScheduledTransferRequest, now time.Time) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateID(req.GetFromAccountId()); err != nil {
+		violations = append(violations, fieldViolation("from_account_id", err))
+	}
+	if err := val.ValidateID(req.GetToAccountId()); err != nil {
+		violations = append(violations, fieldViolation("to_account_id", err))
+	}
+	if req.GetFromAccountId() == req.GetToAccountId() {
+		violations = append(violations, fieldViolation("to_account_id", fmt.Errorf("must be different from from_account_id")))
+	}
+	if err := val.ValidateAmount(req.GetAmount()); err != nil {
+		violations = append(violations, fieldViolation("amount", err))
+	}
+	if err := val.ValidateCurrency(req.GetCurrency()); err != nil {
+		violations = append(violations, fieldViolation("currency", err))
+	}
+	if err := val.ValidateSchedule(req.GetSchedule()); err != nil {
+		violations = append(violations, fieldViolation("schedule", err))
+	}
+
+	startAt := now
+	if req.StartAt != nil {
+		startAt = req.GetStartAt().AsTime()
+		if startAt.Before(now.Add(-time.Minute)) {
+			violations = append(violations, fieldViolation("start_at", fmt.Errorf("must not be in the past")))
+		}
+	}
+	if req.EndAt != nil && !req.GetEndAt().AsTime().After(startAt) {
+		violations = append(violations, fieldViolation("end_at", fmt.Errorf("must be after start_at")))
+	}
+	return violations
+}
@@ -0,0 +1,199 @@
+package gapi
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	mockdb "github.com/mahanth/simplebank/db/mock"
+	db "github.com/mahanth/simplebank/db/sqlc"
+	"github.com/mahanth/simplebank/pb"
+	"github.com/mahanth/simplebank/token"
+	"github.com/mahanth/simplebank/util"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestCreateScheduledTransferAPI(t *testing.T) {
+	amount := int64(10)
+
+	user1, _ := randomUser()
+	user2, _ := randomUser()
+	account1 := randomAccount(user1.Username)
+	account2 := randomAccount(user2.Username)
+	account2.ID = account1.ID + 1
+
+	startAt := time.Now().Add(time.Hour).Truncate(time.Second)
+	request := &pb.CreateScheduledTransferRequest{
+		FromAccountId: account1.ID,
+		ToAccountId:   account2.ID,
+		Amount:        amount,
+		Currency:      util.USD,
+		Schedule:      "@every 24h",
+		StartAt:       timestamppb.New(startAt),
+	}
+
+	testCases := []struct {
+		name          string
+		request       *pb.CreateScheduledTransferRequest
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, resp *pb.CreateScheduledTransferResponse, err error)
+	}{
+		{
+			name:    "OK",
+			request: request,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(account1, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account2.ID)).Times(1).Return(account2, nil)
+				store.EXPECT().CreateScheduledTransfer(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ context.Context, arg db.CreateScheduledTransferParams) (db.ScheduledTransfer, error) {
+						require.Equal(t, user1.Username, arg.Owner)
+						require.Equal(t, user1.Role, arg.OwnerRole)
+						require.Equal(t, amount, arg.Amount)
+						require.True(t, arg.StartAt.Time.Equal(startAt))
+						// an @every schedule first runs at its start
+						require.True(t, arg.NextRunAt.Time.Equal(startAt))
+						require.False(t, arg.EndAt.Valid)
+						return db.ScheduledTransfer{
+							ID:            1,
+							Owner:         arg.Owner,
+							FromAccountID: arg.FromAccountID,
+							ToAccountID:   arg.ToAccountID,
+							Amount:        arg.Amount,
+							Schedule:      arg.Schedule,
+							StartAt:       arg.StartAt,
+							NextRunAt:     arg.NextRunAt,
+							Status:        db.ScheduledTransferActive,
+						}, nil
+					})
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user1.Username, user1.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, resp *pb.CreateScheduledTransferResponse, err error) {
+				require.NoError(t, err)
+				scheduled := resp.GetScheduledTransfer()
+				require.Equal(t, db.ScheduledTransferActive, scheduled.GetStatus())
+				require.Equal(t, "@every 24h", scheduled.GetSchedule())
+				require.True(t, scheduled.GetNextRunAt().AsTime().Equal(startAt))
+				require.Nil(t, scheduled.GetEndAt())
+			},
+		},
+		{
+			name:    "NotAccountOwner",
+			request: request,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(account1, nil)
+				store.EXPECT().GetAccountHolder(gomock.Any(), gomock.Any()).Times(1).Return(db.AccountHolder{}, db.ErrRecordNotFound)
+				store.EXPECT().CreateScheduledTransfer(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user2.Username, util.BankerRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, resp *pb.CreateScheduledTransferResponse, err error) {
+				requireStatusCode(t, codes.PermissionDenied, err)
+			},
+		},
+		{
+			name:    "CurrencyMismatch",
+			request: request,
+			buildStubs: func(store *mockdb.MockStore) {
+				account := account2
+				account.Currency = util.EUR
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(account1, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account2.ID)).Times(1).Return(account, nil)
+				store.EXPECT().CreateScheduledTransfer(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user1.Username, user1.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, resp *pb.CreateScheduledTransferResponse, err error) {
+				requireStatusCode(t, codes.InvalidArgument, err)
+			},
+		},
+		{
+			name: "InvalidSchedule",
+			request: &pb.CreateScheduledTransferRequest{
+				FromAccountId: account1.ID,
+				ToAccountId:   account2.ID,
+				Amount:        amount,
+				Currency:      util.USD,
+				Schedule:      "every monday",
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().CreateScheduledTransfer(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user1.Username, user1.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, resp *pb.CreateScheduledTransferResponse, err error) {
+				requireStatusCode(t, codes.InvalidArgument, err)
+			},
+		},
+		{
+			name: "EndBeforeFirstRun",
+			request: &pb.CreateScheduledTransferRequest{
+				FromAccountId: account1.ID,
+				ToAccountId:   account2.ID,
+				Amount:        amount,
+				Currency:      util.USD,
+				Schedule:      "0 9 1 * *",
+				StartAt:       timestamppb.New(time.Date(2100, 1, 2, 0, 0, 0, 0, time.UTC)),
+				EndAt:         timestamppb.New(time.Date(2100, 1, 20, 0, 0, 0, 0, time.UTC)),
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(account1, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account2.ID)).Times(1).Return(account2, nil)
+				store.EXPECT().CreateScheduledTransfer(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user1.Username, user1.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, resp *pb.CreateScheduledTransferResponse, err error) {
+				requireStatusCode(t, codes.InvalidArgument, err)
+			},
+		},
+		{
+			name: "StartInThePast",
+			request: &pb.CreateScheduledTransferRequest{
+				FromAccountId: account1.ID,
+				ToAccountId:   account2.ID,
+				Amount:        amount,
+				Currency:      util.USD,
+				Schedule:      "@daily",
+				StartAt:       timestamppb.New(time.Now().Add(-time.Hour)),
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().CreateScheduledTransfer(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user1.Username, user1.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, resp *pb.CreateScheduledTransferResponse, err error) {
+				requireStatusCode(t, codes.InvalidArgument, err)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil)
+			ctx := tc.buildContext(t, server.tokenMaker)
+			res, err := server.CreateScheduledTransfer(ctx, tc.request)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
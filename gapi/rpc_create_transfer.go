@@ -0,0 +1,170 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	db "github.com/mahanth/simplebank/db/sqlc"
+	"github.com/mahanth/simplebank/pb"
+	"github.com/mahanth/simplebank/token"
+	"github.com/mahanth/simplebank/util"
+	"github.com/mahanth/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) CreateTransfer(ctx context.Context, req *pb.CreateTransferRequest) (*pb.CreateTransferResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, []string{util.DepositorRole, util.BankerRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateCreateTransferRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	amount, currency := transferAmount(req)
+
+	fromAccount, err := server.getAccount(ctx, req.GetFromAccountId())
+	if err != nil {
+		return nil, err
+	}
+
+	// only the owner or a holder allowed to may move money out of an account, whatever their role;
+	// the store holds the transfer to the limit set for the holder
+	if _, err = server.authorizeAccount(ctx, authPayload, fromAccount, db.HolderPermissionTransfer); err != nil {
+		return nil, err
+	}
+
+	toAccount, err := server.getAccount(ctx, req.GetToAccountId())
+	if err != nil {
+		return nil, err
+	}
+
+	for _, account := range []db.Account{fromAccount, toAccount} {
+		if err = checkAccountActive(account); err != nil {
+			return nil, err
+		}
+	}
+
+	// the amount is in the sender's currency and converted if the receiver holds another one
+	if fromAccount.Currency != currency {
+		return nil, status.Errorf(codes.InvalidArgument, "account %d currency mismatch: expected %s, got %s",
+			fromAccount.ID, currency, fromAccount.Currency)
+	}
+
+	transferTx := server.store.TransferTx
+	if toAccount.Currency != fromAccount.Currency {
+		transferTx = server.store.CrossCurrencyTransferTx
+	}
+
+	result, err := transferTx(server.auditContext(ctx, authPayload.Username, authPayload.Role), db.TransferTxParams{
+		FromAccountID:  req.GetFromAccountId(),
+		ToAccountID:    req.GetToAccountId(),
+		Amount:         amount,
+		Memo:           req.GetMemo(),
+		Reference:      req.GetReference(),
+		Username:       authPayload.Username,
+		IdempotencyKey: req.GetIdempotencyKey(),
+		Role:           authPayload.Role,
+	})
+	if err != nil {
+		// the store refuses amounts above the approval threshold, which are held for a banker instead
+		if errors.Is(err, db.ErrApprovalRequired) {
+			return server.requestTransferApproval(ctx, authPayload, req, amount)
+		}
+		return nil, transferError(err)
+	}
+
+	response := &pb.CreateTransferResponse{
+		Transfer:    convertTransfer(result.Transfer),
+		FromAccount: convertAccount(result.FromAccount),
+		ToAccount:   convertAccount(result.ToAccount),
+		FromEntry:   convertEntry(result.FromEntry),
+		ToEntry:     convertEntry(result.ToEntry),
+		Fee:         convertTransferFee(result.Fee),
+		Sent:        convertMoney(result.Transfer.Amount, result.FromAccount.Currency),
+		Received:    convertMoney(result.Transfer.ToAmount, result.ToAccount.Currency),
+	}
+	return response, nil
+}
+
+// requestTransferApproval holds the funds of a transfer that needs approval and responds with the
+// pending approval instead of a transfer.
+func (server *Server) requestTransferApproval(ctx context.Context, authPayload *token.Payload,
+	req *pb.CreateTransferRequest, amount int64) (*pb.CreateTransferResponse, error) {
+	result, err := server.store.RequestTransferApprovalTx(server.auditContext(ctx, authPayload.Username, authPayload.Role), db.RequestTransferApprovalTxParams{
+		TransferTxParams: db.TransferTxParams{
+			FromAccountID:  req.GetFromAccountId(),
+			ToAccountID:    req.GetToAccountId(),
+			Amount:         amount,
+			Memo:           req.GetMemo(),
+			Reference:      req.GetReference(),
+			Username:       authPayload.Username,
+			IdempotencyKey: req.GetIdempotencyKey(),
+			Role:           authPayload.Role,
+		},
+		ExpiresAt: time.Now().Add(server.config.TransferApprovalTTL),
+	})
+	if err != nil {
+		return nil, transferError(err)
+	}
+
+	response := &pb.CreateTransferResponse{
+		FromAccount: convertAccount(result.FromAccount),
+		Approval:    convertTransferApproval(result.Approval),
+	}
+	return response, nil
+}
+
+func validateCreateTransferRequest(req *pb.CreateTransferRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateID(req.GetFromAccountId()); err != nil {
+		violations = append(violations, fieldViolation("from_account_id", err))
+	}
+	if err := val.ValidateID(req.GetToAccountId()); err != nil {
+		violations = append(violations, fieldViolation("to_account_id", err))
+	}
+	if req.GetFromAccountId() == req.GetToAccountId() {
+		violations = append(violations, fieldViolation("to_account_id", fmt.Errorf("must be different from from_account_id")))
+	}
+	if req.Money != nil {
+		if req.GetAmount() != 0 || req.GetCurrency() != "" {
+			violations = append(violations, fieldViolation("money", fmt.Errorf("must not be set with amount and currency")))
+		}
+		if err := val.ValidateMoney(req.GetMoney().GetAmount(), req.GetMoney().GetCurrency()); err != nil {
+			violations = append(violations, fieldViolation("money", err))
+		}
+	} else {
+		if err := val.ValidateAmount(req.GetAmount()); err != nil {
+			violations = append(violations, fieldViolation("amount", err))
+		}
+		if err := val.ValidateCurrency(req.GetCurrency()); err != nil {
+			violations = append(violations, fieldViolation("currency", err))
+		}
+	}
+	if err := val.ValidateMemo(req.GetMemo()); err != nil {
+		violations = append(violations, fieldViolation("memo", err))
+	}
+	if err := val.ValidateReference(req.GetReference()); err != nil {
+		violations = append(violations, fieldViolation("reference", err))
+	}
+	if req.IdempotencyKey != nil {
+		if err := val.ValidateString(req.GetIdempotencyKey(), 1, 255); err != nil {
+			violations = append(violations, fieldViolation("idempotency_key", err))
+		}
+	}
+	return violations
+}
+
+// transferAmount returns the amount in minor units and the currency of a validated request.
+func transferAmount(req *pb.CreateTransferRequest) (int64, string) {
+	if req.Money == nil {
+		return req.GetAmount(), req.GetCurrency()
+	}
+	money, _ := util.ParseMoney(req.GetMoney().GetAmount(), req.GetMoney().GetCurrency())
+	return money.Amount, money.Currency
+}
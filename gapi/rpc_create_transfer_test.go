@@ -0,0 +1,588 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	mockdb "github.com/mahanth/simplebank/db/mock"
+	db "github.com/mahanth/simplebank/db/sqlc"
+	"github.com/mahanth/simplebank/fee"
+	"github.com/mahanth/simplebank/pb"
+	"github.com/mahanth/simplebank/token"
+	"github.com/mahanth/simplebank/util"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestCreateTransferAPI(t *testing.T) {
+	amount := int64(10)
+
+	user1, _ := randomUser()
+	user2, _ := randomUser()
+	account1 := randomAccount(user1.Username)
+	account2 := randomAccount(user2.Username)
+	account2.ID = account1.ID + 1
+
+	request := &pb.CreateTransferRequest{
+		FromAccountId: account1.ID,
+		ToAccountId:   account2.ID,
+		Amount:        amount,
+		Currency:      util.USD,
+	}
+
+	testCases := []struct {
+		name          string
+		request       *pb.CreateTransferRequest
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, resp *pb.CreateTransferResponse, err error)
+	}{
+		{
+			name:    "OK",
+			request: request,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(account1, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account2.ID)).Times(1).Return(account2, nil)
+
+				arg := db.TransferTxParams{
+					FromAccountID: account1.ID,
+					ToAccountID:   account2.ID,
+					Amount:        amount,
+					Username:      user1.Username,
+					Role:          user1.Role,
+				}
+				store.EXPECT().TransferTx(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(db.TransferTxResult{
+						Transfer: db.Transfer{ID: 1, FromAccountID: account1.ID, ToAccountID: account2.ID, Amount: amount, FeeAmount: 3},
+						Fee: db.TransferFee{
+							RuleID:       7,
+							Breakdown:    fee.Breakdown{Flat: 2, Percentage: 1, Total: 3},
+							Entry:        db.Entry{ID: 3, AccountID: account1.ID, Amount: -3, Type: db.EntryTypeFee},
+							RevenueEntry: db.Entry{ID: 4, AccountID: 99, Amount: 3, Type: db.EntryTypeFee},
+						},
+					}, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user1.Username, user1.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, resp *pb.CreateTransferResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, amount, resp.GetTransfer().GetAmount())
+				require.Equal(t, account1.ID, resp.GetTransfer().GetFromAccountId())
+				require.Equal(t, int64(3), resp.GetTransfer().GetFeeAmount())
+				require.Equal(t, int64(7), resp.GetFee().GetRuleId())
+				require.Equal(t, int64(2), resp.GetFee().GetFlat())
+				require.Equal(t, int64(1), resp.GetFee().GetPercentage())
+				require.Equal(t, int64(3), resp.GetFee().GetTotal())
+				require.Equal(t, int64(-3), resp.GetFee().GetEntry().GetAmount())
+			},
+		},
+		{
+			name:    "NotAccountOwner",
+			request: request,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(account1, nil)
+				store.EXPECT().GetAccountHolder(gomock.Any(), gomock.Any()).Times(1).Return(db.AccountHolder{}, db.ErrRecordNotFound)
+				store.EXPECT().TransferTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user2.Username, util.BankerRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, resp *pb.CreateTransferResponse, err error) {
+				requireStatusCode(t, codes.PermissionDenied, err)
+			},
+		},
+		{
+			name: "CurrencyMismatch",
+			request: &pb.CreateTransferRequest{
+				FromAccountId: account1.ID,
+				ToAccountId:   account2.ID,
+				Amount:        amount,
+				Currency:      util.EUR,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(account1, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account2.ID)).Times(1).Return(account2, nil)
+				store.EXPECT().TransferTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user1.Username, user1.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, resp *pb.CreateTransferResponse, err error) {
+				requireStatusCode(t, codes.InvalidArgument, err)
+			},
+		},
+		{
+			name:    "CrossCurrency",
+			request: request,
+			buildStubs: func(store *mockdb.MockStore) {
+				account := account2
+				account.Currency = util.EUR
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(account1, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account2.ID)).Times(1).Return(account, nil)
+				store.EXPECT().TransferTx(gomock.Any(), gomock.Any()).Times(0)
+
+				arg := db.TransferTxParams{
+					FromAccountID: account1.ID,
+					ToAccountID:   account2.ID,
+					Amount:        amount,
+					Username:      user1.Username,
+					Role:          user1.Role,
+				}
+				store.EXPECT().CrossCurrencyTransferTx(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(db.TransferTxResult{
+						Transfer: db.Transfer{ID: 1, FromAccountID: account1.ID, ToAccountID: account2.ID, Amount: amount, ToAmount: 9},
+					}, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user1.Username, user1.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, resp *pb.CreateTransferResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, amount, resp.GetTransfer().GetAmount())
+				require.Equal(t, int64(9), resp.GetTransfer().GetToAmount())
+			},
+		},
+		{
+			name:    "ExchangeRateNotFound",
+			request: request,
+			buildStubs: func(store *mockdb.MockStore) {
+				account := account2
+				account.Currency = util.CAD
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(account1, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account2.ID)).Times(1).Return(account, nil)
+				store.EXPECT().CrossCurrencyTransferTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.TransferTxResult{}, fmt.Errorf("%w: EUR/CAD", db.ErrExchangeRateNotFound))
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user1.Username, user1.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, resp *pb.CreateTransferResponse, err error) {
+				requireStatusCode(t, codes.FailedPrecondition, err)
+			},
+		},
+		{
+			name:    "InsufficientFunds",
+			request: request,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(account1, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account2.ID)).Times(1).Return(account2, nil)
+				store.EXPECT().TransferTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.TransferTxResult{}, fmt.Errorf("%w: account %d", db.ErrInsufficientFunds, account1.ID))
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user1.Username, user1.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, resp *pb.CreateTransferResponse, err error) {
+				requireStatusCode(t, codes.FailedPrecondition, err)
+			},
+		},
+		{
+			name:    "CurrencyMismatch",
+			request: request,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(account1, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account2.ID)).Times(1).Return(account2, nil)
+				store.EXPECT().TransferTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.TransferTxResult{}, fmt.Errorf("%w: account %d holds EUR", db.ErrCurrencyMismatch, account2.ID))
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user1.Username, user1.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, resp *pb.CreateTransferResponse, err error) {
+				requireStatusCode(t, codes.FailedPrecondition, err)
+			},
+		},
+		{
+			name:    "TransferLimitExceeded",
+			request: request,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(account1, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account2.ID)).Times(1).Return(account2, nil)
+				store.EXPECT().TransferTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.TransferTxResult{}, &db.TransferLimitError{
+						AccountID: account1.ID,
+						Limit:     db.LimitDailyTotal,
+						Max:       100,
+						Used:      90,
+						Requested: 20,
+						Headroom:  10,
+					})
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user1.Username, user1.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, resp *pb.CreateTransferResponse, err error) {
+				requireStatusCode(t, codes.ResourceExhausted, err)
+
+				st, _ := status.FromError(err)
+				require.Len(t, st.Details(), 1)
+				errorInfo, ok := st.Details()[0].(*errdetails.ErrorInfo)
+				require.True(t, ok)
+				require.Equal(t, db.LimitDailyTotal, errorInfo.GetMetadata()["limit"])
+				require.Equal(t, "10", errorInfo.GetMetadata()["headroom"])
+			},
+		},
+		{
+			name:    "ClosedFromAccount",
+			request: request,
+			buildStubs: func(store *mockdb.MockStore) {
+				closed := account1
+				closed.Status = db.AccountStatusClosed
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(closed, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account2.ID)).Times(1).Return(account2, nil)
+				store.EXPECT().TransferTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user1.Username, user1.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, resp *pb.CreateTransferResponse, err error) {
+				requireStatusCode(t, codes.FailedPrecondition, err)
+			},
+		},
+		{
+			name:    "IdempotencyKeyReused",
+			request: request,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(account1, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account2.ID)).Times(1).Return(account2, nil)
+				store.EXPECT().TransferTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.TransferTxResult{}, db.ErrIdempotencyKeyReused)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user1.Username, user1.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, resp *pb.CreateTransferResponse, err error) {
+				requireStatusCode(t, codes.AlreadyExists, err)
+			},
+		},
+		{
+			name: "SameAccount",
+			request: &pb.CreateTransferRequest{
+				FromAccountId: account1.ID,
+				ToAccountId:   account1.ID,
+				Amount:        amount,
+				Currency:      util.USD,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().TransferTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user1.Username, user1.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, resp *pb.CreateTransferResponse, err error) {
+				requireStatusCode(t, codes.InvalidArgument, err)
+			},
+		},
+		{
+			name: "WithMemo",
+			request: &pb.CreateTransferRequest{
+				FromAccountId: account1.ID,
+				ToAccountId:   account2.ID,
+				Amount:        amount,
+				Currency:      util.USD,
+				Memo:          "Rent for March ☂",
+				Reference:     "INV-2026/03",
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(account1, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account2.ID)).Times(1).Return(account2, nil)
+
+				arg := db.TransferTxParams{
+					FromAccountID: account1.ID,
+					ToAccountID:   account2.ID,
+					Amount:        amount,
+					Memo:          "Rent for March ☂",
+					Reference:     "INV-2026/03",
+					Username:      user1.Username,
+					Role:          user1.Role,
+				}
+				store.EXPECT().TransferTx(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(db.TransferTxResult{
+						Transfer: db.Transfer{ID: 1, Amount: amount, Memo: arg.Memo, Reference: arg.Reference},
+					}, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user1.Username, user1.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, resp *pb.CreateTransferResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "Rent for March ☂", resp.GetTransfer().GetMemo())
+				require.Equal(t, "INV-2026/03", resp.GetTransfer().GetReference())
+			},
+		},
+		{
+			name: "WithMoney",
+			request: &pb.CreateTransferRequest{
+				FromAccountId: account1.ID,
+				ToAccountId:   account2.ID,
+				Money:         &pb.Money{Amount: "12.50", Currency: util.USD},
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(account1, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account2.ID)).Times(1).Return(account2, nil)
+
+				arg := db.TransferTxParams{
+					FromAccountID: account1.ID,
+					ToAccountID:   account2.ID,
+					Amount:        1250,
+					Username:      user1.Username,
+					Role:          user1.Role,
+				}
+				store.EXPECT().TransferTx(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(db.TransferTxResult{
+						Transfer:    db.Transfer{ID: 1, Amount: 1250, ToAmount: 1250},
+						FromAccount: account1,
+						ToAccount:   account2,
+					}, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user1.Username, user1.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, resp *pb.CreateTransferResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "12.50", resp.GetSent().GetAmount())
+				require.Equal(t, util.USD, resp.GetSent().GetCurrency())
+				require.Equal(t, "12.50", resp.GetReceived().GetAmount())
+			},
+		},
+		{
+			name: "InvalidMoney",
+			request: &pb.CreateTransferRequest{
+				FromAccountId: account1.ID,
+				ToAccountId:   account2.ID,
+				Amount:        amount,
+				Money:         &pb.Money{Amount: "12.505", Currency: util.USD},
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().TransferTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user1.Username, user1.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, resp *pb.CreateTransferResponse, err error) {
+				requireStatusCode(t, codes.InvalidArgument, err)
+			},
+		},
+		{
+			name: "InvalidMemo",
+			request: &pb.CreateTransferRequest{
+				FromAccountId: account1.ID,
+				ToAccountId:   account2.ID,
+				Amount:        amount,
+				Currency:      util.USD,
+				Memo:          "line one\nline two",
+				Reference:     "no spaces allowed",
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().TransferTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user1.Username, user1.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, resp *pb.CreateTransferResponse, err error) {
+				requireStatusCode(t, codes.InvalidArgument, err)
+
+				st, _ := status.FromError(err)
+				var fields []string
+				for _, detail := range st.Details() {
+					if badRequest, ok := detail.(*errdetails.BadRequest); ok {
+						for _, violation := range badRequest.GetFieldViolations() {
+							fields = append(fields, violation.GetField())
+						}
+					}
+				}
+				require.ElementsMatch(t, []string{"memo", "reference"}, fields)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil)
+			ctx := tc.buildContext(t, server.tokenMaker)
+			res, err := server.CreateTransfer(ctx, tc.request)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
+
+func TestCreateTransferApprovalRequiredAPI(t *testing.T) {
+	amount := int64(1000)
+
+	user1, _ := randomUser()
+	user2, _ := randomUser()
+	account1 := randomAccount(user1.Username)
+	account2 := randomAccount(user2.Username)
+	account2.ID = account1.ID + 1
+
+	testCases := []struct {
+		name          string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, resp *pb.CreateTransferResponse, err error)
+	}{
+		{
+			name: "NotRequired",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().RequestTransferApprovalTx(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().TransferTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.TransferTxResult{Transfer: db.Transfer{ID: 1, Amount: amount}}, nil)
+			},
+			checkResponse: func(t *testing.T, resp *pb.CreateTransferResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, amount, resp.GetTransfer().GetAmount())
+				require.Nil(t, resp.GetApproval())
+			},
+		},
+		{
+			name: "Required",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().TransferTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.TransferTxResult{}, db.ErrApprovalRequired)
+				store.EXPECT().RequestTransferApprovalTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ context.Context, arg db.RequestTransferApprovalTxParams) (db.RequestTransferApprovalTxResult, error) {
+						require.Equal(t, amount, arg.Amount)
+						require.Equal(t, user1.Username, arg.Username)
+						require.WithinDuration(t, time.Now().Add(time.Hour), arg.ExpiresAt, time.Minute)
+						return db.RequestTransferApprovalTxResult{
+							Approval: db.TransferApproval{
+								ID:            1,
+								FromAccountID: arg.FromAccountID,
+								ToAccountID:   arg.ToAccountID,
+								Amount:        arg.Amount,
+								RequestedBy:   arg.Username,
+								Status:        db.TransferApprovalStatusPending,
+							},
+						}, nil
+					})
+			},
+			checkResponse: func(t *testing.T, resp *pb.CreateTransferResponse, err error) {
+				require.NoError(t, err)
+				require.Nil(t, resp.GetTransfer())
+				require.Equal(t, db.TransferApprovalStatusPending, resp.GetApproval().GetStatus())
+				require.Equal(t, amount, resp.GetApproval().GetAmount())
+			},
+		},
+		{
+			name: "RequestFails",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().TransferTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.TransferTxResult{}, db.ErrApprovalRequired)
+				store.EXPECT().RequestTransferApprovalTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.RequestTransferApprovalTxResult{}, db.ErrInsufficientFunds)
+			},
+			checkResponse: func(t *testing.T, resp *pb.CreateTransferResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.FailedPrecondition, st.Code())
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(account1, nil)
+			store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account2.ID)).Times(1).Return(account2, nil)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil)
+			server.config.TransferApprovalTTL = time.Hour
+
+			ctx := newContextWithBearerToken(t, server.tokenMaker, user1.Username, user1.Role, time.Minute)
+			res, err := server.CreateTransfer(ctx, &pb.CreateTransferRequest{
+				FromAccountId: account1.ID,
+				ToAccountId:   account2.ID,
+				Amount:        amount,
+				Currency:      util.USD,
+			})
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
+
+func TestCreateTransferApprovalRetryAPI(t *testing.T) {
+	store := db.NewMemoryStore()
+	server := newTestServer(t, store, nil)
+	server.config.TransferApprovalTTL = time.Hour
+
+	user1, _ := randomUser()
+	user2, _ := randomUser()
+	accounts := make([]db.Account, 2)
+	for i, user := range []db.User{user1, user2} {
+		_, err := store.CreateUser(context.Background(), db.CreateUserParams{
+			Username:       user.Username,
+			HashedPassword: user.HashedPassword,
+			FullName:       user.FullName,
+			Email:          user.Email,
+		})
+		require.NoError(t, err)
+		accounts[i], err = store.CreateAccount(context.Background(), db.CreateAccountParams{
+			Owner:    user.Username,
+			Balance:  1000000,
+			Currency: util.USD,
+			Type:     db.AccountTypeChecking,
+		})
+		require.NoError(t, err)
+	}
+
+	// above the USD approval threshold, so every attempt answers with the same pending approval
+	idempotencyKey := "retry-key"
+	req := &pb.CreateTransferRequest{
+		FromAccountId:  accounts[0].ID,
+		ToAccountId:    accounts[1].ID,
+		Amount:         600000,
+		Currency:       util.USD,
+		IdempotencyKey: &idempotencyKey,
+	}
+
+	var approvalIDs []int64
+	for i := 0; i < 2; i++ {
+		ctx := newContextWithBearerToken(t, server.tokenMaker, user1.Username, user1.Role, time.Minute)
+		res, err := server.CreateTransfer(ctx, req)
+		require.NoError(t, err)
+		require.Nil(t, res.GetTransfer())
+		require.Equal(t, db.TransferApprovalStatusPending, res.GetApproval().GetStatus())
+		approvalIDs = append(approvalIDs, res.GetApproval().GetId())
+	}
+	require.Equal(t, approvalIDs[0], approvalIDs[1])
+
+	// the funds are held once
+	account, err := store.GetAccount(context.Background(), accounts[0].ID)
+	require.NoError(t, err)
+	require.Equal(t, int64(600000), account.HeldAmount)
+}
@@ -2,14 +2,11 @@ package gapi
 
 import (
 	"context"
-	"time"
 
-	"github.com/hibiken/asynq"
 	db "github.com/mahanth/simplebank/db/sqlc"
 	"github.com/mahanth/simplebank/pb"
 	"github.com/mahanth/simplebank/util"
 	"github.com/mahanth/simplebank/val"
-	"github.com/mahanth/simplebank/worker"
 	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -34,23 +31,11 @@ func (server *Server) CreateUser(ctx context.Context, req *pb.CreateUserRequest)
 			FullName:       req.GetFullName(),
 			Email:          req.GetEmail(),
 		},
-		AfterCreate: func(user db.User) error {
-
-			taskPayload := &worker.PayloadSendVerifyEmail{
-				Username: user.Username,
-			}
-
-			opts := []asynq.Option{
-				asynq.MaxRetry(10),
-				asynq.ProcessIn(10 * time.Second),
-				asynq.Queue(worker.QueueCritical),
-			}
-
-			return server.taskDistributor.DistributeTaskSendVerifyEmail(ctx, taskPayload, opts...)
-		},
 	}
 
-	CreateUserTxResult, err := server.store.CreateUserTx(ctx, arg)
+	// the new user is the actor, as signing up needs no login
+	auditCtx := server.auditContext(ctx, req.GetUsername(), util.DepositorRole)
+	CreateUserTxResult, err := server.store.CreateUserTx(auditCtx, arg)
 	if err != nil {
 		return nil, status.Errorf(codes.Unknown, "failed to create user %s", err)
 	}
@@ -0,0 +1,68 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+
+	db "github.com/mahanth/simplebank/db/sqlc"
+	"github.com/mahanth/simplebank/pb"
+	"github.com/mahanth/simplebank/util"
+	"github.com/mahanth/simplebank/val"
+	"github.com/mahanth/simplebank/webhook"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) CreateWebhookSubscription(ctx context.Context, req *pb.CreateWebhookSubscriptionRequest) (*pb.CreateWebhookSubscriptionResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, []string{util.DepositorRole, util.BankerRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateCreateWebhookSubscriptionRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	secret, err := webhook.NewSecret()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%s", err)
+	}
+
+	subscription, err := server.store.CreateWebhookSubscription(server.auditContext(ctx, authPayload.Username, authPayload.Role), db.CreateWebhookSubscriptionParams{
+		Owner:      authPayload.Username,
+		Url:        req.GetUrl(),
+		EventTypes: req.GetEventTypes(),
+		Secret:     secret,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create webhook subscription: %s", err)
+	}
+
+	response := &pb.CreateWebhookSubscriptionResponse{
+		Subscription: convertWebhookSubscription(subscription),
+		Secret:       subscription.Secret,
+	}
+	return response, nil
+}
+
+func validateCreateWebhookSubscriptionRequest(req *pb.CreateWebhookSubscriptionRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateWebhookURL(req.GetUrl()); err != nil {
+		violations = append(violations, fieldViolation("url", err))
+	}
+
+	if len(req.GetEventTypes()) == 0 {
+		violations = append(violations, fieldViolation("event_types", fmt.Errorf("must contain at least one event type")))
+	}
+	seen := make(map[string]bool)
+	for _, eventType := range req.GetEventTypes() {
+		if !db.IsSupportedWebhookEvent(eventType) {
+			violations = append(violations, fieldViolation("event_types", fmt.Errorf("unsupported event type %q", eventType)))
+		} else if seen[eventType] {
+			violations = append(violations, fieldViolation("event_types", fmt.Errorf("duplicate event type %q", eventType)))
+		}
+		seen[eventType] = true
+	}
+	return violations
+}
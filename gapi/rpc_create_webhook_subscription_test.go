@@ -0,0 +1,170 @@
+package gapi
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	mockdb "github.com/mahanth/simplebank/db/mock"
+	db "github.com/mahanth/simplebank/db/sqlc"
+	"github.com/mahanth/simplebank/pb"
+	"github.com/mahanth/simplebank/token"
+	"github.com/mahanth/simplebank/util"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+)
+
+func randomWebhookSubscription(owner string) db.WebhookSubscription {
+	return db.WebhookSubscription{
+		ID:         util.RandomInt(1, 1000),
+		Owner:      owner,
+		Url:        "https://example.com/hooks/" + util.RandomString(6),
+		EventTypes: []string{db.WebhookTransferReceived},
+		Secret:     "whsec_" + util.RandomString(48),
+		Active:     true,
+	}
+}
+
+func TestCreateWebhookSubscriptionAPI(t *testing.T) {
+	user, _ := randomUser()
+	user.Username = strings.ToLower(user.Username)
+	subscription := randomWebhookSubscription(user.Username)
+
+	testCases := []struct {
+		name          string
+		req           *pb.CreateWebhookSubscriptionRequest
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, resp *pb.CreateWebhookSubscriptionResponse, err error)
+	}{
+		{
+			name: "OK",
+			req: &pb.CreateWebhookSubscriptionRequest{
+				Url:        subscription.Url,
+				EventTypes: []string{db.WebhookTransferSent, db.WebhookTransferReceived},
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					CreateWebhookSubscription(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(ctx context.Context, arg db.CreateWebhookSubscriptionParams) (db.WebhookSubscription, error) {
+						require.Equal(t, user.Username, arg.Owner)
+						require.Equal(t, subscription.Url, arg.Url)
+						require.Equal(t, []string{db.WebhookTransferSent, db.WebhookTransferReceived}, arg.EventTypes)
+						require.True(t, strings.HasPrefix(arg.Secret, "whsec_"))
+
+						created := subscription
+						created.EventTypes = arg.EventTypes
+						created.Secret = arg.Secret
+						return created, nil
+					})
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, util.DepositorRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, resp *pb.CreateWebhookSubscriptionResponse, err error) {
+				require.NoError(t, err)
+				require.True(t, strings.HasPrefix(resp.GetSecret(), "whsec_"))
+				require.Equal(t, subscription.ID, resp.GetSubscription().GetId())
+				require.Len(t, resp.GetSubscription().GetEventTypes(), 2)
+			},
+		},
+		{
+			name: "InsecureURL",
+			req: &pb.CreateWebhookSubscriptionRequest{
+				Url:        "http://example.com/hooks",
+				EventTypes: []string{db.WebhookTransferSent},
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateWebhookSubscription(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, util.DepositorRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, resp *pb.CreateWebhookSubscriptionResponse, err error) {
+				requireStatusCode(t, codes.InvalidArgument, err)
+			},
+		},
+		{
+			name: "UnsupportedEventType",
+			req: &pb.CreateWebhookSubscriptionRequest{
+				Url:        subscription.Url,
+				EventTypes: []string{db.EventUserCreated},
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateWebhookSubscription(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, util.DepositorRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, resp *pb.CreateWebhookSubscriptionResponse, err error) {
+				requireStatusCode(t, codes.InvalidArgument, err)
+			},
+		},
+		{
+			name: "DuplicateEventType",
+			req: &pb.CreateWebhookSubscriptionRequest{
+				Url:        subscription.Url,
+				EventTypes: []string{db.WebhookTransferSent, db.WebhookTransferSent},
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateWebhookSubscription(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, util.DepositorRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, resp *pb.CreateWebhookSubscriptionResponse, err error) {
+				requireStatusCode(t, codes.InvalidArgument, err)
+			},
+		},
+		{
+			name: "NoEventTypes",
+			req: &pb.CreateWebhookSubscriptionRequest{
+				Url: subscription.Url,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateWebhookSubscription(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, util.DepositorRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, resp *pb.CreateWebhookSubscriptionResponse, err error) {
+				requireStatusCode(t, codes.InvalidArgument, err)
+			},
+		},
+		{
+			name: "Unauthenticated",
+			req: &pb.CreateWebhookSubscriptionRequest{
+				Url:        subscription.Url,
+				EventTypes: []string{db.WebhookTransferSent},
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateWebhookSubscription(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return context.Background()
+			},
+			checkResponse: func(t *testing.T, resp *pb.CreateWebhookSubscriptionResponse, err error) {
+				requireStatusCode(t, codes.Unauthenticated, err)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil)
+			ctx := tc.buildContext(t, server.tokenMaker)
+			res, err := server.CreateWebhookSubscription(ctx, tc.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
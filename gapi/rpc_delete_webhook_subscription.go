@@ -0,0 +1,52 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+
+	db "github.com/mahanth/simplebank/db/sqlc"
+	"github.com/mahanth/simplebank/pb"
+	"github.com/mahanth/simplebank/util"
+	"github.com/mahanth/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) DeleteWebhookSubscription(ctx context.Context, req *pb.DeleteWebhookSubscriptionRequest) (*pb.DeleteWebhookSubscriptionResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, []string{util.DepositorRole, util.BankerRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateDeleteWebhookSubscriptionRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	subscription, err := server.getWebhookSubscription(ctx, authPayload, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	// the subscription is deactivated rather than deleted so that its delivery log stays queryable
+	subscription, err = server.store.DeactivateWebhookSubscription(server.auditContext(ctx, authPayload.Username, authPayload.Role), subscription.ID)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.FailedPrecondition, "webhook subscription %d is already deleted", req.GetId())
+		}
+		return nil, status.Errorf(codes.Internal, "failed to delete webhook subscription: %s", err)
+	}
+
+	response := &pb.DeleteWebhookSubscriptionResponse{
+		Subscription: convertWebhookSubscription(subscription),
+	}
+	return response, nil
+}
+
+func validateDeleteWebhookSubscriptionRequest(req *pb.DeleteWebhookSubscriptionRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateID(req.GetId()); err != nil {
+		violations = append(violations, fieldViolation("id", err))
+	}
+	return violations
+}
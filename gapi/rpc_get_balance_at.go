@@ -0,0 +1,69 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	db "github.com/mahanth/simplebank/db/sqlc"
+	"github.com/mahanth/simplebank/pb"
+	"github.com/mahanth/simplebank/util"
+	"github.com/mahanth/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) GetBalanceAt(ctx context.Context, req *pb.GetBalanceAtRequest) (*pb.GetBalanceAtResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, []string{util.DepositorRole, util.BankerRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateGetBalanceAtRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	account, err := server.getAccount(ctx, req.GetAccountId())
+	if err != nil {
+		return nil, err
+	}
+
+	if err = server.authorizeAccountView(ctx, authPayload, account); err != nil {
+		return nil, err
+	}
+
+	at := req.GetAt().AsTime()
+	if at.Before(account.CreatedAt.Time) {
+		return nil, status.Errorf(codes.FailedPrecondition, "account %d was opened after %s", account.ID, at)
+	}
+
+	balance, err := server.store.GetBalanceAt(ctx, db.GetBalanceAtParams{
+		AccountID: account.ID,
+		At:        pgtype.Timestamptz{Time: at, Valid: true},
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get balance: %s", err)
+	}
+
+	response := &pb.GetBalanceAtResponse{
+		AccountId: account.ID,
+		Currency:  account.Currency,
+		Balance:   balance,
+		At:        req.GetAt(),
+	}
+	return response, nil
+}
+
+func validateGetBalanceAtRequest(req *pb.GetBalanceAtRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateID(req.GetAccountId()); err != nil {
+		violations = append(violations, fieldViolation("account_id", err))
+	}
+	if req.At == nil {
+		violations = append(violations, fieldViolation("at", errors.New("must be set")))
+	} else if err := req.GetAt().CheckValid(); err != nil {
+		violations = append(violations, fieldViolation("at", err))
+	}
+	return violations
+}
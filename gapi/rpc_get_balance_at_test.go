@@ -0,0 +1,114 @@
+package gapi
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/jackc/pgx/v5/pgtype"
+	mockdb "github.com/mahanth/simplebank/db/mock"
+	db "github.com/mahanth/simplebank/db/sqlc"
+	"github.com/mahanth/simplebank/pb"
+	"github.com/mahanth/simplebank/token"
+	"github.com/mahanth/simplebank/util"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestGetBalanceAtAPI(t *testing.T) {
+	user, _ := randomUser()
+	account := randomAccount(user.Username)
+	account.CreatedAt = pgtype.Timestamptz{Time: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), Valid: true}
+
+	at := time.Date(2026, 3, 31, 23, 59, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name          string
+		request       *pb.GetBalanceAtRequest
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, resp *pb.GetBalanceAtResponse, err error)
+	}{
+		{
+			name:    "OK",
+			request: &pb.GetBalanceAtRequest{AccountId: account.ID, At: timestamppb.New(at)},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().GetBalanceAt(gomock.Any(), gomock.Eq(db.GetBalanceAtParams{
+					AccountID: account.ID,
+					At:        pgtype.Timestamptz{Time: at, Valid: true},
+				})).Times(1).Return(int64(1234), nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, resp *pb.GetBalanceAtResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, account.ID, resp.GetAccountId())
+				require.Equal(t, account.Currency, resp.GetCurrency())
+				require.Equal(t, int64(1234), resp.GetBalance())
+				require.True(t, at.Equal(resp.GetAt().AsTime()))
+			},
+		},
+		{
+			name:    "OtherUsersAccount",
+			request: &pb.GetBalanceAtRequest{AccountId: account.ID, At: timestamppb.New(at)},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().GetAccountHolder(gomock.Any(), gomock.Any()).Times(1).Return(db.AccountHolder{}, db.ErrRecordNotFound)
+				store.EXPECT().GetBalanceAt(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, "other_user", util.DepositorRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, resp *pb.GetBalanceAtResponse, err error) {
+				requireStatusCode(t, codes.PermissionDenied, err)
+			},
+		},
+		{
+			name:    "BeforeAccountOpened",
+			request: &pb.GetBalanceAtRequest{AccountId: account.ID, At: timestamppb.New(account.CreatedAt.Time.Add(-time.Hour))},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().GetBalanceAt(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, "banker", util.BankerRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, resp *pb.GetBalanceAtResponse, err error) {
+				requireStatusCode(t, codes.FailedPrecondition, err)
+			},
+		},
+		{
+			name:    "MissingTime",
+			request: &pb.GetBalanceAtRequest{AccountId: account.ID},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, resp *pb.GetBalanceAtResponse, err error) {
+				requireStatusCode(t, codes.InvalidArgument, err)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil)
+			ctx := tc.buildContext(t, server.tokenMaker)
+			res, err := server.GetBalanceAt(ctx, tc.request)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
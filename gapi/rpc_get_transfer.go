@@ -0,0 +1,67 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+
+	db "github.com/mahanth/simplebank/db/sqlc"
+	"github.com/mahanth/simplebank/pb"
+	"github.com/mahanth/simplebank/util"
+	"github.com/mahanth/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) GetTransfer(ctx context.Context, req *pb.GetTransferRequest) (*pb.GetTransferResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, []string{util.DepositorRole, util.BankerRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateGetTransferRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	transfer, err := server.store.GetTransfer(ctx, req.GetId())
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "transfer %d not found", req.GetId())
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get transfer %s", err)
+	}
+
+	// the transfer is visible to whoever can view either side of it
+	fromAccount, err := server.getAccount(ctx, transfer.FromAccountID)
+	if err != nil {
+		return nil, err
+	}
+	if err = server.authorizeAccountView(ctx, authPayload, fromAccount); err != nil {
+		if status.Code(err) != codes.PermissionDenied {
+			return nil, err
+		}
+		toAccount, err := server.getAccount(ctx, transfer.ToAccountID)
+		if err != nil {
+			return nil, err
+		}
+		if err = server.authorizeAccountView(ctx, authPayload, toAccount); err != nil {
+			if status.Code(err) != codes.PermissionDenied {
+				return nil, err
+			}
+			return nil, status.Errorf(codes.PermissionDenied, "transfer doesn't involve an account of the authenticated user")
+		}
+	}
+
+	response := &pb.GetTransferResponse{
+		Transfer: convertTransfer(transfer),
+	}
+	return response, nil
+}
+
+func validateGetTransferRequest(req *pb.GetTransferRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateID(req.GetId()); err != nil {
+		violations = append(violations, fieldViolation("id", err))
+	}
+	return violations
+}
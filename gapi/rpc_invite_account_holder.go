@@ -0,0 +1,93 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	db "github.com/mahanth/simplebank/db/sqlc"
+	"github.com/mahanth/simplebank/pb"
+	"github.com/mahanth/simplebank/util"
+	"github.com/mahanth/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) InviteAccountHolder(ctx context.Context, req *pb.InviteAccountHolderRequest) (*pb.InviteAccountHolderResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, []string{util.DepositorRole, util.BankerRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateInviteAccountHolderRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	account, err := server.getAccount(ctx, req.GetAccountId())
+	if err != nil {
+		return nil, err
+	}
+
+	access, err := server.authorizeAccount(ctx, authPayload, account, db.HolderPermissionManage)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GetUsername() == account.Owner {
+		return nil, status.Errorf(codes.AlreadyExists, "%s already owns account %d", req.GetUsername(), account.ID)
+	}
+
+	// a manager cannot grant more than they hold themselves
+	if access.TransferLimit != 0 && req.GetPermission() != db.HolderPermissionView &&
+		(req.TransferLimit == nil || req.GetTransferLimit() > access.TransferLimit) {
+		return nil, status.Errorf(codes.PermissionDenied, "transfer_limit must not exceed %d, the limit of the authenticated user", access.TransferLimit)
+	}
+
+	arg := db.CreateAccountHolderParams{
+		AccountID:  account.ID,
+		Username:   req.GetUsername(),
+		Permission: req.GetPermission(),
+		InvitedBy:  authPayload.Username,
+	}
+	if req.TransferLimit != nil {
+		arg.TransferLimit = pgtype.Int8{Int64: req.GetTransferLimit(), Valid: true}
+	}
+
+	holder, err := server.store.CreateAccountHolder(server.auditContext(ctx, authPayload.Username, authPayload.Role), arg)
+	if err != nil {
+		switch db.ErrorCode(err) {
+		case db.UniqueViolation:
+			return nil, status.Errorf(codes.AlreadyExists, "%s is already a holder of account %d", req.GetUsername(), account.ID)
+		case db.ForeignKeyViolation:
+			return nil, status.Errorf(codes.NotFound, "user %s not found", req.GetUsername())
+		}
+		return nil, status.Errorf(codes.Internal, "failed to create account holder: %s", err)
+	}
+
+	response := &pb.InviteAccountHolderResponse{
+		Holder: convertAccountHolder(holder),
+	}
+	return response, nil
+}
+
+func validateInviteAccountHolderRequest(req *pb.InviteAccountHolderRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateID(req.GetAccountId()); err != nil {
+		violations = append(violations, fieldViolation("account_id", err))
+	}
+	if err := val.ValidateUsername(req.GetUsername()); err != nil {
+		violations = append(violations, fieldViolation("username", err))
+	}
+	if !db.IsSupportedHolderPermission(req.GetPermission()) {
+		violations = append(violations, fieldViolation("permission", fmt.Errorf("unsupported permission %q", req.GetPermission())))
+	}
+	if req.TransferLimit != nil {
+		if req.GetPermission() == db.HolderPermissionView {
+			violations = append(violations, fieldViolation("transfer_limit", fmt.Errorf("must not be set for the view permission")))
+		} else if err := val.ValidateAmount(req.GetTransferLimit()); err != nil {
+			violations = append(violations, fieldViolation("transfer_limit", err))
+		}
+	}
+	return violations
+}
@@ -0,0 +1,254 @@
+package gapi
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	mockdb "github.com/mahanth/simplebank/db/mock"
+	db "github.com/mahanth/simplebank/db/sqlc"
+	"github.com/mahanth/simplebank/pb"
+	"github.com/mahanth/simplebank/token"
+	"github.com/mahanth/simplebank/util"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+)
+
+func TestInviteAccountHolderAPI(t *testing.T) {
+	account := randomAccount("owner")
+	transferLimit := int64(5000)
+
+	manager := db.AccountHolder{
+		AccountID:     account.ID,
+		Username:      "manager",
+		Permission:    db.HolderPermissionManage,
+		TransferLimit: pgtype.Int8{Int64: transferLimit, Valid: true},
+		Status:        db.HolderStatusActive,
+	}
+
+	testCases := []struct {
+		name          string
+		request       *pb.InviteAccountHolderRequest
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, resp *pb.InviteAccountHolderResponse, err error)
+	}{
+		{
+			name: "OK",
+			request: &pb.InviteAccountHolderRequest{
+				AccountId:     account.ID,
+				Username:      "partner",
+				Permission:    db.HolderPermissionTransfer,
+				TransferLimit: &transferLimit,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.CreateAccountHolderParams{
+					AccountID:     account.ID,
+					Username:      "partner",
+					Permission:    db.HolderPermissionTransfer,
+					TransferLimit: pgtype.Int8{Int64: transferLimit, Valid: true},
+					InvitedBy:     account.Owner,
+				}
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().GetAccountHolder(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().CreateAccountHolder(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(db.AccountHolder{
+						ID:            1,
+						AccountID:     arg.AccountID,
+						Username:      arg.Username,
+						Permission:    arg.Permission,
+						TransferLimit: arg.TransferLimit,
+						Status:        db.HolderStatusInvited,
+						InvitedBy:     arg.InvitedBy,
+					}, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, account.Owner, util.DepositorRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, resp *pb.InviteAccountHolderResponse, err error) {
+				require.NoError(t, err)
+				holder := resp.GetHolder()
+				require.Equal(t, "partner", holder.GetUsername())
+				require.Equal(t, db.HolderPermissionTransfer, holder.GetPermission())
+				require.Equal(t, transferLimit, holder.GetTransferLimit())
+				require.Equal(t, db.HolderStatusInvited, holder.GetStatus())
+				require.Nil(t, holder.AcceptedAt)
+			},
+		},
+		{
+			name: "ManagerWithinLimit",
+			request: &pb.InviteAccountHolderRequest{
+				AccountId:     account.ID,
+				Username:      "partner",
+				Permission:    db.HolderPermissionManage,
+				TransferLimit: &transferLimit,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().GetAccountHolder(gomock.Any(), gomock.Eq(db.GetAccountHolderParams{AccountID: account.ID, Username: manager.Username})).
+					Times(1).
+					Return(manager, nil)
+				store.EXPECT().CreateAccountHolder(gomock.Any(), gomock.Any()).Times(1).Return(db.AccountHolder{}, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, manager.Username, util.DepositorRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, resp *pb.InviteAccountHolderResponse, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "ManagerGrantsNoLimit",
+			request: &pb.InviteAccountHolderRequest{
+				AccountId:  account.ID,
+				Username:   "partner",
+				Permission: db.HolderPermissionTransfer,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().GetAccountHolder(gomock.Any(), gomock.Any()).Times(1).Return(manager, nil)
+				store.EXPECT().CreateAccountHolder(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, manager.Username, util.DepositorRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, resp *pb.InviteAccountHolderResponse, err error) {
+				requireStatusCode(t, codes.PermissionDenied, err)
+			},
+		},
+		{
+			name: "TransferHolderCannotInvite",
+			request: &pb.InviteAccountHolderRequest{
+				AccountId:  account.ID,
+				Username:   "partner",
+				Permission: db.HolderPermissionView,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				holder := manager
+				holder.Permission = db.HolderPermissionTransfer
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().GetAccountHolder(gomock.Any(), gomock.Any()).Times(1).Return(holder, nil)
+				store.EXPECT().CreateAccountHolder(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, manager.Username, util.DepositorRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, resp *pb.InviteAccountHolderResponse, err error) {
+				requireStatusCode(t, codes.PermissionDenied, err)
+			},
+		},
+		{
+			name: "InviteOwner",
+			request: &pb.InviteAccountHolderRequest{
+				AccountId:  account.ID,
+				Username:   account.Owner,
+				Permission: db.HolderPermissionView,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().CreateAccountHolder(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, account.Owner, util.DepositorRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, resp *pb.InviteAccountHolderResponse, err error) {
+				requireStatusCode(t, codes.AlreadyExists, err)
+			},
+		},
+		{
+			name: "AlreadyHolder",
+			request: &pb.InviteAccountHolderRequest{
+				AccountId:  account.ID,
+				Username:   "partner",
+				Permission: db.HolderPermissionView,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().CreateAccountHolder(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.AccountHolder{}, &pgconn.PgError{Code: db.UniqueViolation})
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, account.Owner, util.DepositorRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, resp *pb.InviteAccountHolderResponse, err error) {
+				requireStatusCode(t, codes.AlreadyExists, err)
+			},
+		},
+		{
+			name: "UserNotFound",
+			request: &pb.InviteAccountHolderRequest{
+				AccountId:  account.ID,
+				Username:   "nobody",
+				Permission: db.HolderPermissionView,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().CreateAccountHolder(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.AccountHolder{}, &pgconn.PgError{Code: db.ForeignKeyViolation})
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, account.Owner, util.DepositorRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, resp *pb.InviteAccountHolderResponse, err error) {
+				requireStatusCode(t, codes.NotFound, err)
+			},
+		},
+		{
+			name: "LimitOnViewPermission",
+			request: &pb.InviteAccountHolderRequest{
+				AccountId:     account.ID,
+				Username:      "partner",
+				Permission:    db.HolderPermissionView,
+				TransferLimit: &transferLimit,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, account.Owner, util.DepositorRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, resp *pb.InviteAccountHolderResponse, err error) {
+				requireStatusCode(t, codes.InvalidArgument, err)
+			},
+		},
+		{
+			name: "InvalidPermission",
+			request: &pb.InviteAccountHolderRequest{
+				AccountId:  account.ID,
+				Username:   "partner",
+				Permission: "owner",
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, account.Owner, util.DepositorRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, resp *pb.InviteAccountHolderResponse, err error) {
+				requireStatusCode(t, codes.InvalidArgument, err)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil)
+			ctx := tc.buildContext(t, server.tokenMaker)
+			res, err := server.InviteAccountHolder(ctx, tc.request)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package gapi
+
+import (
+	"context"
+
+	db "github.com/mahanth/simplebank/db/sqlc"
+	"github.com/mahanth/simplebank/pb"
+	"github.com/mahanth/simplebank/util"
+	"github.com/mahanth/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) ListAccountStatusChanges(ctx context.Context, req *pb.ListAccountStatusChangesRequest) (*pb.ListAccountStatusChangesResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, []string{util.DepositorRole, util.BankerRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateListAccountStatusChangesRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	account, err := server.getAccount(ctx, req.GetAccountId())
+	if err != nil {
+		return nil, err
+	}
+	if err = server.authorizeAccountView(ctx, authPayload, account); err != nil {
+		return nil, err
+	}
+
+	changes, err := server.store.ListAccountStatusChanges(ctx, db.ListAccountStatusChangesParams{
+		AccountID: account.ID,
+		Limit:     req.GetPageSize(),
+		Offset:    (req.GetPageId() - 1) * req.GetPageSize(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list account status changes %s", err)
+	}
+
+	response := &pb.ListAccountStatusChangesResponse{}
+	for _, change := range changes {
+		response.Changes = append(response.Changes, convertAccountStatusChange(change))
+	}
+	return response, nil
+}
+
+func validateListAccountStatusChangesRequest(req *pb.ListAccountStatusChangesRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateID(req.GetAccountId()); err != nil {
+		violations = append(violations, fieldViolation("account_id", err))
+	}
+	if err := val.ValidatePageID(req.GetPageId()); err != nil {
+		violations = append(violations, fieldViolation("page_id", err))
+	}
+	if err := val.ValidatePageSize(req.GetPageSize()); err != nil {
+		violations = append(violations, fieldViolation("page_size", err))
+	}
+	return violations
+}
@@ -0,0 +1,28 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/mahanth/simplebank/pb"
+	"github.com/mahanth/simplebank/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) ListCurrencies(ctx context.Context, req *pb.ListCurrenciesRequest) (*pb.ListCurrenciesResponse, error) {
+	_, err := server.authorizeUser(ctx, []string{util.DepositorRole, util.BankerRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	currencies, err := server.store.ListCurrencies(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list currencies: %s", err)
+	}
+
+	response := &pb.ListCurrenciesResponse{}
+	for _, currency := range currencies {
+		response.Currencies = append(response.Currencies, convertCurrency(currency))
+	}
+	return response, nil
+}
@@ -0,0 +1,61 @@
+package gapi
+
+import (
+	"context"
+
+	db "github.com/mahanth/simplebank/db/sqlc"
+	"github.com/mahanth/simplebank/pb"
+	"github.com/mahanth/simplebank/util"
+	"github.com/mahanth/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) ListEntries(ctx context.Context, req *pb.ListEntriesRequest) (*pb.ListEntriesResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, []string{util.DepositorRole, util.BankerRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateListEntriesRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	account, err := server.getAccount(ctx, req.GetAccountId())
+	if err != nil {
+		return nil, err
+	}
+
+	if err = server.authorizeAccountView(ctx, authPayload, account); err != nil {
+		return nil, err
+	}
+
+	entries, err := server.store.ListEntries(ctx,
+		db.ListEntriesParams(historyParams(account.ID, req.GetPageSize(), req.GetPageToken(), req.GetFilter())))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list entries %s", err)
+	}
+
+	response := &pb.ListEntriesResponse{}
+	entries, response.NextPageToken = util.NextPage(entries, req.GetPageSize(), func(entry db.Entry) int64 {
+		return entry.ID
+	})
+	for _, entry := range entries {
+		pbEntry := convertEntry(entry)
+		if req.GetWithBalance() {
+			pbEntry.BalanceAfter = &entry.BalanceAfter
+		}
+		response.Entries = append(response.Entries, pbEntry)
+	}
+	return response, nil
+}
+
+func validateListEntriesRequest(req *pb.ListEntriesRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateID(req.GetAccountId()); err != nil {
+		violations = append(violations, fieldViolation("account_id", err))
+	}
+	violations = append(violations, validateHistoryPage(req.GetPageSize(), req.GetPageToken(), req.GetFilter())...)
+	return violations
+}
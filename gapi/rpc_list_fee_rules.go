@@ -0,0 +1,49 @@
+package gapi
+
+import (
+	"context"
+
+	db "github.com/mahanth/simplebank/db/sqlc"
+	"github.com/mahanth/simplebank/pb"
+	"github.com/mahanth/simplebank/util"
+	"github.com/mahanth/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) ListFeeRules(ctx context.Context, req *pb.ListFeeRulesRequest) (*pb.ListFeeRulesResponse, error) {
+	_, err := server.authorizeUser(ctx, []string{util.BankerRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateListFeeRulesRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	rules, err := server.store.ListFeeRules(ctx, db.ListFeeRulesParams{
+		Limit:  req.GetPageSize(),
+		Offset: (req.GetPageId() - 1) * req.GetPageSize(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list fee rules %s", err)
+	}
+
+	response := &pb.ListFeeRulesResponse{}
+	for _, rule := range rules {
+		response.FeeRules = append(response.FeeRules, convertFeeRule(rule))
+	}
+	return response, nil
+}
+
+func validateListFeeRulesRequest(req *pb.ListFeeRulesRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidatePageID(req.GetPageId()); err != nil {
+		violations = append(violations, fieldViolation("page_id", err))
+	}
+	if err := val.ValidatePageSize(req.GetPageSize()); err != nil {
+		violations = append(violations, fieldViolation("page_size", err))
+	}
+	return violations
+}
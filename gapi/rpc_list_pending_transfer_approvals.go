@@ -0,0 +1,49 @@
+package gapi
+
+import (
+	"context"
+
+	db "github.com/mahanth/simplebank/db/sqlc"
+	"github.com/mahanth/simplebank/pb"
+	"github.com/mahanth/simplebank/util"
+	"github.com/mahanth/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) ListPendingTransferApprovals(ctx context.Context, req *pb.ListPendingTransferApprovalsRequest) (*pb.ListPendingTransferApprovalsResponse, error) {
+	_, err := server.authorizeUser(ctx, []string{util.BankerRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateListPendingTransferApprovalsRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	approvals, err := server.store.ListPendingTransferApprovals(ctx, db.ListPendingTransferApprovalsParams{
+		Limit:  req.GetPageSize(),
+		Offset: (req.GetPageId() - 1) * req.GetPageSize(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list pending transfer approvals %s", err)
+	}
+
+	response := &pb.ListPendingTransferApprovalsResponse{}
+	for _, approval := range approvals {
+		response.Approvals = append(response.Approvals, convertTransferApproval(approval))
+	}
+	return response, nil
+}
+
+func validateListPendingTransferApprovalsRequest(req *pb.ListPendingTransferApprovalsRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidatePageID(req.GetPageId()); err != nil {
+		violations = append(violations, fieldViolation("page_id", err))
+	}
+	if err := val.ValidatePageSize(req.GetPageSize()); err != nil {
+		violations = append(violations, fieldViolation("page_size", err))
+	}
+	return violations
+}
@@ -0,0 +1,58 @@
+package gapi
+
+import (
+	"context"
+
+	db "github.com/mahanth/simplebank/db/sqlc"
+	"github.com/mahanth/simplebank/pb"
+	"github.com/mahanth/simplebank/util"
+	"github.com/mahanth/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) ListScheduledTransferExecutions(ctx context.Context, req *pb.ListScheduledTransferExecutionsRequest) (*pb.ListScheduledTransferExecutionsResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, []string{util.DepositorRole, util.BankerRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateListScheduledTransferExecutionsRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	scheduled, err := server.getScheduledTransfer(ctx, authPayload, req.GetScheduledTransferId())
+	if err != nil {
+		return nil, err
+	}
+
+	executions, err := server.store.ListScheduledTransferExecutions(ctx, db.ListScheduledTransferExecutionsParams{
+		ScheduledTransferID: scheduled.ID,
+		Limit:               req.GetPageSize(),
+		Offset:              (req.GetPageId() - 1) * req.GetPageSize(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list scheduled transfer executions %s", err)
+	}
+
+	response := &pb.ListScheduledTransferExecutionsResponse{}
+	for _, execution := range executions {
+		response.Executions = append(response.Executions, convertScheduledTransferExecution(execution))
+	}
+	return response, nil
+}
+
+func validateListScheduledTransferExecutionsRequest(req *pb.ListScheduledTransferExecutionsRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateID(req.GetScheduledTransferId()); err != nil {
+		violations = append(violations, fieldViolation("scheduled_transfer_id", err))
+	}
+	if err := val.ValidatePageID(req.GetPageId()); err != nil {
+		violations = append(violations, fieldViolation("page_id", err))
+	}
+	if err := val.ValidatePageSize(req.GetPageSize()); err != nil {
+		violations = append(violations, fieldViolation("page_size", err))
+	}
+	return violations
+}
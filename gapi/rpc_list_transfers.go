@@ -0,0 +1,57 @@
+package gapi
+
+import (
+	"context"
+
+	db "github.com/mahanth/simplebank/db/sqlc"
+	"github.com/mahanth/simplebank/pb"
+	"github.com/mahanth/simplebank/util"
+	"github.com/mahanth/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) ListTransfers(ctx context.Context, req *pb.ListTransfersRequest) (*pb.ListTransfersResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, []string{util.DepositorRole, util.BankerRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateListTransfersRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	account, err := server.getAccount(ctx, req.GetAccountId())
+	if err != nil {
+		return nil, err
+	}
+
+	if err = server.authorizeAccountView(ctx, authPayload, account); err != nil {
+		return nil, err
+	}
+
+	transfers, err := server.store.ListTransfers(ctx,
+		historyParams(account.ID, req.GetPageSize(), req.GetPageToken(), req.GetFilter()))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list transfers %s", err)
+	}
+
+	response := &pb.ListTransfersResponse{}
+	transfers, response.NextPageToken = util.NextPage(transfers, req.GetPageSize(), func(transfer db.Transfer) int64 {
+		return transfer.ID
+	})
+	for _, transfer := range transfers {
+		response.Transfers = append(response.Transfers, convertTransfer(transfer))
+	}
+	return response, nil
+}
+
+func validateListTransfersRequest(req *pb.ListTransfersRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateID(req.GetAccountId()); err != nil {
+		violations = append(violations, fieldViolation("account_id", err))
+	}
+	violations = append(violations, validateHistoryPage(req.GetPageSize(), req.GetPageToken(), req.GetFilter())...)
+	return violations
+}
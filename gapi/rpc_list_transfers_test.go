@@ -0,0 +1,168 @@
+package gapi
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/jackc/pgx/v5/pgtype"
+	mockdb "github.com/mahanth/simplebank/db/mock"
+	db "github.com/mahanth/simplebank/db/sqlc"
+	"github.com/mahanth/simplebank/pb"
+	"github.com/mahanth/simplebank/token"
+	"github.com/mahanth/simplebank/util"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestListTransfersAPI(t *testing.T) {
+	user, _ := randomUser()
+	account := randomAccount(user.Username)
+
+	transfers := make([]db.Transfer, 6)
+	for i := range transfers {
+		transfers[i] = db.Transfer{ID: int64(100 - i), FromAccountID: account.ID, ToAccountID: account.ID + 1, Amount: 10}
+	}
+
+	counterparty := account.ID + 1
+	startTime := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	endTime := startTime.AddDate(0, 1, 0)
+
+	testCases := []struct {
+		name          string
+		request       *pb.ListTransfersRequest
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, resp *pb.ListTransfersResponse, err error)
+	}{
+		{
+			name: "FilteredPage",
+			request: &pb.ListTransfersRequest{
+				AccountId: account.ID,
+				PageSize:  5,
+				PageToken: util.EncodePageToken(101),
+				Filter: &pb.HistoryFilter{
+					StartTime:             timestamppb.New(startTime),
+					EndTime:               timestamppb.New(endTime),
+					Direction:             db.DirectionOut,
+					CounterpartyAccountId: &counterparty,
+				},
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				arg := db.ListTransfersParams{
+					AccountID:             account.ID,
+					BeforeID:              pgtype.Int8{Int64: 101, Valid: true},
+					StartTime:             pgtype.Timestamptz{Time: startTime, Valid: true},
+					EndTime:               pgtype.Timestamptz{Time: endTime, Valid: true},
+					Direction:             pgtype.Text{String: db.DirectionOut, Valid: true},
+					CounterpartyAccountID: pgtype.Int8{Int64: counterparty, Valid: true},
+					PageSize:              6,
+				}
+				store.EXPECT().ListTransfers(gomock.Any(), gomock.Eq(arg)).Times(1).Return(transfers, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, resp *pb.ListTransfersResponse, err error) {
+				require.NoError(t, err)
+				require.Len(t, resp.GetTransfers(), 5)
+
+				lastID, err := util.DecodePageToken(resp.GetNextPageToken())
+				require.NoError(t, err)
+				require.Equal(t, transfers[4].ID, lastID)
+			},
+		},
+		{
+			name:    "LastPage",
+			request: &pb.ListTransfersRequest{AccountId: account.ID, PageSize: 5},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().ListTransfers(gomock.Any(), gomock.Any()).Times(1).Return(transfers[:2], nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, resp *pb.ListTransfersResponse, err error) {
+				require.NoError(t, err)
+				require.Len(t, resp.GetTransfers(), 2)
+				require.Empty(t, resp.GetNextPageToken())
+			},
+		},
+		{
+			name: "InvalidFilter",
+			request: &pb.ListTransfersRequest{
+				AccountId: account.ID,
+				PageSize:  5,
+				PageToken: "bogus",
+				Filter: &pb.HistoryFilter{
+					StartTime: timestamppb.New(endTime),
+					EndTime:   timestamppb.New(startTime),
+					Direction: "sideways",
+				},
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().ListTransfers(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, resp *pb.ListTransfersResponse, err error) {
+				requireStatusCode(t, codes.InvalidArgument, err)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil)
+			ctx := tc.buildContext(t, server.tokenMaker)
+			res, err := server.ListTransfers(ctx, tc.request)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
+
+func TestListEntriesWithBalanceAPI(t *testing.T) {
+	user, _ := randomUser()
+	account := randomAccount(user.Username)
+
+	entries := []db.Entry{
+		{ID: 11, AccountID: account.ID, Amount: -20, BalanceAfter: 10},
+		{ID: 10, AccountID: account.ID, Amount: 30, BalanceAfter: 30},
+	}
+
+	storeCtrl := gomock.NewController(t)
+	defer storeCtrl.Finish()
+	store := mockdb.NewMockStore(storeCtrl)
+
+	store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(2).Return(account, nil)
+	store.EXPECT().ListEntries(gomock.Any(), gomock.Eq(db.ListEntriesParams{
+		AccountID: account.ID,
+		PageSize:  6,
+	})).Times(2).Return(entries, nil)
+
+	server := newTestServer(t, store, nil)
+	ctx := newContextWithBearerToken(t, server.tokenMaker, user.Username, user.Role, time.Minute)
+
+	res, err := server.ListEntries(ctx, &pb.ListEntriesRequest{AccountId: account.ID, PageSize: 5})
+	require.NoError(t, err)
+	require.Len(t, res.GetEntries(), 2)
+	require.Nil(t, res.GetEntries()[0].BalanceAfter)
+
+	res, err = server.ListEntries(ctx, &pb.ListEntriesRequest{AccountId: account.ID, PageSize: 5, WithBalance: true})
+	require.NoError(t, err)
+	require.Equal(t, int64(10), res.GetEntries()[0].GetBalanceAfter())
+	require.Equal(t, int64(30), res.GetEntries()[1].GetBalanceAfter())
+	require.Empty(t, res.GetNextPageToken())
+}
@@ -0,0 +1,146 @@
+package gapi
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/jackc/pgx/v5/pgtype"
+	mockdb "github.com/mahanth/simplebank/db/mock"
+	db "github.com/mahanth/simplebank/db/sqlc"
+	"github.com/mahanth/simplebank/pb"
+	"github.com/mahanth/simplebank/token"
+	"github.com/mahanth/simplebank/util"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+)
+
+func TestListWebhookDeliveriesAPI(t *testing.T) {
+	user1, _ := randomUser()
+	user2, _ := randomUser()
+	subscription := randomWebhookSubscription(user1.Username)
+
+	deliveries := []db.WebhookDelivery{
+		{
+			ID:             2,
+			SubscriptionID: subscription.ID,
+			EventID:        util.RandomInt(1, 1000),
+			EventType:      db.WebhookTransferReceived,
+			Payload:        []byte(`{"transfer_id":2}`),
+			Status:         db.WebhookDeliveryPending,
+			Attempts:       3,
+			ResponseStatus: pgtype.Int4{Int32: 503, Valid: true},
+			LastError:      pgtype.Text{String: "webhook receiver responded 503 Service Unavailable", Valid: true},
+		},
+		{
+			ID:             1,
+			SubscriptionID: subscription.ID,
+			EventID:        util.RandomInt(1, 1000),
+			EventType:      db.WebhookTransferReceived,
+			Payload:        []byte(`{"transfer_id":1}`),
+			Status:         db.WebhookDeliverySucceeded,
+			Attempts:       1,
+			ResponseStatus: pgtype.Int4{Int32: 200, Valid: true},
+			DeliveredAt:    pgtype.Timestamptz{Time: time.Now(), Valid: true},
+		},
+	}
+
+	testCases := []struct {
+		name          string
+		req           *pb.ListWebhookDeliveriesRequest
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, resp *pb.ListWebhookDeliveriesResponse, err error)
+	}{
+		{
+			name: "OK",
+			req:  &pb.ListWebhookDeliveriesRequest{SubscriptionId: subscription.ID, PageId: 1, PageSize: 5},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetWebhookSubscription(gomock.Any(), gomock.Eq(subscription.ID)).Times(1).Return(subscription, nil)
+
+				arg := db.ListWebhookDeliveriesParams{
+					SubscriptionID: subscription.ID,
+					Limit:          5,
+					Offset:         0,
+				}
+				store.EXPECT().ListWebhookDeliveries(gomock.Any(), gomock.Eq(arg)).Times(1).Return(deliveries, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user1.Username, util.DepositorRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, resp *pb.ListWebhookDeliveriesResponse, err error) {
+				require.NoError(t, err)
+				require.Len(t, resp.GetDeliveries(), 2)
+
+				pending := resp.GetDeliveries()[0]
+				require.Equal(t, db.WebhookDeliveryPending, pending.GetStatus())
+				require.Equal(t, int32(503), pending.GetResponseStatus())
+				require.NotEmpty(t, pending.GetLastError())
+				require.Nil(t, pending.GetDeliveredAt())
+
+				succeeded := resp.GetDeliveries()[1]
+				require.Equal(t, `{"transfer_id":1}`, succeeded.GetPayload())
+				require.NotNil(t, succeeded.GetDeliveredAt())
+			},
+		},
+		{
+			name: "NotOwner",
+			req:  &pb.ListWebhookDeliveriesRequest{SubscriptionId: subscription.ID, PageId: 1, PageSize: 5},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetWebhookSubscription(gomock.Any(), gomock.Eq(subscription.ID)).Times(1).Return(subscription, nil)
+				store.EXPECT().ListWebhookDeliveries(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user2.Username, util.DepositorRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, resp *pb.ListWebhookDeliveriesResponse, err error) {
+				requireStatusCode(t, codes.PermissionDenied, err)
+			},
+		},
+		{
+			name: "SubscriptionNotFound",
+			req:  &pb.ListWebhookDeliveriesRequest{SubscriptionId: subscription.ID, PageId: 1, PageSize: 5},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetWebhookSubscription(gomock.Any(), gomock.Eq(subscription.ID)).Times(1).Return(db.WebhookSubscription{}, db.ErrRecordNotFound)
+				store.EXPECT().ListWebhookDeliveries(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user1.Username, util.DepositorRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, resp *pb.ListWebhookDeliveriesResponse, err error) {
+				requireStatusCode(t, codes.NotFound, err)
+			},
+		},
+		{
+			name: "InvalidPageSize",
+			req:  &pb.ListWebhookDeliveriesRequest{SubscriptionId: subscription.ID, PageId: 1, PageSize: 1000},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetWebhookSubscription(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().ListWebhookDeliveries(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user1.Username, util.DepositorRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, resp *pb.ListWebhookDeliveriesResponse, err error) {
+				requireStatusCode(t, codes.InvalidArgument, err)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil)
+			ctx := tc.buildContext(t, server.tokenMaker)
+			res, err := server.ListWebhookDeliveries(ctx, tc.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
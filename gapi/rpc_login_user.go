@@ -51,7 +51,7 @@ func (server *Server) LoginUser(ctx context.Context, req *pb.LoginUserRequest) (
 
 	mtdt := server.extractMetadata(ctx)
 
-	session, err := server.store.CreateSession(ctx, db.CreateSessionParams{
+	session, err := server.store.CreateSession(server.auditContext(ctx, user.Username, user.Role), db.CreateSessionParams{
 		ID:           pgtype.UUID{Bytes: refreshTokenPayload.ID, Valid: true},
 		Username:     refreshTokenPayload.Username,
 		RefreshToken: refreshToken,
@@ -0,0 +1,57 @@
+package gapi
+
+import (
+	"context"
+
+	db "github.com/mahanth/simplebank/db/sqlc"
+	"github.com/mahanth/simplebank/pb"
+	"github.com/mahanth/simplebank/util"
+	"github.com/mahanth/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) PauseScheduledTransfer(ctx context.Context, req *pb.PauseScheduledTransferRequest) (*pb.PauseScheduledTransferResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, []string{util.DepositorRole, util.BankerRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validatePauseScheduledTransferRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	scheduled, err := server.getScheduledTransfer(ctx, authPayload, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	if scheduled.Status != db.ScheduledTransferActive {
+		return nil, status.Errorf(codes.FailedPrecondition, "scheduled transfer %d is %s, only an active one can be paused",
+			scheduled.ID, scheduled.Status)
+	}
+
+	// next_run_at is kept so that resuming can tell which runs were missed
+	scheduled, err = server.store.UpdateScheduledTransferStatus(server.auditContext(ctx, authPayload.Username, authPayload.Role), db.UpdateScheduledTransferStatusParams{
+		ID:        scheduled.ID,
+		Status:    db.ScheduledTransferPaused,
+		NextRunAt: scheduled.NextRunAt,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to pause scheduled transfer: %s", err)
+	}
+
+	response := &pb.PauseScheduledTransferResponse{
+		ScheduledTransfer: convertScheduledTransfer(scheduled),
+	}
+	return response, nil
+}
+
+func validatePauseScheduledTransferRequest(req *pb.PauseScheduledTransferRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateID(req.GetId()); err != nil {
+		violations = append(violations, fieldViolation("id", err))
+	}
+	return violations
+}
@@ -0,0 +1,142 @@
+package gapi
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/jackc/pgx/v5/pgtype"
+	mockdb "github.com/mahanth/simplebank/db/mock"
+	db "github.com/mahanth/simplebank/db/sqlc"
+	"github.com/mahanth/simplebank/pb"
+	"github.com/mahanth/simplebank/token"
+	"github.com/mahanth/simplebank/util"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+)
+
+func randomScheduledTransfer(owner string, status string) db.ScheduledTransfer {
+	nextRunAt := time.Now().Add(time.Hour).Truncate(time.Second)
+	return db.ScheduledTransfer{
+		ID:            util.RandomInt(1, 1000),
+		Owner:         owner,
+		FromAccountID: util.RandomInt(1, 1000),
+		ToAccountID:   util.RandomInt(1001, 2000),
+		Amount:        util.RandomInt(1, 1000),
+		Schedule:      "@every 24h",
+		StartAt:       pgtype.Timestamptz{Time: nextRunAt.Add(-24 * time.Hour), Valid: true},
+		NextRunAt:     pgtype.Timestamptz{Time: nextRunAt, Valid: true},
+		Status:        status,
+	}
+}
+
+func TestPauseScheduledTransferAPI(t *testing.T) {
+	user1, _ := randomUser()
+	user2, _ := randomUser()
+	scheduled := randomScheduledTransfer(user1.Username, db.ScheduledTransferActive)
+
+	testCases := []struct {
+		name          string
+		scheduled     db.ScheduledTransfer
+		buildStubs    func(store *mockdb.MockStore, scheduled db.ScheduledTransfer)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, resp *pb.PauseScheduledTransferResponse, err error)
+	}{
+		{
+			name:      "OK",
+			scheduled: scheduled,
+			buildStubs: func(store *mockdb.MockStore, scheduled db.ScheduledTransfer) {
+				store.EXPECT().GetScheduledTransfer(gomock.Any(), gomock.Eq(scheduled.ID)).Times(1).Return(scheduled, nil)
+
+				arg := db.UpdateScheduledTransferStatusParams{
+					ID:        scheduled.ID,
+					Status:    db.ScheduledTransferPaused,
+					NextRunAt: scheduled.NextRunAt,
+				}
+				paused := scheduled
+				paused.Status = db.ScheduledTransferPaused
+				store.EXPECT().UpdateScheduledTransferStatus(gomock.Any(), gomock.Eq(arg)).Times(1).Return(paused, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user1.Username, user1.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, resp *pb.PauseScheduledTransferResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, db.ScheduledTransferPaused, resp.GetScheduledTransfer().GetStatus())
+			},
+		},
+		{
+			name:      "BankerCanPause",
+			scheduled: scheduled,
+			buildStubs: func(store *mockdb.MockStore, scheduled db.ScheduledTransfer) {
+				store.EXPECT().GetScheduledTransfer(gomock.Any(), gomock.Eq(scheduled.ID)).Times(1).Return(scheduled, nil)
+				store.EXPECT().UpdateScheduledTransferStatus(gomock.Any(), gomock.Any()).Times(1).Return(scheduled, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user2.Username, util.BankerRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, resp *pb.PauseScheduledTransferResponse, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name:      "NotOwner",
+			scheduled: scheduled,
+			buildStubs: func(store *mockdb.MockStore, scheduled db.ScheduledTransfer) {
+				store.EXPECT().GetScheduledTransfer(gomock.Any(), gomock.Eq(scheduled.ID)).Times(1).Return(scheduled, nil)
+				store.EXPECT().UpdateScheduledTransferStatus(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user2.Username, util.DepositorRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, resp *pb.PauseScheduledTransferResponse, err error) {
+				requireStatusCode(t, codes.PermissionDenied, err)
+			},
+		},
+		{
+			name:      "NotActive",
+			scheduled: randomScheduledTransfer(user1.Username, db.ScheduledTransferCancelled),
+			buildStubs: func(store *mockdb.MockStore, scheduled db.ScheduledTransfer) {
+				store.EXPECT().GetScheduledTransfer(gomock.Any(), gomock.Eq(scheduled.ID)).Times(1).Return(scheduled, nil)
+				store.EXPECT().UpdateScheduledTransferStatus(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user1.Username, user1.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, resp *pb.PauseScheduledTransferResponse, err error) {
+				requireStatusCode(t, codes.FailedPrecondition, err)
+			},
+		},
+		{
+			name:      "NotFound",
+			scheduled: scheduled,
+			buildStubs: func(store *mockdb.MockStore, scheduled db.ScheduledTransfer) {
+				store.EXPECT().GetScheduledTransfer(gomock.Any(), gomock.Eq(scheduled.ID)).Times(1).Return(db.ScheduledTransfer{}, db.ErrRecordNotFound)
+				store.EXPECT().UpdateScheduledTransferStatus(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user1.Username, user1.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, resp *pb.PauseScheduledTransferResponse, err error) {
+				requireStatusCode(t, codes.NotFound, err)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			tc.buildStubs(store, tc.scheduled)
+
+			server := newTestServer(t, store, nil)
+			ctx := tc.buildContext(t, server.tokenMaker)
+			res, err := server.PauseScheduledTransfer(ctx, &pb.PauseScheduledTransferRequest{Id: tc.scheduled.ID})
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
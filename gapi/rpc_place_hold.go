@@ -0,0 +1,105 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	db "github.com/mahanth/simplebank/db/sqlc"
+	"github.com/mahanth/simplebank/pb"
+	"github.com/mahanth/simplebank/util"
+	"github.com/mahanth/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxHoldDuration is how far ahead a hold may expire.
+const maxHoldDuration = 30 * 24 * time.Hour
+
+func (server *Server) PlaceHold(ctx context.Context, req *pb.PlaceHoldRequest) (*pb.PlaceHoldResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, []string{util.DepositorRole, util.BankerRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validatePlaceHoldRequest(req, time.Now())
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	account, err := server.getAccount(ctx, req.GetAccountId())
+	if err != nil {
+		return nil, err
+	}
+
+	// a hold commits the funds like a transfer does, so only those who may transfer them may place it
+	if _, err = server.authorizeAccount(ctx, authPayload, account, db.HolderPermissionTransfer); err != nil {
+		return nil, err
+	}
+
+	toAccount, err := server.getAccount(ctx, req.GetToAccountId())
+	if err != nil {
+		return nil, err
+	}
+
+	if err = checkAccountActive(account); err != nil {
+		return nil, err
+	}
+	if err = checkAccountActive(toAccount); err != nil {
+		return nil, err
+	}
+	if toAccount.Currency != account.Currency {
+		return nil, status.Errorf(codes.InvalidArgument, "account %d currency mismatch: expected %s, got %s",
+			toAccount.ID, account.Currency, toAccount.Currency)
+	}
+
+	result, err := server.store.PlaceHoldTx(server.auditContext(ctx, authPayload.Username, authPayload.Role), db.PlaceHoldTxParams{
+		AccountID:   account.ID,
+		ToAccountID: toAccount.ID,
+		Amount:      req.GetAmount(),
+		Description: req.GetDescription(),
+		ExpiresAt:   req.GetExpiresAt().AsTime(),
+		PlacedBy:    authPayload.Username,
+		Role:        authPayload.Role,
+	})
+	if err != nil {
+		return nil, transferError(err)
+	}
+
+	response := &pb.PlaceHoldResponse{
+		Hold:    convertAccountHold(result.Hold),
+		Account: convertAccount(result.Account),
+	}
+	return response, nil
+}
+
+func validatePlaceHoldRequest(req *pb.PlaceHoldRequest, now time.Time) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateID(req.GetAccountId()); err != nil {
+		violations = append(violations, fieldViolation("account_id", err))
+	}
+	if err := val.ValidateID(req.GetToAccountId()); err != nil {
+		violations = append(violations, fieldViolation("to_account_id", err))
+	}
+	if req.GetAccountId() == req.GetToAccountId() {
+		violations = append(violations, fieldViolation("to_account_id", fmt.Errorf("must be different from account_id")))
+	}
+	if err := val.ValidateAmount(req.GetAmount()); err != nil {
+		violations = append(violations, fieldViolation("amount", err))
+	}
+	if err := val.ValidateString(req.GetDescription(), 1, 255); err != nil {
+		violations = append(violations, fieldViolation("description", err))
+	}
+
+	if req.ExpiresAt == nil {
+		violations = append(violations, fieldViolation("expires_at", fmt.Errorf("is required")))
+	} else {
+		expiresAt := req.GetExpiresAt().AsTime()
+		if !expiresAt.After(now) {
+			violations = append(violations, fieldViolation("expires_at", fmt.Errorf("must be in the future")))
+		} else if expiresAt.After(now.Add(maxHoldDuration)) {
+			violations = append(violations, fieldViolation("expires_at", fmt.Errorf("must be within %s", maxHoldDuration)))
+		}
+	}
+	return violations
+}
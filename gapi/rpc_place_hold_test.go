@@ -0,0 +1,168 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	mockdb "github.com/mahanth/simplebank/db/mock"
+	db "github.com/mahanth/simplebank/db/sqlc"
+	"github.com/mahanth/simplebank/pb"
+	"github.com/mahanth/simplebank/token"
+	"github.com/mahanth/simplebank/util"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestPlaceHoldAPI(t *testing.T) {
+	user, _ := randomUser()
+	merchant, _ := randomUser()
+	account := randomAccount(user.Username)
+	toAccount := randomAccount(merchant.Username)
+	toAccount.ID = account.ID + 1
+	toAccount.Currency = account.Currency
+
+	expiresAt := time.Now().Add(24 * time.Hour).Truncate(time.Second)
+	amount := util.RandomInt(1, 1000)
+
+	validRequest := func() *pb.PlaceHoldRequest {
+		return &pb.PlaceHoldRequest{
+			AccountId:   account.ID,
+			ToAccountId: toAccount.ID,
+			Amount:      amount,
+			Description: "hotel deposit",
+			ExpiresAt:   timestamppb.New(expiresAt),
+		}
+	}
+
+	testCases := []struct {
+		name          string
+		request       func() *pb.PlaceHoldRequest
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, resp *pb.PlaceHoldResponse, err error)
+	}{
+		{
+			name:    "OK",
+			request: validRequest,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(toAccount.ID)).Times(1).Return(toAccount, nil)
+
+				arg := db.PlaceHoldTxParams{
+					AccountID:   account.ID,
+					ToAccountID: toAccount.ID,
+					Amount:      amount,
+					Description: "hotel deposit",
+					ExpiresAt:   expiresAt.UTC(),
+					PlacedBy:    user.Username,
+					Role:        util.DepositorRole,
+				}
+				held := account
+				held.HeldAmount = amount
+				held.AvailableBalance = account.Balance - amount
+				store.EXPECT().PlaceHoldTx(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(db.PlaceHoldTxResult{
+						Hold:    db.AccountHold{ID: 1, AccountID: account.ID, ToAccountID: toAccount.ID, Amount: amount, Status: db.HoldStatusActive},
+						Account: held,
+					}, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, util.DepositorRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, resp *pb.PlaceHoldResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, db.HoldStatusActive, resp.GetHold().GetStatus())
+				require.Equal(t, account.Balance, resp.GetAccount().GetBalance())
+				require.Equal(t, account.Balance-amount, resp.GetAccount().GetAvailableBalance())
+			},
+		},
+		{
+			name:    "NotOwner",
+			request: validRequest,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().GetAccountHolder(gomock.Any(), gomock.Any()).Times(1).Return(db.AccountHolder{}, db.ErrRecordNotFound)
+				store.EXPECT().PlaceHoldTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, merchant.Username, util.DepositorRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, resp *pb.PlaceHoldResponse, err error) {
+				requireStatusCode(t, codes.PermissionDenied, err)
+			},
+		},
+		{
+			name:    "InsufficientFunds",
+			request: validRequest,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(toAccount.ID)).Times(1).Return(toAccount, nil)
+				store.EXPECT().PlaceHoldTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.PlaceHoldTxResult{}, fmt.Errorf("%w: account %d", db.ErrInsufficientFunds, account.ID))
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, util.DepositorRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, resp *pb.PlaceHoldResponse, err error) {
+				requireStatusCode(t, codes.FailedPrecondition, err)
+			},
+		},
+		{
+			name: "ExpiresInThePast",
+			request: func() *pb.PlaceHoldRequest {
+				req := validRequest()
+				req.ExpiresAt = timestamppb.New(time.Now().Add(-time.Minute))
+				return req
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().PlaceHoldTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, util.DepositorRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, resp *pb.PlaceHoldResponse, err error) {
+				requireStatusCode(t, codes.InvalidArgument, err)
+			},
+		},
+		{
+			name: "MissingExpiry",
+			request: func() *pb.PlaceHoldRequest {
+				req := validRequest()
+				req.ExpiresAt = nil
+				return req
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().PlaceHoldTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, util.DepositorRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, resp *pb.PlaceHoldResponse, err error) {
+				requireStatusCode(t, codes.InvalidArgument, err)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil)
+			ctx := tc.buildContext(t, server.tokenMaker)
+			res, err := server.PlaceHold(ctx, tc.request())
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/hibiken/asynq"
+	db "github.com/mahanth/simplebank/db/sqlc"
+	"github.com/mahanth/simplebank/pb"
+	"github.com/mahanth/simplebank/util"
+	"github.com/mahanth/simplebank/worker"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) ReconcileLedger(ctx context.Context, req *pb.ReconcileLedgerRequest) (*pb.ReconcileLedgerResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, []string{util.BankerRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	result, err := server.store.ReconcileLedgerTx(ctx, db.ReconcileLedgerTxParams{
+		TriggeredBy: authPayload.Username,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to reconcile ledger: %s", err)
+	}
+
+	if result.Report.DiscrepancyCount > 0 {
+		taskPayload := &worker.PayloadSendReconciliationReport{
+			ReportID: result.Report.ID,
+		}
+		// The report is already stored, so a failure to notify the bankers shouldn't fail the request.
+		err = server.taskDistributor.DistributeTaskSendReconciliationReport(ctx, taskPayload,
+			asynq.MaxRetry(10), asynq.Queue(worker.QueueCritical))
+		if err != nil {
+			log.Error().Err(err).Int64("report_id", result.Report.ID).Msg("failed to distribute reconciliation report")
+		}
+	}
+
+	response := &pb.ReconcileLedgerResponse{
+		Report: convertReconciliationReport(result.Report, result.Discrepancies),
+	}
+	return response, nil
+}
@@ -0,0 +1,155 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	mockdb "github.com/mahanth/simplebank/db/mock"
+	db "github.com/mahanth/simplebank/db/sqlc"
+	"github.com/mahanth/simplebank/pb"
+	"github.com/mahanth/simplebank/token"
+	"github.com/mahanth/simplebank/util"
+	"github.com/mahanth/simplebank/worker"
+	mockwk "github.com/mahanth/simplebank/worker/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+)
+
+func TestReconcileLedgerAPI(t *testing.T) {
+	banker := "banker"
+	discrepancy := db.Discrepancy{
+		Kind:      db.DiscrepancyAccountBalance,
+		AccountID: util.RandomInt(1, 1000),
+		Expected:  100,
+		Actual:    90,
+		Detail:    "account balance does not match its entries",
+	}
+
+	testCases := []struct {
+		name          string
+		buildStubs    func(store *mockdb.MockStore, distributor *mockwk.MockTaskDistributor)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, resp *pb.ReconcileLedgerResponse, err error)
+	}{
+		{
+			name: "Balanced",
+			buildStubs: func(store *mockdb.MockStore, distributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().
+					ReconcileLedgerTx(gomock.Any(), gomock.Eq(db.ReconcileLedgerTxParams{TriggeredBy: banker})).
+					Times(1).
+					Return(db.ReconcileLedgerTxResult{
+						Report:        db.ReconciliationReport{ID: 1, TriggeredBy: banker},
+						Discrepancies: []db.Discrepancy{},
+					}, nil)
+				distributor.EXPECT().DistributeTaskSendReconciliationReport(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker, util.BankerRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, resp *pb.ReconcileLedgerResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, int64(1), resp.GetReport().GetId())
+				require.Zero(t, resp.GetReport().GetDiscrepancyCount())
+				require.Empty(t, resp.GetReport().GetDiscrepancies())
+			},
+		},
+		{
+			name: "DiscrepanciesNotifyBankers",
+			buildStubs: func(store *mockdb.MockStore, distributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().
+					ReconcileLedgerTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.ReconcileLedgerTxResult{
+						Report:        db.ReconciliationReport{ID: 2, TriggeredBy: banker, DiscrepancyCount: 1},
+						Discrepancies: []db.Discrepancy{discrepancy},
+					}, nil)
+				distributor.EXPECT().
+					DistributeTaskSendReconciliationReport(gomock.Any(), gomock.Eq(&worker.PayloadSendReconciliationReport{ReportID: 2}), gomock.Any()).
+					Times(1).
+					Return(nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker, util.BankerRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, resp *pb.ReconcileLedgerResponse, err error) {
+				require.NoError(t, err)
+				require.Len(t, resp.GetReport().GetDiscrepancies(), 1)
+				got := resp.GetReport().GetDiscrepancies()[0]
+				require.Equal(t, discrepancy.Kind, got.GetKind())
+				require.Equal(t, discrepancy.AccountID, got.GetAccountId())
+				require.Equal(t, discrepancy.Expected, got.GetExpected())
+				require.Equal(t, discrepancy.Actual, got.GetActual())
+			},
+		},
+		{
+			name: "NotifyFailureStillReturnsReport",
+			buildStubs: func(store *mockdb.MockStore, distributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().
+					ReconcileLedgerTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.ReconcileLedgerTxResult{
+						Report:        db.ReconciliationReport{ID: 3, TriggeredBy: banker, DiscrepancyCount: 1},
+						Discrepancies: []db.Discrepancy{discrepancy},
+					}, nil)
+				distributor.EXPECT().
+					DistributeTaskSendReconciliationReport(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(fmt.Errorf("redis is down"))
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker, util.BankerRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, resp *pb.ReconcileLedgerResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, int64(3), resp.GetReport().GetId())
+			},
+		},
+		{
+			name: "DepositorNotAllowed",
+			buildStubs: func(store *mockdb.MockStore, distributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().ReconcileLedgerTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, "depositor", util.DepositorRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, resp *pb.ReconcileLedgerResponse, err error) {
+				requireStatusCode(t, codes.Unauthenticated, err)
+			},
+		},
+		{
+			name: "InternalError",
+			buildStubs: func(store *mockdb.MockStore, distributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().
+					ReconcileLedgerTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.ReconcileLedgerTxResult{}, fmt.Errorf("connection refused"))
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker, util.BankerRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, resp *pb.ReconcileLedgerResponse, err error) {
+				requireStatusCode(t, codes.Internal, err)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			store := mockdb.NewMockStore(ctrl)
+			distributor := mockwk.NewMockTaskDistributor(ctrl)
+
+			tc.buildStubs(store, distributor)
+
+			server := newTestServer(t, store, distributor)
+			ctx := tc.buildContext(t, server.tokenMaker)
+			res, err := server.ReconcileLedger(ctx, &pb.ReconcileLedgerRequest{})
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
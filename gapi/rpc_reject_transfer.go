@@ -0,0 +1,53 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+
+	db "github.com/mahanth/simplebank/db/sqlc"
+	"github.com/mahanth/simplebank/pb"
+	"github.com/mahanth/simplebank/util"
+	"github.com/mahanth/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) RejectTransfer(ctx context.Context, req *pb.RejectTransferRequest) (*pb.RejectTransferResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, []string{util.BankerRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateRejectTransferRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	result, err := server.store.RejectTransferTx(server.auditContext(ctx, authPayload.Username, authPayload.Role), db.DecideTransferApprovalTxParams{
+		ApprovalID: req.GetApprovalId(),
+		DecidedBy:  authPayload.Username,
+		Reason:     req.GetReason(),
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "transfer approval %d not found", req.GetApprovalId())
+		}
+		return nil, transferError(err)
+	}
+
+	response := &pb.RejectTransferResponse{
+		Approval: convertTransferApproval(result.Approval),
+	}
+	return response, nil
+}
+
+func validateRejectTransferRequest(req *pb.RejectTransferRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateID(req.GetApprovalId()); err != nil {
+		violations = append(violations, fieldViolation("approval_id", err))
+	}
+	if err := val.ValidateString(req.GetReason(), 1, 255); err != nil {
+		violations = append(violations, fieldViolation("reason", err))
+	}
+	return violations
+}
@@ -0,0 +1,45 @@
+package gapi
+
+import (
+	"context"
+
+	db "github.com/mahanth/simplebank/db/sqlc"
+	"github.com/mahanth/simplebank/pb"
+	"github.com/mahanth/simplebank/util"
+	"github.com/mahanth/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+func (server *Server) ReleaseHold(ctx context.Context, req *pb.ReleaseHoldRequest) (*pb.ReleaseHoldResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, []string{util.DepositorRole, util.BankerRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateReleaseHoldRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	hold, err := server.getAccountHold(ctx, authPayload, req.GetHoldId())
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := server.store.ReleaseHoldTx(server.auditContext(ctx, authPayload.Username, authPayload.Role), db.ReleaseHoldTxParams{HoldID: hold.ID})
+	if err != nil {
+		return nil, transferError(err)
+	}
+
+	response := &pb.ReleaseHoldResponse{
+		Hold: convertAccountHold(result.Hold),
+	}
+	return response, nil
+}
+
+func validateReleaseHoldRequest(req *pb.ReleaseHoldRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateID(req.GetHoldId()); err != nil {
+		violations = append(violations, fieldViolation("hold_id", err))
+	}
+	return violations
+}
@@ -0,0 +1,66 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+
+	db "github.com/mahanth/simplebank/db/sqlc"
+	"github.com/mahanth/simplebank/pb"
+	"github.com/mahanth/simplebank/util"
+	"github.com/mahanth/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) RemoveAccountHolder(ctx context.Context, req *pb.RemoveAccountHolderRequest) (*pb.RemoveAccountHolderResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, []string{util.DepositorRole, util.BankerRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateRemoveAccountHolderRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	// holders can always leave an account or decline an invitation; removing others takes manage
+	if req.GetUsername() != authPayload.Username {
+		account, err := server.getAccount(ctx, req.GetAccountId())
+		if err != nil {
+			return nil, err
+		}
+		if _, err = server.authorizeAccount(ctx, authPayload, account, db.HolderPermissionManage); err != nil {
+			return nil, err
+		}
+		if req.GetUsername() == account.Owner {
+			return nil, status.Errorf(codes.FailedPrecondition, "the owner of account %d cannot be removed", account.ID)
+		}
+	}
+
+	holder, err := server.store.DeleteAccountHolder(server.auditContext(ctx, authPayload.Username, authPayload.Role), db.DeleteAccountHolderParams{
+		AccountID: req.GetAccountId(),
+		Username:  req.GetUsername(),
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "%s is not a holder of account %d", req.GetUsername(), req.GetAccountId())
+		}
+		return nil, status.Errorf(codes.Internal, "failed to remove account holder: %s", err)
+	}
+
+	response := &pb.RemoveAccountHolderResponse{
+		Holder: convertAccountHolder(holder),
+	}
+	return response, nil
+}
+
+func validateRemoveAccountHolderRequest(req *pb.RemoveAccountHolderRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateID(req.GetAccountId()); err != nil {
+		violations = append(violations, fieldViolation("account_id", err))
+	}
+	if err := val.ValidateUsername(req.GetUsername()); err != nil {
+		violations = append(violations, fieldViolation("username", err))
+	}
+	return violations
+}
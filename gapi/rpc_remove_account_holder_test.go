@@ -0,0 +1,133 @@
+package gapi
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	mockdb "github.com/mahanth/simplebank/db/mock"
+	db "github.com/mahanth/simplebank/db/sqlc"
+	"github.com/mahanth/simplebank/pb"
+	"github.com/mahanth/simplebank/token"
+	"github.com/mahanth/simplebank/util"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+)
+
+func TestRemoveAccountHolderAPI(t *testing.T) {
+	account := randomAccount("owner")
+	holder := db.AccountHolder{
+		ID:         1,
+		AccountID:  account.ID,
+		Username:   "partner",
+		Permission: db.HolderPermissionTransfer,
+		Status:     db.HolderStatusActive,
+		InvitedBy:  account.Owner,
+	}
+	deleteArg := db.DeleteAccountHolderParams{AccountID: account.ID, Username: holder.Username}
+
+	testCases := []struct {
+		name          string
+		request       *pb.RemoveAccountHolderRequest
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, resp *pb.RemoveAccountHolderResponse, err error)
+	}{
+		{
+			name:    "RemovedByOwner",
+			request: &pb.RemoveAccountHolderRequest{AccountId: account.ID, Username: holder.Username},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().DeleteAccountHolder(gomock.Any(), gomock.Eq(deleteArg)).Times(1).Return(holder, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, account.Owner, util.DepositorRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, resp *pb.RemoveAccountHolderResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, holder.Username, resp.GetHolder().GetUsername())
+			},
+		},
+		{
+			name:    "HolderLeaves",
+			request: &pb.RemoveAccountHolderRequest{AccountId: account.ID, Username: holder.Username},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().DeleteAccountHolder(gomock.Any(), gomock.Eq(deleteArg)).Times(1).Return(holder, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, holder.Username, util.DepositorRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, resp *pb.RemoveAccountHolderResponse, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name:    "OtherHolderCannotRemove",
+			request: &pb.RemoveAccountHolderRequest{AccountId: account.ID, Username: holder.Username},
+			buildStubs: func(store *mockdb.MockStore) {
+				other := holder
+				other.Username = "sibling"
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().GetAccountHolder(gomock.Any(), gomock.Any()).Times(1).Return(other, nil)
+				store.EXPECT().DeleteAccountHolder(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, "sibling", util.DepositorRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, resp *pb.RemoveAccountHolderResponse, err error) {
+				requireStatusCode(t, codes.PermissionDenied, err)
+			},
+		},
+		{
+			name:    "RemoveOwner",
+			request: &pb.RemoveAccountHolderRequest{AccountId: account.ID, Username: account.Owner},
+			buildStubs: func(store *mockdb.MockStore) {
+				manager := holder
+				manager.Permission = db.HolderPermissionManage
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().GetAccountHolder(gomock.Any(), gomock.Any()).Times(1).Return(manager, nil)
+				store.EXPECT().DeleteAccountHolder(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, holder.Username, util.DepositorRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, resp *pb.RemoveAccountHolderResponse, err error) {
+				requireStatusCode(t, codes.FailedPrecondition, err)
+			},
+		},
+		{
+			name:    "NotHolder",
+			request: &pb.RemoveAccountHolderRequest{AccountId: account.ID, Username: holder.Username},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().DeleteAccountHolder(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.AccountHolder{}, db.ErrRecordNotFound)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, account.Owner, util.DepositorRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, resp *pb.RemoveAccountHolderResponse, err error) {
+				requireStatusCode(t, codes.NotFound, err)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil)
+			ctx := tc.buildContext(t, server.tokenMaker)
+			res, err := server.RemoveAccountHolder(ctx, tc.request)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
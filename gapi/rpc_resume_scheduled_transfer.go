@@ -0,0 +1,76 @@
+package gapi
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	db "github.com/mahanth/simplebank/db/sqlc"
+	"github.com/mahanth/simplebank/pb"
+	"github.com/mahanth/simplebank/util"
+	"github.com/mahanth/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) ResumeScheduledTransfer(ctx context.Context, req *pb.ResumeScheduledTransferRequest) (*pb.ResumeScheduledTransferResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, []string{util.DepositorRole, util.BankerRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateResumeScheduledTransferRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	scheduled, err := server.getScheduledTransfer(ctx, authPayload, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	if scheduled.Status != db.ScheduledTransferPaused {
+		return nil, status.Errorf(codes.FailedPrecondition, "scheduled transfer %d is %s, only a paused one can be resumed",
+			scheduled.ID, scheduled.Status)
+	}
+
+	schedule, err := util.ParseSchedule(scheduled.Schedule)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "scheduled transfer %d has an invalid schedule: %s", scheduled.ID, err)
+	}
+
+	// runs missed while paused are skipped
+	now := time.Now()
+	nextRun := scheduled.NextRunAt.Time
+	if !nextRun.After(now) {
+		nextRun = util.NextRun(schedule, nextRun, now)
+	}
+
+	arg := db.UpdateScheduledTransferStatusParams{
+		ID:        scheduled.ID,
+		Status:    db.ScheduledTransferActive,
+		NextRunAt: pgtype.Timestamptz{Time: nextRun, Valid: true},
+	}
+	if scheduled.EndAt.Valid && nextRun.After(scheduled.EndAt.Time) {
+		arg.Status = db.ScheduledTransferCompleted
+		arg.NextRunAt = pgtype.Timestamptz{}
+	}
+
+	scheduled, err = server.store.UpdateScheduledTransferStatus(server.auditContext(ctx, authPayload.Username, authPayload.Role), arg)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to resume scheduled transfer: %s", err)
+	}
+
+	response := &pb.ResumeScheduledTransferResponse{
+		ScheduledTransfer: convertScheduledTransfer(scheduled),
+	}
+	return response, nil
+}
+
+func validateResumeScheduledTransferRequest(req *pb.ResumeScheduledTransferRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateID(req.GetId()); err != nil {
+		violations = append(violations, fieldViolation("id", err))
+	}
+	return violations
+}
@@ -0,0 +1,105 @@
+package gapi
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/jackc/pgx/v5/pgtype"
+	mockdb "github.com/mahanth/simplebank/db/mock"
+	db "github.com/mahanth/simplebank/db/sqlc"
+	"github.com/mahanth/simplebank/pb"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+)
+
+func TestResumeScheduledTransferAPI(t *testing.T) {
+	user, _ := randomUser()
+
+	// paused for a while, so the runs in between were missed
+	missed := randomScheduledTransfer(user.Username, db.ScheduledTransferPaused)
+	missed.NextRunAt.Time = missed.NextRunAt.Time.Add(-72 * time.Hour)
+
+	expired := randomScheduledTransfer(user.Username, db.ScheduledTransferPaused)
+	expired.NextRunAt.Time = expired.NextRunAt.Time.Add(-72 * time.Hour)
+	expired.EndAt = pgtype.Timestamptz{Time: time.Now().Add(-time.Hour), Valid: true}
+
+	testCases := []struct {
+		name          string
+		scheduled     db.ScheduledTransfer
+		buildStubs    func(store *mockdb.MockStore, scheduled db.ScheduledTransfer)
+		checkResponse func(t *testing.T, resp *pb.ResumeScheduledTransferResponse, err error)
+	}{
+		{
+			name:      "SkipsMissedRuns",
+			scheduled: missed,
+			buildStubs: func(store *mockdb.MockStore, scheduled db.ScheduledTransfer) {
+				store.EXPECT().GetScheduledTransfer(gomock.Any(), gomock.Eq(scheduled.ID)).Times(1).Return(scheduled, nil)
+				store.EXPECT().UpdateScheduledTransferStatus(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ context.Context, arg db.UpdateScheduledTransferStatusParams) (db.ScheduledTransfer, error) {
+						require.Equal(t, db.ScheduledTransferActive, arg.Status)
+						// the next run stays on the original daily rhythm
+						require.True(t, arg.NextRunAt.Time.Equal(scheduled.NextRunAt.Time.Add(72*time.Hour)))
+						scheduled.Status = arg.Status
+						scheduled.NextRunAt = arg.NextRunAt
+						return scheduled, nil
+					})
+			},
+			checkResponse: func(t *testing.T, resp *pb.ResumeScheduledTransferResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, db.ScheduledTransferActive, resp.GetScheduledTransfer().GetStatus())
+				require.True(t, resp.GetScheduledTransfer().GetNextRunAt().AsTime().After(time.Now()))
+			},
+		},
+		{
+			name:      "CompletesAfterEnd",
+			scheduled: expired,
+			buildStubs: func(store *mockdb.MockStore, scheduled db.ScheduledTransfer) {
+				store.EXPECT().GetScheduledTransfer(gomock.Any(), gomock.Eq(scheduled.ID)).Times(1).Return(scheduled, nil)
+
+				arg := db.UpdateScheduledTransferStatusParams{
+					ID:     scheduled.ID,
+					Status: db.ScheduledTransferCompleted,
+				}
+				completed := scheduled
+				completed.Status = db.ScheduledTransferCompleted
+				completed.NextRunAt = pgtype.Timestamptz{}
+				store.EXPECT().UpdateScheduledTransferStatus(gomock.Any(), gomock.Eq(arg)).Times(1).Return(completed, nil)
+			},
+			checkResponse: func(t *testing.T, resp *pb.ResumeScheduledTransferResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, db.ScheduledTransferCompleted, resp.GetScheduledTransfer().GetStatus())
+				require.Nil(t, resp.GetScheduledTransfer().GetNextRunAt())
+			},
+		},
+		{
+			name:      "NotPaused",
+			scheduled: randomScheduledTransfer(user.Username, db.ScheduledTransferActive),
+			buildStubs: func(store *mockdb.MockStore, scheduled db.ScheduledTransfer) {
+				store.EXPECT().GetScheduledTransfer(gomock.Any(), gomock.Eq(scheduled.ID)).Times(1).Return(scheduled, nil)
+				store.EXPECT().UpdateScheduledTransferStatus(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, resp *pb.ResumeScheduledTransferResponse, err error) {
+				requireStatusCode(t, codes.FailedPrecondition, err)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			tc.buildStubs(store, tc.scheduled)
+
+			server := newTestServer(t, store, nil)
+			ctx := newContextWithBearerToken(t, server.tokenMaker, user.Username, user.Role, time.Minute)
+			res, err := server.ResumeScheduledTransfer(ctx, &pb.ResumeScheduledTransferRequest{Id: tc.scheduled.ID})
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
@@ -0,0 +1,91 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+
+	db "github.com/mahanth/simplebank/db/sqlc"
+	"github.com/mahanth/simplebank/pb"
+	"github.com/mahanth/simplebank/util"
+	"github.com/mahanth/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) ReverseTransfer(ctx context.Context, req *pb.ReverseTransferRequest) (*pb.ReverseTransferResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, []string{util.DepositorRole, util.BankerRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateReverseTransferRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	transfer, err := server.store.GetTransfer(ctx, req.GetTransferId())
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "transfer %d not found", req.GetTransferId())
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get transfer %s", err)
+	}
+
+	// a refund takes money from the recipient, so only whoever may transfer from their account or
+	// a banker may give it back
+	if authPayload.Role != util.BankerRole {
+		toAccount, err := server.getAccount(ctx, transfer.ToAccountID)
+		if err != nil {
+			return nil, err
+		}
+		if _, err = server.authorizeAccount(ctx, authPayload, toAccount, db.HolderPermissionTransfer); err != nil {
+			if status.Code(err) != codes.PermissionDenied {
+				return nil, err
+			}
+			return nil, status.Errorf(codes.PermissionDenied, "only the recipient or a banker can reverse a transfer")
+		}
+	}
+
+	if transfer.Status == db.TransferStatusReversed {
+		return nil, status.Errorf(codes.FailedPrecondition, "transfer %d is already fully reversed", transfer.ID)
+	}
+
+	amount := transfer.Amount - transfer.ReversedAmount
+	if req.Amount != nil {
+		amount = req.GetAmount()
+	}
+
+	result, err := server.store.ReverseTransferTx(server.auditContext(ctx, authPayload.Username, authPayload.Role), db.ReverseTransferTxParams{
+		TransferID:  transfer.ID,
+		Amount:      amount,
+		Reason:      req.GetReason(),
+		InitiatedBy: authPayload.Username,
+	})
+	if err != nil {
+		return nil, transferError(err)
+	}
+
+	response := &pb.ReverseTransferResponse{
+		Transfer:  convertTransfer(result.Transfer),
+		Reversal:  convertTransferReversal(result.Reversal),
+		FromEntry: convertEntry(result.FromEntry),
+		ToEntry:   convertEntry(result.ToEntry),
+	}
+	return response, nil
+}
+
+func validateReverseTransferRequest(req *pb.ReverseTransferRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateID(req.GetTransferId()); err != nil {
+		violations = append(violations, fieldViolation("transfer_id", err))
+	}
+	if req.Amount != nil {
+		if err := val.ValidateAmount(req.GetAmount()); err != nil {
+			violations = append(violations, fieldViolation("amount", err))
+		}
+	}
+	if err := val.ValidateString(req.GetReason(), 1, 255); err != nil {
+		violations = append(violations, fieldViolation("reason", err))
+	}
+	return violations
+}
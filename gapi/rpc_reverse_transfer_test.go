@@ -0,0 +1,196 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	mockdb "github.com/mahanth/simplebank/db/mock"
+	db "github.com/mahanth/simplebank/db/sqlc"
+	"github.com/mahanth/simplebank/pb"
+	"github.com/mahanth/simplebank/token"
+	"github.com/mahanth/simplebank/util"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+)
+
+func TestReverseTransferAPI(t *testing.T) {
+	sender, _ := randomUser()
+	recipient, _ := randomUser()
+	fromAccount := randomAccount(sender.Username)
+	toAccount := randomAccount(recipient.Username)
+	toAccount.ID = fromAccount.ID + 1
+
+	transfer := db.Transfer{
+		ID:             util.RandomInt(1, 1000),
+		FromAccountID:  fromAccount.ID,
+		ToAccountID:    toAccount.ID,
+		Amount:         100,
+		ToAmount:       100,
+		ReversedAmount: 30,
+		Status:         db.TransferStatusPartiallyReversed,
+	}
+	partial := int64(20)
+
+	testCases := []struct {
+		name          string
+		request       *pb.ReverseTransferRequest
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, resp *pb.ReverseTransferResponse, err error)
+	}{
+		{
+			name:    "RecipientRefundsTheRest",
+			request: &pb.ReverseTransferRequest{TransferId: transfer.ID, Reason: "sent by mistake"},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTransfer(gomock.Any(), gomock.Eq(transfer.ID)).Times(1).Return(transfer, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(toAccount.ID)).Times(1).Return(toAccount, nil)
+
+				arg := db.ReverseTransferTxParams{
+					TransferID:  transfer.ID,
+					Amount:      70,
+					Reason:      "sent by mistake",
+					InitiatedBy: recipient.Username,
+				}
+				reversed := transfer
+				reversed.ReversedAmount = transfer.Amount
+				reversed.Status = db.TransferStatusReversed
+				store.EXPECT().ReverseTransferTx(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(db.ReverseTransferTxResult{
+						Transfer: reversed,
+						Reversal: db.TransferReversal{ID: 1, TransferID: transfer.ID, Amount: 70, ToAmount: 70},
+					}, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, recipient.Username, util.DepositorRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, resp *pb.ReverseTransferResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, db.TransferStatusReversed, resp.GetTransfer().GetStatus())
+				require.Equal(t, int64(70), resp.GetReversal().GetAmount())
+			},
+		},
+		{
+			name:    "BankerPartialRefund",
+			request: &pb.ReverseTransferRequest{TransferId: transfer.ID, Amount: &partial, Reason: "duplicate charge"},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTransfer(gomock.Any(), gomock.Eq(transfer.ID)).Times(1).Return(transfer, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+
+				arg := db.ReverseTransferTxParams{
+					TransferID:  transfer.ID,
+					Amount:      partial,
+					Reason:      "duplicate charge",
+					InitiatedBy: "banker",
+				}
+				store.EXPECT().ReverseTransferTx(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(db.ReverseTransferTxResult{Transfer: transfer}, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, "banker", util.BankerRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, resp *pb.ReverseTransferResponse, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name:    "SenderCannotReverse",
+			request: &pb.ReverseTransferRequest{TransferId: transfer.ID, Reason: "changed my mind"},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTransfer(gomock.Any(), gomock.Eq(transfer.ID)).Times(1).Return(transfer, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(toAccount.ID)).Times(1).Return(toAccount, nil)
+				store.EXPECT().GetAccountHolder(gomock.Any(), gomock.Any()).Times(1).Return(db.AccountHolder{}, db.ErrRecordNotFound)
+				store.EXPECT().ReverseTransferTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, sender.Username, util.DepositorRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, resp *pb.ReverseTransferResponse, err error) {
+				requireStatusCode(t, codes.PermissionDenied, err)
+			},
+		},
+		{
+			name:    "ExceedsTransfer",
+			request: &pb.ReverseTransferRequest{TransferId: transfer.ID, Amount: &transfer.Amount, Reason: "refund"},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTransfer(gomock.Any(), gomock.Eq(transfer.ID)).Times(1).Return(transfer, nil)
+				store.EXPECT().ReverseTransferTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.ReverseTransferTxResult{}, fmt.Errorf("%w: transfer %d", db.ErrReversalExceedsTransfer, transfer.ID))
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, "banker", util.BankerRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, resp *pb.ReverseTransferResponse, err error) {
+				requireStatusCode(t, codes.FailedPrecondition, err)
+			},
+		},
+		{
+			name:    "RecipientInsufficientFunds",
+			request: &pb.ReverseTransferRequest{TransferId: transfer.ID, Reason: "refund"},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTransfer(gomock.Any(), gomock.Eq(transfer.ID)).Times(1).Return(transfer, nil)
+				store.EXPECT().ReverseTransferTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.ReverseTransferTxResult{}, fmt.Errorf("%w: account %d", db.ErrInsufficientFunds, toAccount.ID))
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, "banker", util.BankerRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, resp *pb.ReverseTransferResponse, err error) {
+				requireStatusCode(t, codes.FailedPrecondition, err)
+			},
+		},
+		{
+			name:    "AlreadyReversed",
+			request: &pb.ReverseTransferRequest{TransferId: transfer.ID, Reason: "refund"},
+			buildStubs: func(store *mockdb.MockStore) {
+				reversed := transfer
+				reversed.ReversedAmount = transfer.Amount
+				reversed.Status = db.TransferStatusReversed
+				store.EXPECT().GetTransfer(gomock.Any(), gomock.Eq(transfer.ID)).Times(1).Return(reversed, nil)
+				store.EXPECT().ReverseTransferTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, "banker", util.BankerRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, resp *pb.ReverseTransferResponse, err error) {
+				requireStatusCode(t, codes.FailedPrecondition, err)
+			},
+		},
+		{
+			name:    "MissingReason",
+			request: &pb.ReverseTransferRequest{TransferId: transfer.ID},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTransfer(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().ReverseTransferTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, "banker", util.BankerRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, resp *pb.ReverseTransferResponse, err error) {
+				requireStatusCode(t, codes.InvalidArgument, err)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil)
+			ctx := tc.buildContext(t, server.tokenMaker)
+			res, err := server.ReverseTransfer(ctx, tc.request)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
@@ -0,0 +1,72 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	db "github.com/mahanth/simplebank/db/sqlc"
+	"github.com/mahanth/simplebank/pb"
+	"github.com/mahanth/simplebank/util"
+	"github.com/mahanth/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) SearchTransfers(ctx context.Context, req *pb.SearchTransfersRequest) (*pb.SearchTransfersResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, []string{util.DepositorRole, util.BankerRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateSearchTransfersRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	// only the authenticated user's own transfers are searched, whatever their role
+	arg := db.SearchTransfersParams{
+		Owner:                 authPayload.Username,
+		Query:                 req.GetQuery(),
+		CounterpartyAccountID: pgtype.Int8{Int64: req.GetCounterpartyAccountId(), Valid: req.CounterpartyAccountId != nil},
+		PageSize:              req.GetPageSize() + 1,
+	}
+	if req.GetPageToken() != "" {
+		lastID, _ := util.DecodePageToken(req.GetPageToken())
+		arg.BeforeID = pgtype.Int8{Int64: lastID, Valid: true}
+	}
+
+	transfers, err := server.store.SearchTransfers(ctx, arg)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to search transfers %s", err)
+	}
+
+	response := &pb.SearchTransfersResponse{}
+	transfers, response.NextPageToken = util.NextPage(transfers, req.GetPageSize(), func(transfer db.Transfer) int64 {
+		return transfer.ID
+	})
+	for _, transfer := range transfers {
+		response.Transfers = append(response.Transfers, convertTransfer(transfer))
+	}
+	return response, nil
+}
+
+func validateSearchTransfersRequest(req *pb.SearchTransfersRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateString(req.GetQuery(), 1, 100); err != nil {
+		violations = append(violations, fieldViolation("query", err))
+	}
+	if err := val.ValidatePageSize(req.GetPageSize()); err != nil {
+		violations = append(violations, fieldViolation("page_size", err))
+	}
+	if req.GetPageToken() != "" {
+		if _, err := util.DecodePageToken(req.GetPageToken()); err != nil {
+			violations = append(violations, fieldViolation("page_token", err))
+		}
+	}
+	if req.CounterpartyAccountId != nil {
+		if err := val.ValidateID(req.GetCounterpartyAccountId()); err != nil {
+			violations = append(violations, fieldViolation("counterparty_account_id", err))
+		}
+	}
+	return violations
+}
@@ -0,0 +1,112 @@
+package gapi
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/jackc/pgx/v5/pgtype"
+	mockdb "github.com/mahanth/simplebank/db/mock"
+	db "github.com/mahanth/simplebank/db/sqlc"
+	"github.com/mahanth/simplebank/pb"
+	"github.com/mahanth/simplebank/token"
+	"github.com/mahanth/simplebank/util"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+)
+
+func TestSearchTransfersAPI(t *testing.T) {
+	user, _ := randomUser()
+	account := randomAccount(user.Username)
+
+	transfers := make([]db.Transfer, 6)
+	for i := range transfers {
+		transfers[i] = db.Transfer{ID: int64(100 - i), FromAccountID: account.ID, ToAccountID: account.ID + 1, Amount: 10, Memo: "rent march"}
+	}
+
+	counterparty := account.ID + 1
+
+	testCases := []struct {
+		name          string
+		request       *pb.SearchTransfersRequest
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, resp *pb.SearchTransfersResponse, err error)
+	}{
+		{
+			name: "OK",
+			request: &pb.SearchTransfersRequest{
+				Query:                 "rent",
+				PageSize:              5,
+				PageToken:             util.EncodePageToken(101),
+				CounterpartyAccountId: &counterparty,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.SearchTransfersParams{
+					Owner:                 user.Username,
+					Query:                 "rent",
+					CounterpartyAccountID: pgtype.Int8{Int64: counterparty, Valid: true},
+					BeforeID:              pgtype.Int8{Int64: 101, Valid: true},
+					PageSize:              6,
+				}
+				store.EXPECT().SearchTransfers(gomock.Any(), gomock.Eq(arg)).Times(1).Return(transfers, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, resp *pb.SearchTransfersResponse, err error) {
+				require.NoError(t, err)
+				require.Len(t, resp.GetTransfers(), 5)
+				require.Equal(t, "rent march", resp.GetTransfers()[0].GetMemo())
+
+				lastID, err := util.DecodePageToken(resp.GetNextPageToken())
+				require.NoError(t, err)
+				require.Equal(t, transfers[4].ID, lastID)
+			},
+		},
+		{
+			name:    "InvalidRequest",
+			request: &pb.SearchTransfersRequest{Query: strings.Repeat("a", 101), PageSize: 50, PageToken: "bogus"},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().SearchTransfers(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, resp *pb.SearchTransfersResponse, err error) {
+				requireStatusCode(t, codes.InvalidArgument, err)
+			},
+		},
+		{
+			name:    "NoAuthorization",
+			request: &pb.SearchTransfersRequest{Query: "rent", PageSize: 5},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().SearchTransfers(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return context.Background()
+			},
+			checkResponse: func(t *testing.T, resp *pb.SearchTransfersResponse, err error) {
+				requireStatusCode(t, codes.Unauthenticated, err)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil)
+			ctx := tc.buildContext(t, server.tokenMaker)
+			res, err := server.SearchTransfers(ctx, tc.request)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
@@ -0,0 +1,56 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	db "github.com/mahanth/simplebank/db/sqlc"
+	"github.com/mahanth/simplebank/pb"
+	"github.com/mahanth/simplebank/util"
+	"github.com/mahanth/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) SetCurrencyApprovalThreshold(ctx context.Context, req *pb.SetCurrencyApprovalThresholdRequest) (*pb.SetCurrencyApprovalThresholdResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, []string{util.BankerRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateSetCurrencyApprovalThresholdRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	currency, err := server.store.UpdateCurrencyApprovalThreshold(server.auditContext(ctx, authPayload.Username, authPayload.Role), db.UpdateCurrencyApprovalThresholdParams{
+		Code:              req.GetCode(),
+		ApprovalThreshold: pgtype.Int8{Int64: req.GetApprovalThreshold(), Valid: req.ApprovalThreshold != nil},
+		UpdatedBy:         pgtype.Text{String: authPayload.Username, Valid: true},
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "currency %s not found", req.GetCode())
+		}
+		return nil, status.Errorf(codes.Internal, "failed to update currency: %s", err)
+	}
+
+	response := &pb.SetCurrencyApprovalThresholdResponse{
+		Currency: convertCurrency(currency),
+	}
+	return response, nil
+}
+
+func validateSetCurrencyApprovalThresholdRequest(req *pb.SetCurrencyApprovalThresholdRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateCurrencyCode(req.GetCode()); err != nil {
+		violations = append(violations, fieldViolation("code", err))
+	}
+	if req.ApprovalThreshold != nil {
+		if err := val.ValidateAmount(req.GetApprovalThreshold()); err != nil {
+			violations = append(violations, fieldViolation("approval_threshold", err))
+		}
+	}
+	return violations
+}
@@ -0,0 +1,130 @@
+package gapi
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/jackc/pgx/v5/pgtype"
+	mockdb "github.com/mahanth/simplebank/db/mock"
+	db "github.com/mahanth/simplebank/db/sqlc"
+	"github.com/mahanth/simplebank/pb"
+	"github.com/mahanth/simplebank/token"
+	"github.com/mahanth/simplebank/util"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+)
+
+func TestSetCurrencyApprovalThresholdAPI(t *testing.T) {
+	threshold := int64(1000000)
+
+	testCases := []struct {
+		name          string
+		request       *pb.SetCurrencyApprovalThresholdRequest
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, resp *pb.SetCurrencyApprovalThresholdResponse, err error)
+	}{
+		{
+			name:    "OK",
+			request: &pb.SetCurrencyApprovalThresholdRequest{Code: util.USD, ApprovalThreshold: &threshold},
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.UpdateCurrencyApprovalThresholdParams{
+					Code:              util.USD,
+					ApprovalThreshold: pgtype.Int8{Int64: threshold, Valid: true},
+					UpdatedBy:         pgtype.Text{String: "banker", Valid: true},
+				}
+				store.EXPECT().UpdateCurrencyApprovalThreshold(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(db.Currency{Code: util.USD, Exponent: 2, Name: "US Dollar", Enabled: true,
+						UpdatedBy: arg.UpdatedBy, ApprovalThreshold: arg.ApprovalThreshold}, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, "banker", util.BankerRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, resp *pb.SetCurrencyApprovalThresholdResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, threshold, resp.GetCurrency().GetApprovalThreshold())
+				require.Equal(t, "banker", resp.GetCurrency().GetUpdatedBy())
+			},
+		},
+		{
+			name:    "Unset",
+			request: &pb.SetCurrencyApprovalThresholdRequest{Code: util.USD},
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.UpdateCurrencyApprovalThresholdParams{
+					Code:      util.USD,
+					UpdatedBy: pgtype.Text{String: "banker", Valid: true},
+				}
+				store.EXPECT().UpdateCurrencyApprovalThreshold(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(db.Currency{Code: util.USD, Exponent: 2, Name: "US Dollar", Enabled: true, UpdatedBy: arg.UpdatedBy}, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, "banker", util.BankerRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, resp *pb.SetCurrencyApprovalThresholdResponse, err error) {
+				require.NoError(t, err)
+				require.Nil(t, resp.GetCurrency().ApprovalThreshold)
+			},
+		},
+		{
+			name:    "NotFound",
+			request: &pb.SetCurrencyApprovalThresholdRequest{Code: "XYZ", ApprovalThreshold: &threshold},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().UpdateCurrencyApprovalThreshold(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.Currency{}, db.ErrRecordNotFound)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, "banker", util.BankerRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, resp *pb.SetCurrencyApprovalThresholdResponse, err error) {
+				requireStatusCode(t, codes.NotFound, err)
+			},
+		},
+		{
+			name:    "InvalidThreshold",
+			request: &pb.SetCurrencyApprovalThresholdRequest{Code: util.USD, ApprovalThreshold: new(int64)},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().UpdateCurrencyApprovalThreshold(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, "banker", util.BankerRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, resp *pb.SetCurrencyApprovalThresholdResponse, err error) {
+				requireStatusCode(t, codes.InvalidArgument, err)
+			},
+		},
+		{
+			name:    "NotBanker",
+			request: &pb.SetCurrencyApprovalThresholdRequest{Code: util.USD, ApprovalThreshold: &threshold},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().UpdateCurrencyApprovalThreshold(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, "depositor", util.DepositorRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, resp *pb.SetCurrencyApprovalThresholdResponse, err error) {
+				requireStatusCode(t, codes.Unauthenticated, err)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil)
+			ctx := tc.buildContext(t, server.tokenMaker)
+			res, err := server.SetCurrencyApprovalThreshold(ctx, tc.request)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
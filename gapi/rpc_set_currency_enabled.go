@@ -0,0 +1,54 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	db "github.com/mahanth/simplebank/db/sqlc"
+	"github.com/mahanth/simplebank/pb"
+	"github.com/mahanth/simplebank/util"
+	"github.com/mahanth/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) SetCurrencyEnabled(ctx context.Context, req *pb.SetCurrencyEnabledRequest) (*pb.SetCurrencyEnabledResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, []string{util.BankerRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateSetCurrencyEnabledRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	currency, err := server.store.UpdateCurrencyEnabled(server.auditContext(ctx, authPayload.Username, authPayload.Role), db.UpdateCurrencyEnabledParams{
+		Code:      req.GetCode(),
+		Enabled:   req.GetEnabled(),
+		UpdatedBy: pgtype.Text{String: authPayload.Username, Valid: true},
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "currency %s not found", req.GetCode())
+		}
+		return nil, status.Errorf(codes.Internal, "failed to update currency: %s", err)
+	}
+
+	// the registry of this server takes effect at once; other servers pick it up on their next refresh
+	util.SetCurrency(db.CurrencyFromDB(currency))
+
+	response := &pb.SetCurrencyEnabledResponse{
+		Currency: convertCurrency(currency),
+	}
+	return response, nil
+}
+
+func validateSetCurrencyEnabledRequest(req *pb.SetCurrencyEnabledRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateCurrencyCode(req.GetCode()); err != nil {
+		violations = append(violations, fieldViolation("code", err))
+	}
+	return violations
+}
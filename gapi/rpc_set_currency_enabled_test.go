@@ -0,0 +1,114 @@
+package gapi
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/jackc/pgx/v5/pgtype"
+	mockdb "github.com/mahanth/simplebank/db/mock"
+	db "github.com/mahanth/simplebank/db/sqlc"
+	"github.com/mahanth/simplebank/pb"
+	"github.com/mahanth/simplebank/token"
+	"github.com/mahanth/simplebank/util"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+)
+
+func TestSetCurrencyEnabledAPI(t *testing.T) {
+	defer util.LoadCurrencies(util.DefaultCurrencies)
+
+	testCases := []struct {
+		name          string
+		request       *pb.SetCurrencyEnabledRequest
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, resp *pb.SetCurrencyEnabledResponse, err error)
+	}{
+		{
+			name:    "OK",
+			request: &pb.SetCurrencyEnabledRequest{Code: util.JPY, Enabled: true},
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.UpdateCurrencyEnabledParams{
+					Code:      util.JPY,
+					Enabled:   true,
+					UpdatedBy: pgtype.Text{String: "banker", Valid: true},
+				}
+				store.EXPECT().UpdateCurrencyEnabled(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(db.Currency{Code: util.JPY, Exponent: 0, Name: "Yen", Enabled: true, UpdatedBy: arg.UpdatedBy}, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, "banker", util.BankerRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, resp *pb.SetCurrencyEnabledResponse, err error) {
+				require.NoError(t, err)
+				require.True(t, resp.GetCurrency().GetEnabled())
+				require.Equal(t, "banker", resp.GetCurrency().GetUpdatedBy())
+
+				// the currency can be used at once
+				require.True(t, util.IsSupportedCurrency(util.JPY))
+			},
+		},
+		{
+			name:    "NotFound",
+			request: &pb.SetCurrencyEnabledRequest{Code: "XYZ", Enabled: true},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().UpdateCurrencyEnabled(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.Currency{}, db.ErrRecordNotFound)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, "banker", util.BankerRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, resp *pb.SetCurrencyEnabledResponse, err error) {
+				requireStatusCode(t, codes.NotFound, err)
+				require.False(t, util.IsSupportedCurrency("XYZ"))
+			},
+		},
+		{
+			name:    "InvalidCode",
+			request: &pb.SetCurrencyEnabledRequest{Code: "usd", Enabled: true},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().UpdateCurrencyEnabled(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, "banker", util.BankerRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, resp *pb.SetCurrencyEnabledResponse, err error) {
+				requireStatusCode(t, codes.InvalidArgument, err)
+			},
+		},
+		{
+			name:    "NotBanker",
+			request: &pb.SetCurrencyEnabledRequest{Code: util.USD, Enabled: false},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().UpdateCurrencyEnabled(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, "depositor", util.DepositorRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, resp *pb.SetCurrencyEnabledResponse, err error) {
+				requireStatusCode(t, codes.Unauthenticated, err)
+				require.True(t, util.IsSupportedCurrency(util.USD))
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil)
+			ctx := tc.buildContext(t, server.tokenMaker)
+			res, err := server.SetCurrencyEnabled(ctx, tc.request)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
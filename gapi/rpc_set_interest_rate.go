@@ -0,0 +1,65 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	db "github.com/mahanth/simplebank/db/sqlc"
+	"github.com/mahanth/simplebank/pb"
+	"github.com/mahanth/simplebank/util"
+	"github.com/mahanth/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) SetInterestRate(ctx context.Context, req *pb.SetInterestRateRequest) (*pb.SetInterestRateResponse, error) {
+	_, err := server.authorizeUser(ctx, []string{util.BankerRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateSetInterestRateRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	// the rate was validated above
+	var annualRate pgtype.Numeric
+	_ = annualRate.Scan(req.GetAnnualRate())
+
+	effectiveAt := time.Now()
+	if req.EffectiveAt != nil {
+		effectiveAt = req.GetEffectiveAt().AsTime()
+	}
+
+	rate, err := server.store.UpsertInterestRate(ctx, db.UpsertInterestRateParams{
+		AccountType: req.GetAccountType(),
+		Currency:    req.GetCurrency(),
+		AnnualRate:  annualRate,
+		EffectiveAt: pgtype.Timestamptz{Time: effectiveAt, Valid: true},
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to set interest rate: %s", err)
+	}
+
+	response := &pb.SetInterestRateResponse{
+		InterestRate: convertInterestRate(rate),
+	}
+	return response, nil
+}
+
+func validateSetInterestRateRequest(req *pb.SetInterestRateRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if !db.IsSupportedAccountType(req.GetAccountType()) {
+		violations = append(violations, fieldViolation("account_type", fmt.Errorf("unsupported account type %q", req.GetAccountType())))
+	}
+	if err := val.ValidateCurrency(req.GetCurrency()); err != nil {
+		violations = append(violations, fieldViolation("currency", err))
+	}
+	if err := val.ValidateInterestRate(req.GetAnnualRate()); err != nil {
+		violations = append(violations, fieldViolation("annual_rate", err))
+	}
+	return violations
+}
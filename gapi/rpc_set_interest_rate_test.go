@@ -0,0 +1,139 @@
+package gapi
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/jackc/pgx/v5/pgtype"
+	mockdb "github.com/mahanth/simplebank/db/mock"
+	db "github.com/mahanth/simplebank/db/sqlc"
+	"github.com/mahanth/simplebank/pb"
+	"github.com/mahanth/simplebank/token"
+	"github.com/mahanth/simplebank/util"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestSetInterestRateAPI(t *testing.T) {
+	user, _ := randomUser()
+	effectiveAt := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	var annualRate pgtype.Numeric
+	require.NoError(t, annualRate.Scan("0.025"))
+
+	rate := db.InterestRate{
+		ID:          1,
+		AccountType: db.AccountTypeSavings,
+		Currency:    util.USD,
+		AnnualRate:  annualRate,
+		EffectiveAt: pgtype.Timestamptz{Time: effectiveAt, Valid: true},
+	}
+
+	testCases := []struct {
+		name          string
+		request       *pb.SetInterestRateRequest
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, resp *pb.SetInterestRateResponse, err error)
+	}{
+		{
+			name: "OK",
+			request: &pb.SetInterestRateRequest{
+				AccountType: db.AccountTypeSavings,
+				Currency:    util.USD,
+				AnnualRate:  "0.025",
+				EffectiveAt: timestamppb.New(effectiveAt),
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.UpsertInterestRateParams{
+					AccountType: db.AccountTypeSavings,
+					Currency:    util.USD,
+					AnnualRate:  annualRate,
+					EffectiveAt: pgtype.Timestamptz{Time: effectiveAt, Valid: true},
+				}
+				store.EXPECT().UpsertInterestRate(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(rate, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, "banker", util.BankerRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, resp *pb.SetInterestRateResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, db.AccountTypeSavings, resp.GetInterestRate().GetAccountType())
+				require.Equal(t, "0.025", resp.GetInterestRate().GetAnnualRate())
+				require.True(t, effectiveAt.Equal(resp.GetInterestRate().GetEffectiveAt().AsTime()))
+			},
+		},
+		{
+			name: "DepositorCannotSetRate",
+			request: &pb.SetInterestRateRequest{
+				AccountType: db.AccountTypeSavings,
+				Currency:    util.USD,
+				AnnualRate:  "0.025",
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().UpsertInterestRate(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, util.DepositorRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, resp *pb.SetInterestRateResponse, err error) {
+				requireStatusCode(t, codes.Unauthenticated, err)
+			},
+		},
+		{
+			name: "InvalidRate",
+			request: &pb.SetInterestRateRequest{
+				AccountType: db.AccountTypeSavings,
+				Currency:    util.USD,
+				AnnualRate:  "2.5%",
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().UpsertInterestRate(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, "banker", util.BankerRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, resp *pb.SetInterestRateResponse, err error) {
+				requireStatusCode(t, codes.InvalidArgument, err)
+			},
+		},
+		{
+			name: "UnsupportedAccountType",
+			request: &pb.SetInterestRateRequest{
+				AccountType: "brokerage",
+				Currency:    util.USD,
+				AnnualRate:  "0.025",
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().UpsertInterestRate(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, "banker", util.BankerRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, resp *pb.SetInterestRateResponse, err error) {
+				requireStatusCode(t, codes.InvalidArgument, err)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil)
+			ctx := tc.buildContext(t, server.tokenMaker)
+			res, err := server.SetInterestRate(ctx, tc.request)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
@@ -0,0 +1,87 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	db "github.com/mahanth/simplebank/db/sqlc"
+	"github.com/mahanth/simplebank/pb"
+	"github.com/mahanth/simplebank/util"
+	"github.com/mahanth/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) SetTransferLimits(ctx context.Context, req *pb.SetTransferLimitsRequest) (*pb.SetTransferLimitsResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, []string{util.BankerRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateSetTransferLimitsRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	maxSingle := pgtype.Int8{Int64: req.GetMaxSingle(), Valid: req.MaxSingle != nil}
+	maxDailyTotal := pgtype.Int8{Int64: req.GetMaxDailyTotal(), Valid: req.MaxDailyTotal != nil}
+	maxDailyCount := pgtype.Int4{Int32: req.GetMaxDailyCount(), Valid: req.MaxDailyCount != nil}
+
+	var limit db.TransferLimit
+	if req.Username != nil {
+		limit, err = server.store.UpsertUserTransferLimit(server.auditContext(ctx, authPayload.Username, authPayload.Role), db.UpsertUserTransferLimitParams{
+			Username:      pgtype.Text{String: req.GetUsername(), Valid: true},
+			MaxSingle:     maxSingle,
+			MaxDailyTotal: maxDailyTotal,
+			MaxDailyCount: maxDailyCount,
+			UpdatedBy:     authPayload.Username,
+		})
+	} else {
+		limit, err = server.store.UpsertAccountTransferLimit(server.auditContext(ctx, authPayload.Username, authPayload.Role), db.UpsertAccountTransferLimitParams{
+			AccountID:     pgtype.Int8{Int64: req.GetAccountId(), Valid: true},
+			MaxSingle:     maxSingle,
+			MaxDailyTotal: maxDailyTotal,
+			MaxDailyCount: maxDailyCount,
+			UpdatedBy:     authPayload.Username,
+		})
+	}
+	if err != nil {
+		if db.ErrorCode(err) == db.ForeignKeyViolation {
+			return nil, status.Errorf(codes.NotFound, "user or account not found: %s", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to set transfer limits: %s", err)
+	}
+
+	response := &pb.SetTransferLimitsResponse{
+		TransferLimit: convertTransferLimit(limit),
+	}
+	return response, nil
+}
+
+func validateSetTransferLimitsRequest(req *pb.SetTransferLimitsRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if (req.Username == nil) == (req.AccountId == nil) {
+		violations = append(violations, fieldViolation("username", errors.New("exactly one of username and account_id must be set")))
+	}
+	if req.Username != nil {
+		if err := val.ValidateUsername(req.GetUsername()); err != nil {
+			violations = append(violations, fieldViolation("username", err))
+		}
+	}
+	if req.AccountId != nil {
+		if err := val.ValidateID(req.GetAccountId()); err != nil {
+			violations = append(violations, fieldViolation("account_id", err))
+		}
+	}
+	if req.GetMaxSingle() < 0 {
+		violations = append(violations, fieldViolation("max_single", errors.New("must not be negative")))
+	}
+	if req.GetMaxDailyTotal() < 0 {
+		violations = append(violations, fieldViolation("max_daily_total", errors.New("must not be negative")))
+	}
+	if req.GetMaxDailyCount() < 0 {
+		violations = append(violations, fieldViolation("max_daily_count", errors.New("must not be negative")))
+	}
+	return violations
+}
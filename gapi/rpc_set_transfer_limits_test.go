@@ -0,0 +1,182 @@
+package gapi
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	mockdb "github.com/mahanth/simplebank/db/mock"
+	db "github.com/mahanth/simplebank/db/sqlc"
+	"github.com/mahanth/simplebank/pb"
+	"github.com/mahanth/simplebank/token"
+	"github.com/mahanth/simplebank/util"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+)
+
+func TestSetTransferLimitsAPI(t *testing.T) {
+	user, _ := randomUser()
+	user.Username = "limited_user"
+	account := randomAccount(user.Username)
+
+	maxSingle := int64(10000)
+	maxDailyCount := int32(0)
+	negative := int64(-1)
+
+	testCases := []struct {
+		name          string
+		request       *pb.SetTransferLimitsRequest
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, resp *pb.SetTransferLimitsResponse, err error)
+	}{
+		{
+			name: "UserOverride",
+			request: &pb.SetTransferLimitsRequest{
+				Username:      &user.Username,
+				MaxSingle:     &maxSingle,
+				MaxDailyCount: &maxDailyCount,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.UpsertUserTransferLimitParams{
+					Username:      pgtype.Text{String: user.Username, Valid: true},
+					MaxSingle:     pgtype.Int8{Int64: maxSingle, Valid: true},
+					MaxDailyCount: pgtype.Int4{Int32: 0, Valid: true},
+					UpdatedBy:     "banker",
+				}
+				store.EXPECT().UpsertUserTransferLimit(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(db.TransferLimit{
+						ID:            1,
+						Username:      arg.Username,
+						MaxSingle:     arg.MaxSingle,
+						MaxDailyCount: arg.MaxDailyCount,
+						UpdatedBy:     arg.UpdatedBy,
+					}, nil)
+				store.EXPECT().UpsertAccountTransferLimit(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, "banker", util.BankerRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, resp *pb.SetTransferLimitsResponse, err error) {
+				require.NoError(t, err)
+				limit := resp.GetTransferLimit()
+				require.Equal(t, user.Username, limit.GetUsername())
+				require.Equal(t, maxSingle, limit.GetMaxSingle())
+				require.Nil(t, limit.MaxDailyTotal)
+				require.NotNil(t, limit.MaxDailyCount)
+				require.Zero(t, limit.GetMaxDailyCount())
+			},
+		},
+		{
+			name: "AccountOverride",
+			request: &pb.SetTransferLimitsRequest{
+				AccountId: &account.ID,
+				MaxSingle: &maxSingle,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.UpsertAccountTransferLimitParams{
+					AccountID: pgtype.Int8{Int64: account.ID, Valid: true},
+					MaxSingle: pgtype.Int8{Int64: maxSingle, Valid: true},
+					UpdatedBy: "banker",
+				}
+				store.EXPECT().UpsertAccountTransferLimit(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(db.TransferLimit{ID: 1, AccountID: arg.AccountID, MaxSingle: arg.MaxSingle, UpdatedBy: arg.UpdatedBy}, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, "banker", util.BankerRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, resp *pb.SetTransferLimitsResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, account.ID, resp.GetTransferLimit().GetAccountId())
+			},
+		},
+		{
+			name: "AccountNotFound",
+			request: &pb.SetTransferLimitsRequest{
+				AccountId: &account.ID,
+				MaxSingle: &maxSingle,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().UpsertAccountTransferLimit(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.TransferLimit{}, &pgconn.PgError{Code: db.ForeignKeyViolation})
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, "banker", util.BankerRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, resp *pb.SetTransferLimitsResponse, err error) {
+				requireStatusCode(t, codes.NotFound, err)
+			},
+		},
+		{
+			name: "DepositorCannotSetLimits",
+			request: &pb.SetTransferLimitsRequest{
+				Username:  &user.Username,
+				MaxSingle: &maxSingle,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().UpsertUserTransferLimit(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, util.DepositorRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, resp *pb.SetTransferLimitsResponse, err error) {
+				requireStatusCode(t, codes.Unauthenticated, err)
+			},
+		},
+		{
+			name: "BothUsernameAndAccount",
+			request: &pb.SetTransferLimitsRequest{
+				Username:  &user.Username,
+				AccountId: &account.ID,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().UpsertUserTransferLimit(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().UpsertAccountTransferLimit(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, "banker", util.BankerRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, resp *pb.SetTransferLimitsResponse, err error) {
+				requireStatusCode(t, codes.InvalidArgument, err)
+			},
+		},
+		{
+			name: "NegativeLimit",
+			request: &pb.SetTransferLimitsRequest{
+				Username:      &user.Username,
+				MaxDailyTotal: &negative,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().UpsertUserTransferLimit(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, "banker", util.BankerRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, resp *pb.SetTransferLimitsResponse, err error) {
+				requireStatusCode(t, codes.InvalidArgument, err)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil)
+			ctx := tc.buildContext(t, server.tokenMaker)
+			res, err := server.SetTransferLimits(ctx, tc.request)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
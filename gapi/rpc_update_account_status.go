@@ -0,0 +1,59 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	db "github.com/mahanth/simplebank/db/sqlc"
+	"github.com/mahanth/simplebank/pb"
+	"github.com/mahanth/simplebank/util"
+	"github.com/mahanth/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) UpdateAccountStatus(ctx context.Context, req *pb.UpdateAccountStatusRequest) (*pb.UpdateAccountStatusResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, []string{util.BankerRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateUpdateAccountStatusRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	result, err := server.store.ChangeAccountStatusTx(server.auditContext(ctx, authPayload.Username, authPayload.Role), db.ChangeAccountStatusTxParams{
+		AccountID: req.GetAccountId(),
+		Status:    req.GetStatus(),
+		ChangedBy: authPayload.Username,
+		Reason:    req.GetReason(),
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "account %d not found", req.GetAccountId())
+		}
+		return nil, transferError(err)
+	}
+
+	response := &pb.UpdateAccountStatusResponse{
+		Account: convertAccount(result.Account),
+		Change:  convertAccountStatusChange(result.Change),
+	}
+	return response, nil
+}
+
+func validateUpdateAccountStatusRequest(req *pb.UpdateAccountStatusRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateID(req.GetAccountId()); err != nil {
+		violations = append(violations, fieldViolation("account_id", err))
+	}
+	if !db.IsSupportedAccountStatus(req.GetStatus()) {
+		violations = append(violations, fieldViolation("status", fmt.Errorf("unsupported account status %q", req.GetStatus())))
+	}
+	if err := val.ValidateString(req.GetReason(), 1, 255); err != nil {
+		violations = append(violations, fieldViolation("reason", err))
+	}
+	return violations
+}
@@ -0,0 +1,178 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	mockdb "github.com/mahanth/simplebank/db/mock"
+	db "github.com/mahanth/simplebank/db/sqlc"
+	"github.com/mahanth/simplebank/pb"
+	"github.com/mahanth/simplebank/token"
+	"github.com/mahanth/simplebank/util"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+)
+
+func TestUpdateAccountStatusAPI(t *testing.T) {
+	user, _ := randomUser()
+	account := randomAccount(user.Username)
+
+	testCases := []struct {
+		name          string
+		request       *pb.UpdateAccountStatusRequest
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, resp *pb.UpdateAccountStatusResponse, err error)
+	}{
+		{
+			name: "Freeze",
+			request: &pb.UpdateAccountStatusRequest{
+				AccountId: account.ID,
+				Status:    db.AccountStatusFrozen,
+				Reason:    "suspected fraud",
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.ChangeAccountStatusTxParams{
+					AccountID: account.ID,
+					Status:    db.AccountStatusFrozen,
+					ChangedBy: "banker",
+					Reason:    "suspected fraud",
+				}
+				frozen := account
+				frozen.Status = db.AccountStatusFrozen
+				store.EXPECT().ChangeAccountStatusTx(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(db.ChangeAccountStatusTxResult{
+						Account: frozen,
+						Change: db.AccountStatusChange{
+							ID:         1,
+							AccountID:  account.ID,
+							FromStatus: db.AccountStatusActive,
+							ToStatus:   db.AccountStatusFrozen,
+							ChangedBy:  "banker",
+							Reason:     "suspected fraud",
+						},
+					}, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, "banker", util.BankerRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, resp *pb.UpdateAccountStatusResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, db.AccountStatusFrozen, resp.GetAccount().GetStatus())
+				require.Equal(t, db.AccountStatusActive, resp.GetChange().GetFromStatus())
+				require.Equal(t, "banker", resp.GetChange().GetChangedBy())
+			},
+		},
+		{
+			name: "DepositorCannotChangeStatus",
+			request: &pb.UpdateAccountStatusRequest{
+				AccountId: account.ID,
+				Status:    db.AccountStatusClosed,
+				Reason:    "moving banks",
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ChangeAccountStatusTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, util.DepositorRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, resp *pb.UpdateAccountStatusResponse, err error) {
+				requireStatusCode(t, codes.Unauthenticated, err)
+			},
+		},
+		{
+			name: "CloseWithBalance",
+			request: &pb.UpdateAccountStatusRequest{
+				AccountId: account.ID,
+				Status:    db.AccountStatusClosed,
+				Reason:    "customer request",
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ChangeAccountStatusTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.ChangeAccountStatusTxResult{}, fmt.Errorf("%w: account %d", db.ErrAccountNotEmpty, account.ID))
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, "banker", util.BankerRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, resp *pb.UpdateAccountStatusResponse, err error) {
+				requireStatusCode(t, codes.FailedPrecondition, err)
+			},
+		},
+		{
+			name: "InvalidChange",
+			request: &pb.UpdateAccountStatusRequest{
+				AccountId: account.ID,
+				Status:    db.AccountStatusActive,
+				Reason:    "reopen",
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ChangeAccountStatusTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.ChangeAccountStatusTxResult{}, fmt.Errorf("%w: account %d is closed", db.ErrInvalidStatusChange, account.ID))
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, "banker", util.BankerRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, resp *pb.UpdateAccountStatusResponse, err error) {
+				requireStatusCode(t, codes.FailedPrecondition, err)
+			},
+		},
+		{
+			name: "AccountNotFound",
+			request: &pb.UpdateAccountStatusRequest{
+				AccountId: account.ID,
+				Status:    db.AccountStatusFrozen,
+				Reason:    "suspected fraud",
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ChangeAccountStatusTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.ChangeAccountStatusTxResult{}, db.ErrRecordNotFound)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, "banker", util.BankerRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, resp *pb.UpdateAccountStatusResponse, err error) {
+				requireStatusCode(t, codes.NotFound, err)
+			},
+		},
+		{
+			name: "UnsupportedStatus",
+			request: &pb.UpdateAccountStatusRequest{
+				AccountId: account.ID,
+				Status:    "deleted",
+				Reason:    "cleanup",
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ChangeAccountStatusTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, "banker", util.BankerRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, resp *pb.UpdateAccountStatusResponse, err error) {
+				requireStatusCode(t, codes.InvalidArgument, err)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil)
+			ctx := tc.buildContext(t, server.tokenMaker)
+			res, err := server.UpdateAccountStatus(ctx, tc.request)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
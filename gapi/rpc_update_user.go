@@ -44,7 +44,7 @@ func (server *Server) UpdateUser(ctx context.Context, req *pb.UpdateUserRequest)
 		arg.HashedPassword = pgtype.Text{String: hashedPassword, Valid: hashedPassword != ""}
 	}
 
-	user, err := server.store.UpdateUser(ctx, arg)
+	user, err := server.store.UpdateUser(server.auditContext(ctx, authPayload.Username, authPayload.Role), arg)
 	if err != nil {
 		return nil, status.Errorf(codes.Unknown, "failed to update user %s", err)
 	}
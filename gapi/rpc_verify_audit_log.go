@@ -0,0 +1,40 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/mahanth/simplebank/pb"
+	"github.com/mahanth/simplebank/util"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) VerifyAuditLog(ctx context.Context, req *pb.VerifyAuditLogRequest) (*pb.VerifyAuditLogResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, []string{util.BankerRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	result, err := server.store.VerifyAuditLog(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to verify audit log: %s", err)
+	}
+
+	response := &pb.VerifyAuditLogResponse{
+		Intact:         result.Tampered == nil,
+		EventsVerified: result.EventsVerified,
+		HeadHash:       result.HeadHash,
+	}
+	if result.Tampered != nil {
+		response.TamperedEventId = &result.Tampered.EventID
+		response.Reason = result.Tampered.Reason
+
+		log.Error().
+			Int64("event_id", result.Tampered.EventID).
+			Str("reason", result.Tampered.Reason).
+			Str("verified_by", authPayload.Username).
+			Msg("audit log was tampered with")
+	}
+	return response, nil
+}
@@ -0,0 +1,116 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	mockdb "github.com/mahanth/simplebank/db/mock"
+	db "github.com/mahanth/simplebank/db/sqlc"
+	"github.com/mahanth/simplebank/pb"
+	"github.com/mahanth/simplebank/token"
+	"github.com/mahanth/simplebank/util"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+)
+
+func TestVerifyAuditLogAPI(t *testing.T) {
+	banker := "banker"
+	headHash := util.RandomString(64)
+
+	testCases := []struct {
+		name          string
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, resp *pb.VerifyAuditLogResponse, err error)
+	}{
+		{
+			name: "Intact",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					VerifyAuditLog(gomock.Any()).
+					Times(1).
+					Return(db.AuditVerification{EventsVerified: 42, HeadHash: headHash}, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker, util.BankerRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, resp *pb.VerifyAuditLogResponse, err error) {
+				require.NoError(t, err)
+				require.True(t, resp.GetIntact())
+				require.Equal(t, int64(42), resp.GetEventsVerified())
+				require.Equal(t, headHash, resp.GetHeadHash())
+				require.Nil(t, resp.TamperedEventId)
+				require.Empty(t, resp.GetReason())
+			},
+		},
+		{
+			name: "Tampered",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					VerifyAuditLog(gomock.Any()).
+					Times(1).
+					Return(db.AuditVerification{
+						EventsVerified: 7,
+						HeadHash:       headHash,
+						Tampered:       &db.AuditTamperError{EventID: 8, Reason: "content does not match its hash"},
+					}, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker, util.BankerRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, resp *pb.VerifyAuditLogResponse, err error) {
+				require.NoError(t, err)
+				require.False(t, resp.GetIntact())
+				require.Equal(t, int64(7), resp.GetEventsVerified())
+				require.Equal(t, int64(8), resp.GetTamperedEventId())
+				require.Equal(t, "content does not match its hash", resp.GetReason())
+			},
+		},
+		{
+			name: "DepositorNotAllowed",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().VerifyAuditLog(gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, "depositor", util.DepositorRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, resp *pb.VerifyAuditLogResponse, err error) {
+				requireStatusCode(t, codes.Unauthenticated, err)
+			},
+		},
+		{
+			name: "InternalError",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					VerifyAuditLog(gomock.Any()).
+					Times(1).
+					Return(db.AuditVerification{}, fmt.Errorf("connection refused"))
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker, util.BankerRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, resp *pb.VerifyAuditLogResponse, err error) {
+				requireStatusCode(t, codes.Internal, err)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			store := mockdb.NewMockStore(ctrl)
+
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil)
+			ctx := tc.buildContext(t, server.tokenMaker)
+			res, err := server.VerifyAuditLog(ctx, &pb.VerifyAuditLogRequest{})
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"log"
 	"net"
 	"net/http"
+	"time"
 
 	"github.com/golang-migrate/migrate/v4"
 	_ "github.com/golang-migrate/migrate/v4/database/postgres"
@@ -15,7 +16,9 @@ import (
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	db "github.com/mahanth/simplebank/db/sqlc"
 	"github.com/mahanth/simplebank/email"
+	"github.com/mahanth/simplebank/fx"
 	"github.com/mahanth/simplebank/util"
+	"github.com/mahanth/simplebank/webhook"
 	"github.com/mahanth/simplebank/worker"
 	"github.com/rakyll/statik/fs"
 	"google.golang.org/grpc"
@@ -40,15 +43,26 @@ func main() {
 	}
 
 	ctx := context.Background()
-	dbConnPool, err := pgxpool.New(ctx, config.DBSource)
-	if err != nil {
-		log.Fatalf("error getting new db connection pool")
-		panic(err)
+
+	txRetry := db.DefaultTxRetryPolicy
+	txRetry.MaxRetries = config.TxMaxRetries
+
+	storeOpts := []db.StoreOption{
+		db.WithTransferLimits(db.TransferLimits{
+			MaxSingle:     config.TransferMaxSingle,
+			MaxDailyTotal: config.TransferMaxDailyTotal,
+			MaxDailyCount: config.TransferMaxDailyCount,
+		}),
+		db.WithTxRetryPolicy(txRetry),
 	}
 
-	runDBMigration(config.MigrationURL, config.DBSource)
+	store := newStore(ctx, config, storeOpts)
 
-	store := db.NewStore(dbConnPool)
+	loadCurrencies(ctx, store)
+
+	if config.ExchangeRatesFile != "" {
+		loadExchangeRates(ctx, config.ExchangeRatesFile, store)
+	}
 
 	redisOpt := asynq.RedisClientOpt{
 		Addr: config.RedisAddress,
@@ -56,12 +70,45 @@ func main() {
 
 	taskDistributor := worker.NewRedisTaskDistributor(redisOpt)
 
-	go runTaskProcessor(config, redisOpt, store)
+	go runTaskProcessor(config, redisOpt, store, taskDistributor)
+	go runTaskScheduler(config, redisOpt)
+	go runOutboxRelay(ctx, config, store, taskDistributor)
+	go runCurrencyRefresh(ctx, config, store)
+	go runTxStatsLog(ctx, config, store)
 	go runGrpcGateway(config, store, taskDistributor)
 	runGrpcServer(config, store, taskDistributor)
 
 }
 
+// newStore returns the store selected by DB_DRIVER: "memory" keeps the data in memory for as long
+// as the server runs, anything else uses the Postgres database at DBSOURCE.
+func newStore(ctx context.Context, config util.Config, opts []db.StoreOption) db.Store {
+	if config.DBDriver == "memory" {
+		log.Println("using an in-memory store, data is lost when the server stops")
+		return db.NewMemoryStore(opts...)
+	}
+
+	dbConnPool, err := pgxpool.New(ctx, config.DBSource)
+	if err != nil {
+		log.Fatalf("error getting new db connection pool")
+		panic(err)
+	}
+
+	runDBMigration(config.MigrationURL, config.DBSource)
+
+	if config.DBReplicaSource != "" {
+		// the pool connects lazily, so an unreachable replica only sends reads to the primary
+		replicaConnPool, err := pgxpool.New(ctx, config.DBReplicaSource)
+		if err != nil {
+			log.Fatalf("error getting new db replica connection pool")
+			panic(err)
+		}
+		opts = append(opts, db.WithReplica(replicaConnPool))
+	}
+
+	return db.NewStore(dbConnPool, opts...)
+}
+
 func runDBMigration(migrationURL string, dbSource string) {
 	migrationObject, err := migrate.New(migrationURL, dbSource)
 	if err != nil {
@@ -74,16 +121,132 @@ func runDBMigration(migrationURL string, dbSource string) {
 	log.Println("db migrated successfully")
 }
 
-func runTaskProcessor(config util.Config, redisOpt asynq.RedisClientOpt, store db.Store) {
+// loadCurrencies replaces the built-in currency registry with the currencies bankers manage in the database.
+func loadCurrencies(ctx context.Context, store db.Store) {
+	n, err := reloadCurrencies(ctx, store)
+	if err != nil {
+		log.Fatal("cannot load currencies: ", err)
+	}
+	log.Printf("loaded %d currencies, %v enabled", n, util.SupportedCurrencies())
+}
+
+// reloadCurrencies replaces the currency registry with the currencies in the database and
+// returns how many there are.
+func reloadCurrencies(ctx context.Context, store db.Store) (int, error) {
+	currencies, err := store.ListCurrencies(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	registry := make([]util.Currency, len(currencies))
+	for i, currency := range currencies {
+		registry[i] = db.CurrencyFromDB(currency)
+	}
+	util.LoadCurrencies(registry)
+	return len(registry), nil
+}
+
+// runCurrencyRefresh reloads the currency registry periodically, so that a currency enabled or
+// disabled through another server takes effect in this one, the worker included.
+func runCurrencyRefresh(ctx context.Context, config util.Config, store db.Store) {
+	interval := config.CurrencyRefreshInterval
+	if interval <= 0 {
+		interval = 30 * time.Second
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := reloadCurrencies(ctx, store); err != nil {
+				log.Println("cannot refresh currencies: ", err)
+			}
+		}
+	}
+}
+
+// runTxStatsLog logs the retry counts of the store's transactions periodically whenever they
+// changed, so that rising contention shows up before transactions start to fail.
+func runTxStatsLog(ctx context.Context, config util.Config, store db.Store) {
+	interval := config.TxStatsInterval
+	if interval <= 0 {
+		interval = time.Minute
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	var last db.TxStats
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			stats := store.TxStats()
+			if stats == last {
+				continue
+			}
+			log.Printf("transaction retries: %d (deadlocks: %d, serialization failures: %d, exhausted: %d)",
+				stats.Retries, stats.Deadlocks, stats.SerializationFailures, stats.Exhausted)
+			last = stats
+		}
+	}
+}
+
+// loadExchangeRates stores the rates of an ECB reference rate file, in XML or CSV format.
+func loadExchangeRates(ctx context.Context, path string, store db.Store) {
+	rates, err := fx.LoadFile(path)
+	if err != nil {
+		log.Fatal("cannot read exchange rates: ", err)
+	}
+
+	n, err := store.LoadExchangeRatesTx(ctx, rates)
+	if err != nil {
+		log.Fatal("cannot load exchange rates: ", err)
+	}
+	log.Printf("loaded %d exchange rates from %s", n, path)
+}
+
+func runTaskProcessor(config util.Config, redisOpt asynq.RedisClientOpt, store db.Store, taskDistributor worker.TaskDistributor) {
 	// Initialize the email sender
 	mailer := email.NewGmailSender(config.EmailSenderName, config.EmailSenderAddress, config.EmailSenderPassword)
-	taskProcessor := worker.NewRedisTaskProcessor(redisOpt, store, mailer)
+	webhookTimeout := config.WebhookTimeout
+	if webhookTimeout <= 0 {
+		webhookTimeout = 10 * time.Second
+	}
+	webhooks := webhook.NewHTTPSender(webhookTimeout)
+	taskProcessor := worker.NewRedisTaskProcessor(redisOpt, store, mailer, webhooks, taskDistributor)
 	log.Println("start task processor")
 	err := taskProcessor.Start()
 	if err != nil {
 		log.Fatal("error starting the task processor server", err)
 	}
 }
+
+func runTaskScheduler(config util.Config, redisOpt asynq.RedisClientOpt) {
+	taskScheduler := worker.NewRedisTaskScheduler(redisOpt, config)
+	log.Println("start task scheduler")
+	err := taskScheduler.Start()
+	if err != nil {
+		log.Fatal("error starting the task scheduler", err)
+	}
+}
+
+func runOutboxRelay(ctx context.Context, config util.Config, store db.Store, taskDistributor worker.TaskDistributor) {
+	interval := config.OutboxRelayInterval
+	if interval <= 0 {
+		interval = time.Second
+	}
+	relay := worker.NewOutboxRelay(store, taskDistributor, interval)
+	log.Println("start outbox relay")
+	err := relay.Start(ctx)
+	if err != nil {
+		log.Fatal("outbox relay stopped", err)
+	}
+}
+
 func runGrpcServer(config util.Config, store db.Store, taskDistributor worker.TaskDistributor) {
 
 	server, err := gapi.NewServer(config, store, taskDistributor)
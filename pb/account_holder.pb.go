@@ -0,0 +1,211 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v6.31.1
+// source: account_holder.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// AccountHolder is a user other than the owner who shares an account.
+type AccountHolder struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	AccountId int64                  `protobuf:"varint,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Username  string                 `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	// view, transfer or manage, each including the ones before it
+	Permission string `protobuf:"bytes,4,opt,name=permission,proto3" json:"permission,omitempty"`
+	// largest single transfer the holder may make, unset for no limit
+	TransferLimit *int64 `protobuf:"varint,5,opt,name=transfer_limit,json=transferLimit,proto3,oneof" json:"transfer_limit,omitempty"`
+	// invited until the user accepts, then active
+	Status    string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	InvitedBy string                 `protobuf:"bytes,7,opt,name=invited_by,json=invitedBy,proto3" json:"invited_by,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// unset until the invitation is accepted
+	AcceptedAt    *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=accepted_at,json=acceptedAt,proto3" json:"accepted_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AccountHolder) Reset() {
+	*x = AccountHolder{}
+	mi := &file_account_holder_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountHolder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountHolder) ProtoMessage() {}
+
+func (x *AccountHolder) ProtoReflect() protoreflect.Message {
+	mi := &file_account_holder_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountHolder.ProtoReflect.Descriptor instead.
+func (*AccountHolder) Descriptor() ([]byte, []int) {
+	return file_account_holder_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AccountHolder) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AccountHolder) GetAccountId() int64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *AccountHolder) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *AccountHolder) GetPermission() string {
+	if x != nil {
+		return x.Permission
+	}
+	return ""
+}
+
+func (x *AccountHolder) GetTransferLimit() int64 {
+	if x != nil && x.TransferLimit != nil {
+		return *x.TransferLimit
+	}
+	return 0
+}
+
+func (x *AccountHolder) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *AccountHolder) GetInvitedBy() string {
+	if x != nil {
+		return x.InvitedBy
+	}
+	return ""
+}
+
+func (x *AccountHolder) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *AccountHolder) GetAcceptedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AcceptedAt
+	}
+	return nil
+}
+
+var File_account_holder_proto protoreflect.FileDescriptor
+
+const file_account_holder_proto_rawDesc = "" +
+	"\n" +
+	"\x14account_holder.proto\x12\x02pb\x1a\x1fgoogle/protobuf/timestamp.proto\"\xe8\x02\n" +
+	"\rAccountHolder\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x02 \x01(\x03R\taccountId\x12\x1a\n" +
+	"\busername\x18\x03 \x01(\tR\busername\x12\x1e\n" +
+	"\n" +
+	"permission\x18\x04 \x01(\tR\n" +
+	"permission\x12*\n" +
+	"\x0etransfer_limit\x18\x05 \x01(\x03H\x00R\rtransferLimit\x88\x01\x01\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x12\x1d\n" +
+	"\n" +
+	"invited_by\x18\a \x01(\tR\tinvitedBy\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12;\n" +
+	"\vaccepted_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"acceptedAtB\x11\n" +
+	"\x0f_transfer_limitB\"Z github.com/mahanth/simplebank/pbb\x06proto3"
+
+var (
+	file_account_holder_proto_rawDescOnce sync.Once
+	file_account_holder_proto_rawDescData []byte
+)
+
+func file_account_holder_proto_rawDescGZIP() []byte {
+	file_account_holder_proto_rawDescOnce.Do(func() {
+		file_account_holder_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_account_holder_proto_rawDesc), len(file_account_holder_proto_rawDesc)))
+	})
+	return file_account_holder_proto_rawDescData
+}
+
+var file_account_holder_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_account_holder_proto_goTypes = []any{
+	(*AccountHolder)(nil),         // 0: pb.AccountHolder
+	(*timestamppb.Timestamp)(nil), // 1: google.protobuf.Timestamp
+}
+var file_account_holder_proto_depIdxs = []int32{
+	1, // 0: pb.AccountHolder.created_at:type_name -> google.protobuf.Timestamp
+	1, // 1: pb.AccountHolder.accepted_at:type_name -> google.protobuf.Timestamp
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_account_holder_proto_init() }
+func file_account_holder_proto_init() {
+	if File_account_holder_proto != nil {
+		return
+	}
+	file_account_holder_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_account_holder_proto_rawDesc), len(file_account_holder_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_account_holder_proto_goTypes,
+		DependencyIndexes: file_account_holder_proto_depIdxs,
+		MessageInfos:      file_account_holder_proto_msgTypes,
+	}.Build()
+	File_account_holder_proto = out.File
+	file_account_holder_proto_goTypes = nil
+	file_account_holder_proto_depIdxs = nil
+}
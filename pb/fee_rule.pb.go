@@ -0,0 +1,221 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v6.31.1
+// source: fee_rule.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type FeeRule struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// empty for any currency
+	Currency string `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	// empty for any role
+	Role string `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	// unset for both same-owner and cross-owner transfers
+	SameOwner *bool `protobuf:"varint,4,opt,name=same_owner,json=sameOwner,proto3,oneof" json:"same_owner,omitempty"`
+	FlatFee   int64 `protobuf:"varint,5,opt,name=flat_fee,json=flatFee,proto3" json:"flat_fee,omitempty"`
+	// share of the amount, e.g. 0.005 for 0.5%
+	Percentage string `protobuf:"bytes,6,opt,name=percentage,proto3" json:"percentage,omitempty"`
+	MinFee     int64  `protobuf:"varint,7,opt,name=min_fee,json=minFee,proto3" json:"min_fee,omitempty"`
+	// unset for no maximum
+	MaxFee        *int64                 `protobuf:"varint,8,opt,name=max_fee,json=maxFee,proto3,oneof" json:"max_fee,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,9,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FeeRule) Reset() {
+	*x = FeeRule{}
+	mi := &file_fee_rule_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FeeRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeeRule) ProtoMessage() {}
+
+func (x *FeeRule) ProtoReflect() protoreflect.Message {
+	mi := &file_fee_rule_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeeRule.ProtoReflect.Descriptor instead.
+func (*FeeRule) Descriptor() ([]byte, []int) {
+	return file_fee_rule_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *FeeRule) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *FeeRule) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *FeeRule) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *FeeRule) GetSameOwner() bool {
+	if x != nil && x.SameOwner != nil {
+		return *x.SameOwner
+	}
+	return false
+}
+
+func (x *FeeRule) GetFlatFee() int64 {
+	if x != nil {
+		return x.FlatFee
+	}
+	return 0
+}
+
+func (x *FeeRule) GetPercentage() string {
+	if x != nil {
+		return x.Percentage
+	}
+	return ""
+}
+
+func (x *FeeRule) GetMinFee() int64 {
+	if x != nil {
+		return x.MinFee
+	}
+	return 0
+}
+
+func (x *FeeRule) GetMaxFee() int64 {
+	if x != nil && x.MaxFee != nil {
+		return *x.MaxFee
+	}
+	return 0
+}
+
+func (x *FeeRule) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *FeeRule) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+var File_fee_rule_proto protoreflect.FileDescriptor
+
+const file_fee_rule_proto_rawDesc = "" +
+	"\n" +
+	"\x0efee_rule.proto\x12\x02pb\x1a\x1fgoogle/protobuf/timestamp.proto\"\xd4\x02\n" +
+	"\aFeeRule\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1a\n" +
+	"\bcurrency\x18\x02 \x01(\tR\bcurrency\x12\x12\n" +
+	"\x04role\x18\x03 \x01(\tR\x04role\x12\"\n" +
+	"\n" +
+	"same_owner\x18\x04 \x01(\bH\x00R\tsameOwner\x88\x01\x01\x12\x19\n" +
+	"\bflat_fee\x18\x05 \x01(\x03R\aflatFee\x12\x1e\n" +
+	"\n" +
+	"percentage\x18\x06 \x01(\tR\n" +
+	"percentage\x12\x17\n" +
+	"\amin_fee\x18\a \x01(\x03R\x06minFee\x12\x1c\n" +
+	"\amax_fee\x18\b \x01(\x03H\x01R\x06maxFee\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\t \x01(\tR\tcreatedBy\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAtB\r\n" +
+	"\v_same_ownerB\n" +
+	"\n" +
+	"\b_max_feeB\"Z github.com/mahanth/simplebank/pbb\x06proto3"
+
+var (
+	file_fee_rule_proto_rawDescOnce sync.Once
+	file_fee_rule_proto_rawDescData []byte
+)
+
+func file_fee_rule_proto_rawDescGZIP() []byte {
+	file_fee_rule_proto_rawDescOnce.Do(func() {
+		file_fee_rule_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_fee_rule_proto_rawDesc), len(file_fee_rule_proto_rawDesc)))
+	})
+	return file_fee_rule_proto_rawDescData
+}
+
+var file_fee_rule_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_fee_rule_proto_goTypes = []any{
+	(*FeeRule)(nil),               // 0: pb.FeeRule
+	(*timestamppb.Timestamp)(nil), // 1: google.protobuf.Timestamp
+}
+var file_fee_rule_proto_depIdxs = []int32{
+	1, // 0: pb.FeeRule.created_at:type_name -> google.protobuf.Timestamp
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_fee_rule_proto_init() }
+func file_fee_rule_proto_init() {
+	if File_fee_rule_proto != nil {
+		return
+	}
+	file_fee_rule_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_fee_rule_proto_rawDesc), len(file_fee_rule_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_fee_rule_proto_goTypes,
+		DependencyIndexes: file_fee_rule_proto_depIdxs,
+		MessageInfos:      file_fee_rule_proto_msgTypes,
+	}.Build()
+	File_fee_rule_proto = out.File
+	file_fee_rule_proto_goTypes = nil
+	file_fee_rule_proto_depIdxs = nil
+}
@@ -0,0 +1,183 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v6.31.1
+// source: history_filter.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// HistoryFilter narrows a list of transfers or entries of an account. Unset fields do not filter.
+type HistoryFilter struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// inclusive
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// exclusive
+	EndTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// amounts as the account sees them, in its currency
+	MinAmount *int64 `protobuf:"varint,3,opt,name=min_amount,json=minAmount,proto3,oneof" json:"min_amount,omitempty"`
+	MaxAmount *int64 `protobuf:"varint,4,opt,name=max_amount,json=maxAmount,proto3,oneof" json:"max_amount,omitempty"`
+	// in or out
+	Direction             string `protobuf:"bytes,5,opt,name=direction,proto3" json:"direction,omitempty"`
+	CounterpartyAccountId *int64 `protobuf:"varint,6,opt,name=counterparty_account_id,json=counterpartyAccountId,proto3,oneof" json:"counterparty_account_id,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *HistoryFilter) Reset() {
+	*x = HistoryFilter{}
+	mi := &file_history_filter_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HistoryFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HistoryFilter) ProtoMessage() {}
+
+func (x *HistoryFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_history_filter_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HistoryFilter.ProtoReflect.Descriptor instead.
+func (*HistoryFilter) Descriptor() ([]byte, []int) {
+	return file_history_filter_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *HistoryFilter) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *HistoryFilter) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *HistoryFilter) GetMinAmount() int64 {
+	if x != nil && x.MinAmount != nil {
+		return *x.MinAmount
+	}
+	return 0
+}
+
+func (x *HistoryFilter) GetMaxAmount() int64 {
+	if x != nil && x.MaxAmount != nil {
+		return *x.MaxAmount
+	}
+	return 0
+}
+
+func (x *HistoryFilter) GetDirection() string {
+	if x != nil {
+		return x.Direction
+	}
+	return ""
+}
+
+func (x *HistoryFilter) GetCounterpartyAccountId() int64 {
+	if x != nil && x.CounterpartyAccountId != nil {
+		return *x.CounterpartyAccountId
+	}
+	return 0
+}
+
+var File_history_filter_proto protoreflect.FileDescriptor
+
+const file_history_filter_proto_rawDesc = "" +
+	"\n" +
+	"\x14history_filter.proto\x12\x02pb\x1a\x1fgoogle/protobuf/timestamp.proto\"\xde\x02\n" +
+	"\rHistoryFilter\x129\n" +
+	"\n" +
+	"start_time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x12\"\n" +
+	"\n" +
+	"min_amount\x18\x03 \x01(\x03H\x00R\tminAmount\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"max_amount\x18\x04 \x01(\x03H\x01R\tmaxAmount\x88\x01\x01\x12\x1c\n" +
+	"\tdirection\x18\x05 \x01(\tR\tdirection\x12;\n" +
+	"\x17counterparty_account_id\x18\x06 \x01(\x03H\x02R\x15counterpartyAccountId\x88\x01\x01B\r\n" +
+	"\v_min_amountB\r\n" +
+	"\v_max_amountB\x1a\n" +
+	"\x18_counterparty_account_idB\"Z github.com/mahanth/simplebank/pbb\x06proto3"
+
+var (
+	file_history_filter_proto_rawDescOnce sync.Once
+	file_history_filter_proto_rawDescData []byte
+)
+
+func file_history_filter_proto_rawDescGZIP() []byte {
+	file_history_filter_proto_rawDescOnce.Do(func() {
+		file_history_filter_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_history_filter_proto_rawDesc), len(file_history_filter_proto_rawDesc)))
+	})
+	return file_history_filter_proto_rawDescData
+}
+
+var file_history_filter_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_history_filter_proto_goTypes = []any{
+	(*HistoryFilter)(nil),         // 0: pb.HistoryFilter
+	(*timestamppb.Timestamp)(nil), // 1: google.protobuf.Timestamp
+}
+var file_history_filter_proto_depIdxs = []int32{
+	1, // 0: pb.HistoryFilter.start_time:type_name -> google.protobuf.Timestamp
+	1, // 1: pb.HistoryFilter.end_time:type_name -> google.protobuf.Timestamp
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_history_filter_proto_init() }
+func file_history_filter_proto_init() {
+	if File_history_filter_proto != nil {
+		return
+	}
+	file_history_filter_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_history_filter_proto_rawDesc), len(file_history_filter_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_history_filter_proto_goTypes,
+		DependencyIndexes: file_history_filter_proto_depIdxs,
+		MessageInfos:      file_history_filter_proto_msgTypes,
+	}.Build()
+	File_history_filter_proto = out.File
+	file_history_filter_proto_goTypes = nil
+	file_history_filter_proto_depIdxs = nil
+}
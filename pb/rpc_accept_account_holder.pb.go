@@ -0,0 +1,173 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v6.31.1
+// source: rpc_accept_account_holder.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type AcceptAccountHolderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     int64                  `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptAccountHolderRequest) Reset() {
+	*x = AcceptAccountHolderRequest{}
+	mi := &file_rpc_accept_account_holder_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptAccountHolderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptAccountHolderRequest) ProtoMessage() {}
+
+func (x *AcceptAccountHolderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_accept_account_holder_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptAccountHolderRequest.ProtoReflect.Descriptor instead.
+func (*AcceptAccountHolderRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_accept_account_holder_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AcceptAccountHolderRequest) GetAccountId() int64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+type AcceptAccountHolderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Holder        *AccountHolder         `protobuf:"bytes,1,opt,name=holder,proto3" json:"holder,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptAccountHolderResponse) Reset() {
+	*x = AcceptAccountHolderResponse{}
+	mi := &file_rpc_accept_account_holder_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptAccountHolderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptAccountHolderResponse) ProtoMessage() {}
+
+func (x *AcceptAccountHolderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_accept_account_holder_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptAccountHolderResponse.ProtoReflect.Descriptor instead.
+func (*AcceptAccountHolderResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_accept_account_holder_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AcceptAccountHolderResponse) GetHolder() *AccountHolder {
+	if x != nil {
+		return x.Holder
+	}
+	return nil
+}
+
+var File_rpc_accept_account_holder_proto protoreflect.FileDescriptor
+
+const file_rpc_accept_account_holder_proto_rawDesc = "" +
+	"\n" +
+	"\x1frpc_accept_account_holder.proto\x12\x02pb\x1a\x14account_holder.proto\";\n" +
+	"\x1aAcceptAccountHolderRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\x03R\taccountId\"H\n" +
+	"\x1bAcceptAccountHolderResponse\x12)\n" +
+	"\x06holder\x18\x01 \x01(\v2\x11.pb.AccountHolderR\x06holderB\"Z github.com/mahanth/simplebank/pbb\x06proto3"
+
+var (
+	file_rpc_accept_account_holder_proto_rawDescOnce sync.Once
+	file_rpc_accept_account_holder_proto_rawDescData []byte
+)
+
+func file_rpc_accept_account_holder_proto_rawDescGZIP() []byte {
+	file_rpc_accept_account_holder_proto_rawDescOnce.Do(func() {
+		file_rpc_accept_account_holder_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_accept_account_holder_proto_rawDesc), len(file_rpc_accept_account_holder_proto_rawDesc)))
+	})
+	return file_rpc_accept_account_holder_proto_rawDescData
+}
+
+var file_rpc_accept_account_holder_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_accept_account_holder_proto_goTypes = []any{
+	(*AcceptAccountHolderRequest)(nil),  // 0: pb.AcceptAccountHolderRequest
+	(*AcceptAccountHolderResponse)(nil), // 1: pb.AcceptAccountHolderResponse
+	(*AccountHolder)(nil),               // 2: pb.AccountHolder
+}
+var file_rpc_accept_account_holder_proto_depIdxs = []int32{
+	2, // 0: pb.AcceptAccountHolderResponse.holder:type_name -> pb.AccountHolder
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rpc_accept_account_holder_proto_init() }
+func file_rpc_accept_account_holder_proto_init() {
+	if File_rpc_accept_account_holder_proto != nil {
+		return
+	}
+	file_account_holder_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_accept_account_holder_proto_rawDesc), len(file_rpc_accept_account_holder_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_accept_account_holder_proto_goTypes,
+		DependencyIndexes: file_rpc_accept_account_holder_proto_depIdxs,
+		MessageInfos:      file_rpc_accept_account_holder_proto_msgTypes,
+	}.Build()
+	File_rpc_accept_account_holder_proto = out.File
+	file_rpc_accept_account_holder_proto_goTypes = nil
+	file_rpc_accept_account_holder_proto_depIdxs = nil
+}
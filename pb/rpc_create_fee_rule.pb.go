@@ -0,0 +1,237 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v6.31.1
+// source: rpc_create_fee_rule.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CreateFeeRuleRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Currency  *string                `protobuf:"bytes,1,opt,name=currency,proto3,oneof" json:"currency,omitempty"`
+	Role      *string                `protobuf:"bytes,2,opt,name=role,proto3,oneof" json:"role,omitempty"`
+	SameOwner *bool                  `protobuf:"varint,3,opt,name=same_owner,json=sameOwner,proto3,oneof" json:"same_owner,omitempty"`
+	FlatFee   int64                  `protobuf:"varint,4,opt,name=flat_fee,json=flatFee,proto3" json:"flat_fee,omitempty"`
+	// defaults to 0
+	Percentage    *string `protobuf:"bytes,5,opt,name=percentage,proto3,oneof" json:"percentage,omitempty"`
+	MinFee        int64   `protobuf:"varint,6,opt,name=min_fee,json=minFee,proto3" json:"min_fee,omitempty"`
+	MaxFee        *int64  `protobuf:"varint,7,opt,name=max_fee,json=maxFee,proto3,oneof" json:"max_fee,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateFeeRuleRequest) Reset() {
+	*x = CreateFeeRuleRequest{}
+	mi := &file_rpc_create_fee_rule_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateFeeRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateFeeRuleRequest) ProtoMessage() {}
+
+func (x *CreateFeeRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_create_fee_rule_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateFeeRuleRequest.ProtoReflect.Descriptor instead.
+func (*CreateFeeRuleRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_create_fee_rule_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CreateFeeRuleRequest) GetCurrency() string {
+	if x != nil && x.Currency != nil {
+		return *x.Currency
+	}
+	return ""
+}
+
+func (x *CreateFeeRuleRequest) GetRole() string {
+	if x != nil && x.Role != nil {
+		return *x.Role
+	}
+	return ""
+}
+
+func (x *CreateFeeRuleRequest) GetSameOwner() bool {
+	if x != nil && x.SameOwner != nil {
+		return *x.SameOwner
+	}
+	return false
+}
+
+func (x *CreateFeeRuleRequest) GetFlatFee() int64 {
+	if x != nil {
+		return x.FlatFee
+	}
+	return 0
+}
+
+func (x *CreateFeeRuleRequest) GetPercentage() string {
+	if x != nil && x.Percentage != nil {
+		return *x.Percentage
+	}
+	return ""
+}
+
+func (x *CreateFeeRuleRequest) GetMinFee() int64 {
+	if x != nil {
+		return x.MinFee
+	}
+	return 0
+}
+
+func (x *CreateFeeRuleRequest) GetMaxFee() int64 {
+	if x != nil && x.MaxFee != nil {
+		return *x.MaxFee
+	}
+	return 0
+}
+
+type CreateFeeRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FeeRule       *FeeRule               `protobuf:"bytes,1,opt,name=fee_rule,json=feeRule,proto3" json:"fee_rule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateFeeRuleResponse) Reset() {
+	*x = CreateFeeRuleResponse{}
+	mi := &file_rpc_create_fee_rule_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateFeeRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateFeeRuleResponse) ProtoMessage() {}
+
+func (x *CreateFeeRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_create_fee_rule_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateFeeRuleResponse.ProtoReflect.Descriptor instead.
+func (*CreateFeeRuleResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_create_fee_rule_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateFeeRuleResponse) GetFeeRule() *FeeRule {
+	if x != nil {
+		return x.FeeRule
+	}
+	return nil
+}
+
+var File_rpc_create_fee_rule_proto protoreflect.FileDescriptor
+
+const file_rpc_create_fee_rule_proto_rawDesc = "" +
+	"\n" +
+	"\x19rpc_create_fee_rule.proto\x12\x02pb\x1a\x0efee_rule.proto\"\xab\x02\n" +
+	"\x14CreateFeeRuleRequest\x12\x1f\n" +
+	"\bcurrency\x18\x01 \x01(\tH\x00R\bcurrency\x88\x01\x01\x12\x17\n" +
+	"\x04role\x18\x02 \x01(\tH\x01R\x04role\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"same_owner\x18\x03 \x01(\bH\x02R\tsameOwner\x88\x01\x01\x12\x19\n" +
+	"\bflat_fee\x18\x04 \x01(\x03R\aflatFee\x12#\n" +
+	"\n" +
+	"percentage\x18\x05 \x01(\tH\x03R\n" +
+	"percentage\x88\x01\x01\x12\x17\n" +
+	"\amin_fee\x18\x06 \x01(\x03R\x06minFee\x12\x1c\n" +
+	"\amax_fee\x18\a \x01(\x03H\x04R\x06maxFee\x88\x01\x01B\v\n" +
+	"\t_currencyB\a\n" +
+	"\x05_roleB\r\n" +
+	"\v_same_ownerB\r\n" +
+	"\v_percentageB\n" +
+	"\n" +
+	"\b_max_fee\"?\n" +
+	"\x15CreateFeeRuleResponse\x12&\n" +
+	"\bfee_rule\x18\x01 \x01(\v2\v.pb.FeeRuleR\afeeRuleB\"Z github.com/mahanth/simplebank/pbb\x06proto3"
+
+var (
+	file_rpc_create_fee_rule_proto_rawDescOnce sync.Once
+	file_rpc_create_fee_rule_proto_rawDescData []byte
+)
+
+func file_rpc_create_fee_rule_proto_rawDescGZIP() []byte {
+	file_rpc_create_fee_rule_proto_rawDescOnce.Do(func() {
+		file_rpc_create_fee_rule_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_create_fee_rule_proto_rawDesc), len(file_rpc_create_fee_rule_proto_rawDesc)))
+	})
+	return file_rpc_create_fee_rule_proto_rawDescData
+}
+
+var file_rpc_create_fee_rule_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_create_fee_rule_proto_goTypes = []any{
+	(*CreateFeeRuleRequest)(nil),  // 0: pb.CreateFeeRuleRequest
+	(*CreateFeeRuleResponse)(nil), // 1: pb.CreateFeeRuleResponse
+	(*FeeRule)(nil),               // 2: pb.FeeRule
+}
+var file_rpc_create_fee_rule_proto_depIdxs = []int32{
+	2, // 0: pb.CreateFeeRuleResponse.fee_rule:type_name -> pb.FeeRule
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rpc_create_fee_rule_proto_init() }
+func file_rpc_create_fee_rule_proto_init() {
+	if File_rpc_create_fee_rule_proto != nil {
+		return
+	}
+	file_fee_rule_proto_init()
+	file_rpc_create_fee_rule_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_create_fee_rule_proto_rawDesc), len(file_rpc_create_fee_rule_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_create_fee_rule_proto_goTypes,
+		DependencyIndexes: file_rpc_create_fee_rule_proto_depIdxs,
+		MessageInfos:      file_rpc_create_fee_rule_proto_msgTypes,
+	}.Build()
+	File_rpc_create_fee_rule_proto = out.File
+	file_rpc_create_fee_rule_proto_goTypes = nil
+	file_rpc_create_fee_rule_proto_depIdxs = nil
+}
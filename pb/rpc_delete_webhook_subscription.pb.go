@@ -0,0 +1,173 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v6.31.1
+// source: rpc_delete_webhook_subscription.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// stops deliveries to the subscription; its delivery log is kept
+type DeleteWebhookSubscriptionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteWebhookSubscriptionRequest) Reset() {
+	*x = DeleteWebhookSubscriptionRequest{}
+	mi := &file_rpc_delete_webhook_subscription_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWebhookSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWebhookSubscriptionRequest) ProtoMessage() {}
+
+func (x *DeleteWebhookSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_delete_webhook_subscription_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWebhookSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteWebhookSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_delete_webhook_subscription_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *DeleteWebhookSubscriptionRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteWebhookSubscriptionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Subscription  *WebhookSubscription   `protobuf:"bytes,1,opt,name=subscription,proto3" json:"subscription,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteWebhookSubscriptionResponse) Reset() {
+	*x = DeleteWebhookSubscriptionResponse{}
+	mi := &file_rpc_delete_webhook_subscription_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWebhookSubscriptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWebhookSubscriptionResponse) ProtoMessage() {}
+
+func (x *DeleteWebhookSubscriptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_delete_webhook_subscription_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWebhookSubscriptionResponse.ProtoReflect.Descriptor instead.
+func (*DeleteWebhookSubscriptionResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_delete_webhook_subscription_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *DeleteWebhookSubscriptionResponse) GetSubscription() *WebhookSubscription {
+	if x != nil {
+		return x.Subscription
+	}
+	return nil
+}
+
+var File_rpc_delete_webhook_subscription_proto protoreflect.FileDescriptor
+
+const file_rpc_delete_webhook_subscription_proto_rawDesc = "" +
+	"\n" +
+	"%rpc_delete_webhook_subscription.proto\x12\x02pb\x1a\rwebhook.proto\"2\n" +
+	" DeleteWebhookSubscriptionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"`\n" +
+	"!DeleteWebhookSubscriptionResponse\x12;\n" +
+	"\fsubscription\x18\x01 \x01(\v2\x17.pb.WebhookSubscriptionR\fsubscriptionB\"Z github.com/mahanth/simplebank/pbb\x06proto3"
+
+var (
+	file_rpc_delete_webhook_subscription_proto_rawDescOnce sync.Once
+	file_rpc_delete_webhook_subscription_proto_rawDescData []byte
+)
+
+func file_rpc_delete_webhook_subscription_proto_rawDescGZIP() []byte {
+	file_rpc_delete_webhook_subscription_proto_rawDescOnce.Do(func() {
+		file_rpc_delete_webhook_subscription_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_delete_webhook_subscription_proto_rawDesc), len(file_rpc_delete_webhook_subscription_proto_rawDesc)))
+	})
+	return file_rpc_delete_webhook_subscription_proto_rawDescData
+}
+
+var file_rpc_delete_webhook_subscription_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_delete_webhook_subscription_proto_goTypes = []any{
+	(*DeleteWebhookSubscriptionRequest)(nil),  // 0: pb.DeleteWebhookSubscriptionRequest
+	(*DeleteWebhookSubscriptionResponse)(nil), // 1: pb.DeleteWebhookSubscriptionResponse
+	(*WebhookSubscription)(nil),               // 2: pb.WebhookSubscription
+}
+var file_rpc_delete_webhook_subscription_proto_depIdxs = []int32{
+	2, // 0: pb.DeleteWebhookSubscriptionResponse.subscription:type_name -> pb.WebhookSubscription
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rpc_delete_webhook_subscription_proto_init() }
+func file_rpc_delete_webhook_subscription_proto_init() {
+	if File_rpc_delete_webhook_subscription_proto != nil {
+		return
+	}
+	file_webhook_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_delete_webhook_subscription_proto_rawDesc), len(file_rpc_delete_webhook_subscription_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_delete_webhook_subscription_proto_goTypes,
+		DependencyIndexes: file_rpc_delete_webhook_subscription_proto_depIdxs,
+		MessageInfos:      file_rpc_delete_webhook_subscription_proto_msgTypes,
+	}.Build()
+	File_rpc_delete_webhook_subscription_proto = out.File
+	file_rpc_delete_webhook_subscription_proto_goTypes = nil
+	file_rpc_delete_webhook_subscription_proto_depIdxs = nil
+}
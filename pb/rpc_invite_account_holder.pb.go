@@ -0,0 +1,205 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v6.31.1
+// source: rpc_invite_account_holder.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type InviteAccountHolderRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	AccountId  int64                  `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Username   string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Permission string                 `protobuf:"bytes,3,opt,name=permission,proto3" json:"permission,omitempty"`
+	// only for the transfer and manage permissions, unset for no limit
+	TransferLimit *int64 `protobuf:"varint,4,opt,name=transfer_limit,json=transferLimit,proto3,oneof" json:"transfer_limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InviteAccountHolderRequest) Reset() {
+	*x = InviteAccountHolderRequest{}
+	mi := &file_rpc_invite_account_holder_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InviteAccountHolderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InviteAccountHolderRequest) ProtoMessage() {}
+
+func (x *InviteAccountHolderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_invite_account_holder_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InviteAccountHolderRequest.ProtoReflect.Descriptor instead.
+func (*InviteAccountHolderRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_invite_account_holder_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *InviteAccountHolderRequest) GetAccountId() int64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *InviteAccountHolderRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *InviteAccountHolderRequest) GetPermission() string {
+	if x != nil {
+		return x.Permission
+	}
+	return ""
+}
+
+func (x *InviteAccountHolderRequest) GetTransferLimit() int64 {
+	if x != nil && x.TransferLimit != nil {
+		return *x.TransferLimit
+	}
+	return 0
+}
+
+type InviteAccountHolderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Holder        *AccountHolder         `protobuf:"bytes,1,opt,name=holder,proto3" json:"holder,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InviteAccountHolderResponse) Reset() {
+	*x = InviteAccountHolderResponse{}
+	mi := &file_rpc_invite_account_holder_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InviteAccountHolderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InviteAccountHolderResponse) ProtoMessage() {}
+
+func (x *InviteAccountHolderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_invite_account_holder_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InviteAccountHolderResponse.ProtoReflect.Descriptor instead.
+func (*InviteAccountHolderResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_invite_account_holder_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *InviteAccountHolderResponse) GetHolder() *AccountHolder {
+	if x != nil {
+		return x.Holder
+	}
+	return nil
+}
+
+var File_rpc_invite_account_holder_proto protoreflect.FileDescriptor
+
+const file_rpc_invite_account_holder_proto_rawDesc = "" +
+	"\n" +
+	"\x1frpc_invite_account_holder.proto\x12\x02pb\x1a\x14account_holder.proto\"\xb6\x01\n" +
+	"\x1aInviteAccountHolderRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\x03R\taccountId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x1e\n" +
+	"\n" +
+	"permission\x18\x03 \x01(\tR\n" +
+	"permission\x12*\n" +
+	"\x0etransfer_limit\x18\x04 \x01(\x03H\x00R\rtransferLimit\x88\x01\x01B\x11\n" +
+	"\x0f_transfer_limit\"H\n" +
+	"\x1bInviteAccountHolderResponse\x12)\n" +
+	"\x06holder\x18\x01 \x01(\v2\x11.pb.AccountHolderR\x06holderB\"Z github.com/mahanth/simplebank/pbb\x06proto3"
+
+var (
+	file_rpc_invite_account_holder_proto_rawDescOnce sync.Once
+	file_rpc_invite_account_holder_proto_rawDescData []byte
+)
+
+func file_rpc_invite_account_holder_proto_rawDescGZIP() []byte {
+	file_rpc_invite_account_holder_proto_rawDescOnce.Do(func() {
+		file_rpc_invite_account_holder_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_invite_account_holder_proto_rawDesc), len(file_rpc_invite_account_holder_proto_rawDesc)))
+	})
+	return file_rpc_invite_account_holder_proto_rawDescData
+}
+
+var file_rpc_invite_account_holder_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_invite_account_holder_proto_goTypes = []any{
+	(*InviteAccountHolderRequest)(nil),  // 0: pb.InviteAccountHolderRequest
+	(*InviteAccountHolderResponse)(nil), // 1: pb.InviteAccountHolderResponse
+	(*AccountHolder)(nil),               // 2: pb.AccountHolder
+}
+var file_rpc_invite_account_holder_proto_depIdxs = []int32{
+	2, // 0: pb.InviteAccountHolderResponse.holder:type_name -> pb.AccountHolder
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rpc_invite_account_holder_proto_init() }
+func file_rpc_invite_account_holder_proto_init() {
+	if File_rpc_invite_account_holder_proto != nil {
+		return
+	}
+	file_account_holder_proto_init()
+	file_rpc_invite_account_holder_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_invite_account_holder_proto_rawDesc), len(file_rpc_invite_account_holder_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_invite_account_holder_proto_goTypes,
+		DependencyIndexes: file_rpc_invite_account_holder_proto_depIdxs,
+		MessageInfos:      file_rpc_invite_account_holder_proto_msgTypes,
+	}.Build()
+	File_rpc_invite_account_holder_proto = out.File
+	file_rpc_invite_account_holder_proto_goTypes = nil
+	file_rpc_invite_account_holder_proto_depIdxs = nil
+}
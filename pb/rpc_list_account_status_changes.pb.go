@@ -0,0 +1,191 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v6.31.1
+// source: rpc_list_account_status_changes.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ListAccountStatusChangesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     int64                  `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	PageId        int32                  `protobuf:"varint,2,opt,name=page_id,json=pageId,proto3" json:"page_id,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAccountStatusChangesRequest) Reset() {
+	*x = ListAccountStatusChangesRequest{}
+	mi := &file_rpc_list_account_status_changes_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAccountStatusChangesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAccountStatusChangesRequest) ProtoMessage() {}
+
+func (x *ListAccountStatusChangesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_list_account_status_changes_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAccountStatusChangesRequest.ProtoReflect.Descriptor instead.
+func (*ListAccountStatusChangesRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_list_account_status_changes_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ListAccountStatusChangesRequest) GetAccountId() int64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *ListAccountStatusChangesRequest) GetPageId() int32 {
+	if x != nil {
+		return x.PageId
+	}
+	return 0
+}
+
+func (x *ListAccountStatusChangesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListAccountStatusChangesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Changes       []*AccountStatusChange `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAccountStatusChangesResponse) Reset() {
+	*x = ListAccountStatusChangesResponse{}
+	mi := &file_rpc_list_account_status_changes_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAccountStatusChangesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAccountStatusChangesResponse) ProtoMessage() {}
+
+func (x *ListAccountStatusChangesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_list_account_status_changes_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAccountStatusChangesResponse.ProtoReflect.Descriptor instead.
+func (*ListAccountStatusChangesResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_list_account_status_changes_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListAccountStatusChangesResponse) GetChanges() []*AccountStatusChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+var File_rpc_list_account_status_changes_proto protoreflect.FileDescriptor
+
+const file_rpc_list_account_status_changes_proto_rawDesc = "" +
+	"\n" +
+	"%rpc_list_account_status_changes.proto\x12\x02pb\x1a\x1baccount_status_change.proto\"v\n" +
+	"\x1fListAccountStatusChangesRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\x03R\taccountId\x12\x17\n" +
+	"\apage_id\x18\x02 \x01(\x05R\x06pageId\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\"U\n" +
+	" ListAccountStatusChangesResponse\x121\n" +
+	"\achanges\x18\x01 \x03(\v2\x17.pb.AccountStatusChangeR\achangesB\"Z github.com/mahanth/simplebank/pbb\x06proto3"
+
+var (
+	file_rpc_list_account_status_changes_proto_rawDescOnce sync.Once
+	file_rpc_list_account_status_changes_proto_rawDescData []byte
+)
+
+func file_rpc_list_account_status_changes_proto_rawDescGZIP() []byte {
+	file_rpc_list_account_status_changes_proto_rawDescOnce.Do(func() {
+		file_rpc_list_account_status_changes_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_list_account_status_changes_proto_rawDesc), len(file_rpc_list_account_status_changes_proto_rawDesc)))
+	})
+	return file_rpc_list_account_status_changes_proto_rawDescData
+}
+
+var file_rpc_list_account_status_changes_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_list_account_status_changes_proto_goTypes = []any{
+	(*ListAccountStatusChangesRequest)(nil),  // 0: pb.ListAccountStatusChangesRequest
+	(*ListAccountStatusChangesResponse)(nil), // 1: pb.ListAccountStatusChangesResponse
+	(*AccountStatusChange)(nil),              // 2: pb.AccountStatusChange
+}
+var file_rpc_list_account_status_changes_proto_depIdxs = []int32{
+	2, // 0: pb.ListAccountStatusChangesResponse.changes:type_name -> pb.AccountStatusChange
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rpc_list_account_status_changes_proto_init() }
+func file_rpc_list_account_status_changes_proto_init() {
+	if File_rpc_list_account_status_changes_proto != nil {
+		return
+	}
+	file_account_status_change_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_list_account_status_changes_proto_rawDesc), len(file_rpc_list_account_status_changes_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_list_account_status_changes_proto_goTypes,
+		DependencyIndexes: file_rpc_list_account_status_changes_proto_depIdxs,
+		MessageInfos:      file_rpc_list_account_status_changes_proto_msgTypes,
+	}.Build()
+	File_rpc_list_account_status_changes_proto = out.File
+	file_rpc_list_account_status_changes_proto_goTypes = nil
+	file_rpc_list_account_status_changes_proto_depIdxs = nil
+}
@@ -0,0 +1,181 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v6.31.1
+// source: rpc_list_fee_rules.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ListFeeRulesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageId        int32                  `protobuf:"varint,1,opt,name=page_id,json=pageId,proto3" json:"page_id,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFeeRulesRequest) Reset() {
+	*x = ListFeeRulesRequest{}
+	mi := &file_rpc_list_fee_rules_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFeeRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFeeRulesRequest) ProtoMessage() {}
+
+func (x *ListFeeRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_list_fee_rules_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFeeRulesRequest.ProtoReflect.Descriptor instead.
+func (*ListFeeRulesRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_list_fee_rules_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ListFeeRulesRequest) GetPageId() int32 {
+	if x != nil {
+		return x.PageId
+	}
+	return 0
+}
+
+func (x *ListFeeRulesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListFeeRulesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FeeRules      []*FeeRule             `protobuf:"bytes,1,rep,name=fee_rules,json=feeRules,proto3" json:"fee_rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFeeRulesResponse) Reset() {
+	*x = ListFeeRulesResponse{}
+	mi := &file_rpc_list_fee_rules_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFeeRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFeeRulesResponse) ProtoMessage() {}
+
+func (x *ListFeeRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_list_fee_rules_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFeeRulesResponse.ProtoReflect.Descriptor instead.
+func (*ListFeeRulesResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_list_fee_rules_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListFeeRulesResponse) GetFeeRules() []*FeeRule {
+	if x != nil {
+		return x.FeeRules
+	}
+	return nil
+}
+
+var File_rpc_list_fee_rules_proto protoreflect.FileDescriptor
+
+const file_rpc_list_fee_rules_proto_rawDesc = "" +
+	"\n" +
+	"\x18rpc_list_fee_rules.proto\x12\x02pb\x1a\x0efee_rule.proto\"K\n" +
+	"\x13ListFeeRulesRequest\x12\x17\n" +
+	"\apage_id\x18\x01 \x01(\x05R\x06pageId\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\"@\n" +
+	"\x14ListFeeRulesResponse\x12(\n" +
+	"\tfee_rules\x18\x01 \x03(\v2\v.pb.FeeRuleR\bfeeRulesB\"Z github.com/mahanth/simplebank/pbb\x06proto3"
+
+var (
+	file_rpc_list_fee_rules_proto_rawDescOnce sync.Once
+	file_rpc_list_fee_rules_proto_rawDescData []byte
+)
+
+func file_rpc_list_fee_rules_proto_rawDescGZIP() []byte {
+	file_rpc_list_fee_rules_proto_rawDescOnce.Do(func() {
+		file_rpc_list_fee_rules_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_list_fee_rules_proto_rawDesc), len(file_rpc_list_fee_rules_proto_rawDesc)))
+	})
+	return file_rpc_list_fee_rules_proto_rawDescData
+}
+
+var file_rpc_list_fee_rules_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_list_fee_rules_proto_goTypes = []any{
+	(*ListFeeRulesRequest)(nil),  // 0: pb.ListFeeRulesRequest
+	(*ListFeeRulesResponse)(nil), // 1: pb.ListFeeRulesResponse
+	(*FeeRule)(nil),              // 2: pb.FeeRule
+}
+var file_rpc_list_fee_rules_proto_depIdxs = []int32{
+	2, // 0: pb.ListFeeRulesResponse.fee_rules:type_name -> pb.FeeRule
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rpc_list_fee_rules_proto_init() }
+func file_rpc_list_fee_rules_proto_init() {
+	if File_rpc_list_fee_rules_proto != nil {
+		return
+	}
+	file_fee_rule_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_list_fee_rules_proto_rawDesc), len(file_rpc_list_fee_rules_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_list_fee_rules_proto_goTypes,
+		DependencyIndexes: file_rpc_list_fee_rules_proto_depIdxs,
+		MessageInfos:      file_rpc_list_fee_rules_proto_msgTypes,
+	}.Build()
+	File_rpc_list_fee_rules_proto = out.File
+	file_rpc_list_fee_rules_proto_goTypes = nil
+	file_rpc_list_fee_rules_proto_depIdxs = nil
+}
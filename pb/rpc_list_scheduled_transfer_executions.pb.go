@@ -0,0 +1,192 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v6.31.1
+// source: rpc_list_scheduled_transfer_executions.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ListScheduledTransferExecutionsRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	ScheduledTransferId int64                  `protobuf:"varint,1,opt,name=scheduled_transfer_id,json=scheduledTransferId,proto3" json:"scheduled_transfer_id,omitempty"`
+	PageId              int32                  `protobuf:"varint,2,opt,name=page_id,json=pageId,proto3" json:"page_id,omitempty"`
+	PageSize            int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *ListScheduledTransferExecutionsRequest) Reset() {
+	*x = ListScheduledTransferExecutionsRequest{}
+	mi := &file_rpc_list_scheduled_transfer_executions_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListScheduledTransferExecutionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListScheduledTransferExecutionsRequest) ProtoMessage() {}
+
+func (x *ListScheduledTransferExecutionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_list_scheduled_transfer_executions_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListScheduledTransferExecutionsRequest.ProtoReflect.Descriptor instead.
+func (*ListScheduledTransferExecutionsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_list_scheduled_transfer_executions_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ListScheduledTransferExecutionsRequest) GetScheduledTransferId() int64 {
+	if x != nil {
+		return x.ScheduledTransferId
+	}
+	return 0
+}
+
+func (x *ListScheduledTransferExecutionsRequest) GetPageId() int32 {
+	if x != nil {
+		return x.PageId
+	}
+	return 0
+}
+
+func (x *ListScheduledTransferExecutionsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListScheduledTransferExecutionsResponse struct {
+	state         protoimpl.MessageState        `protogen:"open.v1"`
+	Executions    []*ScheduledTransferExecution `protobuf:"bytes,1,rep,name=executions,proto3" json:"executions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListScheduledTransferExecutionsResponse) Reset() {
+	*x = ListScheduledTransferExecutionsResponse{}
+	mi := &file_rpc_list_scheduled_transfer_executions_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListScheduledTransferExecutionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListScheduledTransferExecutionsResponse) ProtoMessage() {}
+
+func (x *ListScheduledTransferExecutionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_list_scheduled_transfer_executions_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListScheduledTransferExecutionsResponse.ProtoReflect.Descriptor instead.
+func (*ListScheduledTransferExecutionsResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_list_scheduled_transfer_executions_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListScheduledTransferExecutionsResponse) GetExecutions() []*ScheduledTransferExecution {
+	if x != nil {
+		return x.Executions
+	}
+	return nil
+}
+
+var File_rpc_list_scheduled_transfer_executions_proto protoreflect.FileDescriptor
+
+const file_rpc_list_scheduled_transfer_executions_proto_rawDesc = "" +
+	"\n" +
+	",rpc_list_scheduled_transfer_executions.proto\x12\x02pb\x1a\x18scheduled_transfer.proto\"\x92\x01\n" +
+	"&ListScheduledTransferExecutionsRequest\x122\n" +
+	"\x15scheduled_transfer_id\x18\x01 \x01(\x03R\x13scheduledTransferId\x12\x17\n" +
+	"\apage_id\x18\x02 \x01(\x05R\x06pageId\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\"i\n" +
+	"'ListScheduledTransferExecutionsResponse\x12>\n" +
+	"\n" +
+	"executions\x18\x01 \x03(\v2\x1e.pb.ScheduledTransferExecutionR\n" +
+	"executionsB\"Z github.com/mahanth/simplebank/pbb\x06proto3"
+
+var (
+	file_rpc_list_scheduled_transfer_executions_proto_rawDescOnce sync.Once
+	file_rpc_list_scheduled_transfer_executions_proto_rawDescData []byte
+)
+
+func file_rpc_list_scheduled_transfer_executions_proto_rawDescGZIP() []byte {
+	file_rpc_list_scheduled_transfer_executions_proto_rawDescOnce.Do(func() {
+		file_rpc_list_scheduled_transfer_executions_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_list_scheduled_transfer_executions_proto_rawDesc), len(file_rpc_list_scheduled_transfer_executions_proto_rawDesc)))
+	})
+	return file_rpc_list_scheduled_transfer_executions_proto_rawDescData
+}
+
+var file_rpc_list_scheduled_transfer_executions_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_list_scheduled_transfer_executions_proto_goTypes = []any{
+	(*ListScheduledTransferExecutionsRequest)(nil),  // 0: pb.ListScheduledTransferExecutionsRequest
+	(*ListScheduledTransferExecutionsResponse)(nil), // 1: pb.ListScheduledTransferExecutionsResponse
+	(*ScheduledTransferExecution)(nil),              // 2: pb.ScheduledTransferExecution
+}
+var file_rpc_list_scheduled_transfer_executions_proto_depIdxs = []int32{
+	2, // 0: pb.ListScheduledTransferExecutionsResponse.executions:type_name -> pb.ScheduledTransferExecution
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rpc_list_scheduled_transfer_executions_proto_init() }
+func file_rpc_list_scheduled_transfer_executions_proto_init() {
+	if File_rpc_list_scheduled_transfer_executions_proto != nil {
+		return
+	}
+	file_scheduled_transfer_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_list_scheduled_transfer_executions_proto_rawDesc), len(file_rpc_list_scheduled_transfer_executions_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_list_scheduled_transfer_executions_proto_goTypes,
+		DependencyIndexes: file_rpc_list_scheduled_transfer_executions_proto_depIdxs,
+		MessageInfos:      file_rpc_list_scheduled_transfer_executions_proto_msgTypes,
+	}.Build()
+	File_rpc_list_scheduled_transfer_executions_proto = out.File
+	file_rpc_list_scheduled_transfer_executions_proto_goTypes = nil
+	file_rpc_list_scheduled_transfer_executions_proto_depIdxs = nil
+}
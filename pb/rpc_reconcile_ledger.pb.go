@@ -0,0 +1,163 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v6.31.1
+// source: rpc_reconcile_ledger.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ReconcileLedgerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReconcileLedgerRequest) Reset() {
+	*x = ReconcileLedgerRequest{}
+	mi := &file_rpc_reconcile_ledger_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReconcileLedgerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconcileLedgerRequest) ProtoMessage() {}
+
+func (x *ReconcileLedgerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_reconcile_ledger_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconcileLedgerRequest.ProtoReflect.Descriptor instead.
+func (*ReconcileLedgerRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_reconcile_ledger_proto_rawDescGZIP(), []int{0}
+}
+
+type ReconcileLedgerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Report        *ReconciliationReport  `protobuf:"bytes,1,opt,name=report,proto3" json:"report,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReconcileLedgerResponse) Reset() {
+	*x = ReconcileLedgerResponse{}
+	mi := &file_rpc_reconcile_ledger_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReconcileLedgerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconcileLedgerResponse) ProtoMessage() {}
+
+func (x *ReconcileLedgerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_reconcile_ledger_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconcileLedgerResponse.ProtoReflect.Descriptor instead.
+func (*ReconcileLedgerResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_reconcile_ledger_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ReconcileLedgerResponse) GetReport() *ReconciliationReport {
+	if x != nil {
+		return x.Report
+	}
+	return nil
+}
+
+var File_rpc_reconcile_ledger_proto protoreflect.FileDescriptor
+
+const file_rpc_reconcile_ledger_proto_rawDesc = "" +
+	"\n" +
+	"\x1arpc_reconcile_ledger.proto\x12\x02pb\x1a\x1breconciliation_report.proto\"\x18\n" +
+	"\x16ReconcileLedgerRequest\"K\n" +
+	"\x17ReconcileLedgerResponse\x120\n" +
+	"\x06report\x18\x01 \x01(\v2\x18.pb.ReconciliationReportR\x06reportB\"Z github.com/mahanth/simplebank/pbb\x06proto3"
+
+var (
+	file_rpc_reconcile_ledger_proto_rawDescOnce sync.Once
+	file_rpc_reconcile_ledger_proto_rawDescData []byte
+)
+
+func file_rpc_reconcile_ledger_proto_rawDescGZIP() []byte {
+	file_rpc_reconcile_ledger_proto_rawDescOnce.Do(func() {
+		file_rpc_reconcile_ledger_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_reconcile_ledger_proto_rawDesc), len(file_rpc_reconcile_ledger_proto_rawDesc)))
+	})
+	return file_rpc_reconcile_ledger_proto_rawDescData
+}
+
+var file_rpc_reconcile_ledger_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_reconcile_ledger_proto_goTypes = []any{
+	(*ReconcileLedgerRequest)(nil),  // 0: pb.ReconcileLedgerRequest
+	(*ReconcileLedgerResponse)(nil), // 1: pb.ReconcileLedgerResponse
+	(*ReconciliationReport)(nil),    // 2: pb.ReconciliationReport
+}
+var file_rpc_reconcile_ledger_proto_depIdxs = []int32{
+	2, // 0: pb.ReconcileLedgerResponse.report:type_name -> pb.ReconciliationReport
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rpc_reconcile_ledger_proto_init() }
+func file_rpc_reconcile_ledger_proto_init() {
+	if File_rpc_reconcile_ledger_proto != nil {
+		return
+	}
+	file_reconciliation_report_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_reconcile_ledger_proto_rawDesc), len(file_rpc_reconcile_ledger_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_reconcile_ledger_proto_goTypes,
+		DependencyIndexes: file_rpc_reconcile_ledger_proto_depIdxs,
+		MessageInfos:      file_rpc_reconcile_ledger_proto_msgTypes,
+	}.Build()
+	File_rpc_reconcile_ledger_proto = out.File
+	file_rpc_reconcile_ledger_proto_goTypes = nil
+	file_rpc_reconcile_ledger_proto_depIdxs = nil
+}
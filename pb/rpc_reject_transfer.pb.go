@@ -0,0 +1,182 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v6.31.1
+// source: rpc_reject_transfer.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type RejectTransferRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApprovalId    int64                  `protobuf:"varint,1,opt,name=approval_id,json=approvalId,proto3" json:"approval_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectTransferRequest) Reset() {
+	*x = RejectTransferRequest{}
+	mi := &file_rpc_reject_transfer_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectTransferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectTransferRequest) ProtoMessage() {}
+
+func (x *RejectTransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_reject_transfer_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectTransferRequest.ProtoReflect.Descriptor instead.
+func (*RejectTransferRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_reject_transfer_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RejectTransferRequest) GetApprovalId() int64 {
+	if x != nil {
+		return x.ApprovalId
+	}
+	return 0
+}
+
+func (x *RejectTransferRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type RejectTransferResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Approval      *TransferApproval      `protobuf:"bytes,1,opt,name=approval,proto3" json:"approval,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectTransferResponse) Reset() {
+	*x = RejectTransferResponse{}
+	mi := &file_rpc_reject_transfer_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectTransferResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectTransferResponse) ProtoMessage() {}
+
+func (x *RejectTransferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_reject_transfer_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectTransferResponse.ProtoReflect.Descriptor instead.
+func (*RejectTransferResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_reject_transfer_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RejectTransferResponse) GetApproval() *TransferApproval {
+	if x != nil {
+		return x.Approval
+	}
+	return nil
+}
+
+var File_rpc_reject_transfer_proto protoreflect.FileDescriptor
+
+const file_rpc_reject_transfer_proto_rawDesc = "" +
+	"\n" +
+	"\x19rpc_reject_transfer.proto\x12\x02pb\x1a\x17transfer_approval.proto\"P\n" +
+	"\x15RejectTransferRequest\x12\x1f\n" +
+	"\vapproval_id\x18\x01 \x01(\x03R\n" +
+	"approvalId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"J\n" +
+	"\x16RejectTransferResponse\x120\n" +
+	"\bapproval\x18\x01 \x01(\v2\x14.pb.TransferApprovalR\bapprovalB\"Z github.com/mahanth/simplebank/pbb\x06proto3"
+
+var (
+	file_rpc_reject_transfer_proto_rawDescOnce sync.Once
+	file_rpc_reject_transfer_proto_rawDescData []byte
+)
+
+func file_rpc_reject_transfer_proto_rawDescGZIP() []byte {
+	file_rpc_reject_transfer_proto_rawDescOnce.Do(func() {
+		file_rpc_reject_transfer_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_reject_transfer_proto_rawDesc), len(file_rpc_reject_transfer_proto_rawDesc)))
+	})
+	return file_rpc_reject_transfer_proto_rawDescData
+}
+
+var file_rpc_reject_transfer_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_reject_transfer_proto_goTypes = []any{
+	(*RejectTransferRequest)(nil),  // 0: pb.RejectTransferRequest
+	(*RejectTransferResponse)(nil), // 1: pb.RejectTransferResponse
+	(*TransferApproval)(nil),       // 2: pb.TransferApproval
+}
+var file_rpc_reject_transfer_proto_depIdxs = []int32{
+	2, // 0: pb.RejectTransferResponse.approval:type_name -> pb.TransferApproval
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rpc_reject_transfer_proto_init() }
+func file_rpc_reject_transfer_proto_init() {
+	if File_rpc_reject_transfer_proto != nil {
+		return
+	}
+	file_transfer_approval_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_reject_transfer_proto_rawDesc), len(file_rpc_reject_transfer_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_reject_transfer_proto_goTypes,
+		DependencyIndexes: file_rpc_reject_transfer_proto_depIdxs,
+		MessageInfos:      file_rpc_reject_transfer_proto_msgTypes,
+	}.Build()
+	File_rpc_reject_transfer_proto = out.File
+	file_rpc_reject_transfer_proto_goTypes = nil
+	file_rpc_reject_transfer_proto_depIdxs = nil
+}
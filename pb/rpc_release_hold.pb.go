@@ -0,0 +1,172 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v6.31.1
+// source: rpc_release_hold.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ReleaseHoldRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HoldId        int64                  `protobuf:"varint,1,opt,name=hold_id,json=holdId,proto3" json:"hold_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseHoldRequest) Reset() {
+	*x = ReleaseHoldRequest{}
+	mi := &file_rpc_release_hold_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseHoldRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseHoldRequest) ProtoMessage() {}
+
+func (x *ReleaseHoldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_release_hold_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseHoldRequest.ProtoReflect.Descriptor instead.
+func (*ReleaseHoldRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_release_hold_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ReleaseHoldRequest) GetHoldId() int64 {
+	if x != nil {
+		return x.HoldId
+	}
+	return 0
+}
+
+type ReleaseHoldResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hold          *AccountHold           `protobuf:"bytes,1,opt,name=hold,proto3" json:"hold,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseHoldResponse) Reset() {
+	*x = ReleaseHoldResponse{}
+	mi := &file_rpc_release_hold_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseHoldResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseHoldResponse) ProtoMessage() {}
+
+func (x *ReleaseHoldResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_release_hold_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseHoldResponse.ProtoReflect.Descriptor instead.
+func (*ReleaseHoldResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_release_hold_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ReleaseHoldResponse) GetHold() *AccountHold {
+	if x != nil {
+		return x.Hold
+	}
+	return nil
+}
+
+var File_rpc_release_hold_proto protoreflect.FileDescriptor
+
+const file_rpc_release_hold_proto_rawDesc = "" +
+	"\n" +
+	"\x16rpc_release_hold.proto\x12\x02pb\x1a\x12account_hold.proto\"-\n" +
+	"\x12ReleaseHoldRequest\x12\x17\n" +
+	"\ahold_id\x18\x01 \x01(\x03R\x06holdId\":\n" +
+	"\x13ReleaseHoldResponse\x12#\n" +
+	"\x04hold\x18\x01 \x01(\v2\x0f.pb.AccountHoldR\x04holdB\"Z github.com/mahanth/simplebank/pbb\x06proto3"
+
+var (
+	file_rpc_release_hold_proto_rawDescOnce sync.Once
+	file_rpc_release_hold_proto_rawDescData []byte
+)
+
+func file_rpc_release_hold_proto_rawDescGZIP() []byte {
+	file_rpc_release_hold_proto_rawDescOnce.Do(func() {
+		file_rpc_release_hold_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_release_hold_proto_rawDesc), len(file_rpc_release_hold_proto_rawDesc)))
+	})
+	return file_rpc_release_hold_proto_rawDescData
+}
+
+var file_rpc_release_hold_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_release_hold_proto_goTypes = []any{
+	(*ReleaseHoldRequest)(nil),  // 0: pb.ReleaseHoldRequest
+	(*ReleaseHoldResponse)(nil), // 1: pb.ReleaseHoldResponse
+	(*AccountHold)(nil),         // 2: pb.AccountHold
+}
+var file_rpc_release_hold_proto_depIdxs = []int32{
+	2, // 0: pb.ReleaseHoldResponse.hold:type_name -> pb.AccountHold
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rpc_release_hold_proto_init() }
+func file_rpc_release_hold_proto_init() {
+	if File_rpc_release_hold_proto != nil {
+		return
+	}
+	file_account_hold_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_release_hold_proto_rawDesc), len(file_rpc_release_hold_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_release_hold_proto_goTypes,
+		DependencyIndexes: file_rpc_release_hold_proto_depIdxs,
+		MessageInfos:      file_rpc_release_hold_proto_msgTypes,
+	}.Build()
+	File_rpc_release_hold_proto = out.File
+	file_rpc_release_hold_proto_goTypes = nil
+	file_rpc_release_hold_proto_depIdxs = nil
+}
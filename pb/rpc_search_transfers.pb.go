@@ -0,0 +1,216 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v6.31.1
+// source: rpc_search_transfers.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// transfers of the authenticated user's accounts are searched newest first, a page at a time
+type SearchTransfersRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// matched against the memo and reference, in web search syntax: words, "quoted phrases", or and -word
+	Query    string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	PageSize int32  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// next_page_token of the previous page, empty for the first page
+	PageToken string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// only transfers with this account on the other side
+	CounterpartyAccountId *int64 `protobuf:"varint,4,opt,name=counterparty_account_id,json=counterpartyAccountId,proto3,oneof" json:"counterparty_account_id,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *SearchTransfersRequest) Reset() {
+	*x = SearchTransfersRequest{}
+	mi := &file_rpc_search_transfers_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchTransfersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchTransfersRequest) ProtoMessage() {}
+
+func (x *SearchTransfersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_search_transfers_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchTransfersRequest.ProtoReflect.Descriptor instead.
+func (*SearchTransfersRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_search_transfers_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SearchTransfersRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchTransfersRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *SearchTransfersRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *SearchTransfersRequest) GetCounterpartyAccountId() int64 {
+	if x != nil && x.CounterpartyAccountId != nil {
+		return *x.CounterpartyAccountId
+	}
+	return 0
+}
+
+type SearchTransfersResponse struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Transfers []*Transfer            `protobuf:"bytes,1,rep,name=transfers,proto3" json:"transfers,omitempty"`
+	// empty on the last page
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchTransfersResponse) Reset() {
+	*x = SearchTransfersResponse{}
+	mi := &file_rpc_search_transfers_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchTransfersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchTransfersResponse) ProtoMessage() {}
+
+func (x *SearchTransfersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_search_transfers_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchTransfersResponse.ProtoReflect.Descriptor instead.
+func (*SearchTransfersResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_search_transfers_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SearchTransfersResponse) GetTransfers() []*Transfer {
+	if x != nil {
+		return x.Transfers
+	}
+	return nil
+}
+
+func (x *SearchTransfersResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+var File_rpc_search_transfers_proto protoreflect.FileDescriptor
+
+const file_rpc_search_transfers_proto_rawDesc = "" +
+	"\n" +
+	"\x1arpc_search_transfers.proto\x12\x02pb\x1a\x0etransfer.proto\"\xc3\x01\n" +
+	"\x16SearchTransfersRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\x12;\n" +
+	"\x17counterparty_account_id\x18\x04 \x01(\x03H\x00R\x15counterpartyAccountId\x88\x01\x01B\x1a\n" +
+	"\x18_counterparty_account_id\"m\n" +
+	"\x17SearchTransfersResponse\x12*\n" +
+	"\ttransfers\x18\x01 \x03(\v2\f.pb.TransferR\ttransfers\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageTokenB\"Z github.com/mahanth/simplebank/pbb\x06proto3"
+
+var (
+	file_rpc_search_transfers_proto_rawDescOnce sync.Once
+	file_rpc_search_transfers_proto_rawDescData []byte
+)
+
+func file_rpc_search_transfers_proto_rawDescGZIP() []byte {
+	file_rpc_search_transfers_proto_rawDescOnce.Do(func() {
+		file_rpc_search_transfers_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_search_transfers_proto_rawDesc), len(file_rpc_search_transfers_proto_rawDesc)))
+	})
+	return file_rpc_search_transfers_proto_rawDescData
+}
+
+var file_rpc_search_transfers_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_search_transfers_proto_goTypes = []any{
+	(*SearchTransfersRequest)(nil),  // 0: pb.SearchTransfersRequest
+	(*SearchTransfersResponse)(nil), // 1: pb.SearchTransfersResponse
+	(*Transfer)(nil),                // 2: pb.Transfer
+}
+var file_rpc_search_transfers_proto_depIdxs = []int32{
+	2, // 0: pb.SearchTransfersResponse.transfers:type_name -> pb.Transfer
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rpc_search_transfers_proto_init() }
+func file_rpc_search_transfers_proto_init() {
+	if File_rpc_search_transfers_proto != nil {
+		return
+	}
+	file_transfer_proto_init()
+	file_rpc_search_transfers_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_search_transfers_proto_rawDesc), len(file_rpc_search_transfers_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_search_transfers_proto_goTypes,
+		DependencyIndexes: file_rpc_search_transfers_proto_depIdxs,
+		MessageInfos:      file_rpc_search_transfers_proto_msgTypes,
+	}.Build()
+	File_rpc_search_transfers_proto = out.File
+	file_rpc_search_transfers_proto_goTypes = nil
+	file_rpc_search_transfers_proto_depIdxs = nil
+}
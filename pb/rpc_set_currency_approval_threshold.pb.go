@@ -0,0 +1,184 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v6.31.1
+// source: rpc_set_currency_approval_threshold.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// approval_threshold is in minor units of the currency; unset, no transfer in it needs approval
+type SetCurrencyApprovalThresholdRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Code              string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	ApprovalThreshold *int64                 `protobuf:"varint,2,opt,name=approval_threshold,json=approvalThreshold,proto3,oneof" json:"approval_threshold,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *SetCurrencyApprovalThresholdRequest) Reset() {
+	*x = SetCurrencyApprovalThresholdRequest{}
+	mi := &file_rpc_set_currency_approval_threshold_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetCurrencyApprovalThresholdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCurrencyApprovalThresholdRequest) ProtoMessage() {}
+
+func (x *SetCurrencyApprovalThresholdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_set_currency_approval_threshold_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCurrencyApprovalThresholdRequest.ProtoReflect.Descriptor instead.
+func (*SetCurrencyApprovalThresholdRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_set_currency_approval_threshold_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SetCurrencyApprovalThresholdRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *SetCurrencyApprovalThresholdRequest) GetApprovalThreshold() int64 {
+	if x != nil && x.ApprovalThreshold != nil {
+		return *x.ApprovalThreshold
+	}
+	return 0
+}
+
+type SetCurrencyApprovalThresholdResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Currency      *Currency              `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetCurrencyApprovalThresholdResponse) Reset() {
+	*x = SetCurrencyApprovalThresholdResponse{}
+	mi := &file_rpc_set_currency_approval_threshold_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetCurrencyApprovalThresholdResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCurrencyApprovalThresholdResponse) ProtoMessage() {}
+
+func (x *SetCurrencyApprovalThresholdResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_set_currency_approval_threshold_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCurrencyApprovalThresholdResponse.ProtoReflect.Descriptor instead.
+func (*SetCurrencyApprovalThresholdResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_set_currency_approval_threshold_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SetCurrencyApprovalThresholdResponse) GetCurrency() *Currency {
+	if x != nil {
+		return x.Currency
+	}
+	return nil
+}
+
+var File_rpc_set_currency_approval_threshold_proto protoreflect.FileDescriptor
+
+const file_rpc_set_currency_approval_threshold_proto_rawDesc = "" +
+	"\n" +
+	")rpc_set_currency_approval_threshold.proto\x12\x02pb\x1a\x0ecurrency.proto\"\x84\x01\n" +
+	"#SetCurrencyApprovalThresholdRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x122\n" +
+	"\x12approval_threshold\x18\x02 \x01(\x03H\x00R\x11approvalThreshold\x88\x01\x01B\x15\n" +
+	"\x13_approval_threshold\"P\n" +
+	"$SetCurrencyApprovalThresholdResponse\x12(\n" +
+	"\bcurrency\x18\x01 \x01(\v2\f.pb.CurrencyR\bcurrencyB\"Z github.com/mahanth/simplebank/pbb\x06proto3"
+
+var (
+	file_rpc_set_currency_approval_threshold_proto_rawDescOnce sync.Once
+	file_rpc_set_currency_approval_threshold_proto_rawDescData []byte
+)
+
+func file_rpc_set_currency_approval_threshold_proto_rawDescGZIP() []byte {
+	file_rpc_set_currency_approval_threshold_proto_rawDescOnce.Do(func() {
+		file_rpc_set_currency_approval_threshold_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_set_currency_approval_threshold_proto_rawDesc), len(file_rpc_set_currency_approval_threshold_proto_rawDesc)))
+	})
+	return file_rpc_set_currency_approval_threshold_proto_rawDescData
+}
+
+var file_rpc_set_currency_approval_threshold_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_set_currency_approval_threshold_proto_goTypes = []any{
+	(*SetCurrencyApprovalThresholdRequest)(nil),  // 0: pb.SetCurrencyApprovalThresholdRequest
+	(*SetCurrencyApprovalThresholdResponse)(nil), // 1: pb.SetCurrencyApprovalThresholdResponse
+	(*Currency)(nil), // 2: pb.Currency
+}
+var file_rpc_set_currency_approval_threshold_proto_depIdxs = []int32{
+	2, // 0: pb.SetCurrencyApprovalThresholdResponse.currency:type_name -> pb.Currency
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rpc_set_currency_approval_threshold_proto_init() }
+func file_rpc_set_currency_approval_threshold_proto_init() {
+	if File_rpc_set_currency_approval_threshold_proto != nil {
+		return
+	}
+	file_currency_proto_init()
+	file_rpc_set_currency_approval_threshold_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_set_currency_approval_threshold_proto_rawDesc), len(file_rpc_set_currency_approval_threshold_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_set_currency_approval_threshold_proto_goTypes,
+		DependencyIndexes: file_rpc_set_currency_approval_threshold_proto_depIdxs,
+		MessageInfos:      file_rpc_set_currency_approval_threshold_proto_msgTypes,
+	}.Build()
+	File_rpc_set_currency_approval_threshold_proto = out.File
+	file_rpc_set_currency_approval_threshold_proto_goTypes = nil
+	file_rpc_set_currency_approval_threshold_proto_depIdxs = nil
+}
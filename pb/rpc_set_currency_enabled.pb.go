@@ -0,0 +1,181 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v6.31.1
+// source: rpc_set_currency_enabled.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type SetCurrencyEnabledRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Enabled       bool                   `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetCurrencyEnabledRequest) Reset() {
+	*x = SetCurrencyEnabledRequest{}
+	mi := &file_rpc_set_currency_enabled_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetCurrencyEnabledRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCurrencyEnabledRequest) ProtoMessage() {}
+
+func (x *SetCurrencyEnabledRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_set_currency_enabled_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCurrencyEnabledRequest.ProtoReflect.Descriptor instead.
+func (*SetCurrencyEnabledRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_set_currency_enabled_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SetCurrencyEnabledRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *SetCurrencyEnabledRequest) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+type SetCurrencyEnabledResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Currency      *Currency              `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetCurrencyEnabledResponse) Reset() {
+	*x = SetCurrencyEnabledResponse{}
+	mi := &file_rpc_set_currency_enabled_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetCurrencyEnabledResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCurrencyEnabledResponse) ProtoMessage() {}
+
+func (x *SetCurrencyEnabledResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_set_currency_enabled_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCurrencyEnabledResponse.ProtoReflect.Descriptor instead.
+func (*SetCurrencyEnabledResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_set_currency_enabled_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SetCurrencyEnabledResponse) GetCurrency() *Currency {
+	if x != nil {
+		return x.Currency
+	}
+	return nil
+}
+
+var File_rpc_set_currency_enabled_proto protoreflect.FileDescriptor
+
+const file_rpc_set_currency_enabled_proto_rawDesc = "" +
+	"\n" +
+	"\x1erpc_set_currency_enabled.proto\x12\x02pb\x1a\x0ecurrency.proto\"I\n" +
+	"\x19SetCurrencyEnabledRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x18\n" +
+	"\aenabled\x18\x02 \x01(\bR\aenabled\"F\n" +
+	"\x1aSetCurrencyEnabledResponse\x12(\n" +
+	"\bcurrency\x18\x01 \x01(\v2\f.pb.CurrencyR\bcurrencyB\"Z github.com/mahanth/simplebank/pbb\x06proto3"
+
+var (
+	file_rpc_set_currency_enabled_proto_rawDescOnce sync.Once
+	file_rpc_set_currency_enabled_proto_rawDescData []byte
+)
+
+func file_rpc_set_currency_enabled_proto_rawDescGZIP() []byte {
+	file_rpc_set_currency_enabled_proto_rawDescOnce.Do(func() {
+		file_rpc_set_currency_enabled_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_set_currency_enabled_proto_rawDesc), len(file_rpc_set_currency_enabled_proto_rawDesc)))
+	})
+	return file_rpc_set_currency_enabled_proto_rawDescData
+}
+
+var file_rpc_set_currency_enabled_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_set_currency_enabled_proto_goTypes = []any{
+	(*SetCurrencyEnabledRequest)(nil),  // 0: pb.SetCurrencyEnabledRequest
+	(*SetCurrencyEnabledResponse)(nil), // 1: pb.SetCurrencyEnabledResponse
+	(*Currency)(nil),                   // 2: pb.Currency
+}
+var file_rpc_set_currency_enabled_proto_depIdxs = []int32{
+	2, // 0: pb.SetCurrencyEnabledResponse.currency:type_name -> pb.Currency
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rpc_set_currency_enabled_proto_init() }
+func file_rpc_set_currency_enabled_proto_init() {
+	if File_rpc_set_currency_enabled_proto != nil {
+		return
+	}
+	file_currency_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_set_currency_enabled_proto_rawDesc), len(file_rpc_set_currency_enabled_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_set_currency_enabled_proto_goTypes,
+		DependencyIndexes: file_rpc_set_currency_enabled_proto_depIdxs,
+		MessageInfos:      file_rpc_set_currency_enabled_proto_msgTypes,
+	}.Build()
+	File_rpc_set_currency_enabled_proto = out.File
+	file_rpc_set_currency_enabled_proto_goTypes = nil
+	file_rpc_set_currency_enabled_proto_depIdxs = nil
+}
@@ -0,0 +1,204 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v6.31.1
+// source: rpc_set_interest_rate.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type SetInterestRateRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	AccountType string                 `protobuf:"bytes,1,opt,name=account_type,json=accountType,proto3" json:"account_type,omitempty"`
+	Currency    string                 `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	AnnualRate  string                 `protobuf:"bytes,3,opt,name=annual_rate,json=annualRate,proto3" json:"annual_rate,omitempty"`
+	// defaults to now
+	EffectiveAt   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=effective_at,json=effectiveAt,proto3" json:"effective_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetInterestRateRequest) Reset() {
+	*x = SetInterestRateRequest{}
+	mi := &file_rpc_set_interest_rate_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetInterestRateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetInterestRateRequest) ProtoMessage() {}
+
+func (x *SetInterestRateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_set_interest_rate_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetInterestRateRequest.ProtoReflect.Descriptor instead.
+func (*SetInterestRateRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_set_interest_rate_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SetInterestRateRequest) GetAccountType() string {
+	if x != nil {
+		return x.AccountType
+	}
+	return ""
+}
+
+func (x *SetInterestRateRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *SetInterestRateRequest) GetAnnualRate() string {
+	if x != nil {
+		return x.AnnualRate
+	}
+	return ""
+}
+
+func (x *SetInterestRateRequest) GetEffectiveAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EffectiveAt
+	}
+	return nil
+}
+
+type SetInterestRateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InterestRate  *InterestRate          `protobuf:"bytes,1,opt,name=interest_rate,json=interestRate,proto3" json:"interest_rate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetInterestRateResponse) Reset() {
+	*x = SetInterestRateResponse{}
+	mi := &file_rpc_set_interest_rate_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetInterestRateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetInterestRateResponse) ProtoMessage() {}
+
+func (x *SetInterestRateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_set_interest_rate_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetInterestRateResponse.ProtoReflect.Descriptor instead.
+func (*SetInterestRateResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_set_interest_rate_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SetInterestRateResponse) GetInterestRate() *InterestRate {
+	if x != nil {
+		return x.InterestRate
+	}
+	return nil
+}
+
+var File_rpc_set_interest_rate_proto protoreflect.FileDescriptor
+
+const file_rpc_set_interest_rate_proto_rawDesc = "" +
+	"\n" +
+	"\x1brpc_set_interest_rate.proto\x12\x02pb\x1a\x13interest_rate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xb7\x01\n" +
+	"\x16SetInterestRateRequest\x12!\n" +
+	"\faccount_type\x18\x01 \x01(\tR\vaccountType\x12\x1a\n" +
+	"\bcurrency\x18\x02 \x01(\tR\bcurrency\x12\x1f\n" +
+	"\vannual_rate\x18\x03 \x01(\tR\n" +
+	"annualRate\x12=\n" +
+	"\feffective_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\veffectiveAt\"P\n" +
+	"\x17SetInterestRateResponse\x125\n" +
+	"\rinterest_rate\x18\x01 \x01(\v2\x10.pb.InterestRateR\finterestRateB\"Z github.com/mahanth/simplebank/pbb\x06proto3"
+
+var (
+	file_rpc_set_interest_rate_proto_rawDescOnce sync.Once
+	file_rpc_set_interest_rate_proto_rawDescData []byte
+)
+
+func file_rpc_set_interest_rate_proto_rawDescGZIP() []byte {
+	file_rpc_set_interest_rate_proto_rawDescOnce.Do(func() {
+		file_rpc_set_interest_rate_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_set_interest_rate_proto_rawDesc), len(file_rpc_set_interest_rate_proto_rawDesc)))
+	})
+	return file_rpc_set_interest_rate_proto_rawDescData
+}
+
+var file_rpc_set_interest_rate_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_set_interest_rate_proto_goTypes = []any{
+	(*SetInterestRateRequest)(nil),  // 0: pb.SetInterestRateRequest
+	(*SetInterestRateResponse)(nil), // 1: pb.SetInterestRateResponse
+	(*timestamppb.Timestamp)(nil),   // 2: google.protobuf.Timestamp
+	(*InterestRate)(nil),            // 3: pb.InterestRate
+}
+var file_rpc_set_interest_rate_proto_depIdxs = []int32{
+	2, // 0: pb.SetInterestRateRequest.effective_at:type_name -> google.protobuf.Timestamp
+	3, // 1: pb.SetInterestRateResponse.interest_rate:type_name -> pb.InterestRate
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_rpc_set_interest_rate_proto_init() }
+func file_rpc_set_interest_rate_proto_init() {
+	if File_rpc_set_interest_rate_proto != nil {
+		return
+	}
+	file_interest_rate_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_set_interest_rate_proto_rawDesc), len(file_rpc_set_interest_rate_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_set_interest_rate_proto_goTypes,
+		DependencyIndexes: file_rpc_set_interest_rate_proto_depIdxs,
+		MessageInfos:      file_rpc_set_interest_rate_proto_msgTypes,
+	}.Build()
+	File_rpc_set_interest_rate_proto = out.File
+	file_rpc_set_interest_rate_proto_goTypes = nil
+	file_rpc_set_interest_rate_proto_depIdxs = nil
+}
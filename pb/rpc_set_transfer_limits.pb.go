@@ -0,0 +1,217 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v6.31.1
+// source: rpc_set_transfer_limits.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// exactly one of username and account_id must be set; the limits replace any earlier override
+type SetTransferLimitsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      *string                `protobuf:"bytes,1,opt,name=username,proto3,oneof" json:"username,omitempty"`
+	AccountId     *int64                 `protobuf:"varint,2,opt,name=account_id,json=accountId,proto3,oneof" json:"account_id,omitempty"`
+	MaxSingle     *int64                 `protobuf:"varint,3,opt,name=max_single,json=maxSingle,proto3,oneof" json:"max_single,omitempty"`
+	MaxDailyTotal *int64                 `protobuf:"varint,4,opt,name=max_daily_total,json=maxDailyTotal,proto3,oneof" json:"max_daily_total,omitempty"`
+	MaxDailyCount *int32                 `protobuf:"varint,5,opt,name=max_daily_count,json=maxDailyCount,proto3,oneof" json:"max_daily_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetTransferLimitsRequest) Reset() {
+	*x = SetTransferLimitsRequest{}
+	mi := &file_rpc_set_transfer_limits_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTransferLimitsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTransferLimitsRequest) ProtoMessage() {}
+
+func (x *SetTransferLimitsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_set_transfer_limits_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTransferLimitsRequest.ProtoReflect.Descriptor instead.
+func (*SetTransferLimitsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_set_transfer_limits_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SetTransferLimitsRequest) GetUsername() string {
+	if x != nil && x.Username != nil {
+		return *x.Username
+	}
+	return ""
+}
+
+func (x *SetTransferLimitsRequest) GetAccountId() int64 {
+	if x != nil && x.AccountId != nil {
+		return *x.AccountId
+	}
+	return 0
+}
+
+func (x *SetTransferLimitsRequest) GetMaxSingle() int64 {
+	if x != nil && x.MaxSingle != nil {
+		return *x.MaxSingle
+	}
+	return 0
+}
+
+func (x *SetTransferLimitsRequest) GetMaxDailyTotal() int64 {
+	if x != nil && x.MaxDailyTotal != nil {
+		return *x.MaxDailyTotal
+	}
+	return 0
+}
+
+func (x *SetTransferLimitsRequest) GetMaxDailyCount() int32 {
+	if x != nil && x.MaxDailyCount != nil {
+		return *x.MaxDailyCount
+	}
+	return 0
+}
+
+type SetTransferLimitsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TransferLimit *TransferLimit         `protobuf:"bytes,1,opt,name=transfer_limit,json=transferLimit,proto3" json:"transfer_limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetTransferLimitsResponse) Reset() {
+	*x = SetTransferLimitsResponse{}
+	mi := &file_rpc_set_transfer_limits_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTransferLimitsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTransferLimitsResponse) ProtoMessage() {}
+
+func (x *SetTransferLimitsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_set_transfer_limits_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTransferLimitsResponse.ProtoReflect.Descriptor instead.
+func (*SetTransferLimitsResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_set_transfer_limits_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SetTransferLimitsResponse) GetTransferLimit() *TransferLimit {
+	if x != nil {
+		return x.TransferLimit
+	}
+	return nil
+}
+
+var File_rpc_set_transfer_limits_proto protoreflect.FileDescriptor
+
+const file_rpc_set_transfer_limits_proto_rawDesc = "" +
+	"\n" +
+	"\x1drpc_set_transfer_limits.proto\x12\x02pb\x1a\x14transfer_limit.proto\"\xb0\x02\n" +
+	"\x18SetTransferLimitsRequest\x12\x1f\n" +
+	"\busername\x18\x01 \x01(\tH\x00R\busername\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"account_id\x18\x02 \x01(\x03H\x01R\taccountId\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"max_single\x18\x03 \x01(\x03H\x02R\tmaxSingle\x88\x01\x01\x12+\n" +
+	"\x0fmax_daily_total\x18\x04 \x01(\x03H\x03R\rmaxDailyTotal\x88\x01\x01\x12+\n" +
+	"\x0fmax_daily_count\x18\x05 \x01(\x05H\x04R\rmaxDailyCount\x88\x01\x01B\v\n" +
+	"\t_usernameB\r\n" +
+	"\v_account_idB\r\n" +
+	"\v_max_singleB\x12\n" +
+	"\x10_max_daily_totalB\x12\n" +
+	"\x10_max_daily_count\"U\n" +
+	"\x19SetTransferLimitsResponse\x128\n" +
+	"\x0etransfer_limit\x18\x01 \x01(\v2\x11.pb.TransferLimitR\rtransferLimitB\"Z github.com/mahanth/simplebank/pbb\x06proto3"
+
+var (
+	file_rpc_set_transfer_limits_proto_rawDescOnce sync.Once
+	file_rpc_set_transfer_limits_proto_rawDescData []byte
+)
+
+func file_rpc_set_transfer_limits_proto_rawDescGZIP() []byte {
+	file_rpc_set_transfer_limits_proto_rawDescOnce.Do(func() {
+		file_rpc_set_transfer_limits_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_set_transfer_limits_proto_rawDesc), len(file_rpc_set_transfer_limits_proto_rawDesc)))
+	})
+	return file_rpc_set_transfer_limits_proto_rawDescData
+}
+
+var file_rpc_set_transfer_limits_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_set_transfer_limits_proto_goTypes = []any{
+	(*SetTransferLimitsRequest)(nil),  // 0: pb.SetTransferLimitsRequest
+	(*SetTransferLimitsResponse)(nil), // 1: pb.SetTransferLimitsResponse
+	(*TransferLimit)(nil),             // 2: pb.TransferLimit
+}
+var file_rpc_set_transfer_limits_proto_depIdxs = []int32{
+	2, // 0: pb.SetTransferLimitsResponse.transfer_limit:type_name -> pb.TransferLimit
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rpc_set_transfer_limits_proto_init() }
+func file_rpc_set_transfer_limits_proto_init() {
+	if File_rpc_set_transfer_limits_proto != nil {
+		return
+	}
+	file_transfer_limit_proto_init()
+	file_rpc_set_transfer_limits_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_set_transfer_limits_proto_rawDesc), len(file_rpc_set_transfer_limits_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_set_transfer_limits_proto_goTypes,
+		DependencyIndexes: file_rpc_set_transfer_limits_proto_depIdxs,
+		MessageInfos:      file_rpc_set_transfer_limits_proto_msgTypes,
+	}.Build()
+	File_rpc_set_transfer_limits_proto = out.File
+	file_rpc_set_transfer_limits_proto_goTypes = nil
+	file_rpc_set_transfer_limits_proto_depIdxs = nil
+}
@@ -0,0 +1,202 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v6.31.1
+// source: rpc_verify_audit_log.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type VerifyAuditLogRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyAuditLogRequest) Reset() {
+	*x = VerifyAuditLogRequest{}
+	mi := &file_rpc_verify_audit_log_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyAuditLogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyAuditLogRequest) ProtoMessage() {}
+
+func (x *VerifyAuditLogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_verify_audit_log_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyAuditLogRequest.ProtoReflect.Descriptor instead.
+func (*VerifyAuditLogRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_verify_audit_log_proto_rawDescGZIP(), []int{0}
+}
+
+type VerifyAuditLogResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// false if an event was altered, removed or inserted
+	Intact bool `protobuf:"varint,1,opt,name=intact,proto3" json:"intact,omitempty"`
+	// events from the start of the log that passed the check
+	EventsVerified int64 `protobuf:"varint,2,opt,name=events_verified,json=eventsVerified,proto3" json:"events_verified,omitempty"`
+	// hash of the last event that passed; keep it to detect later removals from the end of the log
+	HeadHash string `protobuf:"bytes,3,opt,name=head_hash,json=headHash,proto3" json:"head_hash,omitempty"`
+	// first event that failed the check, unset if the log is intact
+	TamperedEventId *int64 `protobuf:"varint,4,opt,name=tampered_event_id,json=tamperedEventId,proto3,oneof" json:"tampered_event_id,omitempty"`
+	Reason          string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *VerifyAuditLogResponse) Reset() {
+	*x = VerifyAuditLogResponse{}
+	mi := &file_rpc_verify_audit_log_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyAuditLogResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyAuditLogResponse) ProtoMessage() {}
+
+func (x *VerifyAuditLogResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_verify_audit_log_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyAuditLogResponse.ProtoReflect.Descriptor instead.
+func (*VerifyAuditLogResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_verify_audit_log_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *VerifyAuditLogResponse) GetIntact() bool {
+	if x != nil {
+		return x.Intact
+	}
+	return false
+}
+
+func (x *VerifyAuditLogResponse) GetEventsVerified() int64 {
+	if x != nil {
+		return x.EventsVerified
+	}
+	return 0
+}
+
+func (x *VerifyAuditLogResponse) GetHeadHash() string {
+	if x != nil {
+		return x.HeadHash
+	}
+	return ""
+}
+
+func (x *VerifyAuditLogResponse) GetTamperedEventId() int64 {
+	if x != nil && x.TamperedEventId != nil {
+		return *x.TamperedEventId
+	}
+	return 0
+}
+
+func (x *VerifyAuditLogResponse) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+var File_rpc_verify_audit_log_proto protoreflect.FileDescriptor
+
+const file_rpc_verify_audit_log_proto_rawDesc = "" +
+	"\n" +
+	"\x1arpc_verify_audit_log.proto\x12\x02pb\"\x17\n" +
+	"\x15VerifyAuditLogRequest\"\xd5\x01\n" +
+	"\x16VerifyAuditLogResponse\x12\x16\n" +
+	"\x06intact\x18\x01 \x01(\bR\x06intact\x12'\n" +
+	"\x0fevents_verified\x18\x02 \x01(\x03R\x0eeventsVerified\x12\x1b\n" +
+	"\thead_hash\x18\x03 \x01(\tR\bheadHash\x12/\n" +
+	"\x11tampered_event_id\x18\x04 \x01(\x03H\x00R\x0ftamperedEventId\x88\x01\x01\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reasonB\x14\n" +
+	"\x12_tampered_event_idB\"Z github.com/mahanth/simplebank/pbb\x06proto3"
+
+var (
+	file_rpc_verify_audit_log_proto_rawDescOnce sync.Once
+	file_rpc_verify_audit_log_proto_rawDescData []byte
+)
+
+func file_rpc_verify_audit_log_proto_rawDescGZIP() []byte {
+	file_rpc_verify_audit_log_proto_rawDescOnce.Do(func() {
+		file_rpc_verify_audit_log_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_verify_audit_log_proto_rawDesc), len(file_rpc_verify_audit_log_proto_rawDesc)))
+	})
+	return file_rpc_verify_audit_log_proto_rawDescData
+}
+
+var file_rpc_verify_audit_log_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_verify_audit_log_proto_goTypes = []any{
+	(*VerifyAuditLogRequest)(nil),  // 0: pb.VerifyAuditLogRequest
+	(*VerifyAuditLogResponse)(nil), // 1: pb.VerifyAuditLogResponse
+}
+var file_rpc_verify_audit_log_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_rpc_verify_audit_log_proto_init() }
+func file_rpc_verify_audit_log_proto_init() {
+	if File_rpc_verify_audit_log_proto != nil {
+		return
+	}
+	file_rpc_verify_audit_log_proto_msgTypes[1].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_verify_audit_log_proto_rawDesc), len(file_rpc_verify_audit_log_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_verify_audit_log_proto_goTypes,
+		DependencyIndexes: file_rpc_verify_audit_log_proto_depIdxs,
+		MessageInfos:      file_rpc_verify_audit_log_proto_msgTypes,
+	}.Build()
+	File_rpc_verify_audit_log_proto = out.File
+	file_rpc_verify_audit_log_proto_goTypes = nil
+	file_rpc_verify_audit_log_proto_depIdxs = nil
+}
@@ -26,7 +26,7 @@ var File_service_bank_system_proto protoreflect.FileDescriptor
 
 const file_service_bank_system_proto_rawDesc = "" +
 	"\n" +
-	"\x19service_bank_system.proto\x12\x02pb\x1a\x15rpc_create_user.proto\x1a\x15rpc_update_user.proto\x1a\x14rpc_login_user.proto\x1a\x16rpc_verify_email.proto\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto2\xdc\x04\n" +
+	"\x19service_bank_system.proto\x12\x02pb\x1a\x15rpc_create_user.proto\x1a\x15rpc_update_user.proto\x1a\x14rpc_login_user.proto\x1a\x16rpc_verify_email.proto\x1a\x18rpc_create_account.proto\x1a\x15rpc_get_account.proto\x1a\x17rpc_list_accounts.proto\x1a\x19rpc_create_transfer.proto\x1a\x16rpc_get_transfer.proto\x1a\x18rpc_list_transfers.proto\x1a\x16rpc_list_entries.proto\x1a\x1arpc_reconcile_ledger.proto\x1a#rpc_create_scheduled_transfer.proto\x1a\"rpc_list_scheduled_transfers.proto\x1a\"rpc_pause_scheduled_transfer.proto\x1a#rpc_resume_scheduled_transfer.proto\x1a#rpc_cancel_scheduled_transfer.proto\x1a,rpc_list_scheduled_transfer_executions.proto\x1a\x1arpc_reverse_transfer.proto\x1a\x14rpc_place_hold.proto\x1a\x16rpc_capture_hold.proto\x1a\x16rpc_release_hold.proto\x1a\x1frpc_update_account_status.proto\x1a%rpc_list_account_status_changes.proto\x1a\x1brpc_set_interest_rate.proto\x1a\x19rpc_create_fee_rule.proto\x1a\x18rpc_list_fee_rules.proto\x1a\x1drpc_set_transfer_limits.proto\x1a\x18rpc_get_balance_at.proto\x1a\x1arpc_search_transfers.proto\x1a\x19rpc_list_currencies.proto\x1a\x1erpc_set_currency_enabled.proto\x1a)rpc_set_currency_approval_threshold.proto\x1a\x1frpc_invite_account_holder.proto\x1a\x1frpc_accept_account_holder.proto\x1a\x1frpc_remove_account_holder.proto\x1a%rpc_create_webhook_subscription.proto\x1a$rpc_list_webhook_subscriptions.proto\x1a%rpc_delete_webhook_subscription.proto\x1a!rpc_list_webhook_deliveries.proto\x1a\x1arpc_verify_audit_log.proto\x1a)rpc_list_pending_transfer_approvals.proto\x1a\x1arpc_approve_transfer.proto\x1a\x19rpc_reject_transfer.proto\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto2\xefJ\n" +
 	"\n" +
 	"BankSystem\x12\x8e\x01\n" +
 	"\n" +
@@ -35,34 +35,236 @@ const file_service_bank_system_proto_rawDesc = "" +
 	"Login User\x1a<Use this API to login as a user and get access/refresh token\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/v1/login_user\x12\x86\x01\n" +
 	"\n" +
 	"UpdateUser\x12\x15.pb.UpdateUserRequest\x1a\x16.pb.UpdateUserResponse\"I\x92A,\x12\vUpdate User\x1a\x1dUse this API to update a user\x82\xd3\xe4\x93\x02\x14:\x01*2\x0f/v1/update_user\x12\x90\x01\n" +
-	"\vVerifyEmail\x12\x16.pb.VerifyEmailRequest\x1a\x17.pb.VerifyEmailResponse\"P\x92A5\x12\fVerify Email\x1a%Use this API to verify a user's email\x82\xd3\xe4\x93\x02\x12\x12\x10/v1/verify_emailB\x98\x01\x92As\x12q\n" +
+	"\vVerifyEmail\x12\x16.pb.VerifyEmailRequest\x1a\x17.pb.VerifyEmailResponse\"P\x92A5\x12\fVerify Email\x1a%Use this API to verify a user's email\x82\xd3\xe4\x93\x02\x12\x12\x10/v1/verify_email\x12\xc8\x01\n" +
+	"\rCreateAccount\x12\x18.pb.CreateAccountRequest\x1a\x19.pb.CreateAccountResponse\"\x81\x01\x92Aa\x12\x0eCreate Account\x1aOUse this API to open a new account in the given currency for the logged in user\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/v1/create_account\x12\xa0\x01\n" +
+	"\n" +
+	"GetAccount\x12\x15.pb.GetAccountRequest\x1a\x16.pb.GetAccountResponse\"c\x92AI\x12\vGet Account\x1a:Use this API to get an account owned by the logged in user\x82\xd3\xe4\x93\x02\x11\x12\x0f/v1/get_account\x12\xa7\x01\n" +
+	"\fListAccounts\x12\x17.pb.ListAccountsRequest\x1a\x18.pb.ListAccountsResponse\"d\x92AH\x12\rList Accounts\x1a7Use this API to list the accounts of the logged in user\x82\xd3\xe4\x93\x02\x13\x12\x11/v1/list_accounts\x12\xc5\x01\n" +
+	"\x0eCreateTransfer\x12\x19.pb.CreateTransferRequest\x1a\x1a.pb.CreateTransferResponse\"|\x92A[\x12\x0fCreate Transfer\x1aHUse this API to transfer money between two accounts of the same currency\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/v1/create_transfer\x12\xb5\x01\n" +
+	"\vGetTransfer\x12\x16.pb.GetTransferRequest\x1a\x17.pb.GetTransferResponse\"u\x92AZ\x12\fGet Transfer\x1aJUse this API to get a transfer from or to an account of the logged in user\x82\xd3\xe4\x93\x02\x12\x12\x10/v1/get_transfer\x12\xad\x01\n" +
+	"\rListTransfers\x12\x18.pb.ListTransfersRequest\x1a\x19.pb.ListTransfersResponse\"g\x92AJ\x12\x0eList Transfers\x1a8Use this API to list the transfers from or to an account\x82\xd3\xe4\x93\x02\x14\x12\x12/v1/list_transfers\x12\xa0\x01\n" +
+	"\vListEntries\x12\x16.pb.ListEntriesRequest\x1a\x17.pb.ListEntriesResponse\"`\x92AE\x12\fList Entries\x1a5Use this API to list the ledger entries of an account\x82\xd3\xe4\x93\x02\x12\x12\x10/v1/list_entries\x12\xdd\x01\n" +
+	"\x0fReconcileLedger\x12\x1a.pb.ReconcileLedgerRequest\x1a\x1b.pb.ReconcileLedgerResponse\"\x90\x01\x92An\x12\x10Reconcile Ledger\x1aZUse this API as a banker to check that the ledger balances and get a reconciliation report\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/v1/reconcile_ledger\x12\x85\x02\n" +
+	"\x17CreateScheduledTransfer\x12\".pb.CreateScheduledTransferRequest\x1a#.pb.CreateScheduledTransferResponse\"\xa0\x01\x92Au\x12\x19Create Scheduled Transfer\x1aXUse this API to set up a transfer that repeats on a cron schedule or at a fixed interval\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/v1/create_scheduled_transfer\x12\xe7\x01\n" +
+	"\x16ListScheduledTransfers\x12!.pb.ListScheduledTransfersRequest\x1a\".pb.ListScheduledTransfersResponse\"\x85\x01\x92A^\x12\x18List Scheduled Transfers\x1aBUse this API to list the scheduled transfers of the logged in user\x82\xd3\xe4\x93\x02\x1e\x12\x1c/v1/list_scheduled_transfers\x12\xf2\x01\n" +
+	"\x16PauseScheduledTransfer\x12!.pb.PauseScheduledTransferRequest\x1a\".pb.PauseScheduledTransferResponse\"\x90\x01\x92Af\x12\x18Pause Scheduled Transfer\x1aJUse this API to stop a scheduled transfer from running until it is resumed\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/v1/pause_scheduled_transfer\x12\xf1\x01\n" +
+	"\x17ResumeScheduledTransfer\x12\".pb.ResumeScheduledTransferRequest\x1a#.pb.ResumeScheduledTransferResponse\"\x8c\x01\x92Aa\x12\x19Resume Scheduled Transfer\x1aDUse this API to resume a paused scheduled transfer from its next run\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/v1/resume_scheduled_transfer\x12\xde\x01\n" +
+	"\x17CancelScheduledTransfer\x12\".pb.CancelScheduledTransferRequest\x1a#.pb.CancelScheduledTransferResponse\"z\x92AO\x12\x19Cancel Scheduled Transfer\x1a2Use this API to stop a scheduled transfer for good\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/v1/cancel_scheduled_transfer\x12\xa4\x02\n" +
+	"\x1fListScheduledTransferExecutions\x12*.pb.ListScheduledTransferExecutionsRequest\x1a+.pb.ListScheduledTransferExecutionsResponse\"\xa7\x01\x92Av\x12\"List Scheduled Transfer Executions\x1aPUse this API to list the runs of a scheduled transfer and why any of them failed\x82\xd3\xe4\x93\x02(\x12&/v1/list_scheduled_transfer_executions\x12\xd3\x01\n" +
+	"\x0fReverseTransfer\x12\x1a.pb.ReverseTransferRequest\x1a\x1b.pb.ReverseTransferResponse\"\x86\x01\x92Ad\x12\x10Reverse Transfer\x1aPUse this API as a banker or as the recipient to refund all or part of a transfer\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/v1/reverse_transfer\x12\xb8\x01\n" +
+	"\tPlaceHold\x12\x14.pb.PlaceHoldRequest\x1a\x15.pb.PlaceHoldResponse\"~\x92Ab\x12\n" +
+	"Place Hold\x1aTUse this API to reserve funds of your account for a later capture by another account\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/v1/place_hold\x12\xbe\x01\n" +
+	"\vCaptureHold\x12\x16.pb.CaptureHoldRequest\x1a\x17.pb.CaptureHoldResponse\"~\x92A`\x12\fCapture Hold\x1aPUse this API as a banker or as the beneficiary to transfer all or part of a hold\x82\xd3\xe4\x93\x02\x15:\x01*\"\x10/v1/capture_hold\x12\xbd\x01\n" +
+	"\vReleaseHold\x12\x16.pb.ReleaseHoldRequest\x1a\x17.pb.ReleaseHoldResponse\"}\x92A_\x12\fRelease Hold\x1aOUse this API as a banker or as the beneficiary to give the funds of a hold back\x82\xd3\xe4\x93\x02\x15:\x01*\"\x10/v1/release_hold\x12\xe7\x01\n" +
+	"\x13UpdateAccountStatus\x12\x1e.pb.UpdateAccountStatusRequest\x1a\x1f.pb.UpdateAccountStatusResponse\"\x8e\x01\x92Ag\x12\x15Update Account Status\x1aNUse this API as a banker to freeze, unfreeze, mark dormant or close an account\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/v1/update_account_status\x12\xf1\x01\n" +
+	"\x18ListAccountStatusChanges\x12#.pb.ListAccountStatusChangesRequest\x1a$.pb.ListAccountStatusChangesResponse\"\x89\x01\x92A_\x12\x1bList Account Status Changes\x1a@Use this API to see who changed the status of an account and why\x82\xd3\xe4\x93\x02!\x12\x1f/v1/list_account_status_changes\x12\xf4\x01\n" +
+	"\x0fSetInterestRate\x12\x1a.pb.SetInterestRateRequest\x1a\x1b.pb.SetInterestRateResponse\"\xa7\x01\x92A\x83\x01\x12\x11Set Interest Rate\x1anUse this API as a banker to set the annual interest rate an account type earns in a currency from a given time\x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/v1/set_interest_rate\x12\xe2\x01\n" +
+	"\rCreateFeeRule\x12\x18.pb.CreateFeeRuleRequest\x1a\x19.pb.CreateFeeRuleResponse\"\x9b\x01\x92Az\x12\x0fCreate Fee Rule\x1agUse this API as a banker to add a transfer fee rule. The most specific rule matching a transfer applies\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/v1/create_fee_rule\x12\xa9\x01\n" +
+	"\fListFeeRules\x12\x17.pb.ListFeeRulesRequest\x1a\x18.pb.ListFeeRulesResponse\"f\x92AI\x12\x0eList Fee Rules\x1a7Use this API as a banker to list the transfer fee rules\x82\xd3\xe4\x93\x02\x14\x12\x12/v1/list_fee_rules\x12\xf6\x01\n" +
+	"\x11SetTransferLimits\x12\x1c.pb.SetTransferLimitsRequest\x1a\x1d.pb.SetTransferLimitsResponse\"\xa3\x01\x92A~\x12\x13Set Transfer Limits\x1agUse this API as a banker to override the default transfer limits of a user's accounts or of one account\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/v1/set_transfer_limits\x12\xb3\x01\n" +
+	"\fGetBalanceAt\x12\x17.pb.GetBalanceAtRequest\x1a\x18.pb.GetBalanceAtResponse\"p\x92AS\x12\x0eGet Balance At\x1aAUse this API to get the balance an account had at a point in time\x82\xd3\xe4\x93\x02\x14\x12\x12/v1/get_balance_at\x12\xd9\x01\n" +
+	"\x0fSearchTransfers\x12\x1a.pb.SearchTransfersRequest\x1a\x1b.pb.SearchTransfersResponse\"\x8c\x01\x92Am\x12\x10Search Transfers\x1aYUse this API to search the memos and references of the transfers from or to your accounts\x82\xd3\xe4\x93\x02\x16\x12\x14/v1/search_transfers\x12\xca\x01\n" +
+	"\x0eListCurrencies\x12\x19.pb.ListCurrenciesRequest\x1a\x1a.pb.ListCurrenciesResponse\"\x80\x01\x92Ab\x12\x0fList Currencies\x1aOUse this API to list the currencies the bank knows and whether they are enabled\x82\xd3\xe4\x93\x02\x15\x12\x13/v1/list_currencies\x12\xeb\x01\n" +
+	"\x12SetCurrencyEnabled\x12\x1d.pb.SetCurrencyEnabledRequest\x1a\x1e.pb.SetCurrencyEnabledResponse\"\x95\x01\x92Ao\x12\x14Set Currency Enabled\x1aWUse this API as a banker to enable or disable a currency for new accounts and transfers\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/v1/set_currency_enabled\x12\xb0\x02\n" +
+	"\x1cSetCurrencyApprovalThreshold\x12'.pb.SetCurrencyApprovalThresholdRequest\x1a(.pb.SetCurrencyApprovalThresholdResponse\"\xbc\x01\x92A\x8a\x01\x12\x1fSet Currency Approval Threshold\x1agUse this API as a banker to set the amount above which transfers in a currency need a banker's approval\x82\xd3\xe4\x93\x02(:\x01*\"#/v1/set_currency_approval_threshold\x12\xff\x01\n" +
+	"\x13InviteAccountHolder\x12\x1e.pb.InviteAccountHolderRequest\x1a\x1f.pb.InviteAccountHolderResponse\"\xa6\x01\x92A\x7f\x12\x15Invite Account Holder\x1afUse this API to invite a user to share an account you manage, with view, transfer or manage permission\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/v1/invite_account_holder\x12\xd0\x01\n" +
+	"\x13AcceptAccountHolder\x12\x1e.pb.AcceptAccountHolderRequest\x1a\x1f.pb.AcceptAccountHolderResponse\"x\x92AQ\x12\x15Accept Account Holder\x1a8Use this API to accept an invitation to share an account\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/v1/accept_account_holder\x12\xfc\x01\n" +
+	"\x13RemoveAccountHolder\x12\x1e.pb.RemoveAccountHolderRequest\x1a\x1f.pb.RemoveAccountHolderResponse\"\xa3\x01\x92A|\x12\x15Remove Account Holder\x1acUse this API to remove a holder from an account you manage, or to leave or decline a shared account\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/v1/remove_account_holder\x12\x8f\x02\n" +
+	"\x19CreateWebhookSubscription\x12$.pb.CreateWebhookSubscriptionRequest\x1a%.pb.CreateWebhookSubscriptionResponse\"\xa4\x01\x92Aw\x12\x1bCreate Webhook Subscription\x1aXUse this API to get account activity POSTed to a URL, signed with a secret returned once\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/v1/create_webhook_subscription\x12\xdd\x01\n" +
+	"\x18ListWebhookSubscriptions\x12#.pb.ListWebhookSubscriptionsRequest\x1a$.pb.ListWebhookSubscriptionsResponse\"v\x92AM\x12\x1aList Webhook Subscriptions\x1a/Use this API to list your webhook subscriptions\x82\xd3\xe4\x93\x02 \x12\x1e/v1/list_webhook_subscriptions\x12\xf0\x01\n" +
+	"\x19DeleteWebhookSubscription\x12$.pb.DeleteWebhookSubscriptionRequest\x1a%.pb.DeleteWebhookSubscriptionResponse\"\x85\x01\x92AX\x12\x1bDelete Webhook Subscription\x1a9Use this API to stop deliveries to a webhook subscription\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/v1/delete_webhook_subscription\x12\xee\x01\n" +
+	"\x15ListWebhookDeliveries\x12 .pb.ListWebhookDeliveriesRequest\x1a!.pb.ListWebhookDeliveriesResponse\"\x8f\x01\x92Ai\x12\x17List Webhook Deliveries\x1aNUse this API to see what was delivered to a webhook subscription, newest first\x82\xd3\xe4\x93\x02\x1d\x12\x1b/v1/list_webhook_deliveries\x12\xcf\x01\n" +
+	"\x0eVerifyAuditLog\x12\x19.pb.VerifyAuditLogRequest\x1a\x1a.pb.VerifyAuditLogResponse\"\x85\x01\x92Ac\x12\x10Verify Audit Log\x1aOUse this API as a banker to check the hash chain of the audit log for tampering\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/v1/verify_audit_log\x12\xa9\x02\n" +
+	"\x1cListPendingTransferApprovals\x12'.pb.ListPendingTransferApprovalsRequest\x1a(.pb.ListPendingTransferApprovalsResponse\"\xb5\x01\x92A\x86\x01\x12\x1fList Pending Transfer Approvals\x1acUse this API as a banker to see the transfers awaiting approval that have not expired, oldest first\x82\xd3\xe4\x93\x02%\x12#/v1/list_pending_transfer_approvals\x12\xd9\x01\n" +
+	"\x0fApproveTransfer\x12\x1a.pb.ApproveTransferRequest\x1a\x1b.pb.ApproveTransferResponse\"\x8c\x01\x92Aj\x12\x10Approve Transfer\x1aVUse this API as a banker other than the requester to make a transfer awaiting approval\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/v1/approve_transfer\x12\xed\x01\n" +
+	"\x0eRejectTransfer\x12\x19.pb.RejectTransferRequest\x1a\x1a.pb.RejectTransferResponse\"\xa3\x01\x92A\x81\x01\x12\x0fReject Transfer\x1anUse this API as a banker other than the requester to reject a transfer awaiting approval and release its funds\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/v1/reject_transferB\x98\x01\x92As\x12q\n" +
 	"\x0fBank System API\"Y\n" +
 	"\rMahanth Kumar\x12(https://github.com/MAHANTH-wq/BankSystem\x1a\x1evallulrimahanthkumar@gmail.com2\x031.2Z github.com/mahanth/simplebank/pbb\x06proto3"
 
 var file_service_bank_system_proto_goTypes = []any{
-	(*CreateUserRequest)(nil),   // 0: pb.CreateUserRequest
-	(*LoginUserRequest)(nil),    // 1: pb.LoginUserRequest
-	(*UpdateUserRequest)(nil),   // 2: pb.UpdateUserRequest
-	(*VerifyEmailRequest)(nil),  // 3: pb.VerifyEmailRequest
-	(*CreateUserResponse)(nil),  // 4: pb.CreateUserResponse
-	(*LoginUserResponse)(nil),   // 5: pb.LoginUserResponse
-	(*UpdateUserResponse)(nil),  // 6: pb.UpdateUserResponse
-	(*VerifyEmailResponse)(nil), // 7: pb.VerifyEmailResponse
+	(*CreateUserRequest)(nil),                       // 0: pb.CreateUserRequest
+	(*LoginUserRequest)(nil),                        // 1: pb.LoginUserRequest
+	(*UpdateUserRequest)(nil),                       // 2: pb.UpdateUserRequest
+	(*VerifyEmailRequest)(nil),                      // 3: pb.VerifyEmailRequest
+	(*CreateAccountRequest)(nil),                    // 4: pb.CreateAccountRequest
+	(*GetAccountRequest)(nil),                       // 5: pb.GetAccountRequest
+	(*ListAccountsRequest)(nil),                     // 6: pb.ListAccountsRequest
+	(*CreateTransferRequest)(nil),                   // 7: pb.CreateTransferRequest
+	(*GetTransferRequest)(nil),                      // 8: pb.GetTransferRequest
+	(*ListTransfersRequest)(nil),                    // 9: pb.ListTransfersRequest
+	(*ListEntriesRequest)(nil),                      // 10: pb.ListEntriesRequest
+	(*ReconcileLedgerRequest)(nil),                  // 11: pb.ReconcileLedgerRequest
+	(*CreateScheduledTransferRequest)(nil),          // 12: pb.CreateScheduledTransferRequest
+	(*ListScheduledTransfersRequest)(nil),           // 13: pb.ListScheduledTransfersRequest
+	(*PauseScheduledTransferRequest)(nil),           // 14: pb.PauseScheduledTransferRequest
+	(*ResumeScheduledTransferRequest)(nil),          // 15: pb.ResumeScheduledTransferRequest
+	(*CancelScheduledTransferRequest)(nil),          // 16: pb.CancelScheduledTransferRequest
+	(*ListScheduledTransferExecutionsRequest)(nil),  // 17: pb.ListScheduledTransferExecutionsRequest
+	(*ReverseTransferRequest)(nil),                  // 18: pb.ReverseTransferRequest
+	(*PlaceHoldRequest)(nil),                        // 19: pb.PlaceHoldRequest
+	(*CaptureHoldRequest)(nil),                      // 20: pb.CaptureHoldRequest
+	(*ReleaseHoldRequest)(nil),                      // 21: pb.ReleaseHoldRequest
+	(*UpdateAccountStatusRequest)(nil),              // 22: pb.UpdateAccountStatusRequest
+	(*ListAccountStatusChangesRequest)(nil),         // 23: pb.ListAccountStatusChangesRequest
+	(*SetInterestRateRequest)(nil),                  // 24: pb.SetInterestRateRequest
+	(*CreateFeeRuleRequest)(nil),                    // 25: pb.CreateFeeRuleRequest
+	(*ListFeeRulesRequest)(nil),                     // 26: pb.ListFeeRulesRequest
+	(*SetTransferLimitsRequest)(nil),                // 27: pb.SetTransferLimitsRequest
+	(*GetBalanceAtRequest)(nil),                     // 28: pb.GetBalanceAtRequest
+	(*SearchTransfersRequest)(nil),                  // 29: pb.SearchTransfersRequest
+	(*ListCurrenciesRequest)(nil),                   // 30: pb.ListCurrenciesRequest
+	(*SetCurrencyEnabledRequest)(nil),               // 31: pb.SetCurrencyEnabledRequest
+	(*SetCurrencyApprovalThresholdRequest)(nil),     // 32: pb.SetCurrencyApprovalThresholdRequest
+	(*InviteAccountHolderRequest)(nil),              // 33: pb.InviteAccountHolderRequest
+	(*AcceptAccountHolderRequest)(nil),              // 34: pb.AcceptAccountHolderRequest
+	(*RemoveAccountHolderRequest)(nil),              // 35: pb.RemoveAccountHolderRequest
+	(*CreateWebhookSubscriptionRequest)(nil),        // 36: pb.CreateWebhookSubscriptionRequest
+	(*ListWebhookSubscriptionsRequest)(nil),         // 37: pb.ListWebhookSubscriptionsRequest
+	(*DeleteWebhookSubscriptionRequest)(nil),        // 38: pb.DeleteWebhookSubscriptionRequest
+	(*ListWebhookDeliveriesRequest)(nil),            // 39: pb.ListWebhookDeliveriesRequest
+	(*VerifyAuditLogRequest)(nil),                   // 40: pb.VerifyAuditLogRequest
+	(*ListPendingTransferApprovalsRequest)(nil),     // 41: pb.ListPendingTransferApprovalsRequest
+	(*ApproveTransferRequest)(nil),                  // 42: pb.ApproveTransferRequest
+	(*RejectTransferRequest)(nil),                   // 43: pb.RejectTransferRequest
+	(*CreateUserResponse)(nil),                      // 44: pb.CreateUserResponse
+	(*LoginUserResponse)(nil),                       // 45: pb.LoginUserResponse
+	(*UpdateUserResponse)(nil),                      // 46: pb.UpdateUserResponse
+	(*VerifyEmailResponse)(nil),                     // 47: pb.VerifyEmailResponse
+	(*CreateAccountResponse)(nil),                   // 48: pb.CreateAccountResponse
+	(*GetAccountResponse)(nil),                      // 49: pb.GetAccountResponse
+	(*ListAccountsResponse)(nil),                    // 50: pb.ListAccountsResponse
+	(*CreateTransferResponse)(nil),                  // 51: pb.CreateTransferResponse
+	(*GetTransferResponse)(nil),                     // 52: pb.GetTransferResponse
+	(*ListTransfersResponse)(nil),                   // 53: pb.ListTransfersResponse
+	(*ListEntriesResponse)(nil),                     // 54: pb.ListEntriesResponse
+	(*ReconcileLedgerResponse)(nil),                 // 55: pb.ReconcileLedgerResponse
+	(*CreateScheduledTransferResponse)(nil),         // 56: pb.CreateScheduledTransferResponse
+	(*ListScheduledTransfersResponse)(nil),          // 57: pb.ListScheduledTransfersResponse
+	(*PauseScheduledTransferResponse)(nil),          // 58: pb.PauseScheduledTransferResponse
+	(*ResumeScheduledTransferResponse)(nil),         // 59: pb.ResumeScheduledTransferResponse
+	(*CancelScheduledTransferResponse)(nil),         // 60: pb.CancelScheduledTransferResponse
+	(*ListScheduledTransferExecutionsResponse)(nil), // 61: pb.ListScheduledTransferExecutionsResponse
+	(*ReverseTransferResponse)(nil),                 // 62: pb.ReverseTransferResponse
+	(*PlaceHoldResponse)(nil),                       // 63: pb.PlaceHoldResponse
+	(*CaptureHoldResponse)(nil),                     // 64: pb.CaptureHoldResponse
+	(*ReleaseHoldResponse)(nil),                     // 65: pb.ReleaseHoldResponse
+	(*UpdateAccountStatusResponse)(nil),             // 66: pb.UpdateAccountStatusResponse
+	(*ListAccountStatusChangesResponse)(nil),        // 67: pb.ListAccountStatusChangesResponse
+	(*SetInterestRateResponse)(nil),                 // 68: pb.SetInterestRateResponse
+	(*CreateFeeRuleResponse)(nil),                   // 69: pb.CreateFeeRuleResponse
+	(*ListFeeRulesResponse)(nil),                    // 70: pb.ListFeeRulesResponse
+	(*SetTransferLimitsResponse)(nil),               // 71: pb.SetTransferLimitsResponse
+	(*GetBalanceAtResponse)(nil),                    // 72: pb.GetBalanceAtResponse
+	(*SearchTransfersResponse)(nil),                 // 73: pb.SearchTransfersResponse
+	(*ListCurrenciesResponse)(nil),                  // 74: pb.ListCurrenciesResponse
+	(*SetCurrencyEnabledResponse)(nil),              // 75: pb.SetCurrencyEnabledResponse
+	(*SetCurrencyApprovalThresholdResponse)(nil),    // 76: pb.SetCurrencyApprovalThresholdResponse
+	(*InviteAccountHolderResponse)(nil),             // 77: pb.InviteAccountHolderResponse
+	(*AcceptAccountHolderResponse)(nil),             // 78: pb.AcceptAccountHolderResponse
+	(*RemoveAccountHolderResponse)(nil),             // 79: pb.RemoveAccountHolderResponse
+	(*CreateWebhookSubscriptionResponse)(nil),       // 80: pb.CreateWebhookSubscriptionResponse
+	(*ListWebhookSubscriptionsResponse)(nil),        // 81: pb.ListWebhookSubscriptionsResponse
+	(*DeleteWebhookSubscriptionResponse)(nil),       // 82: pb.DeleteWebhookSubscriptionResponse
+	(*ListWebhookDeliveriesResponse)(nil),           // 83: pb.ListWebhookDeliveriesResponse
+	(*VerifyAuditLogResponse)(nil),                  // 84: pb.VerifyAuditLogResponse
+	(*ListPendingTransferApprovalsResponse)(nil),    // 85: pb.ListPendingTransferApprovalsResponse
+	(*ApproveTransferResponse)(nil),                 // 86: pb.ApproveTransferResponse
+	(*RejectTransferResponse)(nil),                  // 87: pb.RejectTransferResponse
 }
 var file_service_bank_system_proto_depIdxs = []int32{
-	0, // 0: pb.BankSystem.CreateUser:input_type -> pb.CreateUserRequest
-	1, // 1: pb.BankSystem.LoginUser:input_type -> pb.LoginUserRequest
-	2, // 2: pb.BankSystem.UpdateUser:input_type -> pb.UpdateUserRequest
-	3, // 3: pb.BankSystem.VerifyEmail:input_type -> pb.VerifyEmailRequest
-	4, // 4: pb.BankSystem.CreateUser:output_type -> pb.CreateUserResponse
-	5, // 5: pb.BankSystem.LoginUser:output_type -> pb.LoginUserResponse
-	6, // 6: pb.BankSystem.UpdateUser:output_type -> pb.UpdateUserResponse
-	7, // 7: pb.BankSystem.VerifyEmail:output_type -> pb.VerifyEmailResponse
-	4, // [4:8] is the sub-list for method output_type
-	0, // [0:4] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	0,  // 0: pb.BankSystem.CreateUser:input_type -> pb.CreateUserRequest
+	1,  // 1: pb.BankSystem.LoginUser:input_type -> pb.LoginUserRequest
+	2,  // 2: pb.BankSystem.UpdateUser:input_type -> pb.UpdateUserRequest
+	3,  // 3: pb.BankSystem.VerifyEmail:input_type -> pb.VerifyEmailRequest
+	4,  // 4: pb.BankSystem.CreateAccount:input_type -> pb.CreateAccountRequest
+	5,  // 5: pb.BankSystem.GetAccount:input_type -> pb.GetAccountRequest
+	6,  // 6: pb.BankSystem.ListAccounts:input_type -> pb.ListAccountsRequest
+	7,  // 7: pb.BankSystem.CreateTransfer:input_type -> pb.CreateTransferRequest
+	8,  // 8: pb.BankSystem.GetTransfer:input_type -> pb.GetTransferRequest
+	9,  // 9: pb.BankSystem.ListTransfers:input_type -> pb.ListTransfersRequest
+	10, // 10: pb.BankSystem.ListEntries:input_type -> pb.ListEntriesRequest
+	11, // 11: pb.BankSystem.ReconcileLedger:input_type -> pb.ReconcileLedgerRequest
+	12, // 12: pb.BankSystem.CreateScheduledTransfer:input_type -> pb.CreateScheduledTransferRequest
+	13, // 13: pb.BankSystem.ListScheduledTransfers:input_type -> pb.ListScheduledTransfersRequest
+	14, // 14: pb.BankSystem.PauseScheduledTransfer:input_type -> pb.PauseScheduledTransferRequest
+	15, // 15: pb.BankSystem.ResumeScheduledTransfer:input_type -> pb.ResumeScheduledTransferRequest
+	16, // 16: pb.BankSystem.CancelScheduledTransfer:input_type -> pb.CancelScheduledTransferRequest
+	17, // 17: pb.BankSystem.ListScheduledTransferExecutions:input_type -> pb.ListScheduledTransferExecutionsRequest
+	18, // 18: pb.BankSystem.ReverseTransfer:input_type -> pb.ReverseTransferRequest
+	19, // 19: pb.BankSystem.PlaceHold:input_type -> pb.PlaceHoldRequest
+	20, // 20: pb.BankSystem.CaptureHold:input_type -> pb.CaptureHoldRequest
+	21, // 21: pb.BankSystem.ReleaseHold:input_type -> pb.ReleaseHoldRequest
+	22, // 22: pb.BankSystem.UpdateAccountStatus:input_type -> pb.UpdateAccountStatusRequest
+	23, // 23: pb.BankSystem.ListAccountStatusChanges:input_type -> pb.ListAccountStatusChangesRequest
+	24, // 24: pb.BankSystem.SetInterestRate:input_type -> pb.SetInterestRateRequest
+	25, // 25: pb.BankSystem.CreateFeeRule:input_type -> pb.CreateFeeRuleRequest
+	26, // 26: pb.BankSystem.ListFeeRules:input_type -> pb.ListFeeRulesRequest
+	27, // 27: pb.BankSystem.SetTransferLimits:input_type -> pb.SetTransferLimitsRequest
+	28, // 28: pb.BankSystem.GetBalanceAt:input_type -> pb.GetBalanceAtRequest
+	29, // 29: pb.BankSystem.SearchTransfers:input_type -> pb.SearchTransfersRequest
+	30, // 30: pb.BankSystem.ListCurrencies:input_type -> pb.ListCurrenciesRequest
+	31, // 31: pb.BankSystem.SetCurrencyEnabled:input_type -> pb.SetCurrencyEnabledRequest
+	32, // 32: pb.BankSystem.SetCurrencyApprovalThreshold:input_type -> pb.SetCurrencyApprovalThresholdRequest
+	33, // 33: pb.BankSystem.InviteAccountHolder:input_type -> pb.InviteAccountHolderRequest
+	34, // 34: pb.BankSystem.AcceptAccountHolder:input_type -> pb.AcceptAccountHolderRequest
+	35, // 35: pb.BankSystem.RemoveAccountHolder:input_type -> pb.RemoveAccountHolderRequest
+	36, // 36: pb.BankSystem.CreateWebhookSubscription:input_type -> pb.CreateWebhookSubscriptionRequest
+	37, // 37: pb.BankSystem.ListWebhookSubscriptions:input_type -> pb.ListWebhookSubscriptionsRequest
+	38, // 38: pb.BankSystem.DeleteWebhookSubscription:input_type -> pb.DeleteWebhookSubscriptionRequest
+	39, // 39: pb.BankSystem.ListWebhookDeliveries:input_type -> pb.ListWebhookDeliveriesRequest
+	40, // 40: pb.BankSystem.VerifyAuditLog:input_type -> pb.VerifyAuditLogRequest
+	41, // 41: pb.BankSystem.ListPendingTransferApprovals:input_type -> pb.ListPendingTransferApprovalsRequest
+	42, // 42: pb.BankSystem.ApproveTransfer:input_type -> pb.ApproveTransferRequest
+	43, // 43: pb.BankSystem.RejectTransfer:input_type -> pb.RejectTransferRequest
+	44, // 44: pb.BankSystem.CreateUser:output_type -> pb.CreateUserResponse
+	45, // 45: pb.BankSystem.LoginUser:output_type -> pb.LoginUserResponse
+	46, // 46: pb.BankSystem.UpdateUser:output_type -> pb.UpdateUserResponse
+	47, // 47: pb.BankSystem.VerifyEmail:output_type -> pb.VerifyEmailResponse
+	48, // 48: pb.BankSystem.CreateAccount:output_type -> pb.CreateAccountResponse
+	49, // 49: pb.BankSystem.GetAccount:output_type -> pb.GetAccountResponse
+	50, // 50: pb.BankSystem.ListAccounts:output_type -> pb.ListAccountsResponse
+	51, // 51: pb.BankSystem.CreateTransfer:output_type -> pb.CreateTransferResponse
+	52, // 52: pb.BankSystem.GetTransfer:output_type -> pb.GetTransferResponse
+	53, // 53: pb.BankSystem.ListTransfers:output_type -> pb.ListTransfersResponse
+	54, // 54: pb.BankSystem.ListEntries:output_type -> pb.ListEntriesResponse
+	55, // 55: pb.BankSystem.ReconcileLedger:output_type -> pb.ReconcileLedgerResponse
+	56, // 56: pb.BankSystem.CreateScheduledTransfer:output_type -> pb.CreateScheduledTransferResponse
+	57, // 57: pb.BankSystem.ListScheduledTransfers:output_type -> pb.ListScheduledTransfersResponse
+	58, // 58: pb.BankSystem.PauseScheduledTransfer:output_type -> pb.PauseScheduledTransferResponse
+	59, // 59: pb.BankSystem.ResumeScheduledTransfer:output_type -> pb.ResumeScheduledTransferResponse
+	60, // 60: pb.BankSystem.CancelScheduledTransfer:output_type -> pb.CancelScheduledTransferResponse
+	61, // 61: pb.BankSystem.ListScheduledTransferExecutions:output_type -> pb.ListScheduledTransferExecutionsResponse
+	62, // 62: pb.BankSystem.ReverseTransfer:output_type -> pb.ReverseTransferResponse
+	63, // 63: pb.BankSystem.PlaceHold:output_type -> pb.PlaceHoldResponse
+	64, // 64: pb.BankSystem.CaptureHold:output_type -> pb.CaptureHoldResponse
+	65, // 65: pb.BankSystem.ReleaseHold:output_type -> pb.ReleaseHoldResponse
+	66, // 66: pb.BankSystem.UpdateAccountStatus:output_type -> pb.UpdateAccountStatusResponse
+	67, // 67: pb.BankSystem.ListAccountStatusChanges:output_type -> pb.ListAccountStatusChangesResponse
+	68, // 68: pb.BankSystem.SetInterestRate:output_type -> pb.SetInterestRateResponse
+	69, // 69: pb.BankSystem.CreateFeeRule:output_type -> pb.CreateFeeRuleResponse
+	70, // 70: pb.BankSystem.ListFeeRules:output_type -> pb.ListFeeRulesResponse
+	71, // 71: pb.BankSystem.SetTransferLimits:output_type -> pb.SetTransferLimitsResponse
+	72, // 72: pb.BankSystem.GetBalanceAt:output_type -> pb.GetBalanceAtResponse
+	73, // 73: pb.BankSystem.SearchTransfers:output_type -> pb.SearchTransfersResponse
+	74, // 74: pb.BankSystem.ListCurrencies:output_type -> pb.ListCurrenciesResponse
+	75, // 75: pb.BankSystem.SetCurrencyEnabled:output_type -> pb.SetCurrencyEnabledResponse
+	76, // 76: pb.BankSystem.SetCurrencyApprovalThreshold:output_type -> pb.SetCurrencyApprovalThresholdResponse
+	77, // 77: pb.BankSystem.InviteAccountHolder:output_type -> pb.InviteAccountHolderResponse
+	78, // 78: pb.BankSystem.AcceptAccountHolder:output_type -> pb.AcceptAccountHolderResponse
+	79, // 79: pb.BankSystem.RemoveAccountHolder:output_type -> pb.RemoveAccountHolderResponse
+	80, // 80: pb.BankSystem.CreateWebhookSubscription:output_type -> pb.CreateWebhookSubscriptionResponse
+	81, // 81: pb.BankSystem.ListWebhookSubscriptions:output_type -> pb.ListWebhookSubscriptionsResponse
+	82, // 82: pb.BankSystem.DeleteWebhookSubscription:output_type -> pb.DeleteWebhookSubscriptionResponse
+	83, // 83: pb.BankSystem.ListWebhookDeliveries:output_type -> pb.ListWebhookDeliveriesResponse
+	84, // 84: pb.BankSystem.VerifyAuditLog:output_type -> pb.VerifyAuditLogResponse
+	85, // 85: pb.BankSystem.ListPendingTransferApprovals:output_type -> pb.ListPendingTransferApprovalsResponse
+	86, // 86: pb.BankSystem.ApproveTransfer:output_type -> pb.ApproveTransferResponse
+	87, // 87: pb.BankSystem.RejectTransfer:output_type -> pb.RejectTransferResponse
+	44, // [44:88] is the sub-list for method output_type
+	0,  // [0:44] is the sub-list for method input_type
+	0,  // [0:0] is the sub-list for extension type_name
+	0,  // [0:0] is the sub-list for extension extendee
+	0,  // [0:0] is the sub-list for field type_name
 }
 
 func init() { file_service_bank_system_proto_init() }
@@ -74,6 +276,46 @@ func file_service_bank_system_proto_init() {
 	file_rpc_update_user_proto_init()
 	file_rpc_login_user_proto_init()
 	file_rpc_verify_email_proto_init()
+	file_rpc_create_account_proto_init()
+	file_rpc_get_account_proto_init()
+	file_rpc_list_accounts_proto_init()
+	file_rpc_create_transfer_proto_init()
+	file_rpc_get_transfer_proto_init()
+	file_rpc_list_transfers_proto_init()
+	file_rpc_list_entries_proto_init()
+	file_rpc_reconcile_ledger_proto_init()
+	file_rpc_create_scheduled_transfer_proto_init()
+	file_rpc_list_scheduled_transfers_proto_init()
+	file_rpc_pause_scheduled_transfer_proto_init()
+	file_rpc_resume_scheduled_transfer_proto_init()
+	file_rpc_cancel_scheduled_transfer_proto_init()
+	file_rpc_list_scheduled_transfer_executions_proto_init()
+	file_rpc_reverse_transfer_proto_init()
+	file_rpc_place_hold_proto_init()
+	file_rpc_capture_hold_proto_init()
+	file_rpc_release_hold_proto_init()
+	file_rpc_update_account_status_proto_init()
+	file_rpc_list_account_status_changes_proto_init()
+	file_rpc_set_interest_rate_proto_init()
+	file_rpc_create_fee_rule_proto_init()
+	file_rpc_list_fee_rules_proto_init()
+	file_rpc_set_transfer_limits_proto_init()
+	file_rpc_get_balance_at_proto_init()
+	file_rpc_search_transfers_proto_init()
+	file_rpc_list_currencies_proto_init()
+	file_rpc_set_currency_enabled_proto_init()
+	file_rpc_set_currency_approval_threshold_proto_init()
+	file_rpc_invite_account_holder_proto_init()
+	file_rpc_accept_account_holder_proto_init()
+	file_rpc_remove_account_holder_proto_init()
+	file_rpc_create_webhook_subscription_proto_init()
+	file_rpc_list_webhook_subscriptions_proto_init()
+	file_rpc_delete_webhook_subscription_proto_init()
+	file_rpc_list_webhook_deliveries_proto_init()
+	file_rpc_verify_audit_log_proto_init()
+	file_rpc_list_pending_transfer_approvals_proto_init()
+	file_rpc_approve_transfer_proto_init()
+	file_rpc_reject_transfer_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
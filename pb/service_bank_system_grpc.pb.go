@@ -19,10 +19,50 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	BankSystem_CreateUser_FullMethodName  = "/pb.BankSystem/CreateUser"
-	BankSystem_LoginUser_FullMethodName   = "/pb.BankSystem/LoginUser"
-	BankSystem_UpdateUser_FullMethodName  = "/pb.BankSystem/UpdateUser"
-	BankSystem_VerifyEmail_FullMethodName = "/pb.BankSystem/VerifyEmail"
+	BankSystem_CreateUser_FullMethodName                      = "/pb.BankSystem/CreateUser"
+	BankSystem_LoginUser_FullMethodName                       = "/pb.BankSystem/LoginUser"
+	BankSystem_UpdateUser_FullMethodName                      = "/pb.BankSystem/UpdateUser"
+	BankSystem_VerifyEmail_FullMethodName                     = "/pb.BankSystem/VerifyEmail"
+	BankSystem_CreateAccount_FullMethodName                   = "/pb.BankSystem/CreateAccount"
+	BankSystem_GetAccount_FullMethodName                      = "/pb.BankSystem/GetAccount"
+	BankSystem_ListAccounts_FullMethodName                    = "/pb.BankSystem/ListAccounts"
+	BankSystem_CreateTransfer_FullMethodName                  = "/pb.BankSystem/CreateTransfer"
+	BankSystem_GetTransfer_FullMethodName                     = "/pb.BankSystem/GetTransfer"
+	BankSystem_ListTransfers_FullMethodName                   = "/pb.BankSystem/ListTransfers"
+	BankSystem_ListEntries_FullMethodName                     = "/pb.BankSystem/ListEntries"
+	BankSystem_ReconcileLedger_FullMethodName                 = "/pb.BankSystem/ReconcileLedger"
+	BankSystem_CreateScheduledTransfer_FullMethodName         = "/pb.BankSystem/CreateScheduledTransfer"
+	BankSystem_ListScheduledTransfers_FullMethodName          = "/pb.BankSystem/ListScheduledTransfers"
+	BankSystem_PauseScheduledTransfer_FullMethodName          = "/pb.BankSystem/PauseScheduledTransfer"
+	BankSystem_ResumeScheduledTransfer_FullMethodName         = "/pb.BankSystem/ResumeScheduledTransfer"
+	BankSystem_CancelScheduledTransfer_FullMethodName         = "/pb.BankSystem/CancelScheduledTransfer"
+	BankSystem_ListScheduledTransferExecutions_FullMethodName = "/pb.BankSystem/ListScheduledTransferExecutions"
+	BankSystem_ReverseTransfer_FullMethodName                 = "/pb.BankSystem/ReverseTransfer"
+	BankSystem_PlaceHold_FullMethodName                       = "/pb.BankSystem/PlaceHold"
+	BankSystem_CaptureHold_FullMethodName                     = "/pb.BankSystem/CaptureHold"
+	BankSystem_ReleaseHold_FullMethodName                     = "/pb.BankSystem/ReleaseHold"
+	BankSystem_UpdateAccountStatus_FullMethodName             = "/pb.BankSystem/UpdateAccountStatus"
+	BankSystem_ListAccountStatusChanges_FullMethodName        = "/pb.BankSystem/ListAccountStatusChanges"
+	BankSystem_SetInterestRate_FullMethodName                 = "/pb.BankSystem/SetInterestRate"
+	BankSystem_CreateFeeRule_FullMethodName                   = "/pb.BankSystem/CreateFeeRule"
+	BankSystem_ListFeeRules_FullMethodName                    = "/pb.BankSystem/ListFeeRules"
+	BankSystem_SetTransferLimits_FullMethodName               = "/pb.BankSystem/SetTransferLimits"
+	BankSystem_GetBalanceAt_FullMethodName                    = "/pb.BankSystem/GetBalanceAt"
+	BankSystem_SearchTransfers_FullMethodName                 = "/pb.BankSystem/SearchTransfers"
+	BankSystem_ListCurrencies_FullMethodName                  = "/pb.BankSystem/ListCurrencies"
+	BankSystem_SetCurrencyEnabled_FullMethodName              = "/pb.BankSystem/SetCurrencyEnabled"
+	BankSystem_SetCurrencyApprovalThreshold_FullMethodName    = "/pb.BankSystem/SetCurrencyApprovalThreshold"
+	BankSystem_InviteAccountHolder_FullMethodName             = "/pb.BankSystem/InviteAccountHolder"
+	BankSystem_AcceptAccountHolder_FullMethodName             = "/pb.BankSystem/AcceptAccountHolder"
+	BankSystem_RemoveAccountHolder_FullMethodName             = "/pb.BankSystem/RemoveAccountHolder"
+	BankSystem_CreateWebhookSubscription_FullMethodName       = "/pb.BankSystem/CreateWebhookSubscription"
+	BankSystem_ListWebhookSubscriptions_FullMethodName        = "/pb.BankSystem/ListWebhookSubscriptions"
+	BankSystem_DeleteWebhookSubscription_FullMethodName       = "/pb.BankSystem/DeleteWebhookSubscription"
+	BankSystem_ListWebhookDeliveries_FullMethodName           = "/pb.BankSystem/ListWebhookDeliveries"
+	BankSystem_VerifyAuditLog_FullMethodName                  = "/pb.BankSystem/VerifyAuditLog"
+	BankSystem_ListPendingTransferApprovals_FullMethodName    = "/pb.BankSystem/ListPendingTransferApprovals"
+	BankSystem_ApproveTransfer_FullMethodName                 = "/pb.BankSystem/ApproveTransfer"
+	BankSystem_RejectTransfer_FullMethodName                  = "/pb.BankSystem/RejectTransfer"
 )
 
 // BankSystemClient is the client API for BankSystem service.
@@ -33,6 +73,46 @@ type BankSystemClient interface {
 	LoginUser(ctx context.Context, in *LoginUserRequest, opts ...grpc.CallOption) (*LoginUserResponse, error)
 	UpdateUser(ctx context.Context, in *UpdateUserRequest, opts ...grpc.CallOption) (*UpdateUserResponse, error)
 	VerifyEmail(ctx context.Context, in *VerifyEmailRequest, opts ...grpc.CallOption) (*VerifyEmailResponse, error)
+	CreateAccount(ctx context.Context, in *CreateAccountRequest, opts ...grpc.CallOption) (*CreateAccountResponse, error)
+	GetAccount(ctx context.Context, in *GetAccountRequest, opts ...grpc.CallOption) (*GetAccountResponse, error)
+	ListAccounts(ctx context.Context, in *ListAccountsRequest, opts ...grpc.CallOption) (*ListAccountsResponse, error)
+	CreateTransfer(ctx context.Context, in *CreateTransferRequest, opts ...grpc.CallOption) (*CreateTransferResponse, error)
+	GetTransfer(ctx context.Context, in *GetTransferRequest, opts ...grpc.CallOption) (*GetTransferResponse, error)
+	ListTransfers(ctx context.Context, in *ListTransfersRequest, opts ...grpc.CallOption) (*ListTransfersResponse, error)
+	ListEntries(ctx context.Context, in *ListEntriesRequest, opts ...grpc.CallOption) (*ListEntriesResponse, error)
+	ReconcileLedger(ctx context.Context, in *ReconcileLedgerRequest, opts ...grpc.CallOption) (*ReconcileLedgerResponse, error)
+	CreateScheduledTransfer(ctx context.Context, in *CreateScheduledTransferRequest, opts ...grpc.CallOption) (*CreateScheduledTransferResponse, error)
+	ListScheduledTransfers(ctx context.Context, in *ListScheduledTransfersRequest, opts ...grpc.CallOption) (*ListScheduledTransfersResponse, error)
+	PauseScheduledTransfer(ctx context.Context, in *PauseScheduledTransferRequest, opts ...grpc.CallOption) (*PauseScheduledTransferResponse, error)
+	ResumeScheduledTransfer(ctx context.Context, in *ResumeScheduledTransferRequest, opts ...grpc.CallOption) (*ResumeScheduledTransferResponse, error)
+	CancelScheduledTransfer(ctx context.Context, in *CancelScheduledTransferRequest, opts ...grpc.CallOption) (*CancelScheduledTransferResponse, error)
+	ListScheduledTransferExecutions(ctx context.Context, in *ListScheduledTransferExecutionsRequest, opts ...grpc.CallOption) (*ListScheduledTransferExecutionsResponse, error)
+	ReverseTransfer(ctx context.Context, in *ReverseTransferRequest, opts ...grpc.CallOption) (*ReverseTransferResponse, error)
+	PlaceHold(ctx context.Context, in *PlaceHoldRequest, opts ...grpc.CallOption) (*PlaceHoldResponse, error)
+	CaptureHold(ctx context.Context, in *CaptureHoldRequest, opts ...grpc.CallOption) (*CaptureHoldResponse, error)
+	ReleaseHold(ctx context.Context, in *ReleaseHoldRequest, opts ...grpc.CallOption) (*ReleaseHoldResponse, error)
+	UpdateAccountStatus(ctx context.Context, in *UpdateAccountStatusRequest, opts ...grpc.CallOption) (*UpdateAccountStatusResponse, error)
+	ListAccountStatusChanges(ctx context.Context, in *ListAccountStatusChangesRequest, opts ...grpc.CallOption) (*ListAccountStatusChangesResponse, error)
+	SetInterestRate(ctx context.Context, in *SetInterestRateRequest, opts ...grpc.CallOption) (*SetInterestRateResponse, error)
+	CreateFeeRule(ctx context.Context, in *CreateFeeRuleRequest, opts ...grpc.CallOption) (*CreateFeeRuleResponse, error)
+	ListFeeRules(ctx context.Context, in *ListFeeRulesRequest, opts ...grpc.CallOption) (*ListFeeRulesResponse, error)
+	SetTransferLimits(ctx context.Context, in *SetTransferLimitsRequest, opts ...grpc.CallOption) (*SetTransferLimitsResponse, error)
+	GetBalanceAt(ctx context.Context, in *GetBalanceAtRequest, opts ...grpc.CallOption) (*GetBalanceAtResponse, error)
+	SearchTransfers(ctx context.Context, in *SearchTransfersRequest, opts ...grpc.CallOption) (*SearchTransfersResponse, error)
+	ListCurrencies(ctx context.Context, in *ListCurrenciesRequest, opts ...grpc.CallOption) (*ListCurrenciesResponse, error)
+	SetCurrencyEnabled(ctx context.Context, in *SetCurrencyEnabledRequest, opts ...grpc.CallOption) (*SetCurrencyEnabledResponse, error)
+	SetCurrencyApprovalThreshold(ctx context.Context, in *SetCurrencyApprovalThresholdRequest, opts ...grpc.CallOption) (*SetCurrencyApprovalThresholdResponse, error)
+	InviteAccountHolder(ctx context.Context, in *InviteAccountHolderRequest, opts ...grpc.CallOption) (*InviteAccountHolderResponse, error)
+	AcceptAccountHolder(ctx context.Context, in *AcceptAccountHolderRequest, opts ...grpc.CallOption) (*AcceptAccountHolderResponse, error)
+	RemoveAccountHolder(ctx context.Context, in *RemoveAccountHolderRequest, opts ...grpc.CallOption) (*RemoveAccountHolderResponse, error)
+	CreateWebhookSubscription(ctx context.Context, in *CreateWebhookSubscriptionRequest, opts ...grpc.CallOption) (*CreateWebhookSubscriptionResponse, error)
+	ListWebhookSubscriptions(ctx context.Context, in *ListWebhookSubscriptionsRequest, opts ...grpc.CallOption) (*ListWebhookSubscriptionsResponse, error)
+	DeleteWebhookSubscription(ctx context.Context, in *DeleteWebhookSubscriptionRequest, opts ...grpc.CallOption) (*DeleteWebhookSubscriptionResponse, error)
+	ListWebhookDeliveries(ctx context.Context, in *ListWebhookDeliveriesRequest, opts ...grpc.CallOption) (*ListWebhookDeliveriesResponse, error)
+	VerifyAuditLog(ctx context.Context, in *VerifyAuditLogRequest, opts ...grpc.CallOption) (*VerifyAuditLogResponse, error)
+	ListPendingTransferApprovals(ctx context.Context, in *ListPendingTransferApprovalsRequest, opts ...grpc.CallOption) (*ListPendingTransferApprovalsResponse, error)
+	ApproveTransfer(ctx context.Context, in *ApproveTransferRequest, opts ...grpc.CallOption) (*ApproveTransferResponse, error)
+	RejectTransfer(ctx context.Context, in *RejectTransferRequest, opts ...grpc.CallOption) (*RejectTransferResponse, error)
 }
 
 type bankSystemClient struct {
@@ -83,6 +163,406 @@ func (c *bankSystemClient) VerifyEmail(ctx context.Context, in *VerifyEmailReque
 	return out, nil
 }
 
+func (c *bankSystemClient) CreateAccount(ctx context.Context, in *CreateAccountRequest, opts ...grpc.CallOption) (*CreateAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateAccountResponse)
+	err := c.cc.Invoke(ctx, BankSystem_CreateAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankSystemClient) GetAccount(ctx context.Context, in *GetAccountRequest, opts ...grpc.CallOption) (*GetAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAccountResponse)
+	err := c.cc.Invoke(ctx, BankSystem_GetAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankSystemClient) ListAccounts(ctx context.Context, in *ListAccountsRequest, opts ...grpc.CallOption) (*ListAccountsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAccountsResponse)
+	err := c.cc.Invoke(ctx, BankSystem_ListAccounts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankSystemClient) CreateTransfer(ctx context.Context, in *CreateTransferRequest, opts ...grpc.CallOption) (*CreateTransferResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateTransferResponse)
+	err := c.cc.Invoke(ctx, BankSystem_CreateTransfer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankSystemClient) GetTransfer(ctx context.Context, in *GetTransferRequest, opts ...grpc.CallOption) (*GetTransferResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTransferResponse)
+	err := c.cc.Invoke(ctx, BankSystem_GetTransfer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankSystemClient) ListTransfers(ctx context.Context, in *ListTransfersRequest, opts ...grpc.CallOption) (*ListTransfersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTransfersResponse)
+	err := c.cc.Invoke(ctx, BankSystem_ListTransfers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankSystemClient) ListEntries(ctx context.Context, in *ListEntriesRequest, opts ...grpc.CallOption) (*ListEntriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListEntriesResponse)
+	err := c.cc.Invoke(ctx, BankSystem_ListEntries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankSystemClient) ReconcileLedger(ctx context.Context, in *ReconcileLedgerRequest, opts ...grpc.CallOption) (*ReconcileLedgerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReconcileLedgerResponse)
+	err := c.cc.Invoke(ctx, BankSystem_ReconcileLedger_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankSystemClient) CreateScheduledTransfer(ctx context.Context, in *CreateScheduledTransferRequest, opts ...grpc.CallOption) (*CreateScheduledTransferResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateScheduledTransferResponse)
+	err := c.cc.Invoke(ctx, BankSystem_CreateScheduledTransfer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankSystemClient) ListScheduledTransfers(ctx context.Context, in *ListScheduledTransfersRequest, opts ...grpc.CallOption) (*ListScheduledTransfersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListScheduledTransfersResponse)
+	err := c.cc.Invoke(ctx, BankSystem_ListScheduledTransfers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankSystemClient) PauseScheduledTransfer(ctx context.Context, in *PauseScheduledTransferRequest, opts ...grpc.CallOption) (*PauseScheduledTransferResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PauseScheduledTransferResponse)
+	err := c.cc.Invoke(ctx, BankSystem_PauseScheduledTransfer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankSystemClient) ResumeScheduledTransfer(ctx context.Context, in *ResumeScheduledTransferRequest, opts ...grpc.CallOption) (*ResumeScheduledTransferResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResumeScheduledTransferResponse)
+	err := c.cc.Invoke(ctx, BankSystem_ResumeScheduledTransfer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankSystemClient) CancelScheduledTransfer(ctx context.Context, in *CancelScheduledTransferRequest, opts ...grpc.CallOption) (*CancelScheduledTransferResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelScheduledTransferResponse)
+	err := c.cc.Invoke(ctx, BankSystem_CancelScheduledTransfer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankSystemClient) ListScheduledTransferExecutions(ctx context.Context, in *ListScheduledTransferExecutionsRequest, opts ...grpc.CallOption) (*ListScheduledTransferExecutionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListScheduledTransferExecutionsResponse)
+	err := c.cc.Invoke(ctx, BankSystem_ListScheduledTransferExecutions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankSystemClient) ReverseTransfer(ctx context.Context, in *ReverseTransferRequest, opts ...grpc.CallOption) (*ReverseTransferResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReverseTransferResponse)
+	err := c.cc.Invoke(ctx, BankSystem_ReverseTransfer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankSystemClient) PlaceHold(ctx context.Context, in *PlaceHoldRequest, opts ...grpc.CallOption) (*PlaceHoldResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PlaceHoldResponse)
+	err := c.cc.Invoke(ctx, BankSystem_PlaceHold_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankSystemClient) CaptureHold(ctx context.Context, in *CaptureHoldRequest, opts ...grpc.CallOption) (*CaptureHoldResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CaptureHoldResponse)
+	err := c.cc.Invoke(ctx, BankSystem_CaptureHold_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankSystemClient) ReleaseHold(ctx context.Context, in *ReleaseHoldRequest, opts ...grpc.CallOption) (*ReleaseHoldResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReleaseHoldResponse)
+	err := c.cc.Invoke(ctx, BankSystem_ReleaseHold_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankSystemClient) UpdateAccountStatus(ctx context.Context, in *UpdateAccountStatusRequest, opts ...grpc.CallOption) (*UpdateAccountStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateAccountStatusResponse)
+	err := c.cc.Invoke(ctx, BankSystem_UpdateAccountStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankSystemClient) ListAccountStatusChanges(ctx context.Context, in *ListAccountStatusChangesRequest, opts ...grpc.CallOption) (*ListAccountStatusChangesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAccountStatusChangesResponse)
+	err := c.cc.Invoke(ctx, BankSystem_ListAccountStatusChanges_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankSystemClient) SetInterestRate(ctx context.Context, in *SetInterestRateRequest, opts ...grpc.CallOption) (*SetInterestRateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetInterestRateResponse)
+	err := c.cc.Invoke(ctx, BankSystem_SetInterestRate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankSystemClient) CreateFeeRule(ctx context.Context, in *CreateFeeRuleRequest, opts ...grpc.CallOption) (*CreateFeeRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateFeeRuleResponse)
+	err := c.cc.Invoke(ctx, BankSystem_CreateFeeRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankSystemClient) ListFeeRules(ctx context.Context, in *ListFeeRulesRequest, opts ...grpc.CallOption) (*ListFeeRulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListFeeRulesResponse)
+	err := c.cc.Invoke(ctx, BankSystem_ListFeeRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankSystemClient) SetTransferLimits(ctx context.Context, in *SetTransferLimitsRequest, opts ...grpc.CallOption) (*SetTransferLimitsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetTransferLimitsResponse)
+	err := c.cc.Invoke(ctx, BankSystem_SetTransferLimits_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankSystemClient) GetBalanceAt(ctx context.Context, in *GetBalanceAtRequest, opts ...grpc.CallOption) (*GetBalanceAtResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBalanceAtResponse)
+	err := c.cc.Invoke(ctx, BankSystem_GetBalanceAt_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankSystemClient) SearchTransfers(ctx context.Context, in *SearchTransfersRequest, opts ...grpc.CallOption) (*SearchTransfersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchTransfersResponse)
+	err := c.cc.Invoke(ctx, BankSystem_SearchTransfers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankSystemClient) ListCurrencies(ctx context.Context, in *ListCurrenciesRequest, opts ...grpc.CallOption) (*ListCurrenciesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCurrenciesResponse)
+	err := c.cc.Invoke(ctx, BankSystem_ListCurrencies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankSystemClient) SetCurrencyEnabled(ctx context.Context, in *SetCurrencyEnabledRequest, opts ...grpc.CallOption) (*SetCurrencyEnabledResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetCurrencyEnabledResponse)
+	err := c.cc.Invoke(ctx, BankSystem_SetCurrencyEnabled_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankSystemClient) SetCurrencyApprovalThreshold(ctx context.Context, in *SetCurrencyApprovalThresholdRequest, opts ...grpc.CallOption) (*SetCurrencyApprovalThresholdResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetCurrencyApprovalThresholdResponse)
+	err := c.cc.Invoke(ctx, BankSystem_SetCurrencyApprovalThreshold_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankSystemClient) InviteAccountHolder(ctx context.Context, in *InviteAccountHolderRequest, opts ...grpc.CallOption) (*InviteAccountHolderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InviteAccountHolderResponse)
+	err := c.cc.Invoke(ctx, BankSystem_InviteAccountHolder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankSystemClient) AcceptAccountHolder(ctx context.Context, in *AcceptAccountHolderRequest, opts ...grpc.CallOption) (*AcceptAccountHolderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AcceptAccountHolderResponse)
+	err := c.cc.Invoke(ctx, BankSystem_AcceptAccountHolder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankSystemClient) RemoveAccountHolder(ctx context.Context, in *RemoveAccountHolderRequest, opts ...grpc.CallOption) (*RemoveAccountHolderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveAccountHolderResponse)
+	err := c.cc.Invoke(ctx, BankSystem_RemoveAccountHolder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankSystemClient) CreateWebhookSubscription(ctx context.Context, in *CreateWebhookSubscriptionRequest, opts ...grpc.CallOption) (*CreateWebhookSubscriptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateWebhookSubscriptionResponse)
+	err := c.cc.Invoke(ctx, BankSystem_CreateWebhookSubscription_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankSystemClient) ListWebhookSubscriptions(ctx context.Context, in *ListWebhookSubscriptionsRequest, opts ...grpc.CallOption) (*ListWebhookSubscriptionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListWebhookSubscriptionsResponse)
+	err := c.cc.Invoke(ctx, BankSystem_ListWebhookSubscriptions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankSystemClient) DeleteWebhookSubscription(ctx context.Context, in *DeleteWebhookSubscriptionRequest, opts ...grpc.CallOption) (*DeleteWebhookSubscriptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteWebhookSubscriptionResponse)
+	err := c.cc.Invoke(ctx, BankSystem_DeleteWebhookSubscription_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankSystemClient) ListWebhookDeliveries(ctx context.Context, in *ListWebhookDeliveriesRequest, opts ...grpc.CallOption) (*ListWebhookDeliveriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListWebhookDeliveriesResponse)
+	err := c.cc.Invoke(ctx, BankSystem_ListWebhookDeliveries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankSystemClient) VerifyAuditLog(ctx context.Context, in *VerifyAuditLogRequest, opts ...grpc.CallOption) (*VerifyAuditLogResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VerifyAuditLogResponse)
+	err := c.cc.Invoke(ctx, BankSystem_VerifyAuditLog_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankSystemClient) ListPendingTransferApprovals(ctx context.Context, in *ListPendingTransferApprovalsRequest, opts ...grpc.CallOption) (*ListPendingTransferApprovalsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPendingTransferApprovalsResponse)
+	err := c.cc.Invoke(ctx, BankSystem_ListPendingTransferApprovals_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankSystemClient) ApproveTransfer(ctx context.Context, in *ApproveTransferRequest, opts ...grpc.CallOption) (*ApproveTransferResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApproveTransferResponse)
+	err := c.cc.Invoke(ctx, BankSystem_ApproveTransfer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankSystemClient) RejectTransfer(ctx context.Context, in *RejectTransferRequest, opts ...grpc.CallOption) (*RejectTransferResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RejectTransferResponse)
+	err := c.cc.Invoke(ctx, BankSystem_RejectTransfer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // BankSystemServer is the server API for BankSystem service.
 // All implementations must embed UnimplementedBankSystemServer
 // for forward compatibility.
@@ -91,117 +571,997 @@ type BankSystemServer interface {
 	LoginUser(context.Context, *LoginUserRequest) (*LoginUserResponse, error)
 	UpdateUser(context.Context, *UpdateUserRequest) (*UpdateUserResponse, error)
 	VerifyEmail(context.Context, *VerifyEmailRequest) (*VerifyEmailResponse, error)
+	CreateAccount(context.Context, *CreateAccountRequest) (*CreateAccountResponse, error)
+	GetAccount(context.Context, *GetAccountRequest) (*GetAccountResponse, error)
+	ListAccounts(context.Context, *ListAccountsRequest) (*ListAccountsResponse, error)
+	CreateTransfer(context.Context, *CreateTransferRequest) (*CreateTransferResponse, error)
+	GetTransfer(context.Context, *GetTransferRequest) (*GetTransferResponse, error)
+	ListTransfers(context.Context, *ListTransfersRequest) (*ListTransfersResponse, error)
+	ListEntries(context.Context, *ListEntriesRequest) (*ListEntriesResponse, error)
+	ReconcileLedger(context.Context, *ReconcileLedgerRequest) (*ReconcileLedgerResponse, error)
+	CreateScheduledTransfer(context.Context, *CreateScheduledTransferRequest) (*CreateScheduledTransferResponse, error)
+	ListScheduledTransfers(context.Context, *ListScheduledTransfersRequest) (*ListScheduledTransfersResponse, error)
+	PauseScheduledTransfer(context.Context, *PauseScheduledTransferRequest) (*PauseScheduledTransferResponse, error)
+	ResumeScheduledTransfer(context.Context, *ResumeScheduledTransferRequest) (*ResumeScheduledTransferResponse, error)
+	CancelScheduledTransfer(context.Context, *CancelScheduledTransferRequest) (*CancelScheduledTransferResponse, error)
+	ListScheduledTransferExecutions(context.Context, *ListScheduledTransferExecutionsRequest) (*ListScheduledTransferExecutionsResponse, error)
+	ReverseTransfer(context.Context, *ReverseTransferRequest) (*ReverseTransferResponse, error)
+	PlaceHold(context.Context, *PlaceHoldRequest) (*PlaceHoldResponse, error)
+	CaptureHold(context.Context, *CaptureHoldRequest) (*CaptureHoldResponse, error)
+	ReleaseHold(context.Context, *ReleaseHoldRequest) (*ReleaseHoldResponse, error)
+	UpdateAccountStatus(context.Context, *UpdateAccountStatusRequest) (*UpdateAccountStatusResponse, error)
+	ListAccountStatusChanges(context.Context, *ListAccountStatusChangesRequest) (*ListAccountStatusChangesResponse, error)
+	SetInterestRate(context.Context, *SetInterestRateRequest) (*SetInterestRateResponse, error)
+	CreateFeeRule(context.Context, *CreateFeeRuleRequest) (*CreateFeeRuleResponse, error)
+	ListFeeRules(context.Context, *ListFeeRulesRequest) (*ListFeeRulesResponse, error)
+	SetTransferLimits(context.Context, *SetTransferLimitsRequest) (*SetTransferLimitsResponse, error)
+	GetBalanceAt(context.Context, *GetBalanceAtRequest) (*GetBalanceAtResponse, error)
+	SearchTransfers(context.Context, *SearchTransfersRequest) (*SearchTransfersResponse, error)
+	ListCurrencies(context.Context, *ListCurrenciesRequest) (*ListCurrenciesResponse, error)
+	SetCurrencyEnabled(context.Context, *SetCurrencyEnabledRequest) (*SetCurrencyEnabledResponse, error)
+	SetCurrencyApprovalThreshold(context.Context, *SetCurrencyApprovalThresholdRequest) (*SetCurrencyApprovalThresholdResponse, error)
+	InviteAccountHolder(context.Context, *InviteAccountHolderRequest) (*InviteAccountHolderResponse, error)
+	AcceptAccountHolder(context.Context, *AcceptAccountHolderRequest) (*AcceptAccountHolderResponse, error)
+	RemoveAccountHolder(context.Context, *RemoveAccountHolderRequest) (*RemoveAccountHolderResponse, error)
+	CreateWebhookSubscription(context.Context, *CreateWebhookSubscriptionRequest) (*CreateWebhookSubscriptionResponse, error)
+	ListWebhookSubscriptions(context.Context, *ListWebhookSubscriptionsRequest) (*ListWebhookSubscriptionsResponse, error)
+	DeleteWebhookSubscription(context.Context, *DeleteWebhookSubscriptionRequest) (*DeleteWebhookSubscriptionResponse, error)
+	ListWebhookDeliveries(context.Context, *ListWebhookDeliveriesRequest) (*ListWebhookDeliveriesResponse, error)
+	VerifyAuditLog(context.Context, *VerifyAuditLogRequest) (*VerifyAuditLogResponse, error)
+	ListPendingTransferApprovals(context.Context, *ListPendingTransferApprovalsRequest) (*ListPendingTransferApprovalsResponse, error)
+	ApproveTransfer(context.Context, *ApproveTransferRequest) (*ApproveTransferResponse, error)
+	RejectTransfer(context.Context, *RejectTransferRequest) (*RejectTransferResponse, error)
 	mustEmbedUnimplementedBankSystemServer()
 }
 
-// UnimplementedBankSystemServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedBankSystemServer struct{}
+// UnimplementedBankSystemServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedBankSystemServer struct{}
+
+func (UnimplementedBankSystemServer) CreateUser(context.Context, *CreateUserRequest) (*CreateUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateUser not implemented")
+}
+func (UnimplementedBankSystemServer) LoginUser(context.Context, *LoginUserRequest) (*LoginUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LoginUser not implemented")
+}
+func (UnimplementedBankSystemServer) UpdateUser(context.Context, *UpdateUserRequest) (*UpdateUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateUser not implemented")
+}
+func (UnimplementedBankSystemServer) VerifyEmail(context.Context, *VerifyEmailRequest) (*VerifyEmailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyEmail not implemented")
+}
+func (UnimplementedBankSystemServer) CreateAccount(context.Context, *CreateAccountRequest) (*CreateAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAccount not implemented")
+}
+func (UnimplementedBankSystemServer) GetAccount(context.Context, *GetAccountRequest) (*GetAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccount not implemented")
+}
+func (UnimplementedBankSystemServer) ListAccounts(context.Context, *ListAccountsRequest) (*ListAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAccounts not implemented")
+}
+func (UnimplementedBankSystemServer) CreateTransfer(context.Context, *CreateTransferRequest) (*CreateTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTransfer not implemented")
+}
+func (UnimplementedBankSystemServer) GetTransfer(context.Context, *GetTransferRequest) (*GetTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTransfer not implemented")
+}
+func (UnimplementedBankSystemServer) ListTransfers(context.Context, *ListTransfersRequest) (*ListTransfersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTransfers not implemented")
+}
+func (UnimplementedBankSystemServer) ListEntries(context.Context, *ListEntriesRequest) (*ListEntriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEntries not implemented")
+}
+func (UnimplementedBankSystemServer) ReconcileLedger(context.Context, *ReconcileLedgerRequest) (*ReconcileLedgerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReconcileLedger not implemented")
+}
+func (UnimplementedBankSystemServer) CreateScheduledTransfer(context.Context, *CreateScheduledTransferRequest) (*CreateScheduledTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateScheduledTransfer not implemented")
+}
+func (UnimplementedBankSystemServer) ListScheduledTransfers(context.Context, *ListScheduledTransfersRequest) (*ListScheduledTransfersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListScheduledTransfers not implemented")
+}
+func (UnimplementedBankSystemServer) PauseScheduledTransfer(context.Context, *PauseScheduledTransferRequest) (*PauseScheduledTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseScheduledTransfer not implemented")
+}
+func (UnimplementedBankSystemServer) ResumeScheduledTransfer(context.Context, *ResumeScheduledTransferRequest) (*ResumeScheduledTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeScheduledTransfer not implemented")
+}
+func (UnimplementedBankSystemServer) CancelScheduledTransfer(context.Context, *CancelScheduledTransferRequest) (*CancelScheduledTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelScheduledTransfer not implemented")
+}
+func (UnimplementedBankSystemServer) ListScheduledTransferExecutions(context.Context, *ListScheduledTransferExecutionsRequest) (*ListScheduledTransferExecutionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListScheduledTransferExecutions not implemented")
+}
+func (UnimplementedBankSystemServer) ReverseTransfer(context.Context, *ReverseTransferRequest) (*ReverseTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReverseTransfer not implemented")
+}
+func (UnimplementedBankSystemServer) PlaceHold(context.Context, *PlaceHoldRequest) (*PlaceHoldResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlaceHold not implemented")
+}
+func (UnimplementedBankSystemServer) CaptureHold(context.Context, *CaptureHoldRequest) (*CaptureHoldResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CaptureHold not implemented")
+}
+func (UnimplementedBankSystemServer) ReleaseHold(context.Context, *ReleaseHoldRequest) (*ReleaseHoldResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseHold not implemented")
+}
+func (UnimplementedBankSystemServer) UpdateAccountStatus(context.Context, *UpdateAccountStatusRequest) (*UpdateAccountStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAccountStatus not implemented")
+}
+func (UnimplementedBankSystemServer) ListAccountStatusChanges(context.Context, *ListAccountStatusChangesRequest) (*ListAccountStatusChangesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAccountStatusChanges not implemented")
+}
+func (UnimplementedBankSystemServer) SetInterestRate(context.Context, *SetInterestRateRequest) (*SetInterestRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetInterestRate not implemented")
+}
+func (UnimplementedBankSystemServer) CreateFeeRule(context.Context, *CreateFeeRuleRequest) (*CreateFeeRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateFeeRule not implemented")
+}
+func (UnimplementedBankSystemServer) ListFeeRules(context.Context, *ListFeeRulesRequest) (*ListFeeRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFeeRules not implemented")
+}
+func (UnimplementedBankSystemServer) SetTransferLimits(context.Context, *SetTransferLimitsRequest) (*SetTransferLimitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTransferLimits not implemented")
+}
+func (UnimplementedBankSystemServer) GetBalanceAt(context.Context, *GetBalanceAtRequest) (*GetBalanceAtResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBalanceAt not implemented")
+}
+func (UnimplementedBankSystemServer) SearchTransfers(context.Context, *SearchTransfersRequest) (*SearchTransfersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchTransfers not implemented")
+}
+func (UnimplementedBankSystemServer) ListCurrencies(context.Context, *ListCurrenciesRequest) (*ListCurrenciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCurrencies not implemented")
+}
+func (UnimplementedBankSystemServer) SetCurrencyEnabled(context.Context, *SetCurrencyEnabledRequest) (*SetCurrencyEnabledResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCurrencyEnabled not implemented")
+}
+func (UnimplementedBankSystemServer) SetCurrencyApprovalThreshold(context.Context, *SetCurrencyApprovalThresholdRequest) (*SetCurrencyApprovalThresholdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCurrencyApprovalThreshold not implemented")
+}
+func (UnimplementedBankSystemServer) InviteAccountHolder(context.Context, *InviteAccountHolderRequest) (*InviteAccountHolderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InviteAccountHolder not implemented")
+}
+func (UnimplementedBankSystemServer) AcceptAccountHolder(context.Context, *AcceptAccountHolderRequest) (*AcceptAccountHolderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptAccountHolder not implemented")
+}
+func (UnimplementedBankSystemServer) RemoveAccountHolder(context.Context, *RemoveAccountHolderRequest) (*RemoveAccountHolderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveAccountHolder not implemented")
+}
+func (UnimplementedBankSystemServer) CreateWebhookSubscription(context.Context, *CreateWebhookSubscriptionRequest) (*CreateWebhookSubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWebhookSubscription not implemented")
+}
+func (UnimplementedBankSystemServer) ListWebhookSubscriptions(context.Context, *ListWebhookSubscriptionsRequest) (*ListWebhookSubscriptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWebhookSubscriptions not implemented")
+}
+func (UnimplementedBankSystemServer) DeleteWebhookSubscription(context.Context, *DeleteWebhookSubscriptionRequest) (*DeleteWebhookSubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWebhookSubscription not implemented")
+}
+func (UnimplementedBankSystemServer) ListWebhookDeliveries(context.Context, *ListWebhookDeliveriesRequest) (*ListWebhookDeliveriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWebhookDeliveries not implemented")
+}
+func (UnimplementedBankSystemServer) VerifyAuditLog(context.Context, *VerifyAuditLogRequest) (*VerifyAuditLogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyAuditLog not implemented")
+}
+func (UnimplementedBankSystemServer) ListPendingTransferApprovals(context.Context, *ListPendingTransferApprovalsRequest) (*ListPendingTransferApprovalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPendingTransferApprovals not implemented")
+}
+func (UnimplementedBankSystemServer) ApproveTransfer(context.Context, *ApproveTransferRequest) (*ApproveTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveTransfer not implemented")
+}
+func (UnimplementedBankSystemServer) RejectTransfer(context.Context, *RejectTransferRequest) (*RejectTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectTransfer not implemented")
+}
+func (UnimplementedBankSystemServer) mustEmbedUnimplementedBankSystemServer() {}
+func (UnimplementedBankSystemServer) testEmbeddedByValue()                    {}
+
+// UnsafeBankSystemServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to BankSystemServer will
+// result in compilation errors.
+type UnsafeBankSystemServer interface {
+	mustEmbedUnimplementedBankSystemServer()
+}
+
+func RegisterBankSystemServer(s grpc.ServiceRegistrar, srv BankSystemServer) {
+	// If the following call pancis, it indicates UnimplementedBankSystemServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&BankSystem_ServiceDesc, srv)
+}
+
+func _BankSystem_CreateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankSystemServer).CreateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BankSystem_CreateUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankSystemServer).CreateUser(ctx, req.(*CreateUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankSystem_LoginUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankSystemServer).LoginUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BankSystem_LoginUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankSystemServer).LoginUser(ctx, req.(*LoginUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankSystem_UpdateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankSystemServer).UpdateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BankSystem_UpdateUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankSystemServer).UpdateUser(ctx, req.(*UpdateUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankSystem_VerifyEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyEmailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankSystemServer).VerifyEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BankSystem_VerifyEmail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankSystemServer).VerifyEmail(ctx, req.(*VerifyEmailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankSystem_CreateAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankSystemServer).CreateAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BankSystem_CreateAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankSystemServer).CreateAccount(ctx, req.(*CreateAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankSystem_GetAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankSystemServer).GetAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BankSystem_GetAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankSystemServer).GetAccount(ctx, req.(*GetAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankSystem_ListAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankSystemServer).ListAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BankSystem_ListAccounts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankSystemServer).ListAccounts(ctx, req.(*ListAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankSystem_CreateTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankSystemServer).CreateTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BankSystem_CreateTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankSystemServer).CreateTransfer(ctx, req.(*CreateTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankSystem_GetTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankSystemServer).GetTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BankSystem_GetTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankSystemServer).GetTransfer(ctx, req.(*GetTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankSystem_ListTransfers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTransfersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankSystemServer).ListTransfers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BankSystem_ListTransfers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankSystemServer).ListTransfers(ctx, req.(*ListTransfersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankSystem_ListEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEntriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankSystemServer).ListEntries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BankSystem_ListEntries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankSystemServer).ListEntries(ctx, req.(*ListEntriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankSystem_ReconcileLedger_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReconcileLedgerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankSystemServer).ReconcileLedger(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BankSystem_ReconcileLedger_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankSystemServer).ReconcileLedger(ctx, req.(*ReconcileLedgerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankSystem_CreateScheduledTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateScheduledTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankSystemServer).CreateScheduledTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BankSystem_CreateScheduledTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankSystemServer).CreateScheduledTransfer(ctx, req.(*CreateScheduledTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankSystem_ListScheduledTransfers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListScheduledTransfersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankSystemServer).ListScheduledTransfers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BankSystem_ListScheduledTransfers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankSystemServer).ListScheduledTransfers(ctx, req.(*ListScheduledTransfersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankSystem_PauseScheduledTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseScheduledTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankSystemServer).PauseScheduledTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BankSystem_PauseScheduledTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankSystemServer).PauseScheduledTransfer(ctx, req.(*PauseScheduledTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankSystem_ResumeScheduledTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeScheduledTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankSystemServer).ResumeScheduledTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BankSystem_ResumeScheduledTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankSystemServer).ResumeScheduledTransfer(ctx, req.(*ResumeScheduledTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankSystem_CancelScheduledTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelScheduledTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankSystemServer).CancelScheduledTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BankSystem_CancelScheduledTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankSystemServer).CancelScheduledTransfer(ctx, req.(*CancelScheduledTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankSystem_ListScheduledTransferExecutions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListScheduledTransferExecutionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankSystemServer).ListScheduledTransferExecutions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BankSystem_ListScheduledTransferExecutions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankSystemServer).ListScheduledTransferExecutions(ctx, req.(*ListScheduledTransferExecutionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankSystem_ReverseTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReverseTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankSystemServer).ReverseTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BankSystem_ReverseTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankSystemServer).ReverseTransfer(ctx, req.(*ReverseTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankSystem_PlaceHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlaceHoldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankSystemServer).PlaceHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BankSystem_PlaceHold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankSystemServer).PlaceHold(ctx, req.(*PlaceHoldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankSystem_CaptureHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CaptureHoldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankSystemServer).CaptureHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BankSystem_CaptureHold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankSystemServer).CaptureHold(ctx, req.(*CaptureHoldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankSystem_ReleaseHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseHoldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankSystemServer).ReleaseHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BankSystem_ReleaseHold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankSystemServer).ReleaseHold(ctx, req.(*ReleaseHoldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankSystem_UpdateAccountStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAccountStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankSystemServer).UpdateAccountStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BankSystem_UpdateAccountStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankSystemServer).UpdateAccountStatus(ctx, req.(*UpdateAccountStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankSystem_ListAccountStatusChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAccountStatusChangesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankSystemServer).ListAccountStatusChanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BankSystem_ListAccountStatusChanges_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankSystemServer).ListAccountStatusChanges(ctx, req.(*ListAccountStatusChangesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankSystem_SetInterestRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetInterestRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankSystemServer).SetInterestRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BankSystem_SetInterestRate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankSystemServer).SetInterestRate(ctx, req.(*SetInterestRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankSystem_CreateFeeRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateFeeRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankSystemServer).CreateFeeRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BankSystem_CreateFeeRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankSystemServer).CreateFeeRule(ctx, req.(*CreateFeeRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankSystem_ListFeeRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFeeRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankSystemServer).ListFeeRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BankSystem_ListFeeRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankSystemServer).ListFeeRules(ctx, req.(*ListFeeRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankSystem_SetTransferLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTransferLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankSystemServer).SetTransferLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BankSystem_SetTransferLimits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankSystemServer).SetTransferLimits(ctx, req.(*SetTransferLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankSystem_GetBalanceAt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBalanceAtRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankSystemServer).GetBalanceAt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BankSystem_GetBalanceAt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankSystemServer).GetBalanceAt(ctx, req.(*GetBalanceAtRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankSystem_SearchTransfers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchTransfersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankSystemServer).SearchTransfers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BankSystem_SearchTransfers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankSystemServer).SearchTransfers(ctx, req.(*SearchTransfersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankSystem_ListCurrencies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCurrenciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankSystemServer).ListCurrencies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BankSystem_ListCurrencies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankSystemServer).ListCurrencies(ctx, req.(*ListCurrenciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
 
-func (UnimplementedBankSystemServer) CreateUser(context.Context, *CreateUserRequest) (*CreateUserResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateUser not implemented")
+func _BankSystem_SetCurrencyEnabled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCurrencyEnabledRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankSystemServer).SetCurrencyEnabled(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BankSystem_SetCurrencyEnabled_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankSystemServer).SetCurrencyEnabled(ctx, req.(*SetCurrencyEnabledRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedBankSystemServer) LoginUser(context.Context, *LoginUserRequest) (*LoginUserResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LoginUser not implemented")
+
+func _BankSystem_SetCurrencyApprovalThreshold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCurrencyApprovalThresholdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankSystemServer).SetCurrencyApprovalThreshold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BankSystem_SetCurrencyApprovalThreshold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankSystemServer).SetCurrencyApprovalThreshold(ctx, req.(*SetCurrencyApprovalThresholdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedBankSystemServer) UpdateUser(context.Context, *UpdateUserRequest) (*UpdateUserResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateUser not implemented")
+
+func _BankSystem_InviteAccountHolder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InviteAccountHolderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankSystemServer).InviteAccountHolder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BankSystem_InviteAccountHolder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankSystemServer).InviteAccountHolder(ctx, req.(*InviteAccountHolderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedBankSystemServer) VerifyEmail(context.Context, *VerifyEmailRequest) (*VerifyEmailResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method VerifyEmail not implemented")
+
+func _BankSystem_AcceptAccountHolder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptAccountHolderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankSystemServer).AcceptAccountHolder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BankSystem_AcceptAccountHolder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankSystemServer).AcceptAccountHolder(ctx, req.(*AcceptAccountHolderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedBankSystemServer) mustEmbedUnimplementedBankSystemServer() {}
-func (UnimplementedBankSystemServer) testEmbeddedByValue()                    {}
 
-// UnsafeBankSystemServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to BankSystemServer will
-// result in compilation errors.
-type UnsafeBankSystemServer interface {
-	mustEmbedUnimplementedBankSystemServer()
+func _BankSystem_RemoveAccountHolder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveAccountHolderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankSystemServer).RemoveAccountHolder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BankSystem_RemoveAccountHolder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankSystemServer).RemoveAccountHolder(ctx, req.(*RemoveAccountHolderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterBankSystemServer(s grpc.ServiceRegistrar, srv BankSystemServer) {
-	// If the following call pancis, it indicates UnimplementedBankSystemServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _BankSystem_CreateWebhookSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWebhookSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&BankSystem_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(BankSystemServer).CreateWebhookSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BankSystem_CreateWebhookSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankSystemServer).CreateWebhookSubscription(ctx, req.(*CreateWebhookSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _BankSystem_CreateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateUserRequest)
+func _BankSystem_ListWebhookSubscriptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWebhookSubscriptionsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BankSystemServer).CreateUser(ctx, in)
+		return srv.(BankSystemServer).ListWebhookSubscriptions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: BankSystem_CreateUser_FullMethodName,
+		FullMethod: BankSystem_ListWebhookSubscriptions_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BankSystemServer).CreateUser(ctx, req.(*CreateUserRequest))
+		return srv.(BankSystemServer).ListWebhookSubscriptions(ctx, req.(*ListWebhookSubscriptionsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BankSystem_LoginUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LoginUserRequest)
+func _BankSystem_DeleteWebhookSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWebhookSubscriptionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BankSystemServer).LoginUser(ctx, in)
+		return srv.(BankSystemServer).DeleteWebhookSubscription(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: BankSystem_LoginUser_FullMethodName,
+		FullMethod: BankSystem_DeleteWebhookSubscription_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BankSystemServer).LoginUser(ctx, req.(*LoginUserRequest))
+		return srv.(BankSystemServer).DeleteWebhookSubscription(ctx, req.(*DeleteWebhookSubscriptionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BankSystem_UpdateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateUserRequest)
+func _BankSystem_ListWebhookDeliveries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWebhookDeliveriesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BankSystemServer).UpdateUser(ctx, in)
+		return srv.(BankSystemServer).ListWebhookDeliveries(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: BankSystem_UpdateUser_FullMethodName,
+		FullMethod: BankSystem_ListWebhookDeliveries_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BankSystemServer).UpdateUser(ctx, req.(*UpdateUserRequest))
+		return srv.(BankSystemServer).ListWebhookDeliveries(ctx, req.(*ListWebhookDeliveriesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BankSystem_VerifyEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(VerifyEmailRequest)
+func _BankSystem_VerifyAuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyAuditLogRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BankSystemServer).VerifyEmail(ctx, in)
+		return srv.(BankSystemServer).VerifyAuditLog(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: BankSystem_VerifyEmail_FullMethodName,
+		FullMethod: BankSystem_VerifyAuditLog_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BankSystemServer).VerifyEmail(ctx, req.(*VerifyEmailRequest))
+		return srv.(BankSystemServer).VerifyAuditLog(ctx, req.(*VerifyAuditLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankSystem_ListPendingTransferApprovals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPendingTransferApprovalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankSystemServer).ListPendingTransferApprovals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BankSystem_ListPendingTransferApprovals_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankSystemServer).ListPendingTransferApprovals(ctx, req.(*ListPendingTransferApprovalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankSystem_ApproveTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankSystemServer).ApproveTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BankSystem_ApproveTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankSystemServer).ApproveTransfer(ctx, req.(*ApproveTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankSystem_RejectTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankSystemServer).RejectTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BankSystem_RejectTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankSystemServer).RejectTransfer(ctx, req.(*RejectTransferRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -229,6 +1589,166 @@ var BankSystem_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "VerifyEmail",
 			Handler:    _BankSystem_VerifyEmail_Handler,
 		},
+		{
+			MethodName: "CreateAccount",
+			Handler:    _BankSystem_CreateAccount_Handler,
+		},
+		{
+			MethodName: "GetAccount",
+			Handler:    _BankSystem_GetAccount_Handler,
+		},
+		{
+			MethodName: "ListAccounts",
+			Handler:    _BankSystem_ListAccounts_Handler,
+		},
+		{
+			MethodName: "CreateTransfer",
+			Handler:    _BankSystem_CreateTransfer_Handler,
+		},
+		{
+			MethodName: "GetTransfer",
+			Handler:    _BankSystem_GetTransfer_Handler,
+		},
+		{
+			MethodName: "ListTransfers",
+			Handler:    _BankSystem_ListTransfers_Handler,
+		},
+		{
+			MethodName: "ListEntries",
+			Handler:    _BankSystem_ListEntries_Handler,
+		},
+		{
+			MethodName: "ReconcileLedger",
+			Handler:    _BankSystem_ReconcileLedger_Handler,
+		},
+		{
+			MethodName: "CreateScheduledTransfer",
+			Handler:    _BankSystem_CreateScheduledTransfer_Handler,
+		},
+		{
+			MethodName: "ListScheduledTransfers",
+			Handler:    _BankSystem_ListScheduledTransfers_Handler,
+		},
+		{
+			MethodName: "PauseScheduledTransfer",
+			Handler:    _BankSystem_PauseScheduledTransfer_Handler,
+		},
+		{
+			MethodName: "ResumeScheduledTransfer",
+			Handler:    _BankSystem_ResumeScheduledTransfer_Handler,
+		},
+		{
+			MethodName: "CancelScheduledTransfer",
+			Handler:    _BankSystem_CancelScheduledTransfer_Handler,
+		},
+		{
+			MethodName: "ListScheduledTransferExecutions",
+			Handler:    _BankSystem_ListScheduledTransferExecutions_Handler,
+		},
+		{
+			MethodName: "ReverseTransfer",
+			Handler:    _BankSystem_ReverseTransfer_Handler,
+		},
+		{
+			MethodName: "PlaceHold",
+			Handler:    _BankSystem_PlaceHold_Handler,
+		},
+		{
+			MethodName: "CaptureHold",
+			Handler:    _BankSystem_CaptureHold_Handler,
+		},
+		{
+			MethodName: "ReleaseHold",
+			Handler:    _BankSystem_ReleaseHold_Handler,
+		},
+		{
+			MethodName: "UpdateAccountStatus",
+			Handler:    _BankSystem_UpdateAccountStatus_Handler,
+		},
+		{
+			MethodName: "ListAccountStatusChanges",
+			Handler:    _BankSystem_ListAccountStatusChanges_Handler,
+		},
+		{
+			MethodName: "SetInterestRate",
+			Handler:    _BankSystem_SetInterestRate_Handler,
+		},
+		{
+			MethodName: "CreateFeeRule",
+			Handler:    _BankSystem_CreateFeeRule_Handler,
+		},
+		{
+			MethodName: "ListFeeRules",
+			Handler:    _BankSystem_ListFeeRules_Handler,
+		},
+		{
+			MethodName: "SetTransferLimits",
+			Handler:    _BankSystem_SetTransferLimits_Handler,
+		},
+		{
+			MethodName: "GetBalanceAt",
+			Handler:    _BankSystem_GetBalanceAt_Handler,
+		},
+		{
+			MethodName: "SearchTransfers",
+			Handler:    _BankSystem_SearchTransfers_Handler,
+		},
+		{
+			MethodName: "ListCurrencies",
+			Handler:    _BankSystem_ListCurrencies_Handler,
+		},
+		{
+			MethodName: "SetCurrencyEnabled",
+			Handler:    _BankSystem_SetCurrencyEnabled_Handler,
+		},
+		{
+			MethodName: "SetCurrencyApprovalThreshold",
+			Handler:    _BankSystem_SetCurrencyApprovalThreshold_Handler,
+		},
+		{
+			MethodName: "InviteAccountHolder",
+			Handler:    _BankSystem_InviteAccountHolder_Handler,
+		},
+		{
+			MethodName: "AcceptAccountHolder",
+			Handler:    _BankSystem_AcceptAccountHolder_Handler,
+		},
+		{
+			MethodName: "RemoveAccountHolder",
+			Handler:    _BankSystem_RemoveAccountHolder_Handler,
+		},
+		{
+			MethodName: "CreateWebhookSubscription",
+			Handler:    _BankSystem_CreateWebhookSubscription_Handler,
+		},
+		{
+			MethodName: "ListWebhookSubscriptions",
+			Handler:    _BankSystem_ListWebhookSubscriptions_Handler,
+		},
+		{
+			MethodName: "DeleteWebhookSubscription",
+			Handler:    _BankSystem_DeleteWebhookSubscription_Handler,
+		},
+		{
+			MethodName: "ListWebhookDeliveries",
+			Handler:    _BankSystem_ListWebhookDeliveries_Handler,
+		},
+		{
+			MethodName: "VerifyAuditLog",
+			Handler:    _BankSystem_VerifyAuditLog_Handler,
+		},
+		{
+			MethodName: "ListPendingTransferApprovals",
+			Handler:    _BankSystem_ListPendingTransferApprovals_Handler,
+		},
+		{
+			MethodName: "ApproveTransfer",
+			Handler:    _BankSystem_ApproveTransfer_Handler,
+		},
+		{
+			MethodName: "RejectTransfer",
+			Handler:    _BankSystem_RejectTransfer_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "service_bank_system.proto",
@@ -0,0 +1,175 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v6.31.1
+// source: transfer_fee.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type TransferFee struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// zero if no fee was charged
+	RuleId     int64 `protobuf:"varint,1,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	Flat       int64 `protobuf:"varint,2,opt,name=flat,proto3" json:"flat,omitempty"`
+	Percentage int64 `protobuf:"varint,3,opt,name=percentage,proto3" json:"percentage,omitempty"`
+	// flat plus percentage, bounded by the rule's minimum and maximum
+	Total         int64  `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`
+	Entry         *Entry `protobuf:"bytes,5,opt,name=entry,proto3" json:"entry,omitempty"`
+	RevenueEntry  *Entry `protobuf:"bytes,6,opt,name=revenue_entry,json=revenueEntry,proto3" json:"revenue_entry,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransferFee) Reset() {
+	*x = TransferFee{}
+	mi := &file_transfer_fee_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferFee) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferFee) ProtoMessage() {}
+
+func (x *TransferFee) ProtoReflect() protoreflect.Message {
+	mi := &file_transfer_fee_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferFee.ProtoReflect.Descriptor instead.
+func (*TransferFee) Descriptor() ([]byte, []int) {
+	return file_transfer_fee_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TransferFee) GetRuleId() int64 {
+	if x != nil {
+		return x.RuleId
+	}
+	return 0
+}
+
+func (x *TransferFee) GetFlat() int64 {
+	if x != nil {
+		return x.Flat
+	}
+	return 0
+}
+
+func (x *TransferFee) GetPercentage() int64 {
+	if x != nil {
+		return x.Percentage
+	}
+	return 0
+}
+
+func (x *TransferFee) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *TransferFee) GetEntry() *Entry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+func (x *TransferFee) GetRevenueEntry() *Entry {
+	if x != nil {
+		return x.RevenueEntry
+	}
+	return nil
+}
+
+var File_transfer_fee_proto protoreflect.FileDescriptor
+
+const file_transfer_fee_proto_rawDesc = "" +
+	"\n" +
+	"\x12transfer_fee.proto\x12\x02pb\x1a\ventry.proto\"\xc1\x01\n" +
+	"\vTransferFee\x12\x17\n" +
+	"\arule_id\x18\x01 \x01(\x03R\x06ruleId\x12\x12\n" +
+	"\x04flat\x18\x02 \x01(\x03R\x04flat\x12\x1e\n" +
+	"\n" +
+	"percentage\x18\x03 \x01(\x03R\n" +
+	"percentage\x12\x14\n" +
+	"\x05total\x18\x04 \x01(\x03R\x05total\x12\x1f\n" +
+	"\x05entry\x18\x05 \x01(\v2\t.pb.EntryR\x05entry\x12.\n" +
+	"\rrevenue_entry\x18\x06 \x01(\v2\t.pb.EntryR\frevenueEntryB\"Z github.com/mahanth/simplebank/pbb\x06proto3"
+
+var (
+	file_transfer_fee_proto_rawDescOnce sync.Once
+	file_transfer_fee_proto_rawDescData []byte
+)
+
+func file_transfer_fee_proto_rawDescGZIP() []byte {
+	file_transfer_fee_proto_rawDescOnce.Do(func() {
+		file_transfer_fee_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_transfer_fee_proto_rawDesc), len(file_transfer_fee_proto_rawDesc)))
+	})
+	return file_transfer_fee_proto_rawDescData
+}
+
+var file_transfer_fee_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_transfer_fee_proto_goTypes = []any{
+	(*TransferFee)(nil), // 0: pb.TransferFee
+	(*Entry)(nil),       // 1: pb.Entry
+}
+var file_transfer_fee_proto_depIdxs = []int32{
+	1, // 0: pb.TransferFee.entry:type_name -> pb.Entry
+	1, // 1: pb.TransferFee.revenue_entry:type_name -> pb.Entry
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_transfer_fee_proto_init() }
+func file_transfer_fee_proto_init() {
+	if File_transfer_fee_proto != nil {
+		return
+	}
+	file_entry_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_transfer_fee_proto_rawDesc), len(file_transfer_fee_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_transfer_fee_proto_goTypes,
+		DependencyIndexes: file_transfer_fee_proto_depIdxs,
+		MessageInfos:      file_transfer_fee_proto_msgTypes,
+	}.Build()
+	File_transfer_fee_proto = out.File
+	file_transfer_fee_proto_goTypes = nil
+	file_transfer_fee_proto_depIdxs = nil
+}
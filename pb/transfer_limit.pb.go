@@ -0,0 +1,202 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v6.31.1
+// source: transfer_limit.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// TransferLimit overrides the default transfer limits of a user's accounts or of one account.
+type TransferLimit struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// set for a user's override
+	Username string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	// set for an account's override
+	AccountId int64 `protobuf:"varint,3,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	// unset to keep the default, 0 for no limit
+	MaxSingle *int64 `protobuf:"varint,4,opt,name=max_single,json=maxSingle,proto3,oneof" json:"max_single,omitempty"`
+	// outgoing total per UTC day, unset to keep the default, 0 for no limit
+	MaxDailyTotal *int64 `protobuf:"varint,5,opt,name=max_daily_total,json=maxDailyTotal,proto3,oneof" json:"max_daily_total,omitempty"`
+	// outgoing transfers per UTC day, unset to keep the default, 0 for no limit
+	MaxDailyCount *int32                 `protobuf:"varint,6,opt,name=max_daily_count,json=maxDailyCount,proto3,oneof" json:"max_daily_count,omitempty"`
+	UpdatedBy     string                 `protobuf:"bytes,7,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransferLimit) Reset() {
+	*x = TransferLimit{}
+	mi := &file_transfer_limit_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferLimit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferLimit) ProtoMessage() {}
+
+func (x *TransferLimit) ProtoReflect() protoreflect.Message {
+	mi := &file_transfer_limit_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferLimit.ProtoReflect.Descriptor instead.
+func (*TransferLimit) Descriptor() ([]byte, []int) {
+	return file_transfer_limit_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TransferLimit) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *TransferLimit) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *TransferLimit) GetAccountId() int64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *TransferLimit) GetMaxSingle() int64 {
+	if x != nil && x.MaxSingle != nil {
+		return *x.MaxSingle
+	}
+	return 0
+}
+
+func (x *TransferLimit) GetMaxDailyTotal() int64 {
+	if x != nil && x.MaxDailyTotal != nil {
+		return *x.MaxDailyTotal
+	}
+	return 0
+}
+
+func (x *TransferLimit) GetMaxDailyCount() int32 {
+	if x != nil && x.MaxDailyCount != nil {
+		return *x.MaxDailyCount
+	}
+	return 0
+}
+
+func (x *TransferLimit) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+func (x *TransferLimit) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+var File_transfer_limit_proto protoreflect.FileDescriptor
+
+const file_transfer_limit_proto_rawDesc = "" +
+	"\n" +
+	"\x14transfer_limit.proto\x12\x02pb\x1a\x1fgoogle/protobuf/timestamp.proto\"\xe9\x02\n" +
+	"\rTransferLimit\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x03 \x01(\x03R\taccountId\x12\"\n" +
+	"\n" +
+	"max_single\x18\x04 \x01(\x03H\x00R\tmaxSingle\x88\x01\x01\x12+\n" +
+	"\x0fmax_daily_total\x18\x05 \x01(\x03H\x01R\rmaxDailyTotal\x88\x01\x01\x12+\n" +
+	"\x0fmax_daily_count\x18\x06 \x01(\x05H\x02R\rmaxDailyCount\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"updated_by\x18\a \x01(\tR\tupdatedBy\x129\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\r\n" +
+	"\v_max_singleB\x12\n" +
+	"\x10_max_daily_totalB\x12\n" +
+	"\x10_max_daily_countB\"Z github.com/mahanth/simplebank/pbb\x06proto3"
+
+var (
+	file_transfer_limit_proto_rawDescOnce sync.Once
+	file_transfer_limit_proto_rawDescData []byte
+)
+
+func file_transfer_limit_proto_rawDescGZIP() []byte {
+	file_transfer_limit_proto_rawDescOnce.Do(func() {
+		file_transfer_limit_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_transfer_limit_proto_rawDesc), len(file_transfer_limit_proto_rawDesc)))
+	})
+	return file_transfer_limit_proto_rawDescData
+}
+
+var file_transfer_limit_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_transfer_limit_proto_goTypes = []any{
+	(*TransferLimit)(nil),         // 0: pb.TransferLimit
+	(*timestamppb.Timestamp)(nil), // 1: google.protobuf.Timestamp
+}
+var file_transfer_limit_proto_depIdxs = []int32{
+	1, // 0: pb.TransferLimit.updated_at:type_name -> google.protobuf.Timestamp
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_transfer_limit_proto_init() }
+func file_transfer_limit_proto_init() {
+	if File_transfer_limit_proto != nil {
+		return
+	}
+	file_transfer_limit_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_transfer_limit_proto_rawDesc), len(file_transfer_limit_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_transfer_limit_proto_goTypes,
+		DependencyIndexes: file_transfer_limit_proto_depIdxs,
+		MessageInfos:      file_transfer_limit_proto_msgTypes,
+	}.Build()
+	File_transfer_limit_proto = out.File
+	file_transfer_limit_proto_goTypes = nil
+	file_transfer_limit_proto_depIdxs = nil
+}
@@ -7,17 +7,33 @@ import (
 )
 
 type Config struct {
-	DBSource             string        `mapstructure:"DBSOURCE"`
-	HttpServerAddress    string        `mapstructure:"HTTP_SERVER_ADDRESS"`
-	GrpcServerAddress    string        `mapstructure:"GRPC_SERVER_ADDRESS"`
-	TokenSymmetricKey    string        `mapstructure:"TOKEN_SYMMETRIC_KEY"`
-	AccessTokenDuration  time.Duration `mapstructure:"ACCESS_TOKEN_DURATION"`
-	RefreshTokenDuration time.Duration `mapstructure:"REFRESH_TOKEN_DURATION"`
-	MigrationURL         string        `mapstructure:"MIGRATION_URL"`
-	RedisAddress         string        `mapstructure:"REDIS_ADDRESS"`
-	EmailSenderName      string        `mapstructure:"EMAIL_SENDER_NAME"`
-	EmailSenderAddress   string        `mapstructure:"EMAIL_SENDER_ADDRESS"`
-	EmailSenderPassword  string        `mapstructure:"EMAIL_SENDER_PASSWORD"`
+	DBDriver                string        `mapstructure:"DB_DRIVER"`
+	DBSource                string        `mapstructure:"DBSOURCE"`
+	DBReplicaSource         string        `mapstructure:"DB_REPLICA_SOURCE"`
+	HttpServerAddress       string        `mapstructure:"HTTP_SERVER_ADDRESS"`
+	GrpcServerAddress       string        `mapstructure:"GRPC_SERVER_ADDRESS"`
+	TokenSymmetricKey       string        `mapstructure:"TOKEN_SYMMETRIC_KEY"`
+	AccessTokenDuration     time.Duration `mapstructure:"ACCESS_TOKEN_DURATION"`
+	RefreshTokenDuration    time.Duration `mapstructure:"REFRESH_TOKEN_DURATION"`
+	MigrationURL            string        `mapstructure:"MIGRATION_URL"`
+	RedisAddress            string        `mapstructure:"REDIS_ADDRESS"`
+	EmailSenderName         string        `mapstructure:"EMAIL_SENDER_NAME"`
+	EmailSenderAddress      string        `mapstructure:"EMAIL_SENDER_ADDRESS"`
+	EmailSenderPassword     string        `mapstructure:"EMAIL_SENDER_PASSWORD"`
+	ReconciliationSchedule  string        `mapstructure:"RECONCILIATION_SCHEDULE"`
+	ExchangeRatesFile       string        `mapstructure:"EXCHANGE_RATES_FILE"`
+	ScheduledTransfersPoll  string        `mapstructure:"SCHEDULED_TRANSFERS_POLL"`
+	HoldExpirySchedule      string        `mapstructure:"HOLD_EXPIRY_SCHEDULE"`
+	InterestAccrualSchedule string        `mapstructure:"INTEREST_ACCRUAL_SCHEDULE"`
+	TransferMaxSingle       int64         `mapstructure:"TRANSFER_MAX_SINGLE"`
+	TransferMaxDailyTotal   int64         `mapstructure:"TRANSFER_MAX_DAILY_TOTAL"`
+	TransferMaxDailyCount   int32         `mapstructure:"TRANSFER_MAX_DAILY_COUNT"`
+	TransferApprovalTTL     time.Duration `mapstructure:"TRANSFER_APPROVAL_TTL"`
+	TxMaxRetries            int           `mapstructure:"TX_MAX_RETRIES"`
+	TxStatsInterval         time.Duration `mapstructure:"TX_STATS_INTERVAL"`
+	OutboxRelayInterval     time.Duration `mapstructure:"OUTBOX_RELAY_INTERVAL"`
+	CurrencyRefreshInterval time.Duration `mapstructure:"CURRENCY_REFRESH_INTERVAL"`
+	WebhookTimeout          time.Duration `mapstructure:"WEBHOOK_TIMEOUT"`
 }
 
 func LoadConfig(path string) (config Config, err error) {
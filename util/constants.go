@@ -4,4 +4,10 @@ const (
 	USD = "USD"
 	EUR = "EUR"
 	CAD = "CAD"
+	AUD = "AUD"
+	CHF = "CHF"
+	GBP = "GBP"
+	INR = "INR"
+	JPY = "JPY"
+	KWD = "KWD"
 )
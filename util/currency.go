@@ -1,11 +1,95 @@
 package util
 
-func IsSupportedCurrency(currency string) bool {
-	supportedCurrencies := []string{"USD", "EUR", "CAD"}
-	for _, c := range supportedCurrencies {
-		if c == currency {
-			return true
+import (
+	"slices"
+	"sync"
+)
+
+// Currency describes an ISO 4217 currency. Exponent is the number of digits of its minor unit,
+// e.g. 2 for USD where amounts are stored in cents. Only enabled currencies can be used for
+// new accounts and transfers.
+type Currency struct {
+	Code     string `json:"code"`
+	Exponent int32  `json:"exponent"`
+	Name     string `json:"name"`
+	Enabled  bool   `json:"enabled"`
+}
+
+// DefaultCurrencies are the currencies known before LoadCurrencies is called. They match the rows
+// the currencies table is seeded with.
+var DefaultCurrencies = []Currency{
+	{Code: AUD, Exponent: 2, Name: "Australian Dollar"},
+	{Code: CAD, Exponent: 2, Name: "Canadian Dollar", Enabled: true},
+	{Code: CHF, Exponent: 2, Name: "Swiss Franc"},
+	{Code: EUR, Exponent: 2, Name: "Euro", Enabled: true},
+	{Code: GBP, Exponent: 2, Name: "Pound Sterling"},
+	{Code: INR, Exponent: 2, Name: "Indian Rupee"},
+	{Code: JPY, Exponent: 0, Name: "Yen"},
+	{Code: KWD, Exponent: 3, Name: "Kuwaiti Dinar"},
+	{Code: USD, Exponent: 2, Name: "US Dollar", Enabled: true},
+}
+
+// currencies is the registry the functions below look currencies up in, keyed by code.
+var currencies = struct {
+	sync.RWMutex
+	byCode map[string]Currency
+}{byCode: currencyMap(DefaultCurrencies)}
+
+func currencyMap(list []Currency) map[string]Currency {
+	byCode := make(map[string]Currency, len(list))
+	for _, currency := range list {
+		byCode[currency.Code] = currency
+	}
+	return byCode
+}
+
+// LoadCurrencies replaces the registry with the given currencies, normally those stored in the database.
+func LoadCurrencies(list []Currency) {
+	byCode := currencyMap(list)
+	currencies.Lock()
+	currencies.byCode = byCode
+	currencies.Unlock()
+}
+
+// SetCurrency adds a currency to the registry or replaces the one with the same code.
+func SetCurrency(currency Currency) {
+	currencies.Lock()
+	currencies.byCode[currency.Code] = currency
+	currencies.Unlock()
+}
+
+// LookupCurrency returns the currency with the given code, enabled or not.
+func LookupCurrency(code string) (Currency, bool) {
+	currencies.RLock()
+	defer currencies.RUnlock()
+	currency, ok := currencies.byCode[code]
+	return currency, ok
+}
+
+// SupportedCurrencies returns the codes of the enabled currencies in alphabetical order.
+func SupportedCurrencies() []string {
+	currencies.RLock()
+	defer currencies.RUnlock()
+	var codes []string
+	for code, currency := range currencies.byCode {
+		if currency.Enabled {
+			codes = append(codes, code)
 		}
 	}
-	return false
+	slices.Sort(codes)
+	return codes
+}
+
+func IsSupportedCurrency(currency string) bool {
+	c, ok := LookupCurrency(currency)
+	return ok && c.Enabled
+}
+
+// CurrencyExponent returns the number of minor units of a currency,
+// e.g. 2 for USD where amounts are stored in cents. Unknown currencies are assumed to have 2.
+func CurrencyExponent(currency string) int32 {
+	if c, ok := LookupCurrency(currency); ok {
+		return c.Exponent
+	}
+	return 2
 }
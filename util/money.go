@@ -0,0 +1,103 @@
+package util
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strings"
+)
+
+// Money is an amount in the minor units of a currency, such as 1250 for 12.50 USD.
+// It is written to JSON as {"amount":"12.50","currency":"USD"}, the amount in major units.
+type Money struct {
+	Amount   int64
+	Currency string
+}
+
+// ParseMoney parses an amount written in major units of the currency, such as "12.50" for USD,
+// into its minor units. It accepts an optional minus sign and at most as many decimals as the
+// currency's minor unit has, so "12.505" is rejected for USD and "12.5" for JPY.
+func ParseMoney(amount string, currency string) (Money, error) {
+	c, ok := LookupCurrency(currency)
+	if !ok {
+		return Money{}, fmt.Errorf("unknown currency %q", currency)
+	}
+
+	digits, negative := strings.CutPrefix(amount, "-")
+	whole, fraction, hasPoint := strings.Cut(digits, ".")
+	if whole == "" || (hasPoint && fraction == "") || !isDigits(whole) || !isDigits(fraction) {
+		return Money{}, fmt.Errorf("amount %q is not a decimal number", amount)
+	}
+	if len(fraction) > int(c.Exponent) {
+		return Money{}, fmt.Errorf("amount %q has more than %d decimals for %s", amount, c.Exponent, currency)
+	}
+	fraction += strings.Repeat("0", int(c.Exponent)-len(fraction))
+
+	var minor int64
+	for _, digit := range whole + fraction {
+		if minor > (math.MaxInt64-int64(digit-'0'))/10 {
+			return Money{}, fmt.Errorf("amount %q is too large", amount)
+		}
+		minor = minor*10 + int64(digit-'0')
+	}
+	if negative {
+		minor = -minor
+	}
+	return Money{Amount: minor, Currency: currency}, nil
+}
+
+func isDigits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// FormatAmount writes the amount in major units with as many decimals as the currency's minor
+// unit has, e.g. "12.50" for 1250 USD and "1250" for 1250 JPY.
+func (m Money) FormatAmount() string {
+	exponent := int(CurrencyExponent(m.Currency))
+	sign := ""
+	// the magnitude of math.MinInt64 does not fit in an int64, so work on the digits
+	digits := fmt.Sprint(m.Amount)
+	if m.Amount < 0 {
+		sign, digits = "-", digits[1:]
+	}
+	if exponent == 0 {
+		return sign + digits
+	}
+	if len(digits) <= exponent {
+		digits = strings.Repeat("0", exponent-len(digits)+1) + digits
+	}
+	point := len(digits) - exponent
+	return sign + digits[:point] + "." + digits[point:]
+}
+
+// String formats the money for display, e.g. "12.50 USD".
+func (m Money) String() string {
+	return m.FormatAmount() + " " + m.Currency
+}
+
+type moneyJSON struct {
+	Amount   string `json:"amount"`
+	Currency string `json:"currency"`
+}
+
+func (m Money) MarshalJSON() ([]byte, error) {
+	return json.Marshal(moneyJSON{Amount: m.FormatAmount(), Currency: m.Currency})
+}
+
+func (m *Money) UnmarshalJSON(data []byte) error {
+	var raw moneyJSON
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	money, err := ParseMoney(raw.Amount, raw.Currency)
+	if err != nil {
+		return err
+	}
+	*m = money
+	return nil
+}
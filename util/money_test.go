@@ -0,0 +1,89 @@
+package util
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseMoney(t *testing.T) {
+	testCases := []struct {
+		amount   string
+		currency string
+		expected int64
+		ok       bool
+	}{
+		{"12.50", USD, 1250, true},
+		{"12.5", USD, 1250, true},
+		{"12", USD, 1200, true},
+		{"0.07", EUR, 7, true},
+		{"-3.10", CAD, -310, true},
+		{"1250", JPY, 1250, true},
+		{"1.234", KWD, 1234, true},
+		{"92233720368547758.07", USD, math.MaxInt64, true},
+		{"92233720368547758.08", USD, 0, false},
+		{"12.505", USD, 0, false},
+		{"12.5", JPY, 0, false},
+		{"12.", USD, 0, false},
+		{".50", USD, 0, false},
+		{"", USD, 0, false},
+		{"1e3", USD, 0, false},
+		{"+1", USD, 0, false},
+		{"1,000", USD, 0, false},
+		{"1", "XYZ", 0, false},
+	}
+
+	for _, tc := range testCases {
+		money, err := ParseMoney(tc.amount, tc.currency)
+		if !tc.ok {
+			require.Error(t, err, tc.amount)
+			continue
+		}
+		require.NoError(t, err, tc.amount)
+		require.Equal(t, Money{Amount: tc.expected, Currency: tc.currency}, money)
+	}
+}
+
+func TestFormatMoney(t *testing.T) {
+	require.Equal(t, "12.50", Money{Amount: 1250, Currency: USD}.FormatAmount())
+	require.Equal(t, "0.05", Money{Amount: 5, Currency: USD}.FormatAmount())
+	require.Equal(t, "-0.05", Money{Amount: -5, Currency: USD}.FormatAmount())
+	require.Equal(t, "1250", Money{Amount: 1250, Currency: JPY}.FormatAmount())
+	require.Equal(t, "0.001", Money{Amount: 1, Currency: KWD}.FormatAmount())
+	require.Equal(t, "-92233720368547758.08", Money{Amount: math.MinInt64, Currency: USD}.FormatAmount())
+	require.Equal(t, "12.50 USD", Money{Amount: 1250, Currency: USD}.String())
+}
+
+func TestMoneyJSON(t *testing.T) {
+	data, err := json.Marshal(Money{Amount: 1250, Currency: USD})
+	require.NoError(t, err)
+	require.JSONEq(t, `{"amount":"12.50","currency":"USD"}`, string(data))
+
+	var money Money
+	require.NoError(t, json.Unmarshal(data, &money))
+	require.Equal(t, Money{Amount: 1250, Currency: USD}, money)
+
+	require.Error(t, json.Unmarshal([]byte(`{"amount":"12.505","currency":"USD"}`), &money))
+	require.Error(t, json.Unmarshal([]byte(`{"amount":12.5,"currency":"USD"}`), &money))
+}
+
+func TestCurrencyRegistry(t *testing.T) {
+	defer LoadCurrencies(DefaultCurrencies)
+
+	require.True(t, IsSupportedCurrency(USD))
+	require.False(t, IsSupportedCurrency(JPY))
+	require.False(t, IsSupportedCurrency("XYZ"))
+	require.Equal(t, []string{CAD, EUR, USD}, SupportedCurrencies())
+	require.Contains(t, SupportedCurrencies(), RandomCurrency())
+
+	SetCurrency(Currency{Code: JPY, Exponent: 0, Name: "Yen", Enabled: true})
+	require.True(t, IsSupportedCurrency(JPY))
+	require.Equal(t, int32(0), CurrencyExponent(JPY))
+
+	LoadCurrencies([]Currency{{Code: GBP, Exponent: 2, Enabled: true}})
+	require.Equal(t, []string{GBP}, SupportedCurrencies())
+	require.False(t, IsSupportedCurrency(USD))
+	require.Equal(t, int32(2), CurrencyExponent(USD))
+}
@@ -0,0 +1,42 @@
+package util
+
+import (
+	"encoding/base64"
+	"errors"
+	"strconv"
+	"strings"
+)
+
+const pageTokenPrefix = "after:"
+
+var errInvalidPageToken = errors.New("invalid page token")
+
+// EncodePageToken returns an opaque token for the page of a newest-first list that
+// follows the item with the given id.
+func EncodePageToken(lastID int64) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(pageTokenPrefix + strconv.FormatInt(lastID, 10)))
+}
+
+// DecodePageToken returns the id of the last item of the previous page encoded in token.
+func DecodePageToken(token string) (int64, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, errInvalidPageToken
+	}
+
+	id, err := strconv.ParseInt(strings.TrimPrefix(string(raw), pageTokenPrefix), 10, 64)
+	if err != nil || !strings.HasPrefix(string(raw), pageTokenPrefix) || id <= 0 {
+		return 0, errInvalidPageToken
+	}
+	return id, nil
+}
+
+// NextPage takes the items of a page fetched with one row more than pageSize. It returns
+// the page itself and the token of the page after it, which is empty if this is the last one.
+func NextPage[T any](items []T, pageSize int32, id func(T) int64) ([]T, string) {
+	if len(items) <= int(pageSize) {
+		return items, ""
+	}
+	items = items[:pageSize]
+	return items, EncodePageToken(id(items[len(items)-1]))
+}
@@ -0,0 +1,33 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPageToken(t *testing.T) {
+	token := EncodePageToken(42)
+	id, err := DecodePageToken(token)
+	require.NoError(t, err)
+	require.Equal(t, int64(42), id)
+
+	for _, token := range []string{"", "42", "not base64!", EncodePageToken(0), EncodePageToken(-1)} {
+		_, err := DecodePageToken(token)
+		require.Error(t, err, token)
+	}
+}
+
+func TestNextPage(t *testing.T) {
+	id := func(n int64) int64 { return n }
+
+	page, token := NextPage([]int64{9, 8, 7}, 3, id)
+	require.Equal(t, []int64{9, 8, 7}, page)
+	require.Empty(t, token)
+
+	page, token = NextPage([]int64{9, 8, 7, 6}, 3, id)
+	require.Equal(t, []int64{9, 8, 7}, page)
+	lastID, err := DecodePageToken(token)
+	require.NoError(t, err)
+	require.Equal(t, int64(7), lastID)
+}
@@ -29,8 +29,9 @@ func RandomOwner() string {
 func RandomEmail() string {
 	return RandomString(6) + "@example.com"
 }
+// RandomCurrency returns one of the currencies that are enabled, so that the app accepts it.
 func RandomCurrency() string {
-	currencies := []string{"USD", "EUR", "CAD", "AUD", "JPY"}
+	currencies := SupportedCurrencies()
 	n := len(currencies)
 	return currencies[rand.Intn(n)]
 }
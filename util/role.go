@@ -4,3 +4,12 @@ const (
 	DepositorRole = "depositor"
 	BankerRole    = "banker"
 )
+
+// IsSupportedRole reports whether role is one a user can have.
+func IsSupportedRole(role string) bool {
+	switch role {
+	case DepositorRole, BankerRole:
+		return true
+	}
+	return false
+}
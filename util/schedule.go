@@ -0,0 +1,65 @@
+package util
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/robfig/cron/v3"
+)
+
+// MinScheduleInterval is the shortest interval accepted for an @every schedule.
+const MinScheduleInterval = time.Minute
+
+// ParseSchedule parses a standard 5-field cron expression, a descriptor such as @daily,
+// or an @every interval. Schedules are always evaluated in UTC.
+func ParseSchedule(spec string) (cron.Schedule, error) {
+	if strings.HasPrefix(spec, "TZ=") || strings.HasPrefix(spec, "CRON_TZ=") {
+		return nil, fmt.Errorf("schedule must not set a time zone, it is evaluated in UTC")
+	}
+
+	schedule, err := cron.ParseStandard(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	switch s := schedule.(type) {
+	case *cron.SpecSchedule:
+		s.Location = time.UTC
+	case cron.ConstantDelaySchedule:
+		if s.Delay < MinScheduleInterval {
+			return nil, fmt.Errorf("schedule interval must be at least %s", MinScheduleInterval)
+		}
+	}
+	return schedule, nil
+}
+
+// FirstRun returns the first run of a schedule that starts at startAt.
+// An @every schedule runs at startAt itself, a cron schedule at its first match from startAt on.
+func FirstRun(schedule cron.Schedule, startAt time.Time) time.Time {
+	startAt = startAt.UTC().Truncate(time.Second)
+	if _, ok := schedule.(cron.ConstantDelaySchedule); ok {
+		return startAt
+	}
+	return schedule.Next(startAt.Add(-time.Second))
+}
+
+// NextRun returns the first run of a schedule that is after both last and now.
+// Runs missed while the schedule was paused or the workers were down are skipped, not replayed.
+func NextRun(schedule cron.Schedule, last, now time.Time) time.Time {
+	last = last.UTC().Truncate(time.Second)
+	if s, ok := schedule.(cron.ConstantDelaySchedule); ok {
+		// keep the runs aligned to the original start rather than to now
+		next := last.Add(s.Delay)
+		if next.After(now) {
+			return next
+		}
+		missed := now.Sub(last) / s.Delay
+		return last.Add((missed + 1) * s.Delay)
+	}
+
+	if now.After(last) {
+		last = now
+	}
+	return schedule.Next(last)
+}
@@ -0,0 +1,49 @@
+package util
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSchedule(t *testing.T) {
+	for _, spec := range []string{"0 9 * * 1", "@daily", "@monthly", "@every 1h"} {
+		_, err := ParseSchedule(spec)
+		require.NoError(t, err, spec)
+	}
+
+	for _, spec := range []string{"", "not a schedule", "@every 10s", "CRON_TZ=Asia/Tokyo 0 9 * * *"} {
+		_, err := ParseSchedule(spec)
+		require.Error(t, err, spec)
+	}
+}
+
+func TestCronScheduleRuns(t *testing.T) {
+	schedule, err := ParseSchedule("0 9 * * *")
+	require.NoError(t, err)
+
+	startAt := time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+	require.Equal(t, startAt, FirstRun(schedule, startAt))
+	require.Equal(t, startAt.AddDate(0, 0, 1), FirstRun(schedule, startAt.Add(time.Minute)))
+
+	// a missed run is skipped rather than replayed
+	now := startAt.AddDate(0, 0, 3).Add(time.Hour)
+	require.Equal(t, startAt.AddDate(0, 0, 4), NextRun(schedule, startAt, now))
+	require.Equal(t, startAt.AddDate(0, 0, 1), NextRun(schedule, startAt, startAt))
+}
+
+func TestEveryScheduleRuns(t *testing.T) {
+	schedule, err := ParseSchedule("@every 1h")
+	require.NoError(t, err)
+
+	startAt := time.Date(2024, 3, 1, 9, 30, 15, 500, time.UTC)
+	first := FirstRun(schedule, startAt)
+	require.Equal(t, startAt.Truncate(time.Second), first)
+
+	require.Equal(t, first.Add(time.Hour), NextRun(schedule, first, first.Add(time.Minute)))
+
+	// runs stay aligned to the start after a gap
+	now := first.Add(5*time.Hour + 10*time.Minute)
+	require.Equal(t, first.Add(6*time.Hour), NextRun(schedule, first, now))
+}
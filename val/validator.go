@@ -2,13 +2,28 @@ package val
 
 import (
 	"fmt"
+	"math/big"
 	"net/mail"
+	"net/url"
 	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/mahanth/simplebank/util"
 )
 
 var (
-	isValidUsername = regexp.MustCompile(`^[a-z0-9_]+$`).MatchString
-	isValidFullName = regexp.MustCompile(`^[a-zA-Z\s]+$`).MatchString
+	isValidUsername     = regexp.MustCompile(`^[a-z0-9_]+$`).MatchString
+	isValidFullName     = regexp.MustCompile(`^[a-zA-Z\s]+$`).MatchString
+	isValidReference    = regexp.MustCompile(`^[a-zA-Z0-9._:/#-]+$`).MatchString
+	isValidCurrencyCode = regexp.MustCompile(`^[A-Z]{3}$`).MatchString
+)
+
+const (
+	maxMemoLength       = 140
+	maxReferenceLength  = 64
+	maxWebhookURLLength = 2048
 )
 
 func ValidateString(value string, minLength int, maxLength int) error {
@@ -61,3 +76,135 @@ func ValidateEmail(value string) error {
 	}
 	return nil
 }
+
+func ValidateCurrency(value string) error {
+	if !util.IsSupportedCurrency(value) {
+		return fmt.Errorf("is not a supported currency")
+	}
+	return nil
+}
+
+// ValidateCurrencyCode checks the form of an ISO 4217 code, whether or not the currency is enabled.
+func ValidateCurrencyCode(value string) error {
+	if !isValidCurrencyCode(value) {
+		return fmt.Errorf("must be 3 upper case letters")
+	}
+	return nil
+}
+
+// ValidateMoney checks a positive amount written in major units of an enabled currency, e.g. "12.50" USD.
+func ValidateMoney(amount string, currency string) error {
+	if err := ValidateCurrency(currency); err != nil {
+		return err
+	}
+	money, err := util.ParseMoney(amount, currency)
+	if err != nil {
+		return err
+	}
+	return ValidateAmount(money.Amount)
+}
+
+func ValidateID(value int64) error {
+	if value <= 0 {
+		return fmt.Errorf("must be a positive integer")
+	}
+	return nil
+}
+
+func ValidateAmount(value int64) error {
+	if value <= 0 {
+		return fmt.Errorf("must be greater than zero")
+	}
+	return nil
+}
+
+func ValidatePageID(value int32) error {
+	if value < 1 {
+		return fmt.Errorf("must be at least 1")
+	}
+	return nil
+}
+
+func ValidatePageSize(value int32) error {
+	if value < 5 || value > 20 {
+		return fmt.Errorf("must be between 5 and 20")
+	}
+	return nil
+}
+
+func ValidateSchedule(value string) error {
+	if err := ValidateString(value, 1, 100); err != nil {
+		return err
+	}
+
+	if _, err := util.ParseSchedule(value); err != nil {
+		return fmt.Errorf("is not a valid schedule: %s", err)
+	}
+	return nil
+}
+
+// ValidateDecimal checks a number written in plain decimal notation, such as "0.025".
+func ValidateDecimal(value string) error {
+	if _, ok := new(big.Rat).SetString(value); !ok || strings.ContainsAny(value, "/eE") {
+		return fmt.Errorf("must be a decimal number")
+	}
+	return nil
+}
+
+// ValidateInterestRate checks an annual interest rate given as a decimal fraction, e.g. "0.025" for 2.5%.
+func ValidateInterestRate(value string) error {
+	if err := ValidateDecimal(value); err != nil {
+		return err
+	}
+	rate, _ := new(big.Rat).SetString(value)
+	if rate.Sign() < 0 || rate.Cmp(big.NewRat(1, 1)) >= 0 {
+		return fmt.Errorf("must be at least 0 and less than 1")
+	}
+	return nil
+}
+
+// ValidateMemo checks the free text description of a transfer. It may be empty, but is limited to
+// maxMemoLength characters of printable text on a single line.
+func ValidateMemo(value string) error {
+	if !utf8.ValidString(value) {
+		return fmt.Errorf("must be valid UTF-8 text")
+	}
+	if n := utf8.RuneCountInString(value); n > maxMemoLength {
+		return fmt.Errorf("must contain at most %d characters", maxMemoLength)
+	}
+	if strings.IndexFunc(value, func(r rune) bool { return !unicode.IsPrint(r) }) >= 0 {
+		return fmt.Errorf("must not contain control or other non-printable characters")
+	}
+	return nil
+}
+
+// ValidateReference checks an external reference of a transfer, such as an invoice number.
+// It may be empty, otherwise it is a single token of letters, digits and . _ : / # -
+func ValidateReference(value string) error {
+	if value == "" {
+		return nil
+	}
+	if err := ValidateString(value, 1, maxReferenceLength); err != nil {
+		return err
+	}
+	if !isValidReference(value) {
+		return fmt.Errorf("must contain only letters, digits, or . _ : / # -")
+	}
+	return nil
+}
+
+// ValidateWebhookURL checks the address webhooks are POSTed to. It must be an absolute https URL,
+// as the deliveries carry account activity.
+func ValidateWebhookURL(value string) error {
+	if err := ValidateString(value, 1, maxWebhookURLLength); err != nil {
+		return err
+	}
+	u, err := url.Parse(value)
+	if err != nil || u.Scheme != "https" || u.Host == "" {
+		return fmt.Errorf("must be an absolute https URL")
+	}
+	if u.User != nil {
+		return fmt.Errorf("must not contain credentials")
+	}
+	return nil
+}
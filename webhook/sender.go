@@ -0,0 +1,61 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// maxErrorBodySize caps how much of a failed response is kept in the delivery log.
+const maxErrorBodySize = 512
+
+// Message is one signed request to a subscriber.
+type Message struct {
+	ID   string
+	Body []byte
+}
+
+type Sender interface {
+	// Send POSTs the message to url signed with secret. It returns the HTTP status of the
+	// response, or 0 if none was received, and an error unless the status is 2xx.
+	Send(ctx context.Context, url string, secret string, msg Message) (int, error)
+}
+
+type HTTPSender struct {
+	client *http.Client
+}
+
+func NewHTTPSender(timeout time.Duration) Sender {
+	return &HTTPSender{
+		client: &http.Client{Timeout: timeout},
+	}
+}
+
+func (sender *HTTPSender) Send(ctx context.Context, url string, secret string, msg Message) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(msg.Body))
+	if err != nil {
+		return 0, fmt.Errorf("failed to create webhook request: %w", err)
+	}
+
+	timestamp := time.Now()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderID, msg.ID)
+	req.Header.Set(HeaderTimestamp, strconv.FormatInt(timestamp.Unix(), 10))
+	req.Header.Set(HeaderSignature, Sign(secret, msg.ID, timestamp, msg.Body))
+
+	resp, err := sender.client.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("failed to send webhook: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodySize))
+		return resp.StatusCode, fmt.Errorf("webhook receiver responded %s: %s", resp.Status, body)
+	}
+	return resp.StatusCode, nil
+}
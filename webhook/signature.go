@@ -0,0 +1,76 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Headers sent with every delivery.
+const (
+	// HeaderID identifies the delivery. It is the same for every retry, so receivers can drop
+	// deliveries they have already processed.
+	HeaderID = "Webhook-Id"
+	// HeaderTimestamp is when the request was sent, in Unix seconds.
+	HeaderTimestamp = "Webhook-Timestamp"
+	// HeaderSignature is the HMAC-SHA256 of the ID, timestamp and body, see Sign.
+	HeaderSignature = "Webhook-Signature"
+)
+
+// DefaultTolerance is how old a delivery may be before Verify treats it as a replay.
+const DefaultTolerance = 5 * time.Minute
+
+const (
+	signatureVersion = "v1="
+	secretPrefix     = "whsec_"
+)
+
+var (
+	ErrInvalidSignature    = errors.New("webhook signature is invalid")
+	ErrTimestampOutOfRange = errors.New("webhook timestamp is outside the tolerance")
+)
+
+// NewSecret returns a random key to sign the deliveries of a subscription with.
+func NewSecret() (string, error) {
+	key := make([]byte, 24)
+	if _, err := rand.Read(key); err != nil {
+		return "", fmt.Errorf("failed to generate webhook secret: %w", err)
+	}
+	return secretPrefix + hex.EncodeToString(key), nil
+}
+
+// Sign returns the signature header value for a delivery: the hex encoded HMAC-SHA256, keyed
+// by secret, of "<id>.<timestamp>.<body>". Covering the timestamp stops an old request from
+// being replayed with a fresh one.
+func Sign(secret string, id string, timestamp time.Time, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(id + "." + strconv.FormatInt(timestamp.Unix(), 10) + "."))
+	mac.Write(body)
+	return signatureVersion + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify checks the signature of a delivery received at now, rejecting ones whose timestamp is
+// more than tolerance away. Receivers should also remember the IDs they have processed.
+func Verify(secret string, header http.Header, body []byte, tolerance time.Duration, now time.Time) error {
+	unix, err := strconv.ParseInt(header.Get(HeaderTimestamp), 10, 64)
+	if err != nil {
+		return ErrInvalidSignature
+	}
+
+	timestamp := time.Unix(unix, 0)
+	if timestamp.Before(now.Add(-tolerance)) || timestamp.After(now.Add(tolerance)) {
+		return ErrTimestampOutOfRange
+	}
+
+	expected := Sign(secret, header.Get(HeaderID), timestamp, body)
+	if !hmac.Equal([]byte(expected), []byte(header.Get(HeaderSignature))) {
+		return ErrInvalidSignature
+	}
+	return nil
+}
@@ -0,0 +1,156 @@
+package webhook
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/mahanth/simplebank/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSendSignedDelivery(t *testing.T) {
+	secret, err := NewSecret()
+	require.NoError(t, err)
+
+	body := []byte(`{"type":"transfer.received"}`)
+	received := 0
+
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		require.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		require.Equal(t, "42", r.Header.Get(HeaderID))
+
+		payload, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		require.Equal(t, body, payload)
+		require.NoError(t, Verify(secret, r.Header, payload, DefaultTolerance, time.Now()))
+
+		received++
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer receiver.Close()
+
+	sender := NewHTTPSender(time.Second)
+	status, err := sender.Send(context.Background(), receiver.URL, secret, Message{ID: "42", Body: body})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusNoContent, status)
+	require.Equal(t, 1, received)
+}
+
+func TestSendRejectedDelivery(t *testing.T) {
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "try again later", http.StatusServiceUnavailable)
+	}))
+	defer receiver.Close()
+
+	sender := NewHTTPSender(time.Second)
+	status, err := sender.Send(context.Background(), receiver.URL, util.RandomString(32), Message{ID: "1", Body: []byte(`{}`)})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "try again later")
+	require.Equal(t, http.StatusServiceUnavailable, status)
+}
+
+func TestSendUnreachableReceiver(t *testing.T) {
+	receiver := httptest.NewServer(http.NotFoundHandler())
+	receiver.Close()
+
+	sender := NewHTTPSender(time.Second)
+	status, err := sender.Send(context.Background(), receiver.URL, util.RandomString(32), Message{ID: "1", Body: []byte(`{}`)})
+	require.Error(t, err)
+	require.Zero(t, status)
+}
+
+func TestVerify(t *testing.T) {
+	secret := util.RandomString(32)
+	body := []byte(`{"amount":100}`)
+	sentAt := time.Now()
+
+	signedHeader := func(secret string, timestamp time.Time, body []byte) http.Header {
+		header := http.Header{}
+		header.Set(HeaderID, "7")
+		header.Set(HeaderTimestamp, strconv.FormatInt(timestamp.Unix(), 10))
+		header.Set(HeaderSignature, Sign(secret, "7", timestamp, body))
+		return header
+	}
+
+	testCases := []struct {
+		name   string
+		header http.Header
+		body   []byte
+		now    time.Time
+		err    error
+	}{
+		{
+			name:   "OK",
+			header: signedHeader(secret, sentAt, body),
+			body:   body,
+			now:    sentAt.Add(time.Minute),
+		},
+		{
+			name:   "TamperedBody",
+			header: signedHeader(secret, sentAt, body),
+			body:   []byte(`{"amount":1000}`),
+			now:    sentAt,
+			err:    ErrInvalidSignature,
+		},
+		{
+			name:   "WrongSecret",
+			header: signedHeader(util.RandomString(32), sentAt, body),
+			body:   body,
+			now:    sentAt,
+			err:    ErrInvalidSignature,
+		},
+		{
+			name:   "Replayed",
+			header: signedHeader(secret, sentAt, body),
+			body:   body,
+			now:    sentAt.Add(DefaultTolerance + time.Minute),
+			err:    ErrTimestampOutOfRange,
+		},
+		{
+			name: "ReplayedWithNewTimestamp",
+			header: func() http.Header {
+				header := signedHeader(secret, sentAt, body)
+				header.Set(HeaderTimestamp, strconv.FormatInt(sentAt.Add(time.Hour).Unix(), 10))
+				return header
+			}(),
+			body: body,
+			now:  sentAt.Add(time.Hour),
+			err:  ErrInvalidSignature,
+		},
+		{
+			name:   "MissingTimestamp",
+			header: http.Header{HeaderSignature: []string{Sign(secret, "7", sentAt, body)}},
+			body:   body,
+			now:    sentAt,
+			err:    ErrInvalidSignature,
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			err := Verify(secret, tc.header, tc.body, DefaultTolerance, tc.now)
+			if tc.err == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.err)
+			}
+		})
+	}
+}
+
+func TestNewSecret(t *testing.T) {
+	secret1, err := NewSecret()
+	require.NoError(t, err)
+	secret2, err := NewSecret()
+	require.NoError(t, err)
+
+	require.Len(t, secret1, len(secretPrefix)+48)
+	require.NotEqual(t, secret1, secret2)
+}
@@ -12,6 +12,31 @@ type TaskDistributor interface {
 		payload *PayloadSendVerifyEmail,
 		opts ...asynq.Option,
 	) error
+	DistributeTaskSendReconciliationReport(
+		ctx context.Context,
+		payload *PayloadSendReconciliationReport,
+		opts ...asynq.Option,
+	) error
+	DistributeTaskExecuteScheduledTransfer(
+		ctx context.Context,
+		payload *PayloadExecuteScheduledTransfer,
+		opts ...asynq.Option,
+	) error
+	DistributeTaskProcessEvent(
+		ctx context.Context,
+		payload *PayloadProcessEvent,
+		opts ...asynq.Option,
+	) error
+	DistributeTaskDeliverWebhook(
+		ctx context.Context,
+		payload *PayloadDeliverWebhook,
+		opts ...asynq.Option,
+	) error
+	DistributeTaskSendTransferApprovalEmail(
+		ctx context.Context,
+		payload *PayloadSendTransferApprovalEmail,
+		opts ...asynq.Option,
+	) error
 }
 
 type RedisTaskDistributor struct {
@@ -36,6 +36,101 @@ func (m *MockTaskDistributor) EXPECT() *MockTaskDistributorMockRecorder {
 	return m.recorder
 }
 
+// DistributeTaskDeliverWebhook mocks base method.
+func (m *MockTaskDistributor) DistributeTaskDeliverWebhook(arg0 context.Context, arg1 *worker.PayloadDeliverWebhook, arg2 ...asynq.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DistributeTaskDeliverWebhook", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DistributeTaskDeliverWebhook indicates an expected call of DistributeTaskDeliverWebhook.
+func (mr *MockTaskDistributorMockRecorder) DistributeTaskDeliverWebhook(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DistributeTaskDeliverWebhook", reflect.TypeOf((*MockTaskDistributor)(nil).DistributeTaskDeliverWebhook), varargs...)
+}
+
+// DistributeTaskExecuteScheduledTransfer mocks base method.
+func (m *MockTaskDistributor) DistributeTaskExecuteScheduledTransfer(arg0 context.Context, arg1 *worker.PayloadExecuteScheduledTransfer, arg2 ...asynq.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DistributeTaskExecuteScheduledTransfer", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DistributeTaskExecuteScheduledTransfer indicates an expected call of DistributeTaskExecuteScheduledTransfer.
+func (mr *MockTaskDistributorMockRecorder) DistributeTaskExecuteScheduledTransfer(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DistributeTaskExecuteScheduledTransfer", reflect.TypeOf((*MockTaskDistributor)(nil).DistributeTaskExecuteScheduledTransfer), varargs...)
+}
+
+// DistributeTaskProcessEvent mocks base method.
+func (m *MockTaskDistributor) DistributeTaskProcessEvent(arg0 context.Context, arg1 *worker.PayloadProcessEvent, arg2 ...asynq.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DistributeTaskProcessEvent", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DistributeTaskProcessEvent indicates an expected call of DistributeTaskProcessEvent.
+func (mr *MockTaskDistributorMockRecorder) DistributeTaskProcessEvent(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DistributeTaskProcessEvent", reflect.TypeOf((*MockTaskDistributor)(nil).DistributeTaskProcessEvent), varargs...)
+}
+
+// DistributeTaskSendReconciliationReport mocks base method.
+func (m *MockTaskDistributor) DistributeTaskSendReconciliationReport(arg0 context.Context, arg1 *worker.PayloadSendReconciliationReport, arg2 ...asynq.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DistributeTaskSendReconciliationReport", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DistributeTaskSendReconciliationReport indicates an expected call of DistributeTaskSendReconciliationReport.
+func (mr *MockTaskDistributorMockRecorder) DistributeTaskSendReconciliationReport(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DistributeTaskSendReconciliationReport", reflect.TypeOf((*MockTaskDistributor)(nil).DistributeTaskSendReconciliationReport), varargs...)
+}
+
+// DistributeTaskSendTransferApprovalEmail mocks base method.
+func (m *MockTaskDistributor) DistributeTaskSendTransferApprovalEmail(arg0 context.Context, arg1 *worker.PayloadSendTransferApprovalEmail, arg2 ...asynq.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DistributeTaskSendTransferApprovalEmail", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DistributeTaskSendTransferApprovalEmail indicates an expected call of DistributeTaskSendTransferApprovalEmail.
+func (mr *MockTaskDistributorMockRecorder) DistributeTaskSendTransferApprovalEmail(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DistributeTaskSendTransferApprovalEmail", reflect.TypeOf((*MockTaskDistributor)(nil).DistributeTaskSendTransferApprovalEmail), varargs...)
+}
+
 // DistributeTaskSendVerifyEmail mocks base method.
 func (m *MockTaskDistributor) DistributeTaskSendVerifyEmail(arg0 context.Context, arg1 *worker.PayloadSendVerifyEmail, arg2 ...asynq.Option) error {
 	m.ctrl.T.Helper()
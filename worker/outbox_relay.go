@@ -0,0 +1,98 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/jackc/pgx/v5/pgtype"
+	db "github.com/mahanth/simplebank/db/sqlc"
+	"github.com/rs/zerolog/log"
+)
+
+const outboxRelayBatchSize = 100
+
+// OutboxRelay publishes the events written to the outbox to the task queue. An event is
+// marked sent only after it has been enqueued, so it is delivered at least once: a crash
+// between the two publishes it again, and the task ID turns that into a no-op while the
+// first task is still queued.
+type OutboxRelay struct {
+	store       db.Store
+	distributor TaskDistributor
+	interval    time.Duration
+	batchSize   int32
+}
+
+func NewOutboxRelay(store db.Store, distributor TaskDistributor, interval time.Duration) *OutboxRelay {
+	return &OutboxRelay{
+		store:       store,
+		distributor: distributor,
+		interval:    interval,
+		batchSize:   outboxRelayBatchSize,
+	}
+}
+
+// Start relays pending events every interval until ctx is done.
+func (relay *OutboxRelay) Start(ctx context.Context) error {
+	ticker := time.NewTicker(relay.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			// keep draining while full batches come back
+			for {
+				n, err := relay.RelayPending(ctx)
+				if err != nil {
+					log.Error().Err(err).Msg("failed to relay outbox events")
+					break
+				}
+				if n < int(relay.batchSize) {
+					break
+				}
+			}
+		}
+	}
+}
+
+// RelayPending publishes one batch of unsent events, oldest first, and returns how many were
+// sent. It stops at the first event that cannot be enqueued so that events keep their order.
+func (relay *OutboxRelay) RelayPending(ctx context.Context) (int, error) {
+	events, err := relay.store.ListUnsentOutboxEvents(ctx, relay.batchSize)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list unsent outbox events: %w", err)
+	}
+
+	for i, event := range events {
+		payload := &PayloadProcessEvent{
+			EventID: event.ID,
+			Type:    event.EventType,
+			Payload: event.Payload,
+		}
+
+		err = relay.distributor.DistributeTaskProcessEvent(ctx, payload,
+			asynq.MaxRetry(10),
+			asynq.Queue(QueueCritical),
+		)
+		if err != nil {
+			failErr := relay.store.RecordOutboxEventFailure(ctx, db.RecordOutboxEventFailureParams{
+				ID:        event.ID,
+				LastError: pgtype.Text{String: err.Error(), Valid: true},
+			})
+			if failErr != nil {
+				log.Error().Err(failErr).Int64("event_id", event.ID).Msg("failed to record outbox event failure")
+			}
+			return i, fmt.Errorf("failed to publish outbox event %d: %w", event.ID, err)
+		}
+
+		err = relay.store.MarkOutboxEventSent(ctx, event.ID)
+		if err != nil {
+			return i, fmt.Errorf("failed to mark outbox event %d sent: %w", event.ID, err)
+		}
+	}
+
+	return len(events), nil
+}
@@ -2,10 +2,12 @@ package worker
 
 import (
 	"context"
+	"time"
 
 	"github.com/hibiken/asynq"
 	db "github.com/mahanth/simplebank/db/sqlc"
 	"github.com/mahanth/simplebank/email"
+	"github.com/mahanth/simplebank/webhook"
 	"github.com/rs/zerolog/log"
 )
 
@@ -17,15 +19,26 @@ const (
 type TaskProcessor interface {
 	Start() error
 	ProcessTaskSendVerifyEmail(ctx context.Context, task *asynq.Task) error
+	ProcessTaskReconcileLedger(ctx context.Context, task *asynq.Task) error
+	ProcessTaskSendReconciliationReport(ctx context.Context, task *asynq.Task) error
+	ProcessTaskEnqueueDueScheduledTransfers(ctx context.Context, task *asynq.Task) error
+	ProcessTaskExecuteScheduledTransfer(ctx context.Context, task *asynq.Task) error
+	ProcessTaskReleaseExpiredHolds(ctx context.Context, task *asynq.Task) error
+	ProcessTaskAccrueInterest(ctx context.Context, task *asynq.Task) error
+	ProcessTaskProcessEvent(ctx context.Context, task *asynq.Task) error
+	ProcessTaskDeliverWebhook(ctx context.Context, task *asynq.Task) error
+	ProcessTaskSendTransferApprovalEmail(ctx context.Context, task *asynq.Task) error
 }
 
 type RedisTaskProcessor struct {
-	server *asynq.Server
-	store  db.Store
-	mailer email.EmailSender
+	server      *asynq.Server
+	store       db.Store
+	mailer      email.EmailSender
+	webhooks    webhook.Sender
+	distributor TaskDistributor
 }
 
-func NewRedisTaskProcessor(redisOpt asynq.RedisClientOpt, store db.Store, mailer email.EmailSender) TaskProcessor {
+func NewRedisTaskProcessor(redisOpt asynq.RedisClientOpt, store db.Store, mailer email.EmailSender, webhooks webhook.Sender, distributor TaskDistributor) TaskProcessor {
 	server := asynq.NewServer(redisOpt, asynq.Config{
 		Queues: map[string]int{
 			QueueCritical: 10,
@@ -37,19 +50,40 @@ func NewRedisTaskProcessor(redisOpt asynq.RedisClientOpt, store db.Store, mailer
 			// log.Printf("Error processing task %s: %v", task.Type, err)
 			log.Error().Err(err).Str("task_type", task.Type()).Msg("Error processing task")
 		}),
-		Logger: NewLogger(),
+		RetryDelayFunc: retryDelay,
+		Logger:         NewLogger(),
 	})
 
 	return &RedisTaskProcessor{
-		server: server,
-		store:  store,
-		mailer: mailer,
+		server:      server,
+		store:       store,
+		mailer:      mailer,
+		webhooks:    webhooks,
+		distributor: distributor,
 	}
 }
 
+// retryDelay backs webhook deliveries off exponentially, as the receiver may be down for a
+// while, and uses the asynq default for the other tasks.
+func retryDelay(retried int, err error, task *asynq.Task) time.Duration {
+	if task.Type() == TaskDeliverWebhook {
+		return webhookRetryDelay(retried)
+	}
+	return asynq.DefaultRetryDelayFunc(retried, err, task)
+}
+
 func (rtp *RedisTaskProcessor) Start() error {
 	mux := asynq.NewServeMux()
 	mux.HandleFunc(TaskSendVerifyEmail, rtp.ProcessTaskSendVerifyEmail)
+	mux.HandleFunc(TaskReconcileLedger, rtp.ProcessTaskReconcileLedger)
+	mux.HandleFunc(TaskSendReconciliationReport, rtp.ProcessTaskSendReconciliationReport)
+	mux.HandleFunc(TaskEnqueueDueScheduledTransfers, rtp.ProcessTaskEnqueueDueScheduledTransfers)
+	mux.HandleFunc(TaskExecuteScheduledTransfer, rtp.ProcessTaskExecuteScheduledTransfer)
+	mux.HandleFunc(TaskReleaseExpiredHolds, rtp.ProcessTaskReleaseExpiredHolds)
+	mux.HandleFunc(TaskAccrueInterest, rtp.ProcessTaskAccrueInterest)
+	mux.HandleFunc(TaskProcessEvent, rtp.ProcessTaskProcessEvent)
+	mux.HandleFunc(TaskDeliverWebhook, rtp.ProcessTaskDeliverWebhook)
+	mux.HandleFunc(TaskSendTransferApprovalEmail, rtp.ProcessTaskSendTransferApprovalEmail)
 	rtp.server.Start(mux)
 	return nil
 }
@@ -0,0 +1,67 @@
+package worker
+
+import (
+	"fmt"
+
+	"github.com/hibiken/asynq"
+	"github.com/mahanth/simplebank/util"
+	"github.com/rs/zerolog/log"
+)
+
+type TaskScheduler interface {
+	Start() error
+}
+
+// RedisTaskScheduler enqueues the periodic tasks on their cron schedules.
+type RedisTaskScheduler struct {
+	scheduler *asynq.Scheduler
+	config    util.Config
+}
+
+func NewRedisTaskScheduler(redisOpt asynq.RedisClientOpt, config util.Config) TaskScheduler {
+	scheduler := asynq.NewScheduler(redisOpt, &asynq.SchedulerOpts{
+		Logger: NewLogger(),
+		PostEnqueueFunc: func(info *asynq.TaskInfo, err error) {
+			if err != nil {
+				log.Error().Err(err).Msg("failed to enqueue periodic task")
+			}
+		},
+	})
+
+	return &RedisTaskScheduler{
+		scheduler: scheduler,
+		config:    config,
+	}
+}
+
+func (rts *RedisTaskScheduler) Start() error {
+	if rts.config.ReconciliationSchedule != "" {
+		_, err := rts.scheduler.Register(rts.config.ReconciliationSchedule, NewReconcileLedgerTask())
+		if err != nil {
+			return fmt.Errorf("failed to register reconciliation task: %w", err)
+		}
+	}
+
+	if rts.config.ScheduledTransfersPoll != "" {
+		_, err := rts.scheduler.Register(rts.config.ScheduledTransfersPoll, NewEnqueueDueScheduledTransfersTask())
+		if err != nil {
+			return fmt.Errorf("failed to register scheduled transfers task: %w", err)
+		}
+	}
+
+	if rts.config.HoldExpirySchedule != "" {
+		_, err := rts.scheduler.Register(rts.config.HoldExpirySchedule, NewReleaseExpiredHoldsTask())
+		if err != nil {
+			return fmt.Errorf("failed to register hold expiry task: %w", err)
+		}
+	}
+
+	if rts.config.InterestAccrualSchedule != "" {
+		_, err := rts.scheduler.Register(rts.config.InterestAccrualSchedule, NewAccrueInterestTask())
+		if err != nil {
+			return fmt.Errorf("failed to register interest accrual task: %w", err)
+		}
+	}
+
+	return rts.scheduler.Start()
+}
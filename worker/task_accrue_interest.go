@@ -0,0 +1,109 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hibiken/asynq"
+	db "github.com/mahanth/simplebank/db/sqlc"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	TaskAccrueInterest = "task:accrue_interest"
+
+	// interestAccountsBatch is how many interest-bearing accounts are listed at a time.
+	interestAccountsBatch = 100
+)
+
+// NewAccrueInterestTask builds the task the scheduler enqueues once a day to accrue interest.
+func NewAccrueInterestTask() *asynq.Task {
+	return asynq.NewTask(TaskAccrueInterest, nil, asynq.Queue(QueueDefault), asynq.MaxRetry(3))
+}
+
+// ProcessTaskAccrueInterest accrues interest on every active savings account for each UTC day from
+// the latest day accrued through the previous one, so that days the task did not run on are caught
+// up. The latest day accrued is accrued again, as a failed run may not have finished it. Every step
+// skips what was already done, so the task can be retried safely.
+func (rtp *RedisTaskProcessor) ProcessTaskAccrueInterest(ctx context.Context, task *asynq.Task) error {
+	through := db.AccrualDate(time.Now()).AddDate(0, 0, -1)
+
+	last, err := rtp.store.GetLastInterestAccrualDate(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get the last interest accrual date: %w", err)
+	}
+
+	date := through
+	if last.Valid && last.Time.Before(through) {
+		date = db.AccrualDate(last.Time)
+	}
+
+	for ; !date.After(through); date = date.AddDate(0, 0, 1) {
+		if err := rtp.accrueInterest(ctx, date); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// accrueInterest accrues a day of interest on every active savings account, and pays the month's
+// accrued interest when the day was the last of a month.
+func (rtp *RedisTaskProcessor) accrueInterest(ctx context.Context, date time.Time) error {
+	monthEnd := date.AddDate(0, 0, 1).Day() == 1
+
+	accrued, credited := 0, 0
+	var afterID int64
+	for {
+		accounts, err := rtp.store.ListInterestBearingAccounts(ctx, db.ListInterestBearingAccountsParams{
+			ID:    afterID,
+			Limit: interestAccountsBatch,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to list interest-bearing accounts: %w", err)
+		}
+
+		for _, account := range accounts {
+			afterID = account.ID
+
+			result, err := rtp.store.AccrueInterestTx(ctx, db.AccrueInterestTxParams{
+				AccountID:   account.ID,
+				AccrualDate: date,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to accrue interest on account %d: %w", account.ID, err)
+			}
+			if result.Accrued {
+				accrued++
+			}
+
+			if !monthEnd {
+				continue
+			}
+
+			credit, err := rtp.store.CreditInterestTx(ctx, db.CreditInterestTxParams{
+				AccountID: account.ID,
+				Through:   date,
+			})
+			if errors.Is(err, db.ErrAccountNotActive) {
+				// the account was frozen or closed after it was listed; it is paid once active again
+				continue
+			}
+			if err != nil {
+				return fmt.Errorf("failed to credit interest to account %d: %w", account.ID, err)
+			}
+			if credit.Amount > 0 {
+				credited++
+			}
+		}
+
+		if len(accounts) < interestAccountsBatch {
+			break
+		}
+	}
+
+	log.Info().Str("date", date.Format(time.DateOnly)).
+		Int("accrued", accrued).Int("credited", credited).Msg("accrued interest")
+	return nil
+}
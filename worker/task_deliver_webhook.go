@@ -0,0 +1,154 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/jackc/pgx/v5/pgtype"
+	db "github.com/mahanth/simplebank/db/sqlc"
+	"github.com/mahanth/simplebank/webhook"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	TaskDeliverWebhook = "task:deliver_webhook"
+)
+
+// webhookMaxRetry with webhookRetryDelay keeps retrying a delivery for most of a day.
+const webhookMaxRetry = 12
+
+// PayloadDeliverWebhook identifies a delivery recorded in webhook_deliveries.
+type PayloadDeliverWebhook struct {
+	DeliveryID int64 `json:"delivery_id"`
+}
+
+func (payload *PayloadDeliverWebhook) taskID() string {
+	return fmt.Sprintf("webhook-delivery:%d", payload.DeliveryID)
+}
+
+// webhookBody is the JSON POSTed to subscribers.
+type webhookBody struct {
+	ID        int64           `json:"id"`
+	Type      string          `json:"type"`
+	CreatedAt time.Time       `json:"created_at"`
+	Data      json.RawMessage `json:"data"`
+}
+
+func (rtd *RedisTaskDistributor) DistributeTaskDeliverWebhook(ctx context.Context, payload *PayloadDeliverWebhook, options ...asynq.Option) error {
+	jsonPayload, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal payload: %w", err)
+	}
+
+	options = append(options, asynq.TaskID(payload.taskID()))
+	task := asynq.NewTask(TaskDeliverWebhook, jsonPayload, options...)
+
+	taskInfo, err := rtd.client.EnqueueContext(ctx, task)
+	if err != nil {
+		if errors.Is(err, asynq.ErrTaskIDConflict) {
+			// the delivery is already queued
+			return nil
+		}
+		return fmt.Errorf("failed to enqueue task: %w", err)
+	}
+
+	log.Info().Str("type", task.Type()).Str("queue", taskInfo.Queue).Int64("delivery_id", payload.DeliveryID).Msg("enqueued task")
+	return nil
+}
+
+func (rtp *RedisTaskProcessor) ProcessTaskDeliverWebhook(ctx context.Context, task *asynq.Task) error {
+	var payload PayloadDeliverWebhook
+	err := json.Unmarshal(task.Payload(), &payload)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal payload: %w", asynq.SkipRetry)
+	}
+
+	delivery, err := rtp.store.GetWebhookDelivery(ctx, payload.DeliveryID)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return fmt.Errorf("webhook delivery %d not found: %w", payload.DeliveryID, asynq.SkipRetry)
+		}
+		return fmt.Errorf("failed to get webhook delivery %d: %w", payload.DeliveryID, err)
+	}
+	if delivery.Status != db.WebhookDeliveryPending {
+		return nil
+	}
+
+	subscription, err := rtp.store.GetWebhookSubscription(ctx, delivery.SubscriptionID)
+	if err != nil {
+		return fmt.Errorf("failed to get webhook subscription %d: %w", delivery.SubscriptionID, err)
+	}
+	if !subscription.Active {
+		_, err = rtp.store.RecordWebhookDeliveryAttempt(ctx, db.RecordWebhookDeliveryAttemptParams{
+			ID:        delivery.ID,
+			Status:    db.WebhookDeliveryFailed,
+			LastError: pgtype.Text{String: "subscription was deleted", Valid: true},
+		})
+		if err != nil && !errors.Is(err, db.ErrRecordNotFound) {
+			return fmt.Errorf("failed to record webhook delivery %d: %w", delivery.ID, err)
+		}
+		return nil
+	}
+
+	body, err := json.Marshal(webhookBody{
+		ID:        delivery.ID,
+		Type:      delivery.EventType,
+		CreatedAt: delivery.CreatedAt.Time,
+		Data:      delivery.Payload,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal webhook delivery %d: %w", delivery.ID, asynq.SkipRetry)
+	}
+
+	statusCode, sendErr := rtp.webhooks.Send(ctx, subscription.Url, subscription.Secret, webhook.Message{
+		ID:   strconv.FormatInt(delivery.ID, 10),
+		Body: body,
+	})
+
+	record := db.RecordWebhookDeliveryAttemptParams{
+		ID:             delivery.ID,
+		Status:         db.WebhookDeliverySucceeded,
+		ResponseStatus: pgtype.Int4{Int32: int32(statusCode), Valid: statusCode != 0},
+	}
+	if sendErr != nil {
+		record.Status = db.WebhookDeliveryPending
+		if isLastAttempt(ctx) {
+			record.Status = db.WebhookDeliveryFailed
+		}
+		record.LastError = pgtype.Text{String: sendErr.Error(), Valid: true}
+	}
+
+	_, err = rtp.store.RecordWebhookDeliveryAttempt(ctx, record)
+	if err != nil && !errors.Is(err, db.ErrRecordNotFound) {
+		return fmt.Errorf("failed to record webhook delivery %d: %w", delivery.ID, err)
+	}
+
+	if sendErr != nil {
+		return fmt.Errorf("failed to deliver webhook %d: %w", delivery.ID, sendErr)
+	}
+
+	log.Info().
+		Int64("delivery_id", delivery.ID).
+		Str("event_type", delivery.EventType).
+		Int("status_code", statusCode).
+		Msg("delivered webhook")
+	return nil
+}
+
+// webhookRetryDelay backs off exponentially from 30 seconds, up to 6 hours between attempts.
+func webhookRetryDelay(retried int) time.Duration {
+	const maxDelay = 6 * time.Hour
+	if retried > 10 {
+		return maxDelay
+	}
+	delay := 30 * time.Second << retried
+	if delay > maxDelay {
+		return maxDelay
+	}
+	return delay
+}
@@ -0,0 +1,54 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	TaskEnqueueDueScheduledTransfers = "task:enqueue_due_scheduled_transfers"
+
+	// dueScheduledTransfersBatch caps how many scheduled transfers one poll enqueues;
+	// the rest are picked up by the next poll.
+	dueScheduledTransfersBatch = 500
+)
+
+// NewEnqueueDueScheduledTransfersTask builds the task the scheduler enqueues to look for
+// scheduled transfers whose next run is due.
+func NewEnqueueDueScheduledTransfersTask() *asynq.Task {
+	return asynq.NewTask(TaskEnqueueDueScheduledTransfers, nil, asynq.Queue(QueueDefault), asynq.MaxRetry(0))
+}
+
+// ProcessTaskEnqueueDueScheduledTransfers enqueues one execute task per due scheduled transfer.
+// The task id is derived from the run, so a run that is still queued from an earlier poll
+// is not enqueued twice.
+func (rtp *RedisTaskProcessor) ProcessTaskEnqueueDueScheduledTransfers(ctx context.Context, task *asynq.Task) error {
+	scheduledTransfers, err := rtp.store.ListDueScheduledTransfers(ctx, dueScheduledTransfersBatch)
+	if err != nil {
+		return fmt.Errorf("failed to list due scheduled transfers: %w", err)
+	}
+
+	for _, scheduled := range scheduledTransfers {
+		payload := &PayloadExecuteScheduledTransfer{
+			ScheduledTransferID: scheduled.ID,
+			ScheduledFor:        scheduled.NextRunAt.Time,
+		}
+
+		err = rtp.distributor.DistributeTaskExecuteScheduledTransfer(ctx, payload,
+			asynq.TaskID(payload.taskID()),
+			asynq.Queue(QueueCritical),
+			asynq.MaxRetry(5),
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(scheduledTransfers) > 0 {
+		log.Info().Int("count", len(scheduledTransfers)).Msg("enqueued due scheduled transfers")
+	}
+	return nil
+}
@@ -0,0 +1,153 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hibiken/asynq"
+	db "github.com/mahanth/simplebank/db/sqlc"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	TaskExecuteScheduledTransfer = "task:execute_scheduled_transfer"
+)
+
+// PayloadExecuteScheduledTransfer identifies one run of a scheduled transfer.
+type PayloadExecuteScheduledTransfer struct {
+	ScheduledTransferID int64     `json:"scheduled_transfer_id"`
+	ScheduledFor        time.Time `json:"scheduled_for"`
+}
+
+func (payload *PayloadExecuteScheduledTransfer) taskID() string {
+	return fmt.Sprintf("scheduled-transfer:%d:%d", payload.ScheduledTransferID, payload.ScheduledFor.Unix())
+}
+
+// idempotencyKey makes retries of a run move the money at most once.
+func (payload *PayloadExecuteScheduledTransfer) idempotencyKey() string {
+	return fmt.Sprintf("scheduled-transfer-%d-%d", payload.ScheduledTransferID, payload.ScheduledFor.Unix())
+}
+
+func (rtd *RedisTaskDistributor) DistributeTaskExecuteScheduledTransfer(ctx context.Context, payload *PayloadExecuteScheduledTransfer, options ...asynq.Option) error {
+	jsonPayload, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal payload: %w", err)
+	}
+
+	task := asynq.NewTask(TaskExecuteScheduledTransfer, jsonPayload, options...)
+
+	taskInfo, err := rtd.client.EnqueueContext(ctx, task)
+	if err != nil {
+		if errors.Is(err, asynq.ErrTaskIDConflict) {
+			// the run is already queued
+			return nil
+		}
+		return fmt.Errorf("failed to enqueue task: %w", err)
+	}
+
+	log.Info().Str("type", task.Type()).Str("queue", taskInfo.Queue).Msg("enqueued task")
+	return nil
+}
+
+func (rtp *RedisTaskProcessor) ProcessTaskExecuteScheduledTransfer(ctx context.Context, task *asynq.Task) error {
+	var payload PayloadExecuteScheduledTransfer
+	err := json.Unmarshal(task.Payload(), &payload)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal payload: %w", asynq.SkipRetry)
+	}
+
+	scheduled, err := rtp.store.GetScheduledTransfer(ctx, payload.ScheduledTransferID)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return fmt.Errorf("scheduled transfer %d not found: %w", payload.ScheduledTransferID, asynq.SkipRetry)
+		}
+		return fmt.Errorf("failed to get scheduled transfer %d: %w", payload.ScheduledTransferID, err)
+	}
+
+	// the schedule may have been paused, cancelled or already advanced since the run was enqueued
+	if scheduled.Status != db.ScheduledTransferActive || !scheduled.NextRunAt.Time.Equal(payload.ScheduledFor) {
+		log.Info().
+			Int64("scheduled_transfer_id", scheduled.ID).
+			Str("status", scheduled.Status).
+			Msg("skipped scheduled transfer run")
+		return nil
+	}
+
+	record := db.RecordScheduledTransferExecutionTxParams{
+		ScheduledTransferID: scheduled.ID,
+		ScheduledFor:        payload.ScheduledFor,
+	}
+
+	// a joint holder who set up the schedule may have been removed or restricted since
+	fromAccount, err := rtp.store.GetAccount(ctx, scheduled.FromAccountID)
+	if err != nil {
+		return fmt.Errorf("failed to get account %d: %w", scheduled.FromAccountID, err)
+	}
+	access, err := db.GetAccountAccess(ctx, rtp.store, fromAccount, scheduled.Owner)
+	if err != nil {
+		return fmt.Errorf("failed to get holder of account %d: %w", fromAccount.ID, err)
+	}
+
+	// the transfer itself is held to the limit set for the holder
+	if !access.Allows(db.HolderPermissionTransfer) {
+		record.FailureReason = fmt.Sprintf("%s is no longer allowed to transfer from account %d",
+			scheduled.Owner, fromAccount.ID)
+	} else {
+		result, err := rtp.store.TransferTx(ctx, db.TransferTxParams{
+			FromAccountID:  scheduled.FromAccountID,
+			ToAccountID:    scheduled.ToAccountID,
+			Amount:         scheduled.Amount,
+			Username:       scheduled.Owner,
+			IdempotencyKey: payload.idempotencyKey(),
+			Role:           scheduled.OwnerRole,
+		})
+		if err != nil {
+			if !isPermanentTransferError(err) && !isLastAttempt(ctx) {
+				return fmt.Errorf("failed to execute scheduled transfer %d: %w", scheduled.ID, err)
+			}
+			record.FailureReason = err.Error()
+		} else {
+			record.TransferID = result.Transfer.ID
+		}
+	}
+
+	recorded, err := rtp.store.RecordScheduledTransferExecutionTx(ctx, record)
+	if err != nil {
+		return fmt.Errorf("failed to record run of scheduled transfer %d: %w", scheduled.ID, err)
+	}
+
+	log.Info().
+		Int64("scheduled_transfer_id", scheduled.ID).
+		Str("status", recorded.Execution.Status).
+		Str("failure_reason", record.FailureReason).
+		Msg("executed scheduled transfer")
+	return nil
+}
+
+// isPermanentTransferError reports whether retrying a transfer cannot succeed, so the run
+// should be recorded as failed instead.
+func isPermanentTransferError(err error) bool {
+	return errors.Is(err, db.ErrInsufficientFunds) ||
+		errors.Is(err, db.ErrCurrencyMismatch) ||
+		errors.Is(err, db.ErrAccountNotActive) ||
+		errors.Is(err, db.ErrExchangeRateNotFound) ||
+		errors.Is(err, db.ErrTransferLimitExceeded) ||
+		errors.Is(err, db.ErrApprovalRequired) ||
+		errors.Is(err, db.ErrIdempotencyKeyReused) ||
+		errors.Is(err, db.ErrRecordNotFound) ||
+		db.ErrorCode(err) == db.ForeignKeyViolation
+}
+
+// isLastAttempt reports whether the task will not be retried if it fails. The run is then
+// recorded as failed so that the schedule moves on instead of stalling on an archived task.
+func isLastAttempt(ctx context.Context) bool {
+	retried, ok := asynq.GetRetryCount(ctx)
+	if !ok {
+		return false
+	}
+	maxRetry, ok := asynq.GetMaxRetry(ctx)
+	return ok && retried >= maxRetry
+}
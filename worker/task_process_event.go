@@ -0,0 +1,152 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/hibiken/asynq"
+	db "github.com/mahanth/simplebank/db/sqlc"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	TaskProcessEvent = "task:process_event"
+)
+
+// PayloadProcessEvent is a domain event published from the outbox.
+type PayloadProcessEvent struct {
+	EventID int64           `json:"event_id"`
+	Type    string          `json:"type"`
+	Payload json.RawMessage `json:"payload"`
+}
+
+// taskID makes publishing an event again while its task is still queued a no-op.
+func (payload *PayloadProcessEvent) taskID() string {
+	return fmt.Sprintf("outbox-event:%d", payload.EventID)
+}
+
+func (rtd *RedisTaskDistributor) DistributeTaskProcessEvent(ctx context.Context, payload *PayloadProcessEvent, options ...asynq.Option) error {
+	jsonPayload, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal payload: %w", err)
+	}
+
+	options = append(options, asynq.TaskID(payload.taskID()))
+	task := asynq.NewTask(TaskProcessEvent, jsonPayload, options...)
+
+	taskInfo, err := rtd.client.EnqueueContext(ctx, task)
+	if err != nil {
+		if errors.Is(err, asynq.ErrTaskIDConflict) {
+			// the event is already queued
+			return nil
+		}
+		return fmt.Errorf("failed to enqueue task: %w", err)
+	}
+
+	log.Info().Str("type", task.Type()).Str("queue", taskInfo.Queue).Int64("event_id", payload.EventID).Msg("enqueued task")
+	return nil
+}
+
+// ProcessTaskProcessEvent reacts to a domain event. Events are delivered at least once, so
+// the reactions must tolerate seeing the same event twice.
+func (rtp *RedisTaskProcessor) ProcessTaskProcessEvent(ctx context.Context, task *asynq.Task) error {
+	var payload PayloadProcessEvent
+	err := json.Unmarshal(task.Payload(), &payload)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal payload: %w", asynq.SkipRetry)
+	}
+
+	switch payload.Type {
+	case db.EventUserCreated:
+		var event db.UserCreatedEvent
+		if err := json.Unmarshal(payload.Payload, &event); err != nil {
+			return fmt.Errorf("failed to unmarshal %s event %d: %w", payload.Type, payload.EventID, asynq.SkipRetry)
+		}
+
+		err = rtp.distributor.DistributeTaskSendVerifyEmail(ctx, &PayloadSendVerifyEmail{Username: event.Username},
+			asynq.MaxRetry(10),
+			asynq.Queue(QueueCritical),
+			asynq.TaskID(fmt.Sprintf("verify-email:%d", payload.EventID)),
+		)
+		if err != nil && !errors.Is(err, asynq.ErrTaskIDConflict) {
+			return fmt.Errorf("failed to distribute verify email task for %s: %w", event.Username, err)
+		}
+	case db.EventTransferCompleted:
+		if err := rtp.fanOutTransferWebhooks(ctx, payload.EventID, payload.Payload); err != nil {
+			return err
+		}
+	case db.EventTransferApprovalRequested:
+		var event db.TransferApprovalRequestedEvent
+		if err := json.Unmarshal(payload.Payload, &event); err != nil {
+			return fmt.Errorf("failed to unmarshal %s event %d: %w", payload.Type, payload.EventID, asynq.SkipRetry)
+		}
+
+		err = rtp.distributor.DistributeTaskSendTransferApprovalEmail(ctx, &PayloadSendTransferApprovalEmail{ApprovalID: event.ApprovalID},
+			asynq.MaxRetry(10),
+			asynq.Queue(QueueCritical),
+			asynq.TaskID(fmt.Sprintf("transfer-approval-email:%d", payload.EventID)),
+		)
+		if err != nil && !errors.Is(err, asynq.ErrTaskIDConflict) {
+			return fmt.Errorf("failed to distribute transfer approval email task for approval %d: %w", event.ApprovalID, err)
+		}
+	}
+
+	log.Info().Int64("event_id", payload.EventID).Str("event_type", payload.Type).Msg("processed event")
+	return nil
+}
+
+// fanOutTransferWebhooks records a delivery for every subscription of the owners of the two
+// accounts and enqueues it. Recording is idempotent, so a redelivered event enqueues the same
+// deliveries again, which their task IDs turn into a no-op.
+func (rtp *RedisTaskProcessor) fanOutTransferWebhooks(ctx context.Context, eventID int64, data json.RawMessage) error {
+	var event db.TransferCompletedEvent
+	if err := json.Unmarshal(data, &event); err != nil {
+		return fmt.Errorf("failed to unmarshal %s event %d: %w", db.EventTransferCompleted, eventID, asynq.SkipRetry)
+	}
+
+	sides := []struct {
+		accountID int64
+		eventType string
+	}{
+		{event.FromAccountID, db.WebhookTransferSent},
+		{event.ToAccountID, db.WebhookTransferReceived},
+	}
+
+	for _, side := range sides {
+		account, err := rtp.store.GetAccount(ctx, side.accountID)
+		if err != nil {
+			return fmt.Errorf("failed to get account %d: %w", side.accountID, err)
+		}
+
+		subscriptions, err := rtp.store.ListWebhookSubscriptionsForEvent(ctx, db.ListWebhookSubscriptionsForEventParams{
+			Owner:     account.Owner,
+			EventType: side.eventType,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to list webhook subscriptions of %s: %w", account.Owner, err)
+		}
+
+		for _, subscription := range subscriptions {
+			delivery, err := rtp.store.CreateWebhookDelivery(ctx, db.CreateWebhookDeliveryParams{
+				SubscriptionID: subscription.ID,
+				EventID:        eventID,
+				EventType:      side.eventType,
+				Payload:        data,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to create webhook delivery for subscription %d: %w", subscription.ID, err)
+			}
+
+			err = rtp.distributor.DistributeTaskDeliverWebhook(ctx, &PayloadDeliverWebhook{DeliveryID: delivery.ID},
+				asynq.MaxRetry(webhookMaxRetry),
+				asynq.Queue(QueueDefault),
+			)
+			if err != nil {
+				return fmt.Errorf("failed to distribute webhook delivery %d: %w", delivery.ID, err)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,48 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hibiken/asynq"
+	db "github.com/mahanth/simplebank/db/sqlc"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	TaskReconcileLedger = "task:reconcile_ledger"
+
+	// reconciliationScheduler is recorded as the trigger of reports produced by the periodic task.
+	reconciliationScheduler = "scheduler"
+)
+
+// NewReconcileLedgerTask builds the task the scheduler enqueues to reconcile the ledger.
+func NewReconcileLedgerTask() *asynq.Task {
+	return asynq.NewTask(TaskReconcileLedger, nil, asynq.Queue(QueueDefault), asynq.MaxRetry(3))
+}
+
+func (rtp *RedisTaskProcessor) ProcessTaskReconcileLedger(ctx context.Context, task *asynq.Task) error {
+	result, err := rtp.store.ReconcileLedgerTx(ctx, db.ReconcileLedgerTxParams{
+		TriggeredBy: reconciliationScheduler,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to reconcile ledger: %w", err)
+	}
+
+	log.Info().
+		Int64("report_id", result.Report.ID).
+		Int32("discrepancies", result.Report.DiscrepancyCount).
+		Msg("reconciled ledger")
+
+	if result.Report.DiscrepancyCount == 0 {
+		return nil
+	}
+
+	// the report is already stored, so the email is retried on its own rather than by reconciling again
+	err = rtp.distributor.DistributeTaskSendReconciliationReport(ctx, &PayloadSendReconciliationReport{ReportID: result.Report.ID},
+		asynq.MaxRetry(10), asynq.Queue(QueueCritical))
+	if err != nil {
+		log.Error().Err(err).Int64("report_id", result.Report.ID).Msg("failed to distribute reconciliation report")
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hibiken/asynq"
+	db "github.com/mahanth/simplebank/db/sqlc"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	TaskReleaseExpiredHolds = "task:release_expired_holds"
+
+	// expiredHoldsBatch is how many expired holds are listed at a time.
+	expiredHoldsBatch = 100
+)
+
+// NewReleaseExpiredHoldsTask builds the task the scheduler enqueues to release the holds that expired.
+func NewReleaseExpiredHoldsTask() *asynq.Task {
+	return asynq.NewTask(TaskReleaseExpiredHolds, nil, asynq.Queue(QueueDefault), asynq.MaxRetry(0))
+}
+
+// ProcessTaskReleaseExpiredHolds marks every active hold past its expiry as expired and gives
+// its funds back to the available balance, expiring the transfer approval it secured if any.
+// A hold captured or released in the meantime is skipped.
+func (rtp *RedisTaskProcessor) ProcessTaskReleaseExpiredHolds(ctx context.Context, task *asynq.Task) error {
+	released := 0
+	for {
+		holds, err := rtp.store.ListExpiredAccountHolds(ctx, expiredHoldsBatch)
+		if err != nil {
+			return fmt.Errorf("failed to list expired holds: %w", err)
+		}
+
+		for _, hold := range holds {
+			_, err = rtp.store.ReleaseHoldTx(ctx, db.ReleaseHoldTxParams{
+				HoldID:  hold.ID,
+				Expired: true,
+			})
+			if err != nil {
+				if errors.Is(err, db.ErrHoldNotActive) {
+					continue
+				}
+				return fmt.Errorf("failed to release hold %d: %w", hold.ID, err)
+			}
+			released++
+		}
+
+		if len(holds) < expiredHoldsBatch {
+			break
+		}
+	}
+
+	if released > 0 {
+		log.Info().Int("count", released).Msg("released expired holds")
+	}
+	return nil
+}
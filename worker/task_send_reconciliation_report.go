@@ -0,0 +1,93 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hibiken/asynq"
+	db "github.com/mahanth/simplebank/db/sqlc"
+	"github.com/mahanth/simplebank/util"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	TaskSendReconciliationReport = "task:send_reconciliation_report"
+)
+
+type PayloadSendReconciliationReport struct {
+	ReportID int64 `json:"report_id"`
+}
+
+func (rtd *RedisTaskDistributor) DistributeTaskSendReconciliationReport(ctx context.Context, payload *PayloadSendReconciliationReport, options ...asynq.Option) error {
+	jsonPayload, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal payload: %w", err)
+	}
+
+	task := asynq.NewTask(TaskSendReconciliationReport, jsonPayload, options...)
+
+	taskInfo, err := rtd.client.EnqueueContext(ctx, task)
+	if err != nil {
+		return fmt.Errorf("failed to enqueue task: %w", err)
+	}
+
+	log.Info().Str("type", task.Type()).Str("queue", taskInfo.Queue).Msg("enqueued task")
+	return nil
+}
+
+func (rtp *RedisTaskProcessor) ProcessTaskSendReconciliationReport(ctx context.Context, task *asynq.Task) error {
+	var payload PayloadSendReconciliationReport
+	err := json.Unmarshal(task.Payload(), &payload)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal payload: %w", asynq.SkipRetry)
+	}
+
+	report, err := rtp.store.GetReconciliationReport(ctx, payload.ReportID)
+	if err != nil {
+		return fmt.Errorf("failed to get reconciliation report %d: %w", payload.ReportID, err)
+	}
+
+	var discrepancies []db.Discrepancy
+	err = json.Unmarshal(report.Discrepancies, &discrepancies)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal discrepancies of report %d: %w", report.ID, asynq.SkipRetry)
+	}
+
+	return rtp.sendReconciliationReport(ctx, report, discrepancies)
+}
+
+// sendReconciliationReport emails the discrepancies of a report to every banker.
+func (rtp *RedisTaskProcessor) sendReconciliationReport(ctx context.Context, report db.ReconciliationReport, discrepancies []db.Discrepancy) error {
+	bankers, err := rtp.store.ListUsersByRole(ctx, util.BankerRole)
+	if err != nil {
+		return fmt.Errorf("failed to list bankers: %w", err)
+	}
+	if len(bankers) == 0 {
+		log.Warn().Int64("report_id", report.ID).Msg("no bankers to notify of reconciliation discrepancies")
+		return nil
+	}
+
+	to := make([]string, 0, len(bankers))
+	for _, banker := range bankers {
+		to = append(to, banker.Email)
+	}
+
+	subject := fmt.Sprintf("Ledger reconciliation #%d found %d discrepancies", report.ID, report.DiscrepancyCount)
+
+	var content strings.Builder
+	fmt.Fprintf(&content, "Hello,\n\n"+
+		"The ledger reconciliation run by %s at %s found the following discrepancies:\n\n",
+		report.TriggeredBy, report.CreatedAt.Time.UTC().Format("2006-01-02 15:04:05 MST"))
+	for _, discrepancy := range discrepancies {
+		fmt.Fprintf(&content, "- [%s] %s\n", discrepancy.Kind, discrepancy.Detail)
+	}
+	content.WriteString("\nPlease investigate before the next run.\n")
+
+	err = rtp.mailer.SendEmail(subject, content.String(), to, nil, nil, nil)
+	if err != nil {
+		return fmt.Errorf("failed to send reconciliation report %d: %w", report.ID, err)
+	}
+	return nil
+}
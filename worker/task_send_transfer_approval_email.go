@@ -0,0 +1,91 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hibiken/asynq"
+	db "github.com/mahanth/simplebank/db/sqlc"
+	"github.com/mahanth/simplebank/util"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	TaskSendTransferApprovalEmail = "task:send_transfer_approval_email"
+)
+
+type PayloadSendTransferApprovalEmail struct {
+	ApprovalID int64 `json:"approval_id"`
+}
+
+func (rtd *RedisTaskDistributor) DistributeTaskSendTransferApprovalEmail(ctx context.Context, payload *PayloadSendTransferApprovalEmail, options ...asynq.Option) error {
+	jsonPayload, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal payload: %w", err)
+	}
+
+	task := asynq.NewTask(TaskSendTransferApprovalEmail, jsonPayload, options...)
+
+	taskInfo, err := rtd.client.EnqueueContext(ctx, task)
+	if err != nil {
+		return fmt.Errorf("failed to enqueue task: %w", err)
+	}
+
+	log.Info().Str("type", task.Type()).Str("queue", taskInfo.Queue).Msg("enqueued task")
+	return nil
+}
+
+// ProcessTaskSendTransferApprovalEmail asks every banker but the requester to decide a pending
+// transfer approval. An approval decided or expired before the task runs needs no email.
+func (rtp *RedisTaskProcessor) ProcessTaskSendTransferApprovalEmail(ctx context.Context, task *asynq.Task) error {
+	var payload PayloadSendTransferApprovalEmail
+	err := json.Unmarshal(task.Payload(), &payload)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal payload: %w", asynq.SkipRetry)
+	}
+
+	approval, err := rtp.store.GetTransferApproval(ctx, payload.ApprovalID)
+	if err != nil {
+		return fmt.Errorf("failed to get transfer approval %d: %w", payload.ApprovalID, err)
+	}
+	if approval.Status != db.TransferApprovalStatusPending {
+		log.Info().Int64("approval_id", approval.ID).Str("status", approval.Status).Msg("transfer approval no longer pending")
+		return nil
+	}
+
+	bankers, err := rtp.store.ListUsersByRole(ctx, util.BankerRole)
+	if err != nil {
+		return fmt.Errorf("failed to list bankers: %w", err)
+	}
+
+	to := make([]string, 0, len(bankers))
+	for _, banker := range bankers {
+		if banker.Username != approval.RequestedBy {
+			to = append(to, banker.Email)
+		}
+	}
+	if len(to) == 0 {
+		log.Warn().Int64("approval_id", approval.ID).Msg("no bankers to approve transfer")
+		return nil
+	}
+
+	fromAccount, err := rtp.store.GetAccount(ctx, approval.FromAccountID)
+	if err != nil {
+		return fmt.Errorf("failed to get account %d: %w", approval.FromAccountID, err)
+	}
+
+	subject := fmt.Sprintf("Transfer #%d awaits your approval", approval.ID)
+	content := fmt.Sprintf("Hello,\n\n"+
+		"%s asked to transfer %s from account %d to account %d.\n"+
+		"The transfer needs the approval of another banker and expires at %s unless it is decided.\n",
+		approval.RequestedBy, util.Money{Amount: approval.Amount, Currency: fromAccount.Currency},
+		approval.FromAccountID, approval.ToAccountID,
+		approval.ExpiresAt.Time.UTC().Format("2006-01-02 15:04:05 MST"))
+
+	err = rtp.mailer.SendEmail(subject, content, to, nil, nil, nil)
+	if err != nil {
+		return fmt.Errorf("failed to send transfer approval %d email: %w", approval.ID, err)
+	}
+	return nil
+}
@@ -31,7 +31,7 @@ func (rtd *RedisTaskDistributor) DistributeTaskSendVerifyEmail(ctx context.Conte
 	taskInfo, err := rtd.client.EnqueueContext(ctx, task)
 
 	if err != nil {
-		fmt.Errorf("failed to enqueue task %w", err)
+		return fmt.Errorf("failed to enqueue task %w", err)
 	}
 
 	fmt.Println("enqeued task ", taskInfo.ID)
@@ -45,7 +45,8 @@ func (rtp *RedisTaskProcessor) ProcessTaskSendVerifyEmail(ctx context.Context, t
 		return fmt.Errorf("failed to unmarshal payload: %w", asynq.SkipRetry)
 	}
 
-	user, err := rtp.store.GetUser(ctx, payload.Username)
+	// the user was created moments ago, so a replica may not have it yet
+	user, err := rtp.store.GetUser(db.WithReadYourWrites(ctx), payload.Username)
 
 	if err != nil {
 		return fmt.Errorf("failed to get user with username %s: %w", payload.Username, err)